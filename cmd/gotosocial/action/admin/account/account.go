@@ -307,3 +307,45 @@ var Password action.GTSAction = func(ctx context.Context) error {
 
 	return nil
 }
+
+// ResetTwoFactor disables two-factor authentication on a user, discarding their
+// secret and recovery codes, eg., when they've lost access to their authenticator app.
+var ResetTwoFactor action.GTSAction = func(ctx context.Context) error {
+	var state state.State
+	state.Caches.Init()
+
+	dbConn, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return fmt.Errorf("error creating dbservice: %s", err)
+	}
+
+	// Set the state DB connection
+	state.DB = dbConn
+
+	username := config.GetAdminAccountUsername()
+	if username == "" {
+		return errors.New("no username set")
+	}
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	a, err := dbConn.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	u, err := dbConn.GetUserByAccountID(ctx, a.ID)
+	if err != nil {
+		return err
+	}
+
+	u.TwoFactorSecret = ""
+	u.TwoFactorEnabledAt = time.Time{}
+	u.TwoFactorRecoveryCodes = nil
+	if err := dbConn.UpdateUser(ctx, u, "two_factor_secret", "two_factor_enabled_at", "two_factor_recovery_codes"); err != nil {
+		return err
+	}
+
+	return dbConn.Stop(ctx)
+}
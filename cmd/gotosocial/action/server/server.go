@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -55,6 +56,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/web"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 
 	// Inherit memory limit if set from cgroup
 	_ "github.com/KimMachineGun/automemlimit"
@@ -119,6 +121,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	}
 	oauthServer := oauth.New(ctx, dbService)
 	transportController := transport.NewController(dbService, federatingDB, &federation.Clock{}, client)
+	transportController.Start()
 	federator := federation.NewFederator(dbService, federatingDB, transportController, typeConverter, mediaManager)
 
 	// decide whether to create a noop email sender (won't send emails) or a real one
@@ -137,8 +140,11 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		}
 	}
 
+	// create the web push sender, which stores its vapid keys in the db
+	webPushSender := webpush.NewSender(dbService, client)
+
 	// create the message processor using the other services we've created so far
-	processor := processing.NewProcessor(typeConverter, federator, oauthServer, mediaManager, storage, dbService, emailSender, clientWorker, fedWorker)
+	processor := processing.NewProcessor(typeConverter, federator, oauthServer, mediaManager, storage, dbService, emailSender, webPushSender, net.DefaultResolver, clientWorker, fedWorker)
 	if err := processor.Start(); err != nil {
 		return fmt.Errorf("error creating processor: %s", err)
 	}
@@ -238,6 +244,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	log.Infof("received signal %s, shutting down", sig)
 
 	// close down all running services in order
+	transportController.Stop()
 	if err := gts.Stop(ctx); err != nil {
 		return fmt.Errorf("error closing gotosocial service: %s", err)
 	}
@@ -121,6 +121,19 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccountPassword(adminAccountPasswordCmd)
 	adminAccountCmd.AddCommand(adminAccountPasswordCmd)
 
+	adminAccountResetTwoFactorCmd := &cobra.Command{
+		Use:   "reset-2fa",
+		Short: "disable two-factor authentication for the given local account, eg., if they lost their authenticator app and recovery codes",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.ResetTwoFactor)
+		},
+	}
+	config.AddAdminAccount(adminAccountResetTwoFactorCmd)
+	adminAccountCmd.AddCommand(adminAccountResetTwoFactorCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
 	/*
@@ -169,6 +169,17 @@ func ExtractPublished(i WithPublished) (time.Time, error) {
 	return t, nil
 }
 
+// ExtractUpdated extracts the time an item was last updated,
+// or the zero time if this property is not set.
+func ExtractUpdated(i WithUpdated) time.Time {
+	updatedProp := i.GetActivityStreamsUpdated()
+	if updatedProp == nil || !updatedProp.IsXMLSchemaDateTime() {
+		return time.Time{}
+	}
+
+	return updatedProp.Get()
+}
+
 // ExtractIconURL extracts a URL to a supported image file from something like:
 //
 //	"icon": {
@@ -283,6 +294,14 @@ func ExtractURL(i WithURL) (*url.URL, error) {
 		if iter.IsIRI() && iter.GetIRI() != nil {
 			return iter.GetIRI(), nil
 		}
+
+		// some implementations (eg., funkwhale)
+		// give the url as a Link with an href
+		if iter.IsActivityStreamsLink() {
+			if href := iter.GetActivityStreamsLink().GetActivityStreamsHref(); href != nil && href.GetIRI() != nil {
+				return href.GetIRI(), nil
+			}
+		}
 	}
 
 	return nil, errors.New("could not extract url")
@@ -405,22 +424,44 @@ func ExtractHashtags(i WithTag) ([]*gtsmodel.Tag, error) {
 	if tagsProp == nil {
 		return tags, nil
 	}
-	for iter := tagsProp.Begin(); iter != tagsProp.End(); iter = iter.Next() {
-		t := iter.GetType()
-		if t == nil {
-			continue
-		}
 
-		if t.GetTypeName() != "Hashtag" {
-			continue
+	// Hashtags from remote instances won't have been
+	// deserialized into a type, so we need the raw
+	// serialized values of the tag property to get them.
+	var rawTags []interface{}
+	if raw, err := tagsProp.Serialize(); err == nil {
+		if rawSlice, ok := raw.([]interface{}); ok {
+			rawTags = rawSlice
+		} else {
+			rawTags = []interface{}{raw}
 		}
+	}
 
-		hashtaggable, ok := t.(Hashtaggable)
-		if !ok {
+	idx := -1
+	for iter := tagsProp.Begin(); iter != tagsProp.End(); iter = iter.Next() {
+		idx++
+
+		var (
+			tag *gtsmodel.Tag
+			err error
+		)
+
+		if t := iter.GetType(); t != nil {
+			hashtaggable, ok := t.(Hashtaggable)
+			if !ok || t.GetTypeName() != TagHashtag {
+				continue
+			}
+			tag, err = ExtractHashtag(hashtaggable)
+		} else if idx < len(rawTags) {
+			raw, ok := rawTags[idx].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			tag, err = extractRawHashtag(raw)
+		} else {
 			continue
 		}
 
-		tag, err := ExtractHashtag(hashtaggable)
 		if err != nil {
 			continue
 		}
@@ -704,6 +745,99 @@ func ExtractSensitive(withSensitive WithSensitive) bool {
 	return false
 }
 
+// ExtractPollOptionables extracts the options of a poll, looking first in
+// its oneOf property (single choice), and then in its anyOf property (multiple
+// choice). The returned bool will be true if options were found in anyOf.
+func ExtractPollOptionables(pollable Pollable) ([]PollOptionable, bool) {
+	if oneOfProp := pollable.GetActivityStreamsOneOf(); oneOfProp != nil && oneOfProp.Len() > 0 {
+		options := make([]PollOptionable, 0, oneOfProp.Len())
+		for iter := oneOfProp.Begin(); iter != oneOfProp.End(); iter = iter.Next() {
+			if iter.IsActivityStreamsNote() {
+				options = append(options, iter.GetActivityStreamsNote())
+			}
+		}
+		return options, false
+	}
+
+	if anyOfProp := pollable.GetActivityStreamsAnyOf(); anyOfProp != nil && anyOfProp.Len() > 0 {
+		options := make([]PollOptionable, 0, anyOfProp.Len())
+		for iter := anyOfProp.Begin(); iter != anyOfProp.End(); iter = iter.Next() {
+			if iter.IsActivityStreamsNote() {
+				options = append(options, iter.GetActivityStreamsNote())
+			}
+		}
+		return options, true
+	}
+
+	return nil, false
+}
+
+// ExtractPollOptionVotes extracts the number of votes received by a poll
+// option, which is stored as the totalItems of its replies collection.
+// If this is not set, then 0 will be returned.
+func ExtractPollOptionVotes(withReplies WithReplies) int {
+	repliesProp := withReplies.GetActivityStreamsReplies()
+	if repliesProp == nil || !repliesProp.IsActivityStreamsCollection() {
+		return 0
+	}
+
+	collection := repliesProp.GetActivityStreamsCollection()
+	if collection == nil {
+		return 0
+	}
+
+	totalItemsProp := collection.GetActivityStreamsTotalItems()
+	if totalItemsProp == nil || !totalItemsProp.IsXMLSchemaNonNegativeInteger() {
+		return 0
+	}
+
+	return totalItemsProp.Get()
+}
+
+// ExtractEndTime extracts the endTime of an item, or
+// the zero time if this property is not set.
+func ExtractEndTime(withEndTime WithEndTime) time.Time {
+	endTimeProp := withEndTime.GetActivityStreamsEndTime()
+	if endTimeProp == nil || !endTimeProp.IsXMLSchemaDateTime() {
+		return time.Time{}
+	}
+
+	return endTimeProp.Get()
+}
+
+// ExtractClosed extracts when an item was closed. The closed property
+// may be either a time or a boolean; if it's a boolean true, then
+// the given fallback time will be returned. If the item isn't closed,
+// or the property isn't set, then the zero time will be returned.
+func ExtractClosed(withClosed WithClosed, fallback time.Time) time.Time {
+	closedProp := withClosed.GetActivityStreamsClosed()
+	if closedProp == nil {
+		return time.Time{}
+	}
+
+	for iter := closedProp.Begin(); iter != closedProp.End(); iter = iter.Next() {
+		switch {
+		case iter.IsXMLSchemaDateTime():
+			return iter.GetXMLSchemaDateTime()
+		case iter.IsXMLSchemaBoolean() && iter.GetXMLSchemaBoolean():
+			return fallback
+		}
+	}
+
+	return time.Time{}
+}
+
+// ExtractVotersCount extracts the number of unique accounts that
+// have voted in a poll, or 0 if this property is not set.
+func ExtractVotersCount(withVotersCount WithVotersCount) int {
+	votersCountProp := withVotersCount.GetTootVotersCount()
+	if votersCountProp == nil || !votersCountProp.IsXMLSchemaNonNegativeInteger() {
+		return 0
+	}
+
+	return votersCountProp.Get()
+}
+
 // ExtractSharedInbox extracts the sharedInbox URI properly from an Actor.
 // Returns nil if this property is not set.
 func ExtractSharedInbox(withEndpoints WithEndpoints) *url.URL {
@@ -733,3 +867,54 @@ func ExtractSharedInbox(withEndpoints WithEndpoints) *url.URL {
 
 	return nil
 }
+
+// ExtractAlsoKnownAs extracts the alsoKnownAs URIs of an account, ie., the
+// other accounts that it is also known as. Since alsoKnownAs isn't part of the
+// ActivityStreams vocabulary, it is taken from the unknown properties of the account.
+// Returns nil if this property is not set.
+func ExtractAlsoKnownAs(i WithUnknownProperties) []*url.URL {
+	var uris []*url.URL
+
+	switch v := i.GetUnknownProperties()[PropertyAlsoKnownAs].(type) {
+	case []interface{}:
+		for _, item := range v {
+			if uri := iriFromUnknown(item); uri != nil {
+				uris = append(uris, uri)
+			}
+		}
+	default:
+		if uri := iriFromUnknown(v); uri != nil {
+			uris = append(uris, uri)
+		}
+	}
+
+	return uris
+}
+
+// ExtractMovedTo extracts the movedTo URI of an account, ie., the account that it
+// has moved to. Since movedTo isn't part of the ActivityStreams vocabulary, it is
+// taken from the unknown properties of the account.
+// Returns nil if this property is not set.
+func ExtractMovedTo(i WithUnknownProperties) *url.URL {
+	return iriFromUnknown(i.GetUnknownProperties()[PropertyMovedTo])
+}
+
+// iriFromUnknown returns the IRI of an unknown property value, which
+// may be given either as a plain string or as an object with an id.
+func iriFromUnknown(v interface{}) *url.URL {
+	if m, ok := v.(map[string]interface{}); ok {
+		v = m["id"]
+	}
+
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return nil
+	}
+
+	uri, err := url.Parse(s)
+	if err != nil {
+		return nil
+	}
+
+	return uri
+}
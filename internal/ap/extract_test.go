@@ -99,6 +99,52 @@ func noteWithMentions1() vocab.ActivityStreamsNote {
 	return note
 }
 
+func noteWithHashtags1() vocab.ActivityStreamsNote {
+	noteJson := []byte(`
+{
+	"@context": [
+		"https://www.w3.org/ns/activitystreams",
+		{
+			"Hashtag": "as:Hashtag"
+		}
+	],
+	"id": "https://example.org/users/someone/statuses/109882372434470001",
+	"type": "Note",
+	"attributedTo": "https://example.org/users/someone",
+	"content": "<p>some <a href=\"https://example.org/tags/Welcome\" class=\"mention hashtag\" rel=\"tag\">#<span>Welcome</span></a> @f0x <a href=\"https://example.org/tags/fediverse\" class=\"mention hashtag\" rel=\"tag\">#<span>fediverse</span></a></p>",
+	"tag": [
+		{
+			"type": "Hashtag",
+			"href": "https://example.org/tags/welcome",
+			"name": "#Welcome"
+		},
+		{
+			"type": "Mention",
+			"href": "https://gts.superseriousbusiness.org/users/f0x",
+			"name": "@f0x@superseriousbusiness.org"
+		},
+		{
+			"type": "Hashtag",
+			"href": "https://example.org/tags/fediverse",
+			"name": "#fediverse"
+		}
+	]
+}`)
+
+	var jsonAsMap map[string]interface{}
+	err := json.Unmarshal(noteJson, &jsonAsMap)
+	if err != nil {
+		panic(err)
+	}
+
+	t, err := streams.ToType(context.Background(), jsonAsMap)
+	if err != nil {
+		panic(err)
+	}
+
+	return t.(vocab.ActivityStreamsNote)
+}
+
 func addressable1() ap.Addressable {
 	// make a note addressed to public with followers in cc
 	note := streams.NewActivityStreamsNote()
@@ -190,6 +236,7 @@ type ExtractTestSuite struct {
 	document1         vocab.ActivityStreamsDocument
 	attachment1       vocab.ActivityStreamsAttachmentProperty
 	noteWithMentions1 vocab.ActivityStreamsNote
+	noteWithHashtags1 vocab.ActivityStreamsNote
 	addressable1      ap.Addressable
 	addressable2      ap.Addressable
 	addressable3      ap.Addressable
@@ -201,6 +248,7 @@ func (suite *ExtractTestSuite) SetupTest() {
 	suite.document1 = document1()
 	suite.attachment1 = attachment1()
 	suite.noteWithMentions1 = noteWithMentions1()
+	suite.noteWithHashtags1 = noteWithHashtags1()
 	suite.addressable1 = addressable1()
 	suite.addressable2 = addressable2()
 	suite.addressable3 = addressable3()
@@ -19,6 +19,7 @@
 package ap_test
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -39,6 +40,28 @@ func (suite *ExtractAttachmentsTestSuite) TestExtractAttachmentMissingURL() {
 	suite.Nil(attachment)
 }
 
+func (suite *ExtractAttachmentsTestSuite) TestExtractAttachmentLinkURL() {
+	// audio as sent by eg. funkwhale, with the url as a link
+	a := streams.NewActivityStreamsAudio()
+
+	href := streams.NewActivityStreamsHrefProperty()
+	href.SetIRI(&url.URL{Scheme: "https", Host: "funkwhale.example.org", Path: "/api/v1/listen/some-track/"})
+	link := streams.NewActivityStreamsLink()
+	link.SetActivityStreamsHref(href)
+	urlProp := streams.NewActivityStreamsUrlProperty()
+	urlProp.AppendActivityStreamsLink(link)
+	a.SetActivityStreamsUrl(urlProp)
+
+	mediaType := streams.NewActivityStreamsMediaTypeProperty()
+	mediaType.Set("audio/mpeg")
+	a.SetActivityStreamsMediaType(mediaType)
+
+	attachment, err := ap.ExtractAttachment(a)
+	suite.NoError(err)
+	suite.Equal("https://funkwhale.example.org/api/v1/listen/some-track/", attachment.RemoteURL)
+	suite.Equal("audio/mpeg", attachment.File.ContentType)
+}
+
 func TestExtractAttachmentsTestSuite(t *testing.T) {
 	suite.Run(t, &ExtractAttachmentsTestSuite{})
 }
@@ -0,0 +1,81 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package ap_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ExtractHashtagsTestSuite struct {
+	ExtractTestSuite
+}
+
+func (suite *ExtractHashtagsTestSuite) TestExtractHashtags() {
+	note := suite.noteWithHashtags1
+
+	hashtags, err := ap.ExtractHashtags(note)
+	suite.NoError(err)
+	suite.Len(hashtags, 2)
+
+	h1 := hashtags[0]
+	suite.Equal("Welcome", h1.Name)
+	suite.Equal("https://example.org/tags/welcome", h1.URL)
+
+	h2 := hashtags[1]
+	suite.Equal("fediverse", h2.Name)
+	suite.Equal("https://example.org/tags/fediverse", h2.URL)
+}
+
+func (suite *ExtractHashtagsTestSuite) TestExtractHashtagsNone() {
+	note := suite.noteWithMentions1
+
+	hashtags, err := ap.ExtractHashtags(note)
+	suite.NoError(err)
+	suite.Empty(hashtags)
+}
+
+func (suite *ExtractHashtagsTestSuite) TestNewHashtag() {
+	note := streams.NewActivityStreamsNote()
+	tags := streams.NewActivityStreamsTagProperty()
+	tags.AppendActivityStreamsLink(ap.NewHashtag(testrig.URLMustParse("http://localhost:8080/tags/welcome"), "#welcome"))
+	note.SetActivityStreamsTag(tags)
+
+	ser, err := streams.Serialize(note)
+	suite.NoError(err)
+
+	b, err := json.Marshal(ser["tag"])
+	suite.NoError(err)
+	suite.Equal(`{"href":"http://localhost:8080/tags/welcome","name":"#welcome","type":"Hashtag"}`, string(b))
+
+	hashtags, err := ap.ExtractHashtags(note)
+	suite.NoError(err)
+	suite.Len(hashtags, 1)
+	suite.Equal("welcome", hashtags[0].Name)
+	suite.Equal("http://localhost:8080/tags/welcome", hashtags[0].URL)
+}
+
+func TestExtractHashtagsTestSuite(t *testing.T) {
+	suite.Run(t, &ExtractHashtagsTestSuite{})
+}
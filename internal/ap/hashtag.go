@@ -0,0 +1,99 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package ap
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// hashtag wraps an ActivityStreams Link so that it serializes
+// as a Hashtag. Hashtag is not a type that our activitystreams
+// library knows about, but since it's an extension of Link, the
+// wrapped Link can be set anywhere that a Link is permitted.
+type hashtag struct {
+	vocab.ActivityStreamsLink
+}
+
+// NewHashtag returns a new Hashtag with the given href and name.
+// The name should include the leading '#', eg., "#somehashtag".
+func NewHashtag(href *url.URL, name string) vocab.ActivityStreamsLink {
+	link := streams.NewActivityStreamsLink()
+
+	hrefProp := streams.NewActivityStreamsHrefProperty()
+	hrefProp.SetIRI(href)
+	link.SetActivityStreamsHref(hrefProp)
+
+	nameProp := streams.NewActivityStreamsNameProperty()
+	nameProp.AppendXMLSchemaString(name)
+	link.SetActivityStreamsName(nameProp)
+
+	return &hashtag{link}
+}
+
+// GetTypeName returns the name of this type, "Hashtag".
+func (h *hashtag) GetTypeName() string {
+	return TagHashtag
+}
+
+// Serialize converts this Hashtag into an interface representation
+// suitable for marshalling into a text or binary format.
+func (h *hashtag) Serialize() (map[string]interface{}, error) {
+	m, err := h.ActivityStreamsLink.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	m["type"] = TagHashtag
+	return m, nil
+}
+
+// extractRawHashtag returns a gtsmodel tag from the raw, serialized
+// form of a Hashtag. This is necessary because incoming Hashtags are
+// not deserialized into any type by our activitystreams library, and
+// so can only be found in their raw form.
+func extractRawHashtag(raw map[string]interface{}) (*gtsmodel.Tag, error) {
+	if t, _ := raw["type"].(string); t != TagHashtag {
+		return nil, errors.New("not a hashtag")
+	}
+
+	href, _ := raw["href"].(string)
+	if href == "" {
+		return nil, errors.New("no href prop")
+	}
+
+	hrefURL, err := url.Parse(href)
+	if err != nil {
+		return nil, err
+	}
+
+	name, _ := raw["name"].(string)
+	if name == "" {
+		return nil, errors.New("name prop empty")
+	}
+
+	return &gtsmodel.Tag{
+		URL:  hrefURL.String(),
+		Name: strings.TrimPrefix(name, "#"),
+	}, nil
+}
@@ -0,0 +1,56 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package ap
+
+// SetAlsoKnownAs sets the alsoKnownAs URIs of an account,
+// or removes the property if no URIs are given.
+func SetAlsoKnownAs(i WithUnknownProperties, uris []string) {
+	if len(uris) == 0 {
+		delete(i.GetUnknownProperties(), PropertyAlsoKnownAs)
+		return
+	}
+
+	values := make([]interface{}, 0, len(uris))
+	for _, uri := range uris {
+		values = append(values, uri)
+	}
+	i.GetUnknownProperties()[PropertyAlsoKnownAs] = values
+}
+
+// SetMovedTo sets the movedTo URI of an account,
+// or removes the property if the URI is empty.
+func SetMovedTo(i WithUnknownProperties, uri string) {
+	if uri == "" {
+		delete(i.GetUnknownProperties(), PropertyMovedTo)
+		return
+	}
+
+	i.GetUnknownProperties()[PropertyMovedTo] = uri
+}
+
+// SetFeaturedTags sets the featuredTags collection URI of an
+// account, or removes the property if the URI is empty.
+func SetFeaturedTags(i WithUnknownProperties, uri string) {
+	if uri == "" {
+		delete(i.GetUnknownProperties(), PropertyFeaturedTags)
+		return
+	}
+
+	i.GetUnknownProperties()[PropertyFeaturedTags] = uri
+}
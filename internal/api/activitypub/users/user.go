@@ -50,6 +50,10 @@ const (
 	FollowersPath = BasePath + "/" + uris.FollowersPath
 	// FollowingPath is for serving GET request's to a user's following list, with the given username key.
 	FollowingPath = BasePath + "/" + uris.FollowingPath
+	// FeaturedCollectionPath is for serving GET requests to a user's featured collection of pinned statuses, with the given username key.
+	FeaturedCollectionPath = BasePath + "/" + uris.CollectionsPath + "/" + uris.FeaturedPath
+	// FeaturedTagsCollectionPath is for serving GET requests to a user's collection of featured hashtags, with the given username key.
+	FeaturedTagsCollectionPath = BasePath + "/" + uris.CollectionsPath + "/" + uris.FeaturedTagsPath
 	// StatusPath is for serving GET requests to a particular status by a user, with the given username key and status ID
 	StatusPath = BasePath + "/" + uris.StatusesPath + "/:" + StatusIDKey
 	// StatusRepliesPath is for serving the replies collection of a status.
@@ -71,6 +75,8 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, InboxPath, m.InboxPOSTHandler)
 	attachHandler(http.MethodGet, FollowersPath, m.FollowersGETHandler)
 	attachHandler(http.MethodGet, FollowingPath, m.FollowingGETHandler)
+	attachHandler(http.MethodGet, FeaturedCollectionPath, m.FeaturedCollectionGETHandler)
+	attachHandler(http.MethodGet, FeaturedTagsCollectionPath, m.FeaturedTagsCollectionGETHandler)
 	attachHandler(http.MethodGet, StatusPath, m.StatusGETHandler)
 	attachHandler(http.MethodGet, StatusRepliesPath, m.StatusRepliesGETHandler)
 	attachHandler(http.MethodGet, OutboxPath, m.OutboxGETHandler)
@@ -35,6 +35,8 @@ const (
 
 	// AuthSignInPath is the API path for users to sign in through
 	AuthSignInPath = "/sign_in"
+	// AuthTwoFactorPath users land here after signing in with a password, if they have two-factor authentication enabled
+	AuthTwoFactorPath = "/2fa"
 	// AuthCheckYourEmailPath users land here after registering a new account, instructs them to confirm their email
 	AuthCheckYourEmailPath = "/check_your_email"
 	// AuthWaitForApprovalPath users land here after confirming their email
@@ -62,18 +64,20 @@ const (
 		params / session keys
 	*/
 
-	callbackStateParam   = "state"
-	callbackCodeParam    = "code"
-	sessionUserID        = "userid"
-	sessionClientID      = "client_id"
-	sessionRedirectURI   = "redirect_uri"
-	sessionForceLogin    = "force_login"
-	sessionResponseType  = "response_type"
-	sessionScope         = "scope"
-	sessionInternalState = "internal_state"
-	sessionClientState   = "client_state"
-	sessionClaims        = "claims"
-	sessionAppID         = "app_id"
+	callbackStateParam    = "state"
+	callbackCodeParam     = "code"
+	sessionUserID         = "userid"
+	sessionPendingUserID  = "pending_userid"   // user who gave a correct password, but still has to pass two-factor auth
+	sessionTwoFactorTries = "two_factor_tries" // number of incorrect two-factor codes given for the pending user
+	sessionClientID       = "client_id"
+	sessionRedirectURI    = "redirect_uri"
+	sessionForceLogin     = "force_login"
+	sessionResponseType   = "response_type"
+	sessionScope          = "scope"
+	sessionInternalState  = "internal_state"
+	sessionClientState    = "client_state"
+	sessionClaims         = "claims"
+	sessionAppID          = "app_id"
 )
 
 type Module struct {
@@ -97,6 +101,8 @@ func New(db db.DB, processor processing.Processor, idp oidc.IDP) *Module {
 func (m *Module) RouteAuth(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, AuthSignInPath, m.SignInGETHandler)
 	attachHandler(http.MethodPost, AuthSignInPath, m.SignInPOSTHandler)
+	attachHandler(http.MethodGet, AuthTwoFactorPath, m.TwoFactorGETHandler)
+	attachHandler(http.MethodPost, AuthTwoFactorPath, m.TwoFactorPOSTHandler)
 	attachHandler(http.MethodGet, AuthCallbackPath, m.CallbackGETHandler)
 }
 
@@ -48,6 +48,7 @@ func (m *Module) AuthorizeGETHandler(c *gin.Context) {
 
 	// UserID will be set in the session by AuthorizePOSTHandler if the caller has already gone through the authentication flow
 	// If it's not set, then we don't know yet who the user is, so we need to redirect them to the sign in page.
+	// Users with two-factor authentication enabled only get a UserID once they've passed TwoFactorPOSTHandler too.
 	userID, ok := s.Get(sessionUserID).(string)
 	if !ok || userID == "" {
 		form := &apimodel.OAuthAuthorize{}
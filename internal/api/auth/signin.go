@@ -80,7 +80,8 @@ func (m *Module) SignInGETHandler(c *gin.Context) {
 
 // SignInPOSTHandler should be served at https://example.org/auth/sign_in.
 // The idea is to present a sign in page to the user, where they can enter their username and password.
-// The handler will then redirect to the auth handler served at /auth
+// The handler will then redirect to the auth handler served at /auth, or to
+// the two-factor page served at /auth/2fa if the user has two-factor auth enabled.
 func (m *Module) SignInPOSTHandler(c *gin.Context) {
 	s := sessions.Default(c)
 
@@ -99,6 +100,30 @@ func (m *Module) SignInPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	user, err := m.db.GetUserByID(c.Request.Context(), userid)
+	if err != nil {
+		err := fmt.Errorf("error getting user %s: %s", userid, err)
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !user.TwoFactorEnabledAt.IsZero() {
+		// the password was correct, but the user isn't signed
+		// in until they've also given a two-factor code, so keep
+		// them out of sessionUserID until TwoFactorPOSTHandler
+		s.Delete(sessionUserID)
+		s.Delete(sessionTwoFactorTries)
+		s.Set(sessionPendingUserID, userid)
+		if err := s.Save(); err != nil {
+			err := fmt.Errorf("error saving user id onto session: %s", err)
+			apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+			return
+		}
+
+		c.Redirect(http.StatusFound, "/auth"+AuthTwoFactorPath)
+		return
+	}
+
 	s.Set(sessionUserID, userid)
 	if err := s.Save(); err != nil {
 		err := fmt.Errorf("error saving user id onto session: %s", err)
@@ -0,0 +1,152 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// maxTwoFactorTries is how many incorrect two-factor codes
+// can be given after a correct password, before the password
+// has to be given again.
+const maxTwoFactorTries = 5
+
+// twoFactor just wraps a form-submitted two-factor code,
+// which can be either a TOTP code or a recovery code.
+type twoFactor struct {
+	Code string `form:"code"`
+}
+
+// TwoFactorGETHandler should be served at https://example.org/auth/2fa.
+// Users land here after giving a correct password on the sign in page, if they have two-factor
+// authentication enabled. It presents a page where they can enter a code from their authenticator app,
+// or one of their recovery codes. The form will then POST to TwoFactorPOSTHandler.
+func (m *Module) TwoFactorGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.HTMLAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	// if there's no user waiting for their second step,
+	// they need to start at the beginning of the sign in
+	s := sessions.Default(c)
+	if userID, ok := s.Get(sessionPendingUserID).(string); !ok || userID == "" {
+		c.Redirect(http.StatusSeeOther, "/auth"+AuthSignInPath)
+		return
+	}
+
+	instance, errWithCode := m.processor.InstanceGetV1(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.HTML(http.StatusOK, "2fa.tmpl", gin.H{
+		"instance": instance,
+	})
+}
+
+// TwoFactorPOSTHandler should be served at https://example.org/auth/2fa.
+// If the given code is correct for the user who gave their password on the
+// sign in page, they're signed in, and redirected to the auth handler served at /auth.
+func (m *Module) TwoFactorPOSTHandler(c *gin.Context) {
+	s := sessions.Default(c)
+
+	userID, ok := s.Get(sessionPendingUserID).(string)
+	if !ok || userID == "" {
+		m.clearSession(s)
+		err := fmt.Errorf("key %s was not found in session", sessionPendingUserID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &twoFactor{}
+	if err := c.ShouldBind(form); err != nil {
+		m.clearSession(s)
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	if form.Code == "" {
+		// don't clear session here, so the user can just go back and try again
+		err := errors.New("two-factor code was not provided")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	user, err := m.db.GetUserByID(c.Request.Context(), userID)
+	if err != nil {
+		m.clearSession(s)
+		safe := fmt.Sprintf("user with id %s could not be retrieved", userID)
+		var errWithCode gtserror.WithCode
+		if err == db.ErrNoEntries {
+			errWithCode = gtserror.NewErrorBadRequest(err, safe, oauth.HelpfulAdvice)
+		} else {
+			errWithCode = gtserror.NewErrorInternalError(err, safe, oauth.HelpfulAdvice)
+		}
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	if errWithCode := m.processor.UserTwoFactorCheck(c.Request.Context(), user, form.Code); errWithCode != nil {
+		// don't clear session here either, in case the code
+		// just expired while being typed in, but only allow
+		// a few tries so that codes can't be guessed
+		tries, _ := s.Get(sessionTwoFactorTries).(int)
+		tries++
+		if tries >= maxTwoFactorTries {
+			// make them start over with their password
+			s.Delete(sessionPendingUserID)
+			s.Delete(sessionTwoFactorTries)
+			err := fmt.Errorf("user %s gave %d incorrect two-factor codes", userID, tries)
+			errWithCode = gtserror.NewErrorUnauthorized(err, "too many incorrect two-factor codes, please sign in again")
+		} else {
+			s.Set(sessionTwoFactorTries, tries)
+		}
+
+		if err := s.Save(); err != nil {
+			err := fmt.Errorf("error saving two-factor tries onto session: %s", err)
+			apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+			return
+		}
+
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	s.Delete(sessionPendingUserID)
+	s.Delete(sessionTwoFactorTries)
+	s.Set(sessionUserID, userID)
+	if err := s.Save(); err != nil {
+		err := fmt.Errorf("error saving user id onto session: %s", err)
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err, oauth.HelpfulAdvice), m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Redirect(http.StatusFound, "/oauth"+OauthAuthorizePath)
+}
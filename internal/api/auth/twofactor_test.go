@@ -0,0 +1,166 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/auth"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+)
+
+type AuthTwoFactorTestSuite struct {
+	AuthStandardTestSuite
+}
+
+const (
+	sessionPendingUserID  = "pending_userid"
+	sessionTwoFactorTries = "two_factor_tries"
+)
+
+// enableTwoFactor enables two-factor auth
+// for the given user, returning the secret.
+func (suite *AuthTwoFactorTestSuite) enableTwoFactor(user *gtsmodel.User) string {
+	secret, err := totp.NewSecret()
+	suite.NoError(err)
+
+	user.TwoFactorSecret = secret
+	user.TwoFactorEnabledAt = time.Now()
+	suite.NoError(suite.db.UpdateUser(context.Background(), user, "two_factor_secret", "two_factor_enabled_at"))
+
+	return secret
+}
+
+func (suite *AuthTwoFactorTestSuite) TestSignInWithoutTwoFactor() {
+	user := suite.testUsers["local_account_1"]
+
+	form := url.Values{"username": {user.Email}, "password": {"password"}}
+	ctx, recorder := suite.newContext(http.MethodPost, auth.AuthSignInPath, []byte(form.Encode()), "application/x-www-form-urlencoded")
+	suite.authModule.SignInPOSTHandler(ctx)
+
+	suite.Equal(http.StatusFound, ctx.Writer.Status())
+	suite.Equal("/oauth"+auth.OauthAuthorizePath, recorder.Header().Get("Location"))
+	suite.Equal(user.ID, sessions.Default(ctx).Get(sessionUserID))
+}
+
+func (suite *AuthTwoFactorTestSuite) TestSignInWithTwoFactor() {
+	user := suite.testUsers["local_account_1"]
+	suite.enableTwoFactor(user)
+
+	form := url.Values{"username": {user.Email}, "password": {"password"}}
+	ctx, recorder := suite.newContext(http.MethodPost, auth.AuthSignInPath, []byte(form.Encode()), "application/x-www-form-urlencoded")
+	suite.authModule.SignInPOSTHandler(ctx)
+
+	// the user has to pass the second step before they're signed in
+	suite.Equal(http.StatusFound, ctx.Writer.Status())
+	suite.Equal("/auth"+auth.AuthTwoFactorPath, recorder.Header().Get("Location"))
+	suite.Nil(sessions.Default(ctx).Get(sessionUserID))
+	suite.Equal(user.ID, sessions.Default(ctx).Get(sessionPendingUserID))
+}
+
+func (suite *AuthTwoFactorTestSuite) TestTwoFactorGET() {
+	ctx, recorder := suite.newContext(http.MethodGet, auth.AuthTwoFactorPath, nil, "")
+	s := sessions.Default(ctx)
+	s.Set(sessionPendingUserID, suite.testUsers["local_account_1"].ID)
+	suite.NoError(s.Save())
+
+	suite.authModule.TwoFactorGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Contains(recorder.Body.String(), `<form action="/auth/2fa" method="POST">`)
+}
+
+func (suite *AuthTwoFactorTestSuite) TestTwoFactorGETNotPending() {
+	ctx, recorder := suite.newContext(http.MethodGet, auth.AuthTwoFactorPath, nil, "")
+	suite.authModule.TwoFactorGETHandler(ctx)
+
+	suite.Equal(http.StatusSeeOther, recorder.Code)
+	suite.Equal("/auth"+auth.AuthSignInPath, recorder.Header().Get("Location"))
+}
+
+func (suite *AuthTwoFactorTestSuite) TestTwoFactorPOST() {
+	user := suite.testUsers["local_account_1"]
+	secret := suite.enableTwoFactor(user)
+
+	code, err := totp.Code(secret, time.Now())
+	suite.NoError(err)
+
+	form := url.Values{"code": {code}}
+	ctx, recorder := suite.newContext(http.MethodPost, auth.AuthTwoFactorPath, []byte(form.Encode()), "application/x-www-form-urlencoded")
+	s := sessions.Default(ctx)
+	s.Set(sessionPendingUserID, user.ID)
+	suite.NoError(s.Save())
+
+	suite.authModule.TwoFactorPOSTHandler(ctx)
+
+	suite.Equal(http.StatusFound, ctx.Writer.Status())
+	suite.Equal("/oauth"+auth.OauthAuthorizePath, recorder.Header().Get("Location"))
+	suite.Equal(user.ID, s.Get(sessionUserID))
+	suite.Nil(s.Get(sessionPendingUserID))
+}
+
+func (suite *AuthTwoFactorTestSuite) TestTwoFactorPOSTWrongCode() {
+	user := suite.testUsers["local_account_1"]
+	suite.enableTwoFactor(user)
+
+	form := url.Values{"code": {"not a code"}}
+	ctx, recorder := suite.newContext(http.MethodPost, auth.AuthTwoFactorPath, []byte(form.Encode()), "application/x-www-form-urlencoded")
+	s := sessions.Default(ctx)
+	s.Set(sessionPendingUserID, user.ID)
+	suite.NoError(s.Save())
+
+	suite.authModule.TwoFactorPOSTHandler(ctx)
+
+	// still not signed in, but free to try again
+	suite.Equal(http.StatusUnauthorized, recorder.Code)
+	suite.Nil(s.Get(sessionUserID))
+	suite.Equal(user.ID, s.Get(sessionPendingUserID))
+	suite.Equal(1, s.Get(sessionTwoFactorTries))
+}
+
+func (suite *AuthTwoFactorTestSuite) TestTwoFactorPOSTTooManyTries() {
+	user := suite.testUsers["local_account_1"]
+	suite.enableTwoFactor(user)
+
+	form := url.Values{"code": {"not a code"}}
+	ctx, recorder := suite.newContext(http.MethodPost, auth.AuthTwoFactorPath, []byte(form.Encode()), "application/x-www-form-urlencoded")
+	s := sessions.Default(ctx)
+	s.Set(sessionPendingUserID, user.ID)
+	s.Set(sessionTwoFactorTries, 4)
+	suite.NoError(s.Save())
+
+	suite.authModule.TwoFactorPOSTHandler(ctx)
+
+	// the fifth wrong code means starting over from the password
+	suite.Equal(http.StatusUnauthorized, recorder.Code)
+	suite.Contains(recorder.Body.String(), "too many incorrect two-factor codes, please sign in again")
+	suite.Nil(s.Get(sessionUserID))
+	suite.Nil(s.Get(sessionPendingUserID))
+	suite.Nil(s.Get(sessionTwoFactorTries))
+}
+
+func TestAuthTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, &AuthTwoFactorTestSuite{})
+}
@@ -60,6 +60,7 @@ type AccountStandardTestSuite struct {
 	testAccounts     map[string]*gtsmodel.Account
 	testAttachments  map[string]*gtsmodel.MediaAttachment
 	testStatuses     map[string]*gtsmodel.Status
+	testInvites      map[string]*gtsmodel.Invite
 
 	// module being tested
 	accountsModule *accounts.Module
@@ -73,6 +74,7 @@ func (suite *AccountStandardTestSuite) SetupSuite() {
 	suite.testAccounts = testrig.NewTestAccounts()
 	suite.testAttachments = testrig.NewTestAttachments()
 	suite.testStatuses = testrig.NewTestStatuses()
+	suite.testInvites = testrig.NewTestInvites()
 }
 
 func (suite *AccountStandardTestSuite) SetupTest() {
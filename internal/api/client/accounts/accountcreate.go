@@ -52,7 +52,7 @@ import (
 //	- application/json
 //
 //	security:
-//	- OAuth2 Application:
+//	- OAuth2 Bearer:
 //		- write:accounts
 //
 //	responses:
@@ -68,6 +68,8 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: unprocessable (email address on a blocked domain, or invite code not valid)
 //		'500':
 //			description: internal server error
 func (m *Module) AccountCreatePOSTHandler(c *gin.Context) {
@@ -118,7 +120,9 @@ func validateCreateAccount(form *apimodel.AccountCreateRequest) error {
 		return errors.New("form was nil")
 	}
 
-	if !config.GetAccountsRegistrationOpen() {
+	// an invite code lets people sign up even when registration
+	// is closed; the code itself is checked by the processor
+	if !config.GetAccountsRegistrationOpen() && form.InviteCode == "" {
 		return errors.New("registration is not open for this server")
 	}
 
@@ -142,7 +146,7 @@ func validateCreateAccount(form *apimodel.AccountCreateRequest) error {
 		return err
 	}
 
-	if err := validate.SignUpReason(form.Reason, config.GetAccountsReasonRequired()); err != nil {
+	if err := validate.SignUpReason(form.Reason, config.GetAccountsReasonRequired() && form.InviteCode == ""); err != nil {
 		return err
 	}
 
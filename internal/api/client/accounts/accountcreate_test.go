@@ -1,19 +1,173 @@
-// /*
-//    GoToSocial
-//    Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
 
-//    This program is free software: you can redistribute it and/or modify
-//    it under the terms of the GNU Affero General Public License as published by
-//    the Free Software Foundation, either version 3 of the License, or
-//    (at your option) any later version.
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
 
-//    This program is distributed in the hope that it will be useful,
-//    but WITHOUT ANY WARRANTY; without even the implied warranty of
-//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
-//    GNU Affero General Public License for more details.
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
 
-//    You should have received a copy of the GNU Affero General Public License
-//    along with this program.  If not, see <http://www.gnu.org/licenses/>.
-// */
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
 
 package accounts_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type AccountCreateTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AccountCreateTestSuite) newForm(email string) url.Values {
+	return url.Values{
+		"username":  {"new_account"},
+		"email":     {email},
+		"password":  {"verygoodnewpassword"},
+		"agreement": {"true"},
+		"locale":    {"en"},
+		"reason":    {"i would like to join this instance to talk about birds please"},
+	}
+}
+
+func (suite *AccountCreateTestSuite) postForm(form url.Values) (int, string) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, nil, accounts.BasePath, "")
+	ctx.Request.Form = form
+
+	suite.accountsModule.AccountCreatePOSTHandler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	return recorder.Code, string(b)
+}
+
+func (suite *AccountCreateTestSuite) createAccount(email string) (int, string) {
+	return suite.postForm(suite.newForm(email))
+}
+
+// createAccountWithInvite creates an account without
+// giving a reason, using the given invite code.
+func (suite *AccountCreateTestSuite) createAccountWithInvite(inviteCode string) (int, string) {
+	form := suite.newForm("new_account@example.org")
+	form.Del("reason")
+	form.Set("invite_code", inviteCode)
+	return suite.postForm(form)
+}
+
+// newUser returns the user created by the account create test.
+func (suite *AccountCreateTestSuite) newUser() *gtsmodel.User {
+	account, err := suite.db.GetAccountByUsernameDomain(context.Background(), "new_account", "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	user, err := suite.db.GetUserByAccountID(context.Background(), account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	user.Account = account
+
+	return user
+}
+
+func (suite *AccountCreateTestSuite) TestAccountCreateBlockedEmailDomain() {
+	code, body := suite.createAccount("someone@spam-mail.org")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+	suite.Equal(`{"error":"Unprocessable Entity: email addresses from this domain are not accepted on this instance"}`, body)
+}
+
+func (suite *AccountCreateTestSuite) TestAccountCreateBlockedEmailSubdomain() {
+	code, _ := suite.createAccount("someone@eu.spam-mail.org")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+}
+
+func (suite *AccountCreateTestSuite) TestAccountCreateBlockedEmailMX() {
+	// sneaky-inbox.com isn't blocked itself, but its mail servers are
+	code, _ := suite.createAccount("someone@sneaky-inbox.com")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+}
+
+func (suite *AccountCreateTestSuite) TestAccountCreateEmailTaken() {
+	code, _ := suite.createAccount("zork@example.org")
+	suite.Equal(http.StatusConflict, code)
+}
+
+func (suite *AccountCreateTestSuite) TestAccountCreateRegistrationClosed() {
+	config.SetAccountsRegistrationOpen(false)
+
+	code, body := suite.createAccount("new_account@example.org")
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: registration is not open for this server"}`, body)
+}
+
+func (suite *AccountCreateTestSuite) TestAccountCreateInviteRegistrationClosed() {
+	config.SetAccountsRegistrationOpen(false)
+	testInvite := suite.testInvites["local_account_1_invite"]
+
+	code, _ := suite.createAccountWithInvite(testInvite.Code)
+	suite.Equal(http.StatusOK, code)
+
+	// invite doesn't skip approval
+	user := suite.newUser()
+	suite.Equal(testInvite.ID, user.InviteID)
+	suite.False(*user.Approved)
+	suite.Empty(user.Account.Reason)
+
+	invite, err := suite.db.GetInviteByID(context.Background(), testInvite.ID)
+	suite.NoError(err)
+	suite.Equal(testInvite.Uses+1, invite.Uses)
+}
+
+func (suite *AccountCreateTestSuite) TestAccountCreateInviteSkipApproval() {
+	testInvite := suite.testInvites["admin_account_invite"]
+
+	code, _ := suite.createAccountWithInvite(testInvite.Code)
+	suite.Equal(http.StatusOK, code)
+
+	user := suite.newUser()
+	suite.Equal(testInvite.ID, user.InviteID)
+	suite.True(*user.Approved)
+
+	// that was its last use
+	form := suite.newForm("another_account@example.org")
+	form.Set("username", "another_account")
+	form.Set("invite_code", testInvite.Code)
+	code, body := suite.postForm(form)
+	suite.Equal(http.StatusUnprocessableEntity, code)
+	suite.Equal(`{"error":"Unprocessable Entity: invite code has expired or has no uses left"}`, body)
+}
+
+func (suite *AccountCreateTestSuite) TestAccountCreateInviteExpired() {
+	code, body := suite.createAccountWithInvite(suite.testInvites["admin_account_invite_expired"].Code)
+	suite.Equal(http.StatusUnprocessableEntity, code)
+	suite.Equal(`{"error":"Unprocessable Entity: invite code has expired or has no uses left"}`, body)
+}
+
+func (suite *AccountCreateTestSuite) TestAccountCreateInviteNotValid() {
+	code, body := suite.createAccountWithInvite("nope")
+	suite.Equal(http.StatusUnprocessableEntity, code)
+	suite.Equal(`{"error":"Unprocessable Entity: invite code is not valid"}`, body)
+}
+
+func TestAccountCreateTestSuite(t *testing.T) {
+	suite.Run(t, &AccountCreateTestSuite{})
+}
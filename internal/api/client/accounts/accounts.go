@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -69,8 +71,20 @@ const (
 	BlockPath = BasePathWithID + "/block"
 	// UnblockPath is for removing a block of an account
 	UnblockPath = BasePathWithID + "/unblock"
+	// MutePath is for creating or updating a mute of an account
+	MutePath = BasePathWithID + "/mute"
+	// UnmutePath is for removing a mute of an account
+	UnmutePath = BasePathWithID + "/unmute"
+	// GetListsPath is for showing lists owned by the requesting account which contain the target account
+	GetListsPath = BasePathWithID + "/lists"
+	// GetFeaturedTagsPath is for showing hashtags featured on the profile of the target account
+	GetFeaturedTagsPath = BasePathWithID + "/featured_tags"
 	// DeleteAccountPath is for deleting one's account via the API
 	DeleteAccountPath = BasePath + "/delete"
+	// AliasPath is for setting the aliases of one's account
+	AliasPath = BasePath + "/alias"
+	// MovePath is for moving one's account to another account
+	MovePath = BasePath + "/move"
 )
 
 type Module struct {
@@ -85,35 +99,49 @@ func New(processor processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	// create account
-	attachHandler(http.MethodPost, BasePath, m.AccountCreatePOSTHandler)
+	attachHandler(http.MethodPost, BasePath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.AccountCreatePOSTHandler)
 
 	// get account
-	attachHandler(http.MethodGet, BasePathWithID, m.AccountGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, middleware.RequireScope(oauth.ScopeReadAccounts), m.AccountGETHandler)
 
 	// delete account
-	attachHandler(http.MethodPost, DeleteAccountPath, m.AccountDeletePOSTHandler)
+	attachHandler(http.MethodPost, DeleteAccountPath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.AccountDeletePOSTHandler)
+
+	// set aliases of account, or move account
+	attachHandler(http.MethodPost, AliasPath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.AccountAliasPOSTHandler)
+	attachHandler(http.MethodPost, MovePath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.AccountMovePOSTHandler)
 
 	// verify account
-	attachHandler(http.MethodGet, VerifyPath, m.AccountVerifyGETHandler)
+	attachHandler(http.MethodGet, VerifyPath, middleware.RequireScope(oauth.ScopeReadAccounts), m.AccountVerifyGETHandler)
 
 	// modify account
-	attachHandler(http.MethodPatch, UpdateCredentialsPath, m.AccountUpdateCredentialsPATCHHandler)
+	attachHandler(http.MethodPatch, UpdateCredentialsPath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.AccountUpdateCredentialsPATCHHandler)
 
 	// get account's statuses
-	attachHandler(http.MethodGet, GetStatusesPath, m.AccountStatusesGETHandler)
+	attachHandler(http.MethodGet, GetStatusesPath, middleware.RequireScope(oauth.ScopeReadStatuses), m.AccountStatusesGETHandler)
 
 	// get following or followers
-	attachHandler(http.MethodGet, GetFollowersPath, m.AccountFollowersGETHandler)
-	attachHandler(http.MethodGet, GetFollowingPath, m.AccountFollowingGETHandler)
+	attachHandler(http.MethodGet, GetFollowersPath, middleware.RequireScope(oauth.ScopeReadAccounts), m.AccountFollowersGETHandler)
+	attachHandler(http.MethodGet, GetFollowingPath, middleware.RequireScope(oauth.ScopeReadAccounts), m.AccountFollowingGETHandler)
 
 	// get relationship with account
-	attachHandler(http.MethodGet, GetRelationshipsPath, m.AccountRelationshipsGETHandler)
+	attachHandler(http.MethodGet, GetRelationshipsPath, middleware.RequireScope(oauth.ScopeReadFollows), m.AccountRelationshipsGETHandler)
 
 	// follow or unfollow account
-	attachHandler(http.MethodPost, FollowPath, m.AccountFollowPOSTHandler)
-	attachHandler(http.MethodPost, UnfollowPath, m.AccountUnfollowPOSTHandler)
+	attachHandler(http.MethodPost, FollowPath, middleware.RequireScope(oauth.ScopeWriteFollows), m.AccountFollowPOSTHandler)
+	attachHandler(http.MethodPost, UnfollowPath, middleware.RequireScope(oauth.ScopeWriteFollows), m.AccountUnfollowPOSTHandler)
 
 	// block or unblock account
-	attachHandler(http.MethodPost, BlockPath, m.AccountBlockPOSTHandler)
-	attachHandler(http.MethodPost, UnblockPath, m.AccountUnblockPOSTHandler)
+	attachHandler(http.MethodPost, BlockPath, middleware.RequireScope(oauth.ScopeWriteBlocks), m.AccountBlockPOSTHandler)
+	attachHandler(http.MethodPost, UnblockPath, middleware.RequireScope(oauth.ScopeWriteBlocks), m.AccountUnblockPOSTHandler)
+
+	// mute or unmute account
+	attachHandler(http.MethodPost, MutePath, middleware.RequireScope(oauth.ScopeWriteMutes), m.AccountMutePOSTHandler)
+	attachHandler(http.MethodPost, UnmutePath, middleware.RequireScope(oauth.ScopeWriteMutes), m.AccountUnmutePOSTHandler)
+
+	// account lists
+	attachHandler(http.MethodGet, GetListsPath, middleware.RequireScope(oauth.ScopeReadLists), m.AccountListsGETHandler)
+
+	// account featured tags
+	attachHandler(http.MethodGet, GetFeaturedTagsPath, middleware.RequireScope(oauth.ScopeReadAccounts), m.AccountFeaturedTagsGETHandler)
 }
@@ -49,7 +49,7 @@ import (
 //	-
 //		name: discoverable
 //		in: formData
-//		description: Account should be made discoverable and shown in the profile directory (if enabled).
+//		description: Account should be made discoverable and shown in the profile directory (if enabled), and its posts included in full-text search results on this instance.
 //		type: boolean
 //	-
 //		name: bot
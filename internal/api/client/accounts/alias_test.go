@@ -0,0 +1,105 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package accounts_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AccountAliasTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AccountAliasTestSuite) TestAccountAliasPOSTHandler() {
+	aliasURI := suite.testAccounts["remote_account_1"].URI
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"also_known_as_uris[]": aliasURI,
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, accounts.AliasPath, w.FormDataContentType())
+
+	// call the handler
+	suite.accountsModule.AccountAliasPOSTHandler(ctx)
+
+	// we should have OK because our request was valid
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	apimodelAccount := &apimodel.Account{}
+	err = json.Unmarshal(b, apimodelAccount)
+	suite.NoError(err)
+
+	suite.Equal([]string{aliasURI}, apimodelAccount.Source.AlsoKnownAsURIs)
+
+	// the alias should be stored on the account
+	dbAccount, err := suite.db.GetAccountByID(ctx, suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	suite.True(dbAccount.HasAlias(aliasURI))
+}
+
+func (suite *AccountAliasTestSuite) TestAccountAliasPOSTHandlerInvalidURI() {
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"also_known_as_uris[]": "not a uri",
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, accounts.AliasPath, w.FormDataContentType())
+
+	// call the handler
+	suite.accountsModule.AccountAliasPOSTHandler(ctx)
+
+	// we should have StatusBadRequest because the uri was invalid
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: invalid alias uri not a uri"}`, string(b))
+}
+
+func TestAccountAliasTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountAliasTestSuite))
+}
@@ -0,0 +1,133 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package accounts_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AccountMoveTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AccountMoveTestSuite) SetupTest() {
+	// moving modifies the authed account, so
+	// make sure each test starts from scratch
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.AccountStandardTestSuite.SetupTest()
+}
+
+func (suite *AccountMoveTestSuite) move(password string, movedToURI string) *httptest.ResponseRecorder {
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"password":     password,
+			"moved_to_uri": movedToURI,
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, accounts.MovePath, w.FormDataContentType())
+
+	// call the handler
+	suite.accountsModule.AccountMovePOSTHandler(ctx)
+
+	return recorder
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandler() {
+	ctx := context.Background()
+
+	// the target needs to list zork as an alias
+	target, err := suite.db.GetAccountByID(ctx, suite.testAccounts["local_account_2"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	target.AlsoKnownAsURIs = []string{suite.testAccounts["local_account_1"].URI}
+	if err := suite.db.UpdateAccount(ctx, target); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := suite.move("password", target.URI)
+
+	// we should have OK because our request was valid
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	apimodelAccount := &apimodel.Account{}
+	err = json.Unmarshal(b, apimodelAccount)
+	suite.NoError(err)
+
+	if suite.NotNil(apimodelAccount.Moved) {
+		suite.Equal(target.ID, apimodelAccount.Moved.ID)
+	}
+
+	// moving again should not be possible
+	recorder = suite.move("password", target.URI)
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandlerNoAlias() {
+	recorder := suite.move("password", suite.testAccounts["local_account_2"].URI)
+
+	// we should have Unprocessable because the target doesn't list zork as an alias
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Unprocessable Entity: account to move to doesn't list this account as an alias"}`, string(b))
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandlerWrongPassword() {
+	recorder := suite.move("aaaaaaaaaaaaaaaaaaaaaaaaaaaa", suite.testAccounts["local_account_2"].URI)
+
+	// we should have Forbidden because we supplied the wrong password
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandlerNoPassword() {
+	recorder := suite.move("", suite.testAccounts["local_account_2"].URI)
+
+	// we should have StatusBadRequest because our request was invalid
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestAccountMoveTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountMoveTestSuite))
+}
@@ -0,0 +1,112 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package accounts_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type MuteTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *MuteTestSuite) muteRequest(handler gin.HandlerFunc, path string, targetAccountID string, body string, expectedHTTPStatus int) *apimodel.Relationship {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/api%s", strings.Replace(path, ":id", targetAccountID, 1)), strings.NewReader(body))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   accounts.IDKey,
+			Value: targetAccountID,
+		},
+	}
+
+	handler(ctx)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+
+	if expectedHTTPStatus != http.StatusOK {
+		return nil
+	}
+
+	relationship := &apimodel.Relationship{}
+	if err := json.Unmarshal(b, relationship); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return relationship
+}
+
+func (suite *MuteTestSuite) TestMuteUnmute() {
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	relationship := suite.muteRequest(suite.accountsModule.AccountMutePOSTHandler, accounts.MutePath, targetAccount.ID, `{"notifications":false,"duration":3600}`, http.StatusOK)
+	suite.True(relationship.Following)
+	suite.True(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+
+	// muting again updates the mute
+	relationship = suite.muteRequest(suite.accountsModule.AccountMutePOSTHandler, accounts.MutePath, targetAccount.ID, `{}`, http.StatusOK)
+	suite.True(relationship.Muting)
+	suite.True(relationship.MutingNotifications)
+
+	relationship = suite.muteRequest(suite.accountsModule.AccountUnmutePOSTHandler, accounts.UnmutePath, targetAccount.ID, ``, http.StatusOK)
+	suite.True(relationship.Following)
+	suite.False(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+}
+
+func (suite *MuteTestSuite) TestMuteSelf() {
+	testAcct := suite.testAccounts["local_account_1"]
+	suite.muteRequest(suite.accountsModule.AccountMutePOSTHandler, accounts.MutePath, testAcct.ID, `{}`, http.StatusNotAcceptable)
+}
+
+func (suite *MuteTestSuite) TestMuteNegativeDuration() {
+	targetAccount := suite.testAccounts["local_account_2"]
+	suite.muteRequest(suite.accountsModule.AccountMutePOSTHandler, accounts.MutePath, targetAccount.ID, `{"duration":-1}`, http.StatusBadRequest)
+}
+
+func (suite *MuteTestSuite) TestMuteNonexistentAccount() {
+	suite.muteRequest(suite.accountsModule.AccountMutePOSTHandler, accounts.MutePath, "01GF8VRXX1R00X7XH8973Z29R1", `{}`, http.StatusNotFound)
+}
+
+func TestMuteTestSuite(t *testing.T) {
+	suite.Run(t, new(MuteTestSuite))
+}
@@ -34,7 +34,7 @@ import (
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- read:accounts
+//		- read:follows
 //
 //	responses:
 //		'200':
@@ -0,0 +1,149 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AccountApprovalTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountApprovalTestSuite) SetupTest() {
+	suite.AdminStandardTestSuite.SetupTest()
+
+	// rejection deletes the account via the client worker
+	suite.NoError(suite.processor.Start())
+}
+
+func (suite *AccountApprovalTestSuite) TearDownTest() {
+	suite.NoError(suite.processor.Stop())
+	suite.AdminStandardTestSuite.TearDownTest()
+}
+
+func (suite *AccountApprovalTestSuite) TestAccountApprove() {
+	recorder := httptest.NewRecorder()
+	testAccount := suite.testAccounts["unconfirmed_account"]
+
+	ctx := suite.newContext(recorder, http.MethodPost, nil, admin.AccountsPath+"/"+testAccount.ID+"/approve", "")
+	ctx.AddParam(admin.IDKey, testAccount.ID)
+
+	suite.adminModule.AccountApprovePOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiAccount := &apimodel.AdminAccountInfo{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), apiAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(testAccount.ID, apiAccount.ID)
+	suite.True(apiAccount.Approved)
+
+	// user should be approved in the db
+	user, err := suite.db.GetUserByAccountID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+	suite.True(*user.Approved)
+
+	// and they should have been emailed
+	suite.Len(suite.sentEmails, 1)
+	suite.Contains(suite.sentEmails["weed_lord420@example.org"], "Subject: GoToSocial Sign-Up Approved")
+	suite.Contains(suite.sentEmails["weed_lord420@example.org"], "Hello weed_lord420!")
+
+	// approving again should fail
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodPost, nil, admin.AccountsPath+"/"+testAccount.ID+"/approve", "")
+	ctx.AddParam(admin.IDKey, testAccount.ID)
+
+	suite.adminModule.AccountApprovePOSTHandler(ctx)
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+	suite.Equal(`{"error":"Unprocessable Entity: account is not pending approval"}`, recorder.Body.String())
+}
+
+func (suite *AccountApprovalTestSuite) TestAccountApproveNotFound() {
+	recorder := httptest.NewRecorder()
+
+	ctx := suite.newContext(recorder, http.MethodPost, nil, admin.AccountsPath+"/01GF8VRXX1R00X7XH8973Z29R1/approve", "")
+	ctx.AddParam(admin.IDKey, "01GF8VRXX1R00X7XH8973Z29R1")
+
+	suite.adminModule.AccountApprovePOSTHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+	suite.Empty(suite.sentEmails)
+}
+
+func (suite *AccountApprovalTestSuite) TestAccountReject() {
+	recorder := httptest.NewRecorder()
+	testAccount := suite.testAccounts["unconfirmed_account"]
+
+	ctx := suite.newContext(recorder, http.MethodPost, nil, admin.AccountsPath+"/"+testAccount.ID+"/reject", "")
+	ctx.AddParam(admin.IDKey, testAccount.ID)
+
+	suite.adminModule.AccountRejectPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiAccount := &apimodel.AdminAccountInfo{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), apiAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(testAccount.ID, apiAccount.ID)
+	suite.False(apiAccount.Approved)
+
+	// they should have been emailed
+	suite.Len(suite.sentEmails, 1)
+	suite.Contains(suite.sentEmails["weed_lord420@example.org"], "Subject: GoToSocial Sign-Up Rejected")
+
+	// the user should be deleted asynchronously
+	if !testrig.WaitFor(func() bool {
+		_, err := suite.db.GetUserByAccountID(context.Background(), testAccount.ID)
+		return err == db.ErrNoEntries
+	}) {
+		suite.FailNow("timed out waiting for user to be deleted")
+	}
+
+	// and the account suspended
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+	suite.WithinDuration(time.Now(), dbAccount.SuspendedAt, 1*time.Minute)
+}
+
+func (suite *AccountApprovalTestSuite) TestAccountRejectApproved() {
+	recorder := httptest.NewRecorder()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	ctx := suite.newContext(recorder, http.MethodPost, nil, admin.AccountsPath+"/"+testAccount.ID+"/reject", "")
+	ctx.AddParam(admin.IDKey, testAccount.ID)
+
+	suite.adminModule.AccountRejectPOSTHandler(ctx)
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+	suite.Empty(suite.sentEmails)
+}
+
+func TestAccountApprovalTestSuite(t *testing.T) {
+	suite.Run(t, &AccountApprovalTestSuite{})
+}
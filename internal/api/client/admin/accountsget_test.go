@@ -0,0 +1,96 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type AccountsGetTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountsGetTestSuite) getAccounts(query string, expectedHTTPStatus int) []*apimodel.AdminAccountInfo {
+	recorder := httptest.NewRecorder()
+
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.AccountsPath+query, "")
+
+	suite.adminModule.AccountsGETHandler(ctx)
+	suite.Equal(expectedHTTPStatus, recorder.Code)
+	if expectedHTTPStatus != http.StatusOK {
+		return nil
+	}
+
+	accounts := []*apimodel.AdminAccountInfo{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &accounts); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return accounts
+}
+
+func (suite *AccountsGetTestSuite) TestAccountsGetPending() {
+	accounts := suite.getAccounts("?status=pending", http.StatusOK)
+	suite.Len(accounts, 1)
+
+	pending := accounts[0]
+	suite.Equal(suite.testAccounts["unconfirmed_account"].ID, pending.ID)
+	suite.Equal("weed_lord420", pending.Username)
+	suite.Equal("weed_lord420@example.org", pending.Email)
+	suite.False(pending.Approved)
+	suite.NotNil(pending.InviteRequest)
+	suite.Equal("hi, please let me in! I'm looking for somewhere neato bombeato to hang out.", *pending.InviteRequest)
+}
+
+func (suite *AccountsGetTestSuite) TestAccountsGetActive() {
+	accounts := suite.getAccounts("?status=active", http.StatusOK)
+	suite.Len(accounts, 3)
+
+	// newest first
+	suite.Equal(suite.testAccounts["local_account_2"].ID, accounts[0].ID)
+	suite.Equal(suite.testAccounts["local_account_1"].ID, accounts[1].ID)
+	suite.Equal(suite.testAccounts["admin_account"].ID, accounts[2].ID)
+	for _, a := range accounts {
+		suite.True(a.Approved)
+	}
+}
+
+func (suite *AccountsGetTestSuite) TestAccountsGetPaged() {
+	recorder := httptest.NewRecorder()
+
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.AccountsPath+"?limit=1", "")
+
+	suite.adminModule.AccountsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(`<http://localhost:8080/api/v1/admin/accounts?limit=1&max_id=01F8MH5NBDF2MV7CTC4Q5128HF>; rel="next", <http://localhost:8080/api/v1/admin/accounts?limit=1&min_id=01F8MH5NBDF2MV7CTC4Q5128HF>; rel="prev"`, recorder.Header().Get("Link"))
+}
+
+func (suite *AccountsGetTestSuite) TestAccountsGetBadStatus() {
+	suite.getAccounts("?status=bored", http.StatusBadRequest)
+}
+
+func TestAccountsGetTestSuite(t *testing.T) {
+	suite.Run(t, &AccountsGetTestSuite{})
+}
@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -38,12 +40,20 @@ const (
 	DomainBlocksPath = BasePath + "/domain_blocks"
 	// DomainBlocksPathWithID is used for interacting with a single domain block.
 	DomainBlocksPathWithID = DomainBlocksPath + "/:" + IDKey
+	// EmailDomainBlocksPath is used for listing + posting email domain blocks.
+	EmailDomainBlocksPath = BasePath + "/email_domain_blocks"
+	// EmailDomainBlocksPathWithID is used for interacting with a single email domain block.
+	EmailDomainBlocksPathWithID = EmailDomainBlocksPath + "/:" + IDKey
 	// AccountsPath is used for listing + acting on accounts.
 	AccountsPath = BasePath + "/accounts"
 	// AccountsPathWithID is used for interacting with a single account.
 	AccountsPathWithID = AccountsPath + "/:" + IDKey
 	// AccountsActionPath is used for taking action on a single account.
 	AccountsActionPath = AccountsPathWithID + "/action"
+	// AccountsApprovePath is used for approving the pending sign-up of a single account.
+	AccountsApprovePath = AccountsPathWithID + "/approve"
+	// AccountsRejectPath is used for rejecting the pending sign-up of a single account.
+	AccountsRejectPath = AccountsPathWithID + "/reject"
 	MediaCleanupPath   = BasePath + "/media_cleanup"
 	MediaRefetchPath   = BasePath + "/media_refetch"
 	// ReportsPath is for serving admin view of user reports.
@@ -52,6 +62,10 @@ const (
 	ReportsPathWithID = ReportsPath + "/:" + IDKey
 	// ReportsResolvePath is for marking one report as resolved.
 	ReportsResolvePath = ReportsPathWithID + "/resolve"
+	// AnnouncementsPath is for creating and listing instance announcements.
+	AnnouncementsPath = BasePath + "/announcements"
+	// AnnouncementsPathWithID is for viewing/updating/deleting one announcement.
+	AnnouncementsPathWithID = AnnouncementsPath + "/:" + IDKey
 
 	// ExportQueryKey is for requesting a public export of some data.
 	ExportQueryKey = "export"
@@ -71,6 +85,8 @@ const (
 	LimitKey = "limit"
 	// DomainQueryKey is for specifying a domain during admin actions.
 	DomainQueryKey = "domain"
+	// StatusKey is for filtering accounts by the status of their user (eg., pending).
+	StatusKey = "status"
 	// ResolvedKey is for filtering reports by their resolved status
 	ResolvedKey = "resolved"
 	// AccountIDKey is for selecting account in API paths.
@@ -94,28 +110,44 @@ func New(processor processing.Processor) *Module {
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	// emoji stuff
-	attachHandler(http.MethodPost, EmojiPath, m.EmojiCreatePOSTHandler)
-	attachHandler(http.MethodGet, EmojiPath, m.EmojisGETHandler)
-	attachHandler(http.MethodDelete, EmojiPathWithID, m.EmojiDELETEHandler)
-	attachHandler(http.MethodGet, EmojiPathWithID, m.EmojiGETHandler)
-	attachHandler(http.MethodPatch, EmojiPathWithID, m.EmojiPATCHHandler)
-	attachHandler(http.MethodGet, EmojiCategoriesPath, m.EmojiCategoriesGETHandler)
+	attachHandler(http.MethodPost, EmojiPath, middleware.RequireScope(oauth.ScopeAdminWrite), m.EmojiCreatePOSTHandler)
+	attachHandler(http.MethodGet, EmojiPath, middleware.RequireScope(oauth.ScopeAdminRead), m.EmojisGETHandler)
+	attachHandler(http.MethodDelete, EmojiPathWithID, middleware.RequireScope(oauth.ScopeAdminWrite), m.EmojiDELETEHandler)
+	attachHandler(http.MethodGet, EmojiPathWithID, middleware.RequireScope(oauth.ScopeAdminRead), m.EmojiGETHandler)
+	attachHandler(http.MethodPatch, EmojiPathWithID, middleware.RequireScope(oauth.ScopeAdminWrite), m.EmojiPATCHHandler)
+	attachHandler(http.MethodGet, EmojiCategoriesPath, middleware.RequireScope(oauth.ScopeAdminRead), m.EmojiCategoriesGETHandler)
 
 	// domain block stuff
-	attachHandler(http.MethodPost, DomainBlocksPath, m.DomainBlocksPOSTHandler)
-	attachHandler(http.MethodGet, DomainBlocksPath, m.DomainBlocksGETHandler)
-	attachHandler(http.MethodGet, DomainBlocksPathWithID, m.DomainBlockGETHandler)
-	attachHandler(http.MethodDelete, DomainBlocksPathWithID, m.DomainBlockDELETEHandler)
+	attachHandler(http.MethodPost, DomainBlocksPath, middleware.RequireScope(oauth.ScopeAdminWriteDomainBlocks), m.DomainBlocksPOSTHandler)
+	attachHandler(http.MethodGet, DomainBlocksPath, middleware.RequireScope(oauth.ScopeAdminReadDomainBlocks), m.DomainBlocksGETHandler)
+	attachHandler(http.MethodGet, DomainBlocksPathWithID, middleware.RequireScope(oauth.ScopeAdminReadDomainBlocks), m.DomainBlockGETHandler)
+	attachHandler(http.MethodDelete, DomainBlocksPathWithID, middleware.RequireScope(oauth.ScopeAdminWriteDomainBlocks), m.DomainBlockDELETEHandler)
+
+	// email domain block stuff
+	attachHandler(http.MethodPost, EmailDomainBlocksPath, middleware.RequireScope(oauth.ScopeAdminWriteEmailDomainBlocks), m.EmailDomainBlocksPOSTHandler)
+	attachHandler(http.MethodGet, EmailDomainBlocksPath, middleware.RequireScope(oauth.ScopeAdminReadEmailDomainBlocks), m.EmailDomainBlocksGETHandler)
+	attachHandler(http.MethodGet, EmailDomainBlocksPathWithID, middleware.RequireScope(oauth.ScopeAdminReadEmailDomainBlocks), m.EmailDomainBlockGETHandler)
+	attachHandler(http.MethodDelete, EmailDomainBlocksPathWithID, middleware.RequireScope(oauth.ScopeAdminWriteEmailDomainBlocks), m.EmailDomainBlockDELETEHandler)
 
 	// accounts stuff
-	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
+	attachHandler(http.MethodGet, AccountsPath, middleware.RequireScope(oauth.ScopeAdminReadAccounts), m.AccountsGETHandler)
+	attachHandler(http.MethodPost, AccountsActionPath, middleware.RequireScope(oauth.ScopeAdminWriteAccounts), m.AccountActionPOSTHandler)
+	attachHandler(http.MethodPost, AccountsApprovePath, middleware.RequireScope(oauth.ScopeAdminWriteAccounts), m.AccountApprovePOSTHandler)
+	attachHandler(http.MethodPost, AccountsRejectPath, middleware.RequireScope(oauth.ScopeAdminWriteAccounts), m.AccountRejectPOSTHandler)
 
 	// media stuff
-	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
-	attachHandler(http.MethodPost, MediaRefetchPath, m.MediaRefetchPOSTHandler)
+	attachHandler(http.MethodPost, MediaCleanupPath, middleware.RequireScope(oauth.ScopeAdminWrite), m.MediaCleanupPOSTHandler)
+	attachHandler(http.MethodPost, MediaRefetchPath, middleware.RequireScope(oauth.ScopeAdminWrite), m.MediaRefetchPOSTHandler)
 
 	// reports stuff
-	attachHandler(http.MethodGet, ReportsPath, m.ReportsGETHandler)
-	attachHandler(http.MethodGet, ReportsPathWithID, m.ReportGETHandler)
-	attachHandler(http.MethodPost, ReportsResolvePath, m.ReportResolvePOSTHandler)
+	attachHandler(http.MethodGet, ReportsPath, middleware.RequireScope(oauth.ScopeAdminReadReports), m.ReportsGETHandler)
+	attachHandler(http.MethodGet, ReportsPathWithID, middleware.RequireScope(oauth.ScopeAdminReadReports), m.ReportGETHandler)
+	attachHandler(http.MethodPost, ReportsResolvePath, middleware.RequireScope(oauth.ScopeAdminWriteReports), m.ReportResolvePOSTHandler)
+
+	// announcements stuff
+	attachHandler(http.MethodPost, AnnouncementsPath, middleware.RequireScope(oauth.ScopeAdminWrite), m.AnnouncementCreatePOSTHandler)
+	attachHandler(http.MethodGet, AnnouncementsPath, middleware.RequireScope(oauth.ScopeAdminRead), m.AnnouncementsGETHandler)
+	attachHandler(http.MethodGet, AnnouncementsPathWithID, middleware.RequireScope(oauth.ScopeAdminRead), m.AnnouncementGETHandler)
+	attachHandler(http.MethodPatch, AnnouncementsPathWithID, middleware.RequireScope(oauth.ScopeAdminWrite), m.AnnouncementPATCHHandler)
+	attachHandler(http.MethodDelete, AnnouncementsPathWithID, middleware.RequireScope(oauth.ScopeAdminWrite), m.AnnouncementDELETEHandler)
 }
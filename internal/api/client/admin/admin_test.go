@@ -53,16 +53,18 @@ type AdminStandardTestSuite struct {
 	sentEmails   map[string]string
 
 	// standard suite models
-	testTokens          map[string]*gtsmodel.Token
-	testClients         map[string]*gtsmodel.Client
-	testApplications    map[string]*gtsmodel.Application
-	testUsers           map[string]*gtsmodel.User
-	testAccounts        map[string]*gtsmodel.Account
-	testAttachments     map[string]*gtsmodel.MediaAttachment
-	testStatuses        map[string]*gtsmodel.Status
-	testEmojis          map[string]*gtsmodel.Emoji
-	testEmojiCategories map[string]*gtsmodel.EmojiCategory
-	testReports         map[string]*gtsmodel.Report
+	testTokens            map[string]*gtsmodel.Token
+	testClients           map[string]*gtsmodel.Client
+	testApplications      map[string]*gtsmodel.Application
+	testUsers             map[string]*gtsmodel.User
+	testAccounts          map[string]*gtsmodel.Account
+	testAttachments       map[string]*gtsmodel.MediaAttachment
+	testStatuses          map[string]*gtsmodel.Status
+	testEmojis            map[string]*gtsmodel.Emoji
+	testEmojiCategories   map[string]*gtsmodel.EmojiCategory
+	testReports           map[string]*gtsmodel.Report
+	testAnnouncements     map[string]*gtsmodel.Announcement
+	testEmailDomainBlocks map[string]*gtsmodel.EmailDomainBlock
 
 	// module being tested
 	adminModule *admin.Module
@@ -79,6 +81,8 @@ func (suite *AdminStandardTestSuite) SetupSuite() {
 	suite.testEmojis = testrig.NewTestEmojis()
 	suite.testEmojiCategories = testrig.NewTestEmojiCategories()
 	suite.testReports = testrig.NewTestReports()
+	suite.testAnnouncements = testrig.NewTestAnnouncements()
+	suite.testEmailDomainBlocks = testrig.NewTestEmailDomainBlocks()
 }
 
 func (suite *AdminStandardTestSuite) SetupTest() {
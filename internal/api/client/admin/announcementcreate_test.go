@@ -0,0 +1,91 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type AnnouncementCreateTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AnnouncementCreateTestSuite) TestAnnouncementCreate() {
+	recorder := httptest.NewRecorder()
+
+	requestBody := []byte(`{"text":"Maintenance **tonight**","starts_at":"2099-04-01T20:00:00Z","ends_at":"2099-04-01T22:00:00Z"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody, admin.AnnouncementsPath, "application/json")
+
+	suite.adminModule.AnnouncementCreatePOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiAnnouncement := &apimodel.Announcement{}
+	suite.NoError(json.Unmarshal(b, apiAnnouncement))
+
+	suite.Equal("<p>Maintenance <strong>tonight</strong></p>", apiAnnouncement.Content)
+	suite.Equal("2099-04-01T20:00:00.000Z", apiAnnouncement.StartsAt)
+	suite.Equal("2099-04-01T22:00:00.000Z", apiAnnouncement.EndsAt)
+	suite.False(apiAnnouncement.AllDay)
+	suite.True(apiAnnouncement.Published)
+
+	// announcement should be in the db
+	dbAnnouncement, err := suite.db.GetAnnouncementByID(context.Background(), apiAnnouncement.ID)
+	suite.NoError(err)
+	suite.Equal("Maintenance **tonight**", dbAnnouncement.Text)
+}
+
+func (suite *AnnouncementCreateTestSuite) TestAnnouncementCreateEndsBeforeStarts() {
+	recorder := httptest.NewRecorder()
+
+	requestBody := []byte(`{"text":"Oops","starts_at":"2099-04-01T20:00:00Z","ends_at":"2099-04-01T18:00:00Z"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody, admin.AnnouncementsPath, "application/json")
+
+	suite.adminModule.AnnouncementCreatePOSTHandler(ctx)
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (suite *AnnouncementCreateTestSuite) TestAnnouncementCreateNotAdmin() {
+	recorder := httptest.NewRecorder()
+
+	requestBody := []byte(`{"text":"Not an admin"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody, admin.AnnouncementsPath, "application/json")
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	suite.adminModule.AnnouncementCreatePOSTHandler(ctx)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func TestAnnouncementCreateTestSuite(t *testing.T) {
+	suite.Run(t, &AnnouncementCreateTestSuite{})
+}
@@ -0,0 +1,65 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+type AnnouncementDeleteTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AnnouncementDeleteTestSuite) TestAnnouncementDelete() {
+	recorder := httptest.NewRecorder()
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, admin.AnnouncementsPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, testAnnouncement.ID)
+
+	suite.adminModule.AnnouncementDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(`{}`, recorder.Body.String())
+
+	// announcement should no longer be in the db
+	dbAnnouncement, err := suite.db.GetAnnouncementByID(context.Background(), testAnnouncement.ID)
+	suite.Nil(dbAnnouncement)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *AnnouncementDeleteTestSuite) TestAnnouncementDeleteNotFound() {
+	recorder := httptest.NewRecorder()
+
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, admin.AnnouncementsPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, "01GF8VRXX1R00X7XH8973Z29R1")
+
+	suite.adminModule.AnnouncementDELETEHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestAnnouncementDeleteTestSuite(t *testing.T) {
+	suite.Run(t, &AnnouncementDeleteTestSuite{})
+}
@@ -0,0 +1,106 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type AnnouncementUpdateTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AnnouncementUpdateTestSuite) TestAnnouncementUpdateText() {
+	recorder := httptest.NewRecorder()
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+
+	requestBody := []byte(`{"text":"Welcome, again!"}`)
+	ctx := suite.newContext(recorder, http.MethodPatch, requestBody, admin.AnnouncementsPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, testAnnouncement.ID)
+
+	suite.adminModule.AnnouncementPATCHHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiAnnouncement := &apimodel.Announcement{}
+	suite.NoError(json.Unmarshal(b, apiAnnouncement))
+
+	suite.Equal(testAnnouncement.ID, apiAnnouncement.ID)
+	suite.Equal("<p>Welcome, again!</p>", apiAnnouncement.Content)
+	suite.Empty(apiAnnouncement.Emojis)
+	suite.True(apiAnnouncement.Published)
+}
+
+func (suite *AnnouncementUpdateTestSuite) TestAnnouncementUpdatePublishScheduled() {
+	recorder := httptest.NewRecorder()
+	testAnnouncement := suite.testAnnouncements["announcement_2_scheduled"]
+
+	requestBody := []byte(`{"published":true}`)
+	ctx := suite.newContext(recorder, http.MethodPatch, requestBody, admin.AnnouncementsPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, testAnnouncement.ID)
+
+	suite.adminModule.AnnouncementPATCHHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiAnnouncement := &apimodel.Announcement{}
+	suite.NoError(json.Unmarshal(b, apiAnnouncement))
+
+	suite.True(apiAnnouncement.Published)
+	suite.Equal("2099-04-09T08:00:00.000Z", apiAnnouncement.StartsAt)
+}
+
+func (suite *AnnouncementUpdateTestSuite) TestAnnouncementUpdateScheduleWhilePublished() {
+	recorder := httptest.NewRecorder()
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+
+	requestBody := []byte(`{"scheduled_at":"2099-01-01T00:00:00Z"}`)
+	ctx := suite.newContext(recorder, http.MethodPatch, requestBody, admin.AnnouncementsPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, testAnnouncement.ID)
+
+	suite.adminModule.AnnouncementPATCHHandler(ctx)
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (suite *AnnouncementUpdateTestSuite) TestAnnouncementUpdateNotFound() {
+	recorder := httptest.NewRecorder()
+
+	requestBody := []byte(`{"text":"Hello"}`)
+	ctx := suite.newContext(recorder, http.MethodPatch, requestBody, admin.AnnouncementsPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, "01GF8VRXX1R00X7XH8973Z29R1")
+
+	suite.adminModule.AnnouncementPATCHHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestAnnouncementUpdateTestSuite(t *testing.T) {
+	suite.Run(t, &AnnouncementUpdateTestSuite{})
+}
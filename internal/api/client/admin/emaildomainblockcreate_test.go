@@ -0,0 +1,107 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type EmailDomainBlockCreateTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmailDomainBlockCreateTestSuite) TestEmailDomainBlockCreate() {
+	recorder := httptest.NewRecorder()
+
+	requestBody := []byte(`{"domain":"Throwaway.Example.ORG"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody, admin.EmailDomainBlocksPath, "application/json")
+
+	suite.adminModule.EmailDomainBlocksPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiBlock := &apimodel.EmailDomainBlock{}
+	suite.NoError(json.Unmarshal(b, apiBlock))
+
+	suite.NotEmpty(apiBlock.ID)
+	suite.Equal("throwaway.example.org", apiBlock.Domain)
+	suite.Equal(suite.testAccounts["admin_account"].ID, apiBlock.CreatedBy)
+
+	// email addresses on the domain should now be blocked
+	blocked, err := suite.db.IsEmailDomainBlocked(context.Background(), "throwaway.example.org")
+	suite.NoError(err)
+	suite.True(blocked)
+}
+
+func (suite *EmailDomainBlockCreateTestSuite) TestEmailDomainBlockCreateExisting() {
+	recorder := httptest.NewRecorder()
+	testBlock := suite.testEmailDomainBlocks["spam-mail.org"]
+
+	requestBody := []byte(`{"domain":"spam-mail.org"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody, admin.EmailDomainBlocksPath, "application/json")
+
+	suite.adminModule.EmailDomainBlocksPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"id":"01GYQM9PW0Y1S2DTQRHVZVX36R","domain":"spam-mail.org","created_by":"01F8MH17FWEB39HZJ76B6VXSKF","created_at":"2022-10-06T08:11:12.000Z"}`, string(b))
+
+	// no duplicate should have been created
+	blocks, err := suite.db.GetEmailDomainBlocks(context.Background())
+	suite.NoError(err)
+	suite.Len(blocks, 1)
+	suite.Equal(testBlock.ID, blocks[0].ID)
+}
+
+func (suite *EmailDomainBlockCreateTestSuite) TestEmailDomainBlockCreateInvalidDomain() {
+	recorder := httptest.NewRecorder()
+
+	requestBody := []byte(`{"domain":"not a domain"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody, admin.EmailDomainBlocksPath, "application/json")
+
+	suite.adminModule.EmailDomainBlocksPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func (suite *EmailDomainBlockCreateTestSuite) TestEmailDomainBlockCreateNoDomain() {
+	recorder := httptest.NewRecorder()
+
+	requestBody := []byte(`{}`)
+	ctx := suite.newContext(recorder, http.MethodPost, requestBody, admin.EmailDomainBlocksPath, "application/json")
+
+	suite.adminModule.EmailDomainBlocksPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+	suite.Equal(`{"error":"Bad Request: empty domain provided"}`, recorder.Body.String())
+}
+
+func TestEmailDomainBlockCreateTestSuite(t *testing.T) {
+	suite.Run(t, &EmailDomainBlockCreateTestSuite{})
+}
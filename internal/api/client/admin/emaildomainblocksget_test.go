@@ -0,0 +1,68 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+)
+
+type EmailDomainBlocksGetTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmailDomainBlocksGetTestSuite) TestEmailDomainBlocksGet() {
+	recorder := httptest.NewRecorder()
+
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.EmailDomainBlocksPath, "application/json")
+
+	suite.adminModule.EmailDomainBlocksGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(`[{"id":"01GYQM9PW0Y1S2DTQRHVZVX36R","domain":"spam-mail.org","created_by":"01F8MH17FWEB39HZJ76B6VXSKF","created_at":"2022-10-06T08:11:12.000Z"}]`, recorder.Body.String())
+}
+
+func (suite *EmailDomainBlocksGetTestSuite) TestEmailDomainBlockGet() {
+	recorder := httptest.NewRecorder()
+	testBlock := suite.testEmailDomainBlocks["spam-mail.org"]
+
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.EmailDomainBlocksPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, testBlock.ID)
+
+	suite.adminModule.EmailDomainBlockGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(`{"id":"01GYQM9PW0Y1S2DTQRHVZVX36R","domain":"spam-mail.org","created_by":"01F8MH17FWEB39HZJ76B6VXSKF","created_at":"2022-10-06T08:11:12.000Z"}`, recorder.Body.String())
+}
+
+func (suite *EmailDomainBlocksGetTestSuite) TestEmailDomainBlockGetNotFound() {
+	recorder := httptest.NewRecorder()
+
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.EmailDomainBlocksPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, "01GF8VRXX1R00X7XH8973Z29R1")
+
+	suite.adminModule.EmailDomainBlockGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestEmailDomainBlocksGetTestSuite(t *testing.T) {
+	suite.Run(t, &EmailDomainBlocksGetTestSuite{})
+}
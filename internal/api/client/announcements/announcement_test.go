@@ -0,0 +1,195 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/announcements"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type AnnouncementTestSuite struct {
+	AnnouncementsStandardTestSuite
+}
+
+func (suite *AnnouncementTestSuite) announcementRequest(handler gin.HandlerFunc, method string, path string, params gin.Params, accountKey string, expectedHTTPStatus int) ([]byte, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountKey]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api/"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Params = params
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d: %s", expectedHTTPStatus, resultCode, string(b)))
+	}
+
+	if err := errs.Combine(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (suite *AnnouncementTestSuite) getAnnouncements(path string, accountKey string) []*apimodel.Announcement {
+	b, err := suite.announcementRequest(suite.announcementsModule.AnnouncementsGETHandler, http.MethodGet, path, nil, accountKey, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	apiAnnouncements := []*apimodel.Announcement{}
+	if err := json.Unmarshal(b, &apiAnnouncements); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return apiAnnouncements
+}
+
+func (suite *AnnouncementTestSuite) TestGetAnnouncements() {
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+
+	apiAnnouncements := suite.getAnnouncements("v1/announcements", "local_account_1")
+	suite.Len(apiAnnouncements, 1)
+
+	apiAnnouncement := apiAnnouncements[0]
+	suite.Equal(testAnnouncement.ID, apiAnnouncement.ID)
+	suite.Equal(testAnnouncement.Content, apiAnnouncement.Content)
+	suite.True(apiAnnouncement.Published)
+	suite.False(apiAnnouncement.Read)
+	suite.Len(apiAnnouncement.Emojis, 1)
+	suite.Equal([]apimodel.AnnouncementReaction{{Name: "👍", Count: 1, Me: false}}, apiAnnouncement.Reactions)
+}
+
+func (suite *AnnouncementTestSuite) TestGetAnnouncementsWithDismissed() {
+	// local_account_2 has already dismissed the only announcement
+	apiAnnouncements := suite.getAnnouncements("v1/announcements", "local_account_2")
+	suite.Empty(apiAnnouncements)
+
+	apiAnnouncements = suite.getAnnouncements("v1/announcements?with_dismissed=true", "local_account_2")
+	suite.Len(apiAnnouncements, 1)
+	suite.True(apiAnnouncements[0].Read)
+	suite.True(apiAnnouncements[0].Reactions[0].Me)
+}
+
+func (suite *AnnouncementTestSuite) TestGetAnnouncementsBadQuery() {
+	_, err := suite.announcementRequest(suite.announcementsModule.AnnouncementsGETHandler, http.MethodGet, "v1/announcements?with_dismissed=sure", nil, "local_account_1", http.StatusBadRequest)
+	suite.NoError(err)
+}
+
+func (suite *AnnouncementTestSuite) TestDismissAnnouncement() {
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+	params := gin.Params{{Key: announcements.IDKey, Value: testAnnouncement.ID}}
+
+	_, err := suite.announcementRequest(suite.announcementsModule.AnnouncementDismissPOSTHandler, http.MethodPost, "v1/announcements/"+testAnnouncement.ID+"/dismiss", params, "local_account_1", http.StatusOK)
+	suite.NoError(err)
+
+	suite.Empty(suite.getAnnouncements("v1/announcements", "local_account_1"))
+}
+
+func (suite *AnnouncementTestSuite) TestDismissScheduledAnnouncement() {
+	testAnnouncement := suite.testAnnouncements["announcement_2_scheduled"]
+	params := gin.Params{{Key: announcements.IDKey, Value: testAnnouncement.ID}}
+
+	_, err := suite.announcementRequest(suite.announcementsModule.AnnouncementDismissPOSTHandler, http.MethodPost, "v1/announcements/"+testAnnouncement.ID+"/dismiss", params, "local_account_1", http.StatusNotFound)
+	suite.NoError(err)
+}
+
+func (suite *AnnouncementTestSuite) TestReactions() {
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+	path := "v1/announcements/" + testAnnouncement.ID + "/reactions/"
+
+	for _, name := range []string{"👍", "rainbow"} {
+		params := gin.Params{
+			{Key: announcements.IDKey, Value: testAnnouncement.ID},
+			{Key: announcements.NameKey, Value: name},
+		}
+		_, err := suite.announcementRequest(suite.announcementsModule.AnnouncementReactionPUTHandler, http.MethodPut, path+name, params, "local_account_1", http.StatusOK)
+		suite.NoError(err)
+	}
+
+	reactions := suite.getAnnouncements("v1/announcements", "local_account_1")[0].Reactions
+	suite.Len(reactions, 2)
+	suite.Equal("👍", reactions[0].Name)
+	suite.Equal(2, reactions[0].Count)
+	suite.True(reactions[0].Me)
+	suite.Equal("rainbow", reactions[1].Name)
+	suite.Equal(1, reactions[1].Count)
+	suite.NotEmpty(reactions[1].URL)
+
+	params := gin.Params{
+		{Key: announcements.IDKey, Value: testAnnouncement.ID},
+		{Key: announcements.NameKey, Value: "rainbow"},
+	}
+	_, err := suite.announcementRequest(suite.announcementsModule.AnnouncementReactionDELETEHandler, http.MethodDelete, path+"rainbow", params, "local_account_1", http.StatusOK)
+	suite.NoError(err)
+
+	_, err = suite.announcementRequest(suite.announcementsModule.AnnouncementReactionDELETEHandler, http.MethodDelete, path+"rainbow", params, "local_account_1", http.StatusNotFound)
+	suite.NoError(err)
+
+	reactions = suite.getAnnouncements("v1/announcements", "local_account_1")[0].Reactions
+	suite.Len(reactions, 1)
+}
+
+func (suite *AnnouncementTestSuite) TestReactionInvalid() {
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+	params := gin.Params{
+		{Key: announcements.IDKey, Value: testAnnouncement.ID},
+		{Key: announcements.NameKey, Value: "not_an_emoji"},
+	}
+
+	_, err := suite.announcementRequest(suite.announcementsModule.AnnouncementReactionPUTHandler, http.MethodPut, "v1/announcements/"+testAnnouncement.ID+"/reactions/not_an_emoji", params, "local_account_1", http.StatusUnprocessableEntity)
+	suite.NoError(err)
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, &AnnouncementTestSuite{})
+}
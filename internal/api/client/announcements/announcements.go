@@ -0,0 +1,62 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// BasePath is the base path for serving the announcements API, minus the 'api' prefix
+	BasePath = "/v1/announcements"
+	// IDKey is the key for announcement IDs
+	IDKey = "id"
+	// NameKey is the key for the name of a reaction
+	NameKey = "name"
+	// BasePathWithID is the base path with the ID key in it, for operations on one announcement.
+	BasePathWithID = BasePath + "/:" + IDKey
+	// DismissPath is used for marking an announcement as read
+	DismissPath = BasePathWithID + "/dismiss"
+	// ReactionPath is used for adding and removing a reaction to an announcement
+	ReactionPath = BasePathWithID + "/reactions/:" + NameKey
+	// WithDismissedKey is the url query for also returning announcements that were already dismissed
+	WithDismissedKey = "with_dismissed"
+)
+
+type Module struct {
+	processor processing.Processor
+}
+
+func New(processor processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, BasePath, middleware.RequireScope(oauth.ScopeRead), m.AnnouncementsGETHandler)
+	attachHandler(http.MethodPost, DismissPath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.AnnouncementDismissPOSTHandler)
+	attachHandler(http.MethodPut, ReactionPath, middleware.RequireScope(oauth.ScopeWriteFavourites), m.AnnouncementReactionPUTHandler)
+	attachHandler(http.MethodDelete, ReactionPath, middleware.RequireScope(oauth.ScopeWriteFavourites), m.AnnouncementReactionDELETEHandler)
+}
@@ -0,0 +1,148 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package conversations_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/conversations"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ConversationTestSuite struct {
+	ConversationsStandardTestSuite
+}
+
+func (suite *ConversationTestSuite) conversationRequest(handler gin.HandlerFunc, method string, path string, id string, expectedHTTPStatus int, expectedBody string) ([]byte, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	if id != "" {
+		ctx.AddParam(conversations.IDKey, id)
+	}
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api/"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	if expectedBody != "" && string(b) != expectedBody {
+		errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+	}
+
+	return b, errs.Combine()
+}
+
+func (suite *ConversationTestSuite) TestGetConversations() {
+	b, err := suite.conversationRequest(suite.conversationsModule.ConversationsGETHandler, http.MethodGet, "v1/conversations", "", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	resp := []*apimodel.Conversation{}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	testConversation := suite.testConversations["local_account_1_conversation_1"]
+	suite.Len(resp, 1)
+	suite.Equal(testConversation.ID, resp[0].ID)
+	suite.True(resp[0].Unread)
+	suite.Len(resp[0].Accounts, 1)
+	suite.Equal(suite.testAccounts["local_account_2"].ID, resp[0].Accounts[0].ID)
+	suite.Equal("01FN3VJGFH10KR7S2PB0GFJZYG", resp[0].LastStatus.ID)
+	suite.Equal(apimodel.VisibilityDirect, resp[0].LastStatus.Visibility)
+}
+
+func (suite *ConversationTestSuite) TestGetConversationsPaged() {
+	b, err := suite.conversationRequest(suite.conversationsModule.ConversationsGETHandler, http.MethodGet, "v1/conversations?max_id=01FN3VJGFH10KR7S2PB0GFJZYG", "", http.StatusOK, "[]")
+	suite.NoError(err)
+	suite.Equal("[]", string(b))
+}
+
+func (suite *ConversationTestSuite) TestReadDeleteConversation() {
+	testConversation := suite.testConversations["local_account_1_conversation_1"]
+
+	b, err := suite.conversationRequest(suite.conversationsModule.ConversationReadPOSTHandler, http.MethodPost, "v1/conversations/"+testConversation.ID+"/read", testConversation.ID, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	read := &apimodel.Conversation{}
+	if err := json.Unmarshal(b, read); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(testConversation.ID, read.ID)
+	suite.False(read.Unread)
+
+	_, err = suite.conversationRequest(suite.conversationsModule.ConversationDELETEHandler, http.MethodDelete, "v1/conversations/"+testConversation.ID, testConversation.ID, http.StatusOK, `{}`)
+	suite.NoError(err)
+
+	_, err = suite.conversationRequest(suite.conversationsModule.ConversationsGETHandler, http.MethodGet, "v1/conversations", "", http.StatusOK, `[]`)
+	suite.NoError(err)
+
+	// the status itself is still there
+	_, err = suite.db.GetStatusByID(context.Background(), testConversation.LastStatusID)
+	suite.NoError(err)
+}
+
+func (suite *ConversationTestSuite) TestReadOtherAccountsConversation() {
+	testConversation := suite.testConversations["local_account_2_conversation_1"]
+
+	_, err := suite.conversationRequest(suite.conversationsModule.ConversationReadPOSTHandler, http.MethodPost, "v1/conversations/"+testConversation.ID+"/read", testConversation.ID, http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func TestConversationTestSuite(t *testing.T) {
+	suite.Run(t, &ConversationTestSuite{})
+}
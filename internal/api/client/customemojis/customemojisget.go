@@ -38,10 +38,6 @@ import (
 //	produces:
 //	- application/json
 //
-//	security:
-//	- OAuth2 Bearer:
-//		- read:custom_emojis
-//
 //	responses:
 //		'200':
 //			description: Array of custom emojis.
@@ -0,0 +1,167 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package featuredtags_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FeaturedTagsTestSuite struct {
+	FeaturedTagsStandardTestSuite
+}
+
+func (suite *FeaturedTagsTestSuite) featuredTagsRequest(handler gin.HandlerFunc, method string, body io.Reader, featuredTagID string, accountKey string, expectedHTTPStatus int, expectedBody string) ([]byte, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountKey]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+
+	// create the request
+	path := config.GetProtocol() + "://" + config.GetHost() + "/api" + featuredtags.BasePath
+	if featuredTagID != "" {
+		path = path + "/" + featuredTagID
+		ctx.AddParam(featuredtags.IDKey, featuredTagID)
+	}
+	ctx.Request = httptest.NewRequest(method, path, body)
+	ctx.Request.Header.Set("accept", "application/json")
+	if body != nil {
+		ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	if expectedBody != "" && string(b) != expectedBody {
+		errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+	}
+
+	return b, errs.Combine()
+}
+
+func (suite *FeaturedTagsTestSuite) TestGetFeaturedTags() {
+	_, err := suite.featuredTagsRequest(suite.featuredTagsModule.FeaturedTagsGETHandler, http.MethodGet, nil, "", "admin_account", http.StatusOK, `[{"id":"01H2WQ0SXKWC7NSS0ZY53PM7X9","name":"welcome","url":"http://localhost:8080/tags/welcome","statuses_count":1,"last_status_at":"2021-10-20T11:36:45.000Z"}]`)
+	suite.NoError(err)
+}
+
+func (suite *FeaturedTagsTestSuite) TestGetFeaturedTagsEmpty() {
+	_, err := suite.featuredTagsRequest(suite.featuredTagsModule.FeaturedTagsGETHandler, http.MethodGet, nil, "", "local_account_1", http.StatusOK, `[]`)
+	suite.NoError(err)
+}
+
+func (suite *FeaturedTagsTestSuite) TestFeatureTag() {
+	account := suite.testAccounts["local_account_1"]
+
+	b, err := suite.featuredTagsRequest(suite.featuredTagsModule.FeaturedTagPOSTHandler, http.MethodPost, strings.NewReader("name=%23Hashtag"), "", "local_account_1", http.StatusOK, "")
+	suite.NoError(err)
+
+	featuredTag := map[string]interface{}{}
+	suite.NoError(json.Unmarshal(b, &featuredTag))
+	suite.Equal("Hashtag", featuredTag["name"])
+	suite.Equal("http://localhost:8080/tags/Hashtag", featuredTag["url"])
+	suite.EqualValues(0, featuredTag["statuses_count"])
+	suite.Nil(featuredTag["last_status_at"])
+
+	dbFeaturedTags, err := suite.db.GetAccountFeaturedTags(context.Background(), account.ID)
+	suite.NoError(err)
+	suite.Len(dbFeaturedTags, 1)
+	suite.Equal(featuredTag["id"], dbFeaturedTags[0].ID)
+
+	// featuring the same tag again should fail
+	_, err = suite.featuredTagsRequest(suite.featuredTagsModule.FeaturedTagPOSTHandler, http.MethodPost, strings.NewReader("name=hashtag"), "", "local_account_1", http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: tag Hashtag is already featured"}`)
+	suite.NoError(err)
+}
+
+func (suite *FeaturedTagsTestSuite) TestFeatureInvalidTag() {
+	_, err := suite.featuredTagsRequest(suite.featuredTagsModule.FeaturedTagPOSTHandler, http.MethodPost, strings.NewReader("name=not-a-tag"), "", "local_account_1", http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: not-a-tag is not a valid hashtag"}`)
+	suite.NoError(err)
+}
+
+func (suite *FeaturedTagsTestSuite) TestFeatureTooManyTags() {
+	account := suite.testAccounts["local_account_1"]
+
+	for i := 0; i < gtsmodel.FeaturedTagsMax; i++ {
+		tag, err := suite.db.TagStringToTag(context.Background(), fmt.Sprintf("tag%d", i), account.ID)
+		suite.NoError(err)
+		suite.NoError(suite.db.PutTag(context.Background(), tag))
+		suite.NoError(suite.db.PutFeaturedTag(context.Background(), &gtsmodel.FeaturedTag{
+			ID:        id.NewULID(),
+			AccountID: account.ID,
+			TagID:     tag.ID,
+		}))
+	}
+
+	_, err := suite.featuredTagsRequest(suite.featuredTagsModule.FeaturedTagPOSTHandler, http.MethodPost, strings.NewReader("name=onetoomany"), "", "local_account_1", http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: you can feature at most 10 hashtags"}`)
+	suite.NoError(err)
+}
+
+func (suite *FeaturedTagsTestSuite) TestUnfeatureTag() {
+	featuredTag := suite.testFeaturedTags["admin_account_welcome"]
+
+	// someone else can't unfeature it
+	_, err := suite.featuredTagsRequest(suite.featuredTagsModule.FeaturedTagDELETEHandler, http.MethodDelete, nil, featuredTag.ID, "local_account_1", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+
+	_, err = suite.featuredTagsRequest(suite.featuredTagsModule.FeaturedTagDELETEHandler, http.MethodDelete, nil, featuredTag.ID, "admin_account", http.StatusOK, `{}`)
+	suite.NoError(err)
+
+	dbFeaturedTags, err := suite.db.GetAccountFeaturedTags(context.Background(), featuredTag.AccountID)
+	suite.NoError(err)
+	suite.Empty(dbFeaturedTags)
+}
+
+func TestFeaturedTagsTestSuite(t *testing.T) {
+	suite.Run(t, new(FeaturedTagsTestSuite))
+}
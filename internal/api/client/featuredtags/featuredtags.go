@@ -22,11 +22,18 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
 const (
+	// IDKey is the key to use for retrieving featured tag ID from context
+	IDKey = "id"
+	// BasePath is the base path for serving the featured tags API, minus the 'api' prefix
 	BasePath = "/v1/featured_tags"
+	// BasePathWithID is the base path with the ID key in it, for operations on an existing featured tag.
+	BasePathWithID = BasePath + "/:" + IDKey
 )
 
 type Module struct {
@@ -40,5 +47,7 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.FeaturedTagsGETHandler)
+	attachHandler(http.MethodGet, BasePath, middleware.RequireScope(oauth.ScopeReadAccounts), m.FeaturedTagsGETHandler)
+	attachHandler(http.MethodPost, BasePath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.FeaturedTagPOSTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, middleware.RequireScope(oauth.ScopeWriteAccounts), m.FeaturedTagDELETEHandler)
 }
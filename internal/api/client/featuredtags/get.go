@@ -31,8 +31,6 @@ import (
 //
 // Get an array of all hashtags that you currently have featured on your profile.
 //
-// THIS ENDPOINT IS CURRENTLY NOT FULLY IMPLEMENTED: it will always return an empty array.
-//
 //	---
 //	tags:
 //	- featured_tags
@@ -46,10 +44,12 @@ import (
 //
 //	responses:
 //		'200':
+//			name: featured tags
+//			description: Array of all hashtags featured on your profile.
 //			schema:
 //				type: array
 //				items:
-//					type: object
+//					"$ref": "#/definitions/featuredTag"
 //		'400':
 //			description: bad request
 //		'401':
@@ -61,7 +61,7 @@ import (
 //		'500':
 //			description: internal server error
 func (m *Module) FeaturedTagsGETHandler(c *gin.Context) {
-	_, err := oauth.Authed(c, true, true, true, true)
+	authed, err := oauth.Authed(c, true, true, true, true)
 	if err != nil {
 		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
 		return
@@ -72,5 +72,11 @@ func (m *Module) FeaturedTagsGETHandler(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, []interface{}{})
+	featuredTags, errWithCode := m.processor.FeaturedTagsGet(c.Request.Context(), authed)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.JSON(http.StatusOK, featuredTags)
 }
@@ -22,12 +22,26 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
 const (
-	// BasePath is the base path for serving the filters API, minus the 'api' prefix
-	BasePath = "/v1/filters"
+	// BasePathV1 is the base path for serving the v1 filters API, minus the 'api' prefix
+	BasePathV1 = "/v1/filters"
+	// BasePathV2 is the base path for serving the v2 filters API, minus the 'api' prefix
+	BasePathV2 = "/v2/filters"
+	// IDKey is the key for filter and filter keyword IDs
+	IDKey = "id"
+	// BasePathV1WithID is the v1 base path with the ID key in it, for operations on an existing v1 filter.
+	BasePathV1WithID = BasePathV1 + "/:" + IDKey
+	// BasePathV2WithID is the v2 base path with the ID key in it, for operations on an existing v2 filter.
+	BasePathV2WithID = BasePathV2 + "/:" + IDKey
+	// KeywordsPath is for serving and creating keywords belonging to a v2 filter.
+	KeywordsPath = BasePathV2WithID + "/keywords"
+	// KeywordPathWithID is for operations on an existing filter keyword.
+	KeywordPathWithID = BasePathV2 + "/keywords/:" + IDKey
 )
 
 type Module struct {
@@ -41,5 +55,24 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.FiltersGETHandler)
+	// create / get / update / delete v1 filters
+	attachHandler(http.MethodGet, BasePathV1, middleware.RequireScope(oauth.ScopeReadFilters), m.FiltersGETHandler)
+	attachHandler(http.MethodPost, BasePathV1, middleware.RequireScope(oauth.ScopeWriteFilters), m.FilterPOSTHandler)
+	attachHandler(http.MethodGet, BasePathV1WithID, middleware.RequireScope(oauth.ScopeReadFilters), m.FilterGETHandler)
+	attachHandler(http.MethodPut, BasePathV1WithID, middleware.RequireScope(oauth.ScopeWriteFilters), m.FilterPUTHandler)
+	attachHandler(http.MethodDelete, BasePathV1WithID, middleware.RequireScope(oauth.ScopeWriteFilters), m.FilterDELETEHandler)
+
+	// create / get / update / delete v2 filters
+	attachHandler(http.MethodGet, BasePathV2, middleware.RequireScope(oauth.ScopeReadFilters), m.FiltersV2GETHandler)
+	attachHandler(http.MethodPost, BasePathV2, middleware.RequireScope(oauth.ScopeWriteFilters), m.FilterV2POSTHandler)
+	attachHandler(http.MethodGet, BasePathV2WithID, middleware.RequireScope(oauth.ScopeReadFilters), m.FilterV2GETHandler)
+	attachHandler(http.MethodPut, BasePathV2WithID, middleware.RequireScope(oauth.ScopeWriteFilters), m.FilterV2PUTHandler)
+	attachHandler(http.MethodDelete, BasePathV2WithID, middleware.RequireScope(oauth.ScopeWriteFilters), m.FilterV2DELETEHandler)
+
+	// create / get / update / delete v2 filter keywords
+	attachHandler(http.MethodGet, KeywordsPath, middleware.RequireScope(oauth.ScopeReadFilters), m.FilterKeywordsGETHandler)
+	attachHandler(http.MethodPost, KeywordsPath, middleware.RequireScope(oauth.ScopeWriteFilters), m.FilterKeywordPOSTHandler)
+	attachHandler(http.MethodGet, KeywordPathWithID, middleware.RequireScope(oauth.ScopeReadFilters), m.FilterKeywordGETHandler)
+	attachHandler(http.MethodPut, KeywordPathWithID, middleware.RequireScope(oauth.ScopeWriteFilters), m.FilterKeywordPUTHandler)
+	attachHandler(http.MethodDelete, KeywordPathWithID, middleware.RequireScope(oauth.ScopeWriteFilters), m.FilterKeywordDELETEHandler)
 }
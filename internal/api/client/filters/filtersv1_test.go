@@ -0,0 +1,182 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	filter "github.com/superseriousbusiness/gotosocial/internal/api/client/filters"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FiltersV1TestSuite struct {
+	FiltersStandardTestSuite
+}
+
+func (suite *FiltersV1TestSuite) newContext(recorder *httptest.ResponseRecorder, method string, path string) *gin.Context {
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	return ctx
+}
+
+func (suite *FiltersV1TestSuite) TestGetFilters() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, filter.BasePathV1)
+
+	suite.filtersModule.FiltersGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dst := new(bytes.Buffer)
+	if err := json.Indent(dst, b, "", "  "); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(`[
+  {
+    "id": "01HN272TAVWAXX72ZX4M8JZ0PS",
+    "phrase": "fnord",
+    "context": [
+      "home",
+      "public"
+    ],
+    "whole_word": true,
+    "irreversible": false
+  }
+]`, dst.String())
+}
+
+func (suite *FiltersV1TestSuite) TestCreateGetDeleteFilter() {
+	// Create a new irreversible filter.
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, filter.BasePathV1)
+	ctx.Request.Form = url.Values{
+		"phrase":       {"cats"},
+		"context[]":    {"home", "notifications"},
+		"irreversible": {"true"},
+		"expires_in":   {"86400"},
+	}
+
+	suite.filtersModule.FilterPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiFilter := &apimodel.Filter{}
+	if err := json.NewDecoder(recorder.Result().Body).Decode(apiFilter); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(apiFilter.ID)
+	suite.Equal("cats", apiFilter.Phrase)
+	suite.Equal([]string{"home", "notifications"}, apiFilter.Context)
+	suite.True(apiFilter.Irreversible)
+	suite.False(apiFilter.WholeWord)
+	suite.NotEmpty(apiFilter.ExpiresAt)
+
+	// Get it back by its ID.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, filter.BasePathV1+"/"+apiFilter.ID)
+	ctx.AddParam(filter.IDKey, apiFilter.ID)
+
+	suite.filtersModule.FilterGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// Delete it.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodDelete, filter.BasePathV1+"/"+apiFilter.ID)
+	ctx.AddParam(filter.IDKey, apiFilter.ID)
+
+	suite.filtersModule.FilterDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// It should be gone now.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, filter.BasePathV1+"/"+apiFilter.ID)
+	ctx.AddParam(filter.IDKey, apiFilter.ID)
+
+	suite.filtersModule.FilterGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *FiltersV1TestSuite) TestCreateFilterBadContext() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, filter.BasePathV1)
+	ctx.Request.Form = url.Values{
+		"phrase":    {"cats"},
+		"context[]": {"somewhere"},
+	}
+
+	suite.filtersModule.FilterPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(`{"error":"Bad Request: filter context \"somewhere\" not recognized, must be one of 'home', 'notifications', 'public', 'thread', 'account'"}`, string(b))
+}
+
+func (suite *FiltersV1TestSuite) TestUpdateFilter() {
+	testKeyword := "01HN272TAVWAXX72ZX4M8JZ0PS"
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, filter.BasePathV1+"/"+testKeyword)
+	ctx.AddParam(filter.IDKey, testKeyword)
+	ctx.Request.Form = url.Values{
+		"phrase":     {"fnords"},
+		"context[]":  {"thread"},
+		"whole_word": {"false"},
+	}
+
+	suite.filtersModule.FilterPUTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiFilter := &apimodel.Filter{}
+	if err := json.NewDecoder(recorder.Result().Body).Decode(apiFilter); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testKeyword, apiFilter.ID)
+	suite.Equal("fnords", apiFilter.Phrase)
+	suite.Equal([]string{"thread"}, apiFilter.Context)
+	suite.False(apiFilter.WholeWord)
+}
+
+func TestFiltersV1TestSuite(t *testing.T) {
+	suite.Run(t, &FiltersV1TestSuite{})
+}
@@ -0,0 +1,207 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	filter "github.com/superseriousbusiness/gotosocial/internal/api/client/filters"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FiltersV2TestSuite struct {
+	FiltersStandardTestSuite
+}
+
+func (suite *FiltersV2TestSuite) newJSONContext(recorder *httptest.ResponseRecorder, method string, path string, body string) *gin.Context {
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+path, bytes.NewReader([]byte(body)))
+	ctx.Request.Header.Set("accept", "application/json")
+	if body != "" {
+		ctx.Request.Header.Set("content-type", "application/json")
+	}
+	return ctx
+}
+
+func (suite *FiltersV2TestSuite) decodeFilter(recorder *httptest.ResponseRecorder) *apimodel.FilterV2 {
+	apiFilter := &apimodel.FilterV2{}
+	if err := json.NewDecoder(recorder.Result().Body).Decode(apiFilter); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return apiFilter
+}
+
+func (suite *FiltersV2TestSuite) TestGetFilter() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newJSONContext(recorder, http.MethodGet, filter.BasePathV2+"/"+testFilter.ID, "")
+	ctx.AddParam(filter.IDKey, testFilter.ID)
+
+	suite.filtersModule.FilterV2GETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Result().Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dst := new(bytes.Buffer)
+	if err := json.Indent(dst, b, "", "  "); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(`{
+  "id": "01HN26VM6KZTW1ANNRVSBMA461",
+  "title": "fnord",
+  "context": [
+    "home",
+    "public"
+  ],
+  "expires_at": null,
+  "filter_action": "warn",
+  "keywords": [
+    {
+      "id": "01HN272TAVWAXX72ZX4M8JZ0PS",
+      "keyword": "fnord",
+      "whole_word": true
+    }
+  ],
+  "statuses": []
+}`, dst.String())
+}
+
+func (suite *FiltersV2TestSuite) TestGetFilterNotOwned() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newJSONContext(recorder, http.MethodGet, filter.BasePathV2+"/"+testFilter.ID, "")
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_2"])
+	ctx.AddParam(filter.IDKey, testFilter.ID)
+
+	suite.filtersModule.FilterV2GETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *FiltersV2TestSuite) TestCreateUpdateFilter() {
+	// Create a filter with two keywords.
+	recorder := httptest.NewRecorder()
+	ctx := suite.newJSONContext(recorder, http.MethodPost, filter.BasePathV2, `{
+		"title": "pets",
+		"context": ["home", "thread"],
+		"filter_action": "hide",
+		"keywords_attributes": [
+			{"keyword": "cat", "whole_word": true},
+			{"keyword": "dog"}
+		]
+	}`)
+
+	suite.filtersModule.FilterV2POSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiFilter := suite.decodeFilter(recorder)
+	suite.Equal("pets", apiFilter.Title)
+	suite.Equal([]string{"home", "thread"}, apiFilter.Context)
+	suite.Equal("hide", apiFilter.FilterAction)
+	suite.Nil(apiFilter.ExpiresAt)
+	suite.Len(apiFilter.Keywords, 2)
+
+	var catID, dogID string
+	for _, k := range apiFilter.Keywords {
+		switch k.Keyword {
+		case "cat":
+			catID = k.ID
+			suite.True(k.WholeWord)
+		case "dog":
+			dogID = k.ID
+			suite.False(k.WholeWord)
+		}
+	}
+	suite.NotEmpty(catID)
+	suite.NotEmpty(dogID)
+
+	// Update it: rename, remove "dog",
+	// rename "cat", and add "hamster".
+	recorder = httptest.NewRecorder()
+	ctx = suite.newJSONContext(recorder, http.MethodPut, filter.BasePathV2+"/"+apiFilter.ID, `{
+		"title": "more pets",
+		"filter_action": "warn",
+		"keywords_attributes": [
+			{"id": "`+catID+`", "keyword": "cats"},
+			{"id": "`+dogID+`", "_destroy": true},
+			{"keyword": "hamster"}
+		]
+	}`)
+	ctx.AddParam(filter.IDKey, apiFilter.ID)
+
+	suite.filtersModule.FilterV2PUTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiFilter = suite.decodeFilter(recorder)
+	suite.Equal("more pets", apiFilter.Title)
+	suite.Equal([]string{"home", "thread"}, apiFilter.Context)
+	suite.Equal("warn", apiFilter.FilterAction)
+
+	keywords := make([]string, 0, len(apiFilter.Keywords))
+	for _, k := range apiFilter.Keywords {
+		keywords = append(keywords, k.Keyword)
+	}
+	suite.ElementsMatch([]string{"cats", "hamster"}, keywords)
+}
+
+func (suite *FiltersV2TestSuite) TestCreateDuplicateKeyword() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newJSONContext(recorder, http.MethodPost, "/v2/filters/"+testFilter.ID+"/keywords", `{"keyword": "fnord"}`)
+	ctx.AddParam(filter.IDKey, testFilter.ID)
+
+	suite.filtersModule.FilterKeywordPOSTHandler(ctx)
+	suite.Equal(http.StatusConflict, recorder.Code)
+}
+
+func (suite *FiltersV2TestSuite) TestCreateFilterBadAction() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newJSONContext(recorder, http.MethodPost, filter.BasePathV2, `{
+		"title": "pets",
+		"context": ["home"],
+		"filter_action": "explode"
+	}`)
+
+	suite.filtersModule.FilterV2POSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestFiltersV2TestSuite(t *testing.T) {
+	suite.Run(t, &FiltersV2TestSuite{})
+}
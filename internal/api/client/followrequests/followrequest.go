@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -50,7 +52,7 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.FollowRequestGETHandler)
-	attachHandler(http.MethodPost, AuthorizePath, m.FollowRequestAuthorizePOSTHandler)
-	attachHandler(http.MethodPost, RejectPath, m.FollowRequestRejectPOSTHandler)
+	attachHandler(http.MethodGet, BasePath, middleware.RequireScope(oauth.ScopeReadFollows), m.FollowRequestGETHandler)
+	attachHandler(http.MethodPost, AuthorizePath, middleware.RequireScope(oauth.ScopeWriteFollows), m.FollowRequestAuthorizePOSTHandler)
+	attachHandler(http.MethodPost, RejectPath, middleware.RequireScope(oauth.ScopeWriteFollows), m.FollowRequestRejectPOSTHandler)
 }
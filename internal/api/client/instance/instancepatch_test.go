@@ -85,7 +85,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -98,7 +98,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -180,7 +183,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -193,7 +196,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -275,7 +281,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -288,7 +294,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -421,7 +430,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -434,7 +443,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -537,7 +549,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -550,7 +562,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -669,7 +684,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -682,7 +697,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -0,0 +1,160 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invites_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/invites"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type InviteTestSuite struct {
+	InvitesStandardTestSuite
+}
+
+func (suite *InviteTestSuite) inviteRequest(handler gin.HandlerFunc, method string, id string, body string, expectedHTTPStatus int, expectedBody string) ([]byte, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	path := config.GetProtocol() + "://" + config.GetHost() + "/api" + invites.BasePath
+	if id != "" {
+		path += "/" + id
+		ctx.AddParam(invites.IDKey, id)
+	}
+
+	var requestBody io.Reader
+	if body != "" {
+		requestBody = strings.NewReader(body)
+	}
+	ctx.Request = httptest.NewRequest(method, path, requestBody)
+	ctx.Request.Header.Set("accept", "application/json")
+	if body != "" {
+		ctx.Request.Header.Set("content-type", "application/json")
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	if expectedBody != "" && string(b) != expectedBody {
+		errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+	}
+
+	return b, errs.Combine()
+}
+
+func (suite *InviteTestSuite) TestGetInvites() {
+	_, err := suite.inviteRequest(suite.invitesModule.InvitesGETHandler, http.MethodGet, "", "", http.StatusOK, `[{"id":"01GZ0RJNPVH1A83WNKPVXSGBQ7","code":"8wLRgQw7","created_at":"2022-06-04T13:12:00.000Z","expires_at":"2050-01-01T12:00:00.000Z","max_uses":5,"uses":1,"skip_approval":false,"expired":false}]`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *InviteTestSuite) TestGetInvite() {
+	testInvite := suite.testInvites["local_account_1_invite"]
+
+	_, err := suite.inviteRequest(suite.invitesModule.InviteGETHandler, http.MethodGet, testInvite.ID, "", http.StatusOK, `{"id":"01GZ0RJNPVH1A83WNKPVXSGBQ7","code":"8wLRgQw7","created_at":"2022-06-04T13:12:00.000Z","expires_at":"2050-01-01T12:00:00.000Z","max_uses":5,"uses":1,"skip_approval":false,"expired":false}`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *InviteTestSuite) TestGetInviteOtherAccount() {
+	testInvite := suite.testInvites["admin_account_invite"]
+
+	_, err := suite.inviteRequest(suite.invitesModule.InviteGETHandler, http.MethodGet, testInvite.ID, "", http.StatusNotFound, `{"error":"Not Found"}`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *InviteTestSuite) TestCreateRevokeInvite() {
+	b, err := suite.inviteRequest(suite.invitesModule.InviteCreatePOSTHandler, http.MethodPost, "", `{"max_uses":2}`, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	created := &apimodel.Invite{}
+	if err := json.Unmarshal(b, created); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(created.Code, 8)
+	suite.Equal(2, *created.MaxUses)
+	suite.Nil(created.ExpiresAt)
+	suite.False(created.Expired)
+
+	b, err = suite.inviteRequest(suite.invitesModule.InviteDELETEHandler, http.MethodDelete, created.ID, "", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	revoked := &apimodel.Invite{}
+	if err := json.Unmarshal(b, revoked); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(created.ID, revoked.ID)
+	suite.NotNil(revoked.ExpiresAt)
+	suite.True(revoked.Expired)
+}
+
+func (suite *InviteTestSuite) TestCreateInviteSkipApprovalNotAdmin() {
+	_, err := suite.inviteRequest(suite.invitesModule.InviteCreatePOSTHandler, http.MethodPost, "", `{"skip_approval":true}`, http.StatusForbidden, `{"error":"Forbidden: only admins can create invites that skip approval"}`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, &InviteTestSuite{})
+}
@@ -22,12 +22,28 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
 const (
 	// BasePath is the base path for serving the lists API, minus the 'api' prefix
 	BasePath = "/v1/lists"
+	// IDKey is the key for list IDs
+	IDKey = "id"
+	// BasePathWithID is the base path with the ID key in it, for operations on an existing list.
+	BasePathWithID = BasePath + "/:" + IDKey
+	// AccountsPath is for serving accounts belonging to a list.
+	AccountsPath = BasePathWithID + "/accounts"
+	// MaxIDKey is the url query for setting a max list ID to return
+	MaxIDKey = "max_id"
+	// SinceIDKey is the url query for returning results newer than the given ID
+	SinceIDKey = "since_id"
+	// MinIDKey is the url query for returning results immediately newer than the given ID
+	MinIDKey = "min_id"
+	// LimitKey is for specifying maximum number of results to return.
+	LimitKey = "limit"
 )
 
 type Module struct {
@@ -41,5 +57,15 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.ListsGETHandler)
+	// create / get / update / delete lists
+	attachHandler(http.MethodPost, BasePath, middleware.RequireScope(oauth.ScopeWriteLists), m.ListCreatePOSTHandler)
+	attachHandler(http.MethodGet, BasePath, middleware.RequireScope(oauth.ScopeReadLists), m.ListsGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, middleware.RequireScope(oauth.ScopeReadLists), m.ListGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, middleware.RequireScope(oauth.ScopeWriteLists), m.ListUpdatePUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, middleware.RequireScope(oauth.ScopeWriteLists), m.ListDELETEHandler)
+
+	// get / add / remove list accounts
+	attachHandler(http.MethodGet, AccountsPath, middleware.RequireScope(oauth.ScopeReadLists), m.ListAccountsGETHandler)
+	attachHandler(http.MethodPost, AccountsPath, middleware.RequireScope(oauth.ScopeWriteLists), m.ListAccountsPOSTHandler)
+	attachHandler(http.MethodDelete, AccountsPath, middleware.RequireScope(oauth.ScopeWriteLists), m.ListAccountsDELETEHandler)
 }
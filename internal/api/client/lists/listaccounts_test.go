@@ -0,0 +1,166 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package lists_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/lists"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ListAccountsTestSuite struct {
+	ListsStandardTestSuite
+}
+
+func (suite *ListAccountsTestSuite) listAccountsRequest(method string, handler gin.HandlerFunc, listID string, accountIDs []string, expectedHTTPStatus int, expectedBody string) ([]byte, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api/"+lists.BasePath+"/"+listID+"/accounts", nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(lists.IDKey, listID)
+	if accountIDs != nil {
+		ctx.Request.Form = url.Values{"account_ids[]": accountIDs}
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	if expectedBody != "" && string(b) != expectedBody {
+		errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+	}
+
+	return b, errs.Combine()
+}
+
+func (suite *ListAccountsTestSuite) getListAccounts(listID string) []*apimodel.Account {
+	b, err := suite.listAccountsRequest(http.MethodGet, suite.listsModule.ListAccountsGETHandler, listID, nil, http.StatusOK, "")
+	suite.NoError(err)
+
+	accounts := []*apimodel.Account{}
+	if err := json.Unmarshal(b, &accounts); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return accounts
+}
+
+func (suite *ListAccountsTestSuite) TestGetListAccounts() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+
+	accounts := suite.getListAccounts(listID)
+	if suite.Len(accounts, 2) {
+		suite.Equal(suite.testAccounts["admin_account"].ID, accounts[0].ID)
+		suite.Equal(suite.testAccounts["local_account_2"].ID, accounts[1].ID)
+	}
+}
+
+func (suite *ListAccountsTestSuite) TestGetListAccountsNotOwned() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+
+	// swap the list owner to someone else
+	list := suite.testLists["local_account_1_list_1"]
+	list.AccountID = suite.testAccounts["local_account_2"].ID
+	if err := suite.db.UpdateList(context.Background(), list, "account_id"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.listAccountsRequest(http.MethodGet, suite.listsModule.ListAccountsGETHandler, listID, nil, http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func (suite *ListAccountsTestSuite) TestRemoveThenAddListAccount() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+	targetAccountID := suite.testAccounts["local_account_2"].ID
+
+	_, err := suite.listAccountsRequest(http.MethodDelete, suite.listsModule.ListAccountsDELETEHandler, listID, []string{targetAccountID}, http.StatusOK, `{}`)
+	suite.NoError(err)
+
+	accounts := suite.getListAccounts(listID)
+	if suite.Len(accounts, 1) {
+		suite.Equal(suite.testAccounts["admin_account"].ID, accounts[0].ID)
+	}
+
+	_, err = suite.listAccountsRequest(http.MethodPost, suite.listsModule.ListAccountsPOSTHandler, listID, []string{targetAccountID}, http.StatusOK, `{}`)
+	suite.NoError(err)
+
+	accounts = suite.getListAccounts(listID)
+	if suite.Len(accounts, 2) {
+		suite.Equal(suite.testAccounts["local_account_2"].ID, accounts[0].ID)
+		suite.Equal(suite.testAccounts["admin_account"].ID, accounts[1].ID)
+	}
+}
+
+func (suite *ListAccountsTestSuite) TestAddListAccountNotFollowed() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+	targetAccountID := suite.testAccounts["remote_account_1"].ID
+
+	_, err := suite.listAccountsRequest(http.MethodPost, suite.listsModule.ListAccountsPOSTHandler, listID, []string{targetAccountID}, http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+
+	// list should be unchanged
+	suite.Len(suite.getListAccounts(listID), 2)
+}
+
+func (suite *ListAccountsTestSuite) TestAddListAccountNoAccounts() {
+	listID := suite.testLists["local_account_1_list_1"].ID
+
+	_, err := suite.listAccountsRequest(http.MethodPost, suite.listsModule.ListAccountsPOSTHandler, listID, []string{}, http.StatusBadRequest, `{"error":"Bad Request: no account IDs specified"}`)
+	suite.NoError(err)
+}
+
+func TestListAccountsTestSuite(t *testing.T) {
+	suite.Run(t, new(ListAccountsTestSuite))
+}
@@ -0,0 +1,128 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package lists_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/lists"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ListCreateTestSuite struct {
+	ListsStandardTestSuite
+}
+
+func (suite *ListCreateTestSuite) createList(expectedHTTPStatus int, expectedBody string, title string, repliesPolicy string) (*apimodel.List, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(http.MethodPost, config.GetProtocol()+"://"+config.GetHost()+"/api/"+lists.BasePath, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"title":          {title},
+		"replies_policy": {repliesPolicy},
+	}
+
+	// trigger the handler
+	suite.listsModule.ListCreatePOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	resp := &apimodel.List{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (suite *ListCreateTestSuite) TestCreateListOK() {
+	list, err := suite.createList(http.StatusOK, "", "Really Cool People", "list")
+	suite.NoError(err)
+	suite.NotEmpty(list.ID)
+	suite.Equal("Really Cool People", list.Title)
+	suite.Equal("list", list.RepliesPolicy)
+}
+
+func (suite *ListCreateTestSuite) TestCreateListDefaultRepliesPolicy() {
+	list, err := suite.createList(http.StatusOK, "", "Really Cool People", "")
+	suite.NoError(err)
+	suite.Equal("followed", list.RepliesPolicy)
+}
+
+func (suite *ListCreateTestSuite) TestCreateListNoTitle() {
+	_, err := suite.createList(http.StatusBadRequest, `{"error":"Bad Request: list title must be provided, and must be no more than 200 chars"}`, "", "list")
+	suite.NoError(err)
+}
+
+func (suite *ListCreateTestSuite) TestCreateListBadRepliesPolicy() {
+	_, err := suite.createList(http.StatusBadRequest, `{"error":"Bad Request: list replies_policy must be either empty or one of 'followed', 'list', 'none'"}`, "Really Cool People", "everyone")
+	suite.NoError(err)
+}
+
+func (suite *ListCreateTestSuite) TestCreateListDuplicateTitle() {
+	existingList := suite.testLists["local_account_1_list_1"]
+
+	_, err := suite.createList(http.StatusConflict, `{"error":"Conflict: you already have a list with this title: already exists"}`, existingList.Title, "list")
+	suite.NoError(err)
+}
+
+func TestListCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(ListCreateTestSuite))
+}
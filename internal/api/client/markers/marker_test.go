@@ -0,0 +1,175 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package markers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type MarkerTestSuite struct {
+	MarkersStandardTestSuite
+}
+
+func (suite *MarkerTestSuite) markerRequest(handler gin.HandlerFunc, method string, path string, contentType string, body string, expectedHTTPStatus int) (*apimodel.Marker, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	var reqBody io.Reader
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api/"+path, reqBody)
+	ctx.Request.Header.Set("accept", "application/json")
+	if contentType != "" {
+		ctx.Request.Header.Set("content-type", contentType)
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d: %s", expectedHTTPStatus, resultCode, string(b)))
+	}
+
+	if err := errs.Combine(); err != nil {
+		return nil, err
+	}
+
+	if expectedHTTPStatus != http.StatusOK {
+		return nil, nil
+	}
+
+	marker := &apimodel.Marker{}
+	if err := json.Unmarshal(b, marker); err != nil {
+		return nil, err
+	}
+
+	return marker, nil
+}
+
+func (suite *MarkerTestSuite) TestGetMarkers() {
+	marker, err := suite.markerRequest(suite.markersModule.MarkersGETHandler, http.MethodGet, "v1/markers?timeline[]=home&timeline[]=notifications", "", "", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	testHome := suite.testMarkers["local_account_1_home_marker"]
+	testNotifications := suite.testMarkers["local_account_1_notification_marker"]
+
+	suite.NotNil(marker.Home)
+	suite.Equal(testHome.LastReadID, marker.Home.LastReadID)
+	suite.Equal(0, marker.Home.Version)
+	suite.Equal("2022-05-14T11:21:09.000Z", marker.Home.UpdatedAt)
+
+	suite.NotNil(marker.Notifications)
+	suite.Equal(testNotifications.LastReadID, marker.Notifications.LastReadID)
+	suite.Equal(4, marker.Notifications.Version)
+}
+
+func (suite *MarkerTestSuite) TestGetMarkersOneTimeline() {
+	marker, err := suite.markerRequest(suite.markersModule.MarkersGETHandler, http.MethodGet, "v1/markers?timeline=notifications", "", "", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Nil(marker.Home)
+	suite.NotNil(marker.Notifications)
+}
+
+func (suite *MarkerTestSuite) TestGetMarkersInvalidTimeline() {
+	_, err := suite.markerRequest(suite.markersModule.MarkersGETHandler, http.MethodGet, "v1/markers?timeline[]=local", "", "", http.StatusBadRequest)
+	suite.NoError(err)
+}
+
+func (suite *MarkerTestSuite) TestPostMarkersForm() {
+	marker, err := suite.markerRequest(suite.markersModule.MarkersPOSTHandler, http.MethodPost, "v1/markers", "application/x-www-form-urlencoded", "home[last_read_id]=01FVW7JHQFSFK166WWKR8CBA6M", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotNil(marker.Home)
+	suite.Equal("01FVW7JHQFSFK166WWKR8CBA6M", marker.Home.LastReadID)
+	suite.Equal(1, marker.Home.Version)
+	suite.Nil(marker.Notifications)
+}
+
+func (suite *MarkerTestSuite) TestPostMarkersJSON() {
+	marker, err := suite.markerRequest(suite.markersModule.MarkersPOSTHandler, http.MethodPost, "v1/markers", "application/json", `{"home":{"last_read_id":"01FVW7JHQFSFK166WWKR8CBA6M"},"notifications":{"last_read_id":"01F8Q0ANPTWW10DAKTX7BRPBJP"}}`, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotNil(marker.Home)
+	suite.Equal("01FVW7JHQFSFK166WWKR8CBA6M", marker.Home.LastReadID)
+	suite.Equal(1, marker.Home.Version)
+	suite.NotNil(marker.Notifications)
+	suite.Equal(5, marker.Notifications.Version)
+
+	// the updated markers should now be returned
+	marker, err = suite.markerRequest(suite.markersModule.MarkersGETHandler, http.MethodGet, "v1/markers?timeline[]=home", "", "", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotNil(marker.Home)
+	suite.Equal("01FVW7JHQFSFK166WWKR8CBA6M", marker.Home.LastReadID)
+	suite.Equal(1, marker.Home.Version)
+}
+
+func (suite *MarkerTestSuite) TestPostMarkersEmpty() {
+	_, err := suite.markerRequest(suite.markersModule.MarkersPOSTHandler, http.MethodPost, "v1/markers", "application/json", `{}`, http.StatusBadRequest)
+	suite.NoError(err)
+}
+
+func TestMarkerTestSuite(t *testing.T) {
+	suite.Run(t, &MarkerTestSuite{})
+}
@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -45,7 +47,7 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodPost, BasePath, m.MediaCreatePOSTHandler)
-	attachHandler(http.MethodGet, AttachmentWithID, m.MediaGETHandler)
-	attachHandler(http.MethodPut, AttachmentWithID, m.MediaPUTHandler)
+	attachHandler(http.MethodPost, BasePath, middleware.RequireScope(oauth.ScopeWriteMedia), m.MediaCreatePOSTHandler)
+	attachHandler(http.MethodGet, AttachmentWithID, middleware.RequireScope(oauth.ScopeWriteMedia), m.MediaGETHandler)
+	attachHandler(http.MethodPut, AttachmentWithID, middleware.RequireScope(oauth.ScopeWriteMedia), m.MediaPUTHandler)
 }
@@ -49,7 +49,7 @@ import (
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- read:media
+//		- write:media
 //
 //	responses:
 //		'200':
@@ -0,0 +1,82 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package mutes_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type MutesGetTestSuite struct {
+	MutesStandardTestSuite
+}
+
+func (suite *MutesGetTestSuite) getMutes(accountKey string, query string) ([]*apimodel.Account, string) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountKey]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+	ctx.Request = httptest.NewRequest(http.MethodGet, config.GetProtocol()+"://"+config.GetHost()+"/api/v1/mutes"+query, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+
+	suite.mutesModule.MutesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	accounts := []*apimodel.Account{}
+	if err := json.Unmarshal(b, &accounts); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return accounts, recorder.Header().Get("Link")
+}
+
+func (suite *MutesGetTestSuite) TestGetMutes() {
+	accounts, link := suite.getMutes("local_account_1", "")
+	suite.Len(accounts, 1)
+	suite.Equal(suite.testAccounts["remote_account_3"].ID, accounts[0].ID)
+	suite.Empty(accounts[0].MuteExpiresAt)
+
+	testMute := suite.testUserMutes["local_account_1_mute_remote_account_3"]
+	suite.Equal(`<http://localhost:8080/api/v1/mutes?limit=20&max_id=`+testMute.ID+`>; rel="next", <http://localhost:8080/api/v1/mutes?limit=20&since_id=`+testMute.ID+`>; rel="prev"`, link)
+}
+
+func (suite *MutesGetTestSuite) TestGetMutesExpired() {
+	// the admin's only mute has expired
+	accounts, link := suite.getMutes("admin_account", "")
+	suite.Empty(accounts)
+	suite.Empty(link)
+}
+
+func TestMutesGetTestSuite(t *testing.T) {
+	suite.Run(t, &MutesGetTestSuite{})
+}
@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -56,6 +58,6 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.NotificationsGETHandler)
-	attachHandler(http.MethodPost, BasePathWithClear, m.NotificationsClearPOSTHandler)
+	attachHandler(http.MethodGet, BasePath, middleware.RequireScope(oauth.ScopeReadNotifications), m.NotificationsGETHandler)
+	attachHandler(http.MethodPost, BasePathWithClear, middleware.RequireScope(oauth.ScopeWriteNotifications), m.NotificationsClearPOSTHandler)
 }
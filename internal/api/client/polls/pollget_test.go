@@ -0,0 +1,134 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package polls_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/polls"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PollGetTestSuite struct {
+	PollsStandardTestSuite
+}
+
+func (suite *PollGetTestSuite) getPoll(accountKey string, pollID string, expectedHTTPStatus int, expectedBody string) (*apimodel.Poll, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountKey]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(http.MethodGet, config.GetProtocol()+"://"+config.GetHost()+"/api/"+strings.Replace(polls.BasePathWithID, ":"+polls.IDKey, pollID, 1), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(polls.IDKey, pollID)
+
+	// trigger the handler
+	suite.pollsModule.PollGETHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	if err := errs.Combine(); err != nil {
+		return nil, err
+	}
+
+	resp := &apimodel.Poll{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (suite *PollGetTestSuite) TestGetPoll() {
+	poll, err := suite.getPoll("local_account_1", suite.testPolls["local_account_2_status_7_poll"].ID, http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal("01GWCS94GZYSDWPPJ9FQHEWVAZ", poll.ID)
+	suite.Empty(poll.ExpiresAt)
+	suite.False(poll.Expired)
+	suite.False(poll.Multiple)
+	suite.True(poll.Voted)
+	suite.Equal([]int{0}, poll.OwnVotes)
+	suite.Equal(1, poll.VotesCount)
+	suite.Equal([]apimodel.PollOptions{
+		{Title: "yes", VotesCount: 1},
+		{Title: "no", VotesCount: 0},
+		{Title: "i'm a turtle too", VotesCount: 0},
+	}, poll.Options)
+}
+
+func (suite *PollGetTestSuite) TestGetPollAsAuthor() {
+	poll, err := suite.getPoll("local_account_2", suite.testPolls["local_account_2_status_7_poll"].ID, http.StatusOK, "")
+	suite.NoError(err)
+	suite.True(poll.Voted)
+	suite.Empty(poll.OwnVotes)
+	suite.Equal(1, poll.VotesCount)
+}
+
+func (suite *PollGetTestSuite) TestGetPollNotVisible() {
+	// admin account doesn't follow the author of this followers-only poll
+	_, err := suite.getPoll("admin_account", suite.testPolls["local_account_2_status_7_poll"].ID, http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func (suite *PollGetTestSuite) TestGetPollNotFound() {
+	_, err := suite.getPoll("local_account_1", "01GWD0CKZRE02XGTB98WQ3K20K", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func TestPollGetTestSuite(t *testing.T) {
+	suite.Run(t, &PollGetTestSuite{})
+}
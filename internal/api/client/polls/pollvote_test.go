@@ -0,0 +1,178 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package polls_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/polls"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PollVoteTestSuite struct {
+	PollsStandardTestSuite
+}
+
+func (suite *PollVoteTestSuite) vote(accountKey string, pollID string, choices []string, expectedHTTPStatus int, expectedBody string) (*apimodel.Poll, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountKey]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(http.MethodPost, config.GetProtocol()+"://"+config.GetHost()+"/api/"+strings.Replace(polls.VotesPath, ":"+polls.IDKey, pollID, 1), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"choices[]": choices,
+	}
+	ctx.AddParam(polls.IDKey, pollID)
+
+	// trigger the handler
+	suite.pollsModule.PollVotePOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	if err := errs.Combine(); err != nil {
+		return nil, err
+	}
+
+	resp := &apimodel.Poll{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// followPollAuthor makes the admin account follow the author of the
+// test poll, so that it can see (and therefore vote in) the poll.
+func (suite *PollVoteTestSuite) followPollAuthor() {
+	if err := suite.db.Put(context.Background(), &gtsmodel.Follow{
+		ID:              "01GWD2BQ5ZKEJ7TA3Z1EWNVH7T",
+		URI:             "http://localhost:8080/users/admin/follow/01GWD2BQ5ZKEJ7TA3Z1EWNVH7T",
+		AccountID:       suite.testAccounts["admin_account"].ID,
+		TargetAccountID: suite.testAccounts["local_account_2"].ID,
+		ShowReblogs:     testrig.TrueBool(),
+		Notify:          testrig.FalseBool(),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *PollVoteTestSuite) TestVote() {
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+	suite.followPollAuthor()
+
+	poll, err := suite.vote("admin_account", testPoll.ID, []string{"1"}, http.StatusOK, "")
+	suite.NoError(err)
+	suite.True(poll.Voted)
+	suite.Equal([]int{1}, poll.OwnVotes)
+	suite.Equal(2, poll.VotesCount)
+	suite.Equal(1, poll.Options[0].VotesCount)
+	suite.Equal(1, poll.Options[1].VotesCount)
+
+	// the vote and new counts should be stored
+	vote, err := suite.db.GetPollVoteBy(context.Background(), testPoll.ID, suite.testAccounts["admin_account"].ID)
+	suite.NoError(err)
+	suite.Equal([]int{1}, vote.Choices)
+
+	dbPoll, err := suite.db.GetPollByID(context.Background(), testPoll.ID)
+	suite.NoError(err)
+	suite.Equal([]int{1, 1, 0}, dbPoll.Votes)
+	suite.Equal(2, dbPoll.Voters)
+}
+
+func (suite *PollVoteTestSuite) TestVoteAlreadyVoted() {
+	_, err := suite.vote("local_account_1", suite.testPolls["local_account_2_status_7_poll"].ID, []string{"1"}, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: you have already voted in this poll"}`)
+	suite.NoError(err)
+}
+
+func (suite *PollVoteTestSuite) TestVoteOwnPoll() {
+	_, err := suite.vote("local_account_2", suite.testPolls["local_account_2_status_7_poll"].ID, []string{"1"}, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: you can't vote in your own poll"}`)
+	suite.NoError(err)
+}
+
+func (suite *PollVoteTestSuite) TestVoteNotVisible() {
+	_, err := suite.vote("admin_account", suite.testPolls["local_account_2_status_7_poll"].ID, []string{"1"}, http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func (suite *PollVoteTestSuite) TestVoteMultipleChoicesSingleChoicePoll() {
+	suite.followPollAuthor()
+	_, err := suite.vote("admin_account", suite.testPolls["local_account_2_status_7_poll"].ID, []string{"0", "1"}, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: poll only allows a single choice"}`)
+	suite.NoError(err)
+}
+
+func (suite *PollVoteTestSuite) TestVoteInvalidChoice() {
+	suite.followPollAuthor()
+	_, err := suite.vote("admin_account", suite.testPolls["local_account_2_status_7_poll"].ID, []string{"3"}, http.StatusBadRequest, `{"error":"Bad Request: choice 3 is not a valid option"}`)
+	suite.NoError(err)
+}
+
+func (suite *PollVoteTestSuite) TestVoteExpiredPoll() {
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+	testPoll.ExpiresAt = testrig.TimeMustParse("2022-01-01T12:00:00+02:00")
+	suite.NoError(suite.db.UpdatePoll(context.Background(), testPoll, "expires_at"))
+
+	_, err := suite.vote("local_account_1", testPoll.ID, []string{"1"}, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: poll has already ended"}`)
+	suite.NoError(err)
+}
+
+func TestPollVoteTestSuite(t *testing.T) {
+	suite.Run(t, &PollVoteTestSuite{})
+}
@@ -0,0 +1,112 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// BasePath is the base path for serving the push API, minus the 'api' prefix
+	BasePath = "/v1/push"
+	// SubscriptionPath is for operations on the push subscription of the current access token.
+	SubscriptionPath = BasePath + "/subscription"
+)
+
+type Module struct {
+	processor processing.Processor
+}
+
+func New(processor processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodPost, SubscriptionPath, middleware.RequireScope(oauth.ScopePush), m.PushSubscriptionPOSTHandler)
+	attachHandler(http.MethodGet, SubscriptionPath, middleware.RequireScope(oauth.ScopePush), m.PushSubscriptionGETHandler)
+	attachHandler(http.MethodPut, SubscriptionPath, middleware.RequireScope(oauth.ScopePush), m.PushSubscriptionPUTHandler)
+	attachHandler(http.MethodDelete, SubscriptionPath, middleware.RequireScope(oauth.ScopePush), m.PushSubscriptionDELETEHandler)
+}
+
+// parseSubscriptionForm parses the subscription of a push subscription
+// create form submitted as form data, where fields are given like
+// subscription[keys][auth]=...; gin can't bind these nested fields
+// on its own, so we have to gather them manually.
+//
+// If no subscription endpoint was submitted, nil will be returned.
+func parseSubscriptionForm(c *gin.Context) *apimodel.PushSubscriptionRequestSubscription {
+	// form will already have been parsed by
+	// the call to ShouldBind, so just read it
+	values := c.Request.Form
+
+	endpoint := values.Get("subscription[endpoint]")
+	if endpoint == "" {
+		return nil
+	}
+
+	return &apimodel.PushSubscriptionRequestSubscription{
+		Endpoint: endpoint,
+		Keys: &apimodel.PushSubscriptionKeys{
+			P256dh: values.Get("subscription[keys][p256dh]"),
+			Auth:   values.Get("subscription[keys][auth]"),
+		},
+	}
+}
+
+// parseDataForm parses the alerts of a push subscription create or
+// update form submitted as form data, where fields are given like
+// data[alerts][mention]=true.
+//
+// Alerts that weren't submitted will be false.
+func parseDataForm(c *gin.Context) (*apimodel.PushSubscriptionRequestData, error) {
+	values := c.Request.Form
+	alerts := &apimodel.PushSubscriptionAlerts{}
+
+	for key, alert := range map[string]*bool{
+		"follow":         &alerts.Follow,
+		"follow_request": &alerts.FollowRequest,
+		"favourite":      &alerts.Favourite,
+		"mention":        &alerts.Mention,
+		"reblog":         &alerts.Reblog,
+		"poll":           &alerts.Poll,
+		"status":         &alerts.Status,
+		"admin.sign_up":  &alerts.AdminSignUp,
+	} {
+		name := "data[alerts][" + key + "]"
+		if s := values.Get(name); s != "" {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %s: %s", name, err)
+			}
+			*alert = b
+		}
+	}
+
+	return &apimodel.PushSubscriptionRequestData{Alerts: alerts}, nil
+}
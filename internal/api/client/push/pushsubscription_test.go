@@ -0,0 +1,192 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PushSubscriptionTestSuite struct {
+	PushStandardTestSuite
+}
+
+func (suite *PushSubscriptionTestSuite) pushRequest(handler gin.HandlerFunc, method string, contentType string, body string, expectedHTTPStatus int, expectedBody string) (*apimodel.PushSubscription, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	var requestBody io.Reader
+	if body != "" {
+		requestBody = strings.NewReader(body)
+	}
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api/v1/push/subscription", requestBody)
+	ctx.Request.Header.Set("accept", "application/json")
+	if contentType != "" {
+		ctx.Request.Header.Set("content-type", contentType)
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	if err := errs.Combine(); err != nil {
+		return nil, err
+	}
+
+	resp := &apimodel.PushSubscription{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+const testSubscriptionForm = "subscription[endpoint]=https%3A%2F%2Fpush.example.org%2Fpush%2Fsome-id" +
+	"&subscription[keys][p256dh]=BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4" +
+	"&subscription[keys][auth]=BTBZMqHH6r4Tts7J_aSIgg" +
+	"&data[alerts][mention]=true" +
+	"&data[alerts][follow]=true"
+
+func (suite *PushSubscriptionTestSuite) TestCreateGetUpdateDeleteSubscription() {
+	created, err := suite.pushRequest(suite.pushModule.PushSubscriptionPOSTHandler, http.MethodPost, "application/x-www-form-urlencoded", testSubscriptionForm, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(created.ID)
+	suite.Equal("https://push.example.org/push/some-id", created.Endpoint)
+	suite.NotEmpty(created.ServerKey)
+	suite.Equal(&apimodel.PushSubscriptionAlerts{
+		Follow:  true,
+		Mention: true,
+	}, created.Alerts)
+
+	got, err := suite.pushRequest(suite.pushModule.PushSubscriptionGETHandler, http.MethodGet, "", "", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(created, got)
+
+	updated, err := suite.pushRequest(suite.pushModule.PushSubscriptionPUTHandler, http.MethodPut, "application/json", `{"data":{"alerts":{"favourite":true,"reblog":true}}}`, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(created.ID, updated.ID)
+	suite.Equal(created.ServerKey, updated.ServerKey)
+	suite.Equal(&apimodel.PushSubscriptionAlerts{
+		Favourite: true,
+		Reblog:    true,
+	}, updated.Alerts)
+
+	_, err = suite.pushRequest(suite.pushModule.PushSubscriptionDELETEHandler, http.MethodDelete, "", "", http.StatusOK, `{}`)
+	suite.NoError(err)
+
+	_, err = suite.pushRequest(suite.pushModule.PushSubscriptionGETHandler, http.MethodGet, "", "", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateSubscriptionReplacesExisting() {
+	first, err := suite.pushRequest(suite.pushModule.PushSubscriptionPOSTHandler, http.MethodPost, "application/x-www-form-urlencoded", testSubscriptionForm, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	second, err := suite.pushRequest(suite.pushModule.PushSubscriptionPOSTHandler, http.MethodPost, "application/json", `{"subscription":{"endpoint":"https://push.example.org/push/other-id","keys":{"p256dh":"BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4","auth":"BTBZMqHH6r4Tts7J_aSIgg"}},"data":{"alerts":{"poll":true}}}`, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEqual(first.ID, second.ID)
+
+	got, err := suite.pushRequest(suite.pushModule.PushSubscriptionGETHandler, http.MethodGet, "", "", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(second, got)
+	suite.Equal(&apimodel.PushSubscriptionAlerts{Poll: true}, got.Alerts)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateSubscriptionNotHTTPS() {
+	form := strings.Replace(testSubscriptionForm, "https", "http", 1)
+	_, err := suite.pushRequest(suite.pushModule.PushSubscriptionPOSTHandler, http.MethodPost, "application/x-www-form-urlencoded", form, http.StatusBadRequest, `{"error":"Bad Request: subscription endpoint must be an https url"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateSubscriptionNoKeys() {
+	_, err := suite.pushRequest(suite.pushModule.PushSubscriptionPOSTHandler, http.MethodPost, "application/x-www-form-urlencoded", "subscription[endpoint]=https%3A%2F%2Fpush.example.org%2Fpush%2Fsome-id", http.StatusBadRequest, `{"error":"Bad Request: subscription keys p256dh and auth must be provided"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestGetSubscriptionNone() {
+	_, err := suite.pushRequest(suite.pushModule.PushSubscriptionGETHandler, http.MethodGet, "", "", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateSubscriptionWebPushDisabled() {
+	config.SetWebPushEnabled(false)
+
+	_, err := suite.pushRequest(suite.pushModule.PushSubscriptionPOSTHandler, http.MethodPost, "application/x-www-form-urlencoded", testSubscriptionForm, http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func TestPushSubscriptionTestSuite(t *testing.T) {
+	suite.Run(t, &PushSubscriptionTestSuite{})
+}
@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -48,7 +50,7 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.ReportsGETHandler)
-	attachHandler(http.MethodPost, BasePath, m.ReportPOSTHandler)
-	attachHandler(http.MethodGet, BasePathWithID, m.ReportGETHandler)
+	attachHandler(http.MethodGet, BasePath, middleware.RequireScope(oauth.ScopeReadReports), m.ReportsGETHandler)
+	attachHandler(http.MethodPost, BasePath, middleware.RequireScope(oauth.ScopeWriteReports), m.ReportPOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithID, middleware.RequireScope(oauth.ScopeReadReports), m.ReportGETHandler)
 }
@@ -0,0 +1,168 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatuses_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/scheduledstatuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ScheduledStatusTestSuite struct {
+	ScheduledStatusesStandardTestSuite
+}
+
+func (suite *ScheduledStatusTestSuite) scheduledStatusRequest(handler gin.HandlerFunc, method string, id string, body string, expectedHTTPStatus int, expectedBody string) ([]byte, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	path := config.GetProtocol() + "://" + config.GetHost() + "/api" + scheduledstatuses.BasePath
+	if id != "" {
+		path += "/" + id
+		ctx.AddParam(scheduledstatuses.IDKey, id)
+	}
+
+	var requestBody io.Reader
+	if body != "" {
+		requestBody = strings.NewReader(body)
+	}
+	ctx.Request = httptest.NewRequest(method, path, requestBody)
+	ctx.Request.Header.Set("accept", "application/json")
+	if body != "" {
+		ctx.Request.Header.Set("content-type", "application/json")
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	if expectedBody != "" && string(b) != expectedBody {
+		errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+	}
+
+	return b, errs.Combine()
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatuses() {
+	b, err := suite.scheduledStatusRequest(suite.scheduledStatusesModule.ScheduledStatusesGETHandler, http.MethodGet, "", "", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	resp := []*apimodel.ScheduledStatus{}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	testScheduledStatus := suite.testScheduledStatuses["local_account_1_scheduled_status_1"]
+	suite.Len(resp, 1)
+	suite.Equal(testScheduledStatus.ID, resp[0].ID)
+	suite.Equal("2050-01-01T12:00:00.000Z", resp[0].ScheduledAt)
+	suite.Equal("this is a message from the future", resp[0].Params.Text)
+	suite.Equal(apimodel.VisibilityPublic, resp[0].Params.Visibility)
+	suite.Empty(resp[0].MediaAttachments)
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetUpdateDeleteScheduledStatus() {
+	testScheduledStatus := suite.testScheduledStatuses["local_account_1_scheduled_status_1"]
+
+	b, err := suite.scheduledStatusRequest(suite.scheduledStatusesModule.ScheduledStatusGETHandler, http.MethodGet, testScheduledStatus.ID, "", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	got := &apimodel.ScheduledStatus{}
+	if err := json.Unmarshal(b, got); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(testScheduledStatus.ID, got.ID)
+
+	newScheduledAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	b, err = suite.scheduledStatusRequest(suite.scheduledStatusesModule.ScheduledStatusPUTHandler, http.MethodPut, testScheduledStatus.ID, `{"scheduled_at":"`+newScheduledAt.Format(time.RFC3339)+`"}`, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	updated := &apimodel.ScheduledStatus{}
+	if err := json.Unmarshal(b, updated); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(util.FormatISO8601(newScheduledAt), updated.ScheduledAt)
+	suite.Equal(got.Params, updated.Params)
+
+	_, err = suite.scheduledStatusRequest(suite.scheduledStatusesModule.ScheduledStatusDELETEHandler, http.MethodDelete, testScheduledStatus.ID, "", http.StatusOK, `{}`)
+	suite.NoError(err)
+
+	_, err = suite.scheduledStatusRequest(suite.scheduledStatusesModule.ScheduledStatusGETHandler, http.MethodGet, testScheduledStatus.ID, "", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func (suite *ScheduledStatusTestSuite) TestUpdateScheduledStatusTooSoon() {
+	testScheduledStatus := suite.testScheduledStatuses["local_account_1_scheduled_status_1"]
+	scheduledAt := time.Now().Add(time.Minute).Format(time.RFC3339)
+
+	_, err := suite.scheduledStatusRequest(suite.scheduledStatusesModule.ScheduledStatusPUTHandler, http.MethodPut, testScheduledStatus.ID, `{"scheduled_at":"`+scheduledAt+`"}`, http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: scheduled_at must be at least 5 minutes in the future"}`)
+	suite.NoError(err)
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatusNotFound() {
+	_, err := suite.scheduledStatusRequest(suite.scheduledStatusesModule.ScheduledStatusGETHandler, http.MethodGet, "01H2GCN6ZKQ5K6QX3ZV4T1W0AM", "", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func TestScheduledStatusTestSuite(t *testing.T) {
+	suite.Run(t, &ScheduledStatusTestSuite{})
+}
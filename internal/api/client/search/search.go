@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -72,6 +74,6 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePathV1, m.SearchGETHandler)
-	attachHandler(http.MethodGet, BasePathV2, m.SearchGETHandler)
+	attachHandler(http.MethodGet, BasePathV1, middleware.RequireScope(oauth.ScopeReadSearch), m.SearchGETHandler)
+	attachHandler(http.MethodGet, BasePathV2, middleware.RequireScope(oauth.ScopeReadSearch), m.SearchGETHandler)
 }
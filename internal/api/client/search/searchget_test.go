@@ -19,6 +19,7 @@
 package search_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -29,6 +30,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/search"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type SearchGetTestSuite struct {
@@ -37,6 +39,10 @@ type SearchGetTestSuite struct {
 
 func (suite *SearchGetTestSuite) testSearch(query string, resolve bool, expectedHTTPStatus int) (*apimodel.SearchResult, error) {
 	requestPath := fmt.Sprintf("%s?q=%s&resolve=%t", search.BasePathV1, query, resolve)
+	return suite.testSearchPath(requestPath, expectedHTTPStatus)
+}
+
+func (suite *SearchGetTestSuite) testSearchPath(requestPath string, expectedHTTPStatus int) (*apimodel.SearchResult, error) {
 	recorder := httptest.NewRecorder()
 
 	ctx := suite.newContext(recorder, requestPath)
@@ -252,6 +258,117 @@ func (suite *SearchGetTestSuite) TestSearchStatusByURL() {
 	suite.NotNil(gotStatus)
 }
 
+func (suite *SearchGetTestSuite) TestSearchHashtag() {
+	query := "%23welcome" // url-encoded #welcome
+	resolve := false
+
+	searchResult, err := suite.testSearch(query, resolve, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(searchResult.Hashtags, 1) {
+		suite.FailNow("expected 1 hashtag in search results but got 0")
+	}
+
+	gotTag := searchResult.Hashtags[0]
+	suite.Equal("welcome", gotTag.Name)
+	suite.Equal("http://localhost:8080/tags/welcome", gotTag.URL)
+	suite.Empty(searchResult.Accounts)
+	suite.Empty(searchResult.Statuses)
+}
+
+func (suite *SearchGetTestSuite) TestSearchHashtagPrefixNoHash() {
+	query := "HASH"
+	resolve := false
+
+	searchResult, err := suite.testSearch(query, resolve, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(searchResult.Hashtags, 1) {
+		suite.FailNow("expected 1 hashtag in search results but got 0")
+	}
+
+	suite.Equal("Hashtag", searchResult.Hashtags[0].Name)
+}
+
+func (suite *SearchGetTestSuite) TestSearchStatusesByText() {
+	requestPath := fmt.Sprintf("%s?q=%s&type=statuses&limit=40", search.BasePathV1, "hi")
+
+	searchResult, err := suite.testSearchPath(requestPath, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Statuses of local_account_2 aren't included
+	// because that account isn't discoverable.
+	if !suite.Len(searchResult.Statuses, 2) {
+		suite.FailNow("expected 2 statuses in search results")
+	}
+
+	suite.Equal(suite.testStatuses["admin_account_status_3"].ID, searchResult.Statuses[0].ID)
+	suite.Equal(suite.testStatuses["local_account_1_status_5"].ID, searchResult.Statuses[1].ID)
+	suite.Empty(searchResult.Accounts)
+	suite.Empty(searchResult.Hashtags)
+}
+
+func (suite *SearchGetTestSuite) TestSearchStatusesByTextPaging() {
+	requestPath := fmt.Sprintf("%s?q=%s&type=statuses&limit=1", search.BasePathV1, "hello")
+
+	searchResult, err := suite.testSearchPath(requestPath, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(searchResult.Statuses, 1) {
+		suite.FailNow("expected 1 status in search results")
+	}
+	suite.Equal(suite.testStatuses["local_account_1_status_1"].ID, searchResult.Statuses[0].ID)
+
+	requestPath = fmt.Sprintf("%s?q=%s&type=statuses&limit=1&max_id=%s", search.BasePathV1, "hello", searchResult.Statuses[0].ID)
+
+	searchResult, err = suite.testSearchPath(requestPath, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(searchResult.Statuses, 1) {
+		suite.FailNow("expected 1 status in search results")
+	}
+	suite.Equal(suite.testStatuses["admin_account_status_1"].ID, searchResult.Statuses[0].ID)
+
+	requestPath = fmt.Sprintf("%s?q=%s&type=statuses&limit=1&max_id=%s", search.BasePathV1, "hello", searchResult.Statuses[0].ID)
+
+	searchResult, err = suite.testSearchPath(requestPath, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(searchResult.Statuses)
+}
+
+func (suite *SearchGetTestSuite) TestSearchStatusesByTextNotVisible() {
+	// Make the admin's puppies status a direct message,
+	// so it's no longer visible to the requesting account.
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["admin_account_status_2"]
+	status.Visibility = gtsmodel.VisibilityDirect
+	if err := suite.db.UpdateStatus(context.Background(), status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	requestPath := fmt.Sprintf("%s?q=%s&type=statuses", search.BasePathV1, "puppies")
+
+	searchResult, err := suite.testSearchPath(requestPath, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(searchResult.Statuses)
+}
+
 func TestSearchGetTestSuite(t *testing.T) {
 	suite.Run(t, &SearchGetTestSuite{})
 }
@@ -22,12 +22,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
@@ -57,7 +60,9 @@ import (
 //
 //	responses:
 //		'200':
-//			description: "The newly created status."
+//			description: >-
+//				The newly created status. If scheduled_at was given, the
+//				newly scheduled status is returned instead.
 //			schema:
 //				"$ref": "#/definitions/status"
 //		'400':
@@ -70,6 +75,8 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: unprocessable (scheduled_at is not far enough in the future)
 //		'500':
 //			description: internal server error
 func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
@@ -90,6 +97,17 @@ func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if form.Poll == nil {
+		// not bound already (eg., from json),
+		// so check the form data for a poll
+		poll, err := parsePollForm(c)
+		if err != nil {
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+		form.Poll = poll
+	}
+
 	// DO NOT COMMIT THIS UNCOMMENTED, IT WILL CAUSE MASS CHAOS.
 	// this is being left in as an ode to kim's shitposting.
 	//
@@ -104,6 +122,19 @@ func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if form.ScheduledAt != "" {
+		// status should be published later
+		// instead, so just schedule it for now
+		apiScheduledStatus, errWithCode := m.processor.ScheduledStatusCreate(c.Request.Context(), authed, form)
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+			return
+		}
+
+		c.JSON(http.StatusOK, apiScheduledStatus)
+		return
+	}
+
 	apiStatus, errWithCode := m.processor.StatusCreate(c.Request.Context(), authed, form)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -146,6 +177,9 @@ func validateCreateStatus(form *apimodel.AdvancedStatusCreateForm) error {
 		if form.Poll.Options == nil {
 			return errors.New("poll with no options")
 		}
+		if len(form.Poll.Options) < 2 {
+			return errors.New("poll must have at least 2 options")
+		}
 		if len(form.Poll.Options) > maxPollOptions {
 			return fmt.Errorf("too many poll options provided, %d provided but limit is %d", len(form.Poll.Options), maxPollOptions)
 		}
@@ -154,6 +188,10 @@ func validateCreateStatus(form *apimodel.AdvancedStatusCreateForm) error {
 				return fmt.Errorf("poll option too long, %d characters provided but limit is %d", length, maxPollChars)
 			}
 		}
+		expiresIn := time.Duration(form.Poll.ExpiresIn) * time.Second
+		if expiresIn < gtsmodel.PollMinExpiry || expiresIn > gtsmodel.PollMaxExpiry {
+			return fmt.Errorf("poll expires_in must be between %d and %d seconds", gtsmodel.PollMinExpiry/time.Second, gtsmodel.PollMaxExpiry/time.Second)
+		}
 	}
 
 	if form.SpoilerText != "" {
@@ -170,3 +208,51 @@ func validateCreateStatus(form *apimodel.AdvancedStatusCreateForm) error {
 
 	return nil
 }
+
+// parsePollForm parses the poll of a status create or edit form submitted
+// as form data, where fields are given like poll[options][]=...; gin can't
+// bind these nested fields on its own, so we have to gather them manually.
+//
+// If no poll was submitted, nil will be returned.
+func parsePollForm(c *gin.Context) (*apimodel.PollRequest, error) {
+	// form will already have been parsed by
+	// the call to ShouldBind, so just read it
+	values := c.Request.Form
+
+	options := values["poll[options][]"]
+	if len(options) == 0 {
+		options = values["poll[options]"]
+	}
+	if len(options) == 0 {
+		// no poll provided
+		return nil, nil
+	}
+
+	poll := &apimodel.PollRequest{Options: options}
+
+	if expiresInString := values.Get("poll[expires_in]"); expiresInString != "" {
+		i, err := strconv.Atoi(expiresInString)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing poll[expires_in]: %s", err)
+		}
+		poll.ExpiresIn = i
+	}
+
+	if multipleString := values.Get("poll[multiple]"); multipleString != "" {
+		b, err := strconv.ParseBool(multipleString)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing poll[multiple]: %s", err)
+		}
+		poll.Multiple = b
+	}
+
+	if hideTotalsString := values.Get("poll[hide_totals]"); hideTotalsString != "" {
+		b, err := strconv.ParseBool(hideTotalsString)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing poll[hide_totals]: %s", err)
+		}
+		poll.HideTotals = b
+	}
+
+	return poll, nil
+}
@@ -0,0 +1,188 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package statuses_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusEditTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) newContext(recorder *httptest.ResponseRecorder, method string, path string, targetStatusID string) *gin.Context {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(method, fmt.Sprintf("http://localhost:8080%s", strings.Replace(path, ":id", targetStatusID, 1)), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: targetStatusID,
+		},
+	}
+
+	return ctx
+}
+
+func (suite *StatusEditTestSuite) TestEditStatus() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// edit the status
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, statuses.BasePathWithID, targetStatus.ID)
+	ctx.Request.Form = url.Values{
+		"status":       {"hello everyone! (edited)"},
+		"spoiler_text": {"introduction post, but edited"},
+	}
+	suite.statusModule.StatusEditPUTHandler(ctx)
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	statusReply := &apimodel.Status{}
+	err = json.Unmarshal(b, statusReply)
+	suite.NoError(err)
+
+	suite.Equal(targetStatus.ID, statusReply.ID)
+	suite.Equal("<p>hello everyone! (edited)</p>", statusReply.Content)
+	suite.Equal("introduction post, but edited", statusReply.SpoilerText)
+	suite.NotEmpty(statusReply.EditedAt)
+
+	// the history should now contain the original and the edit
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, statuses.HistoryPath, targetStatus.ID)
+	suite.statusModule.StatusHistoryGETHandler(ctx)
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result = recorder.Result()
+	defer result.Body.Close()
+	b, err = ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	history := []*apimodel.StatusEdit{}
+	err = json.Unmarshal(b, &history)
+	suite.NoError(err)
+
+	if !suite.Len(history, 2) {
+		suite.FailNow("")
+	}
+	suite.Equal(targetStatus.Content, history[0].Content)
+	suite.Equal(targetStatus.ContentWarning, history[0].SpoilerText)
+	suite.Equal(statusReply.Content, history[1].Content)
+	suite.Equal(statusReply.SpoilerText, history[1].SpoilerText)
+	suite.Equal(statusReply.EditedAt, history[1].CreatedAt)
+
+	// the source should be the edited text
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, statuses.SourcePath, targetStatus.ID)
+	suite.statusModule.StatusSourceGETHandler(ctx)
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result = recorder.Result()
+	defer result.Body.Close()
+	b, err = ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	source := &apimodel.StatusSource{}
+	err = json.Unmarshal(b, source)
+	suite.NoError(err)
+
+	suite.Equal(&apimodel.StatusSource{
+		ID:          targetStatus.ID,
+		Text:        "hello everyone! (edited)",
+		SpoilerText: "introduction post, but edited",
+	}, source)
+}
+
+func (suite *StatusEditTestSuite) TestEditStatusNotOwn() {
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, statuses.BasePathWithID, targetStatus.ID)
+	ctx.Request.Form = url.Values{
+		"status": {"this isn't my status"},
+	}
+	suite.statusModule.StatusEditPUTHandler(ctx)
+	suite.EqualValues(http.StatusForbidden, recorder.Code)
+}
+
+func (suite *StatusEditTestSuite) TestEditStatusEmpty() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPut, statuses.BasePathWithID, targetStatus.ID)
+	ctx.Request.Form = url.Values{}
+	suite.statusModule.StatusEditPUTHandler(ctx)
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: no status, media, or poll provided"}`, string(b))
+}
+
+func (suite *StatusEditTestSuite) TestHistoryUnedited() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, statuses.HistoryPath, targetStatus.ID)
+	suite.statusModule.StatusHistoryGETHandler(ctx)
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	history := []*apimodel.StatusEdit{}
+	err = json.Unmarshal(b, &history)
+	suite.NoError(err)
+
+	// just the current version of the status
+	suite.Len(history, 1)
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
@@ -81,10 +81,18 @@ import (
 //			`direct`: receive updates for direct messages.
 //		in: query
 //		required: true
+//	-
+//		name: list
+//		type: string
+//		description: |-
+//			ID of the list to receive updates for.
+//
+//			Only used, and required, when `stream` is `list`.
+//		in: query
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- read:streaming
+//		- read:statuses
 //
 //	responses:
 //		'101':
@@ -141,6 +149,17 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 		return
 	}
 
+	// list streams are keyed by list ID as well as stream type
+	if streamType == ListQueryKey {
+		listID := c.Query(ListQueryKey)
+		if listID == "" {
+			err := fmt.Errorf("no list id provided under query key %s", ListQueryKey)
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+			return
+		}
+		streamType = streamType + ":" + listID
+	}
+
 	var token string
 
 	// First we check for a query param provided access token
@@ -33,6 +33,8 @@ const (
 
 	// StreamQueryKey is the query key for the type of stream being requested
 	StreamQueryKey = "stream"
+	// ListQueryKey is the query key for the id of the list being requested, when stream type is "list"
+	ListQueryKey = "list"
 
 	// AccessTokenQueryKey is the query key for an oauth access token that should be passed in streaming requests.
 	AccessTokenQueryKey = "access_token"
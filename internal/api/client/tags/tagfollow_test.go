@@ -0,0 +1,167 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tags_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/tags"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TagFollowTestSuite struct {
+	TagsStandardTestSuite
+}
+
+func (suite *TagFollowTestSuite) tagRequest(handler gin.HandlerFunc, method string, path string, tagName string, accountKey string, expectedHTTPStatus int, expectedBody string) (*apimodel.Tag, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[accountKey]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api/"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(tags.TagNameKey, tagName)
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	if err := errs.Combine(); err != nil {
+		return nil, err
+	}
+
+	resp := &apimodel.Tag{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (suite *TagFollowTestSuite) TestGetFollowedTag() {
+	_, err := suite.tagRequest(suite.tagsModule.TagGETHandler, http.MethodGet, "v1/tags/hashtag", "hashtag", "local_account_2", http.StatusOK, `{"name":"Hashtag","url":"http://localhost:8080/tags/Hashtag","following":true}`)
+	suite.NoError(err)
+}
+
+func (suite *TagFollowTestSuite) TestGetNotFollowedTag() {
+	_, err := suite.tagRequest(suite.tagsModule.TagGETHandler, http.MethodGet, "v1/tags/welcome", "welcome", "local_account_2", http.StatusOK, `{"name":"welcome","url":"http://localhost:8080/tags/welcome","following":false}`)
+	suite.NoError(err)
+}
+
+func (suite *TagFollowTestSuite) TestGetTagNotFound() {
+	_, err := suite.tagRequest(suite.tagsModule.TagGETHandler, http.MethodGet, "v1/tags/nonexistent", "nonexistent", "local_account_2", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func (suite *TagFollowTestSuite) TestGetTagInvalid() {
+	_, err := suite.tagRequest(suite.tagsModule.TagGETHandler, http.MethodGet, "v1/tags/not-a-tag", "not-a-tag", "local_account_2", http.StatusBadRequest, `{"error":"Bad Request: not-a-tag is not a valid hashtag"}`)
+	suite.NoError(err)
+}
+
+func (suite *TagFollowTestSuite) TestFollowTag() {
+	account := suite.testAccounts["local_account_1"]
+	testTag := suite.testTags["welcome"]
+
+	tag, err := suite.tagRequest(suite.tagsModule.TagFollowPOSTHandler, http.MethodPost, "v1/tags/welcome/follow", "welcome", "local_account_1", http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal("welcome", tag.Name)
+	suite.True(*tag.Following)
+
+	followedTag, err := suite.db.GetFollowedTag(context.Background(), account.ID, testTag.ID)
+	suite.NoError(err)
+	suite.Equal(testTag.ID, followedTag.TagID)
+
+	// following again should be fine
+	tag, err = suite.tagRequest(suite.tagsModule.TagFollowPOSTHandler, http.MethodPost, "v1/tags/welcome/follow", "welcome", "local_account_1", http.StatusOK, "")
+	suite.NoError(err)
+	suite.True(*tag.Following)
+}
+
+func (suite *TagFollowTestSuite) TestFollowNewTag() {
+	account := suite.testAccounts["local_account_1"]
+
+	tag, err := suite.tagRequest(suite.tagsModule.TagFollowPOSTHandler, http.MethodPost, "v1/tags/brandnew/follow", "brandnew", "local_account_1", http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal("brandnew", tag.Name)
+	suite.Equal("http://localhost:8080/tags/brandnew", tag.URL)
+	suite.True(*tag.Following)
+
+	dbTag, err := suite.db.GetTagByName(context.Background(), "brandnew")
+	suite.NoError(err)
+
+	_, err = suite.db.GetFollowedTag(context.Background(), account.ID, dbTag.ID)
+	suite.NoError(err)
+}
+
+func (suite *TagFollowTestSuite) TestUnfollowTag() {
+	followedTag := suite.testFollowedTags["local_account_2_Hashtag"]
+
+	tag, err := suite.tagRequest(suite.tagsModule.TagUnfollowPOSTHandler, http.MethodPost, "v1/tags/Hashtag/unfollow", "Hashtag", "local_account_2", http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal("Hashtag", tag.Name)
+	suite.False(*tag.Following)
+
+	_, err = suite.db.GetFollowedTag(context.Background(), followedTag.AccountID, followedTag.TagID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestTagFollowTestSuite(t *testing.T) {
+	suite.Run(t, new(TagFollowTestSuite))
+}
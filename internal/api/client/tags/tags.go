@@ -0,0 +1,57 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tags
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// BasePath is the base path for serving the tags API, minus the 'api' prefix
+	BasePath = "/v1/tags"
+	// TagNameKey is the key for hashtag names
+	TagNameKey = "tag_name"
+	// TagPath is the base path with the tag name key in it, for operations on one hashtag.
+	TagPath = BasePath + "/:" + TagNameKey
+	// FollowPath is for following a hashtag.
+	FollowPath = TagPath + "/follow"
+	// UnfollowPath is for unfollowing a hashtag.
+	UnfollowPath = TagPath + "/unfollow"
+)
+
+type Module struct {
+	processor processing.Processor
+}
+
+func New(processor processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, TagPath, middleware.RequireScope(oauth.ScopeReadFollows), m.TagGETHandler)
+	attachHandler(http.MethodPost, FollowPath, middleware.RequireScope(oauth.ScopeWriteFollows), m.TagFollowPOSTHandler)
+	attachHandler(http.MethodPost, UnfollowPath, middleware.RequireScope(oauth.ScopeWriteFollows), m.TagUnfollowPOSTHandler)
+}
@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -32,6 +34,14 @@ const (
 	HomeTimeline = BasePath + "/home"
 	// PublicTimeline is the path for the public (and public local) timeline
 	PublicTimeline = BasePath + "/public"
+	// ListTimeline is the path for the timeline of one list
+	ListTimeline = BasePath + "/list/:" + IDKey
+	// TagTimeline is the path for the timeline of one hashtag
+	TagTimeline = BasePath + "/tag/:" + TagNameKey
+	// IDKey is the key for the ID of the list being requested
+	IDKey = "id"
+	// TagNameKey is the key for the name of the hashtag being requested
+	TagNameKey = "tag_name"
 	// MaxIDKey is the url query for setting a max status ID to return
 	MaxIDKey = "max_id"
 	// SinceIDKey is the url query for returning results newer than the given ID
@@ -55,6 +65,8 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, HomeTimeline, m.HomeTimelineGETHandler)
-	attachHandler(http.MethodGet, PublicTimeline, m.PublicTimelineGETHandler)
+	attachHandler(http.MethodGet, HomeTimeline, middleware.RequireScope(oauth.ScopeReadStatuses), m.HomeTimelineGETHandler)
+	attachHandler(http.MethodGet, PublicTimeline, middleware.RequireScope(oauth.ScopeReadStatuses), m.PublicTimelineGETHandler)
+	attachHandler(http.MethodGet, ListTimeline, middleware.RequireScope(oauth.ScopeReadLists), m.ListTimelineGETHandler)
+	attachHandler(http.MethodGet, TagTimeline, middleware.RequireScope(oauth.ScopeReadStatuses), m.TagTimelineGETHandler)
 }
@@ -0,0 +1,107 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/user"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type EmailChangeTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *EmailChangeTestSuite) newContext(recorder *httptest.ResponseRecorder, form url.Values) *gin.Context {
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080%s", user.EmailChangePath), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = form
+	return ctx
+}
+
+func (suite *EmailChangeTestSuite) TestEmailChangePOST() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, url.Values{
+		"password":  {"password"},
+		"new_email": {"zork.new@example.org"},
+	})
+	suite.userModule.EmailChangePOSTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), suite.testUsers["local_account_1"].ID)
+	suite.NoError(err)
+	suite.Equal("zork@example.org", dbUser.Email)
+	suite.Equal("zork.new@example.org", dbUser.UnconfirmedEmail)
+
+	_, ok := suite.sentEmails["zork.new@example.org"]
+	suite.True(ok)
+}
+
+func (suite *EmailChangeTestSuite) TestEmailChangeMissingPassword() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, url.Values{
+		"new_email": {"zork.new@example.org"},
+	})
+	suite.userModule.EmailChangePOSTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: email change request missing field password"}`, string(b))
+}
+
+func (suite *EmailChangeTestSuite) TestEmailChangeBlockedDomain() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, url.Values{
+		"password":  {"password"},
+		"new_email": {"zork@sneaky-inbox.com"},
+	})
+	suite.userModule.EmailChangePOSTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusUnprocessableEntity, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Unprocessable Entity: email addresses from this domain are not accepted on this instance"}`, string(b))
+	suite.Empty(suite.sentEmails)
+}
+
+func TestEmailChangeTestSuite(t *testing.T) {
+	suite.Run(t, &EmailChangeTestSuite{})
+}
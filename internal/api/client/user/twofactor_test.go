@@ -0,0 +1,194 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TwoFactorTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *TwoFactorTestSuite) newContext(recorder *httptest.ResponseRecorder, method string, path string, form url.Values) *gin.Context {
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(method, fmt.Sprintf("http://localhost:8080/api%s", path), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = form
+	return ctx
+}
+
+func (suite *TwoFactorTestSuite) getStatus() *apimodel.TwoFactorStatus {
+	recorder := httptest.NewRecorder()
+	suite.userModule.TwoFactorGETHandler(suite.newContext(recorder, http.MethodGet, "/v1/user/2fa", nil))
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	status := &apimodel.TwoFactorStatus{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(status))
+	return status
+}
+
+func (suite *TwoFactorTestSuite) TestEnableAndDisable() {
+	suite.False(suite.getStatus().Enabled)
+
+	// set up a new secret
+	recorder := httptest.NewRecorder()
+	suite.userModule.TwoFactorSetupPOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/setup", nil))
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	setup := &apimodel.TwoFactorSetup{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(setup))
+	suite.NotEmpty(setup.Secret)
+	suite.NotEmpty(setup.URI)
+	suite.NotEmpty(setup.QRCode)
+
+	// not enabled until confirmed with a code
+	suite.False(suite.getStatus().Enabled)
+
+	code, err := totp.Code(setup.Secret, time.Now())
+	suite.NoError(err)
+
+	recorder = httptest.NewRecorder()
+	suite.userModule.TwoFactorEnablePOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/enable", url.Values{
+		"password": {"password"},
+		"code":     {code},
+	}))
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recoveryCodes := &apimodel.TwoFactorRecoveryCodes{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(recoveryCodes))
+	suite.Len(recoveryCodes.RecoveryCodes, 10)
+
+	status := suite.getStatus()
+	suite.True(status.Enabled)
+	suite.Equal(10, status.RecoveryCodesLeft)
+
+	// disable it again
+	recorder = httptest.NewRecorder()
+	suite.userModule.TwoFactorDisablePOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/disable", url.Values{
+		"password": {"password"},
+	}))
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), suite.testUsers["local_account_1"].ID)
+	suite.NoError(err)
+	suite.Zero(dbUser.TwoFactorEnabledAt)
+	suite.Empty(dbUser.TwoFactorSecret)
+}
+
+func (suite *TwoFactorTestSuite) TestEnableWrongCode() {
+	recorder := httptest.NewRecorder()
+	suite.userModule.TwoFactorSetupPOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/setup", nil))
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	suite.userModule.TwoFactorEnablePOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/enable", url.Values{
+		"password": {"password"},
+		"code":     {"not a code"},
+	}))
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Unprocessable Entity: two-factor code was incorrect"}`, string(b))
+	suite.False(suite.getStatus().Enabled)
+}
+
+func (suite *TwoFactorTestSuite) TestEnableWrongPassword() {
+	recorder := httptest.NewRecorder()
+	suite.userModule.TwoFactorSetupPOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/setup", nil))
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	setup := &apimodel.TwoFactorSetup{}
+	suite.NoError(json.NewDecoder(recorder.Body).Decode(setup))
+
+	code, err := totp.Code(setup.Secret, time.Now())
+	suite.NoError(err)
+
+	recorder = httptest.NewRecorder()
+	suite.userModule.TwoFactorEnablePOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/enable", url.Values{
+		"password": {"wrong password"},
+		"code":     {code},
+	}))
+	suite.Equal(http.StatusUnauthorized, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Unauthorized: password was incorrect"}`, string(b))
+	suite.False(suite.getStatus().Enabled)
+}
+
+func (suite *TwoFactorTestSuite) TestEnableMissingPassword() {
+	recorder := httptest.NewRecorder()
+	suite.userModule.TwoFactorEnablePOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/enable", url.Values{
+		"code": {"123456"},
+	}))
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: two-factor enable request missing field password"}`, string(b))
+}
+
+func (suite *TwoFactorTestSuite) TestEnableMissingCode() {
+	recorder := httptest.NewRecorder()
+	suite.userModule.TwoFactorEnablePOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/enable", url.Values{
+		"password": {"password"},
+	}))
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: two-factor enable request missing field code"}`, string(b))
+}
+
+func (suite *TwoFactorTestSuite) TestDisableNotEnabled() {
+	recorder := httptest.NewRecorder()
+	suite.userModule.TwoFactorDisablePOSTHandler(suite.newContext(recorder, http.MethodPost, "/v1/user/2fa/disable", url.Values{
+		"password": {"password"},
+	}))
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+
+	b, err := ioutil.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Unprocessable Entity: two-factor authentication is not enabled"}`, string(b))
+}
+
+func TestTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, &TwoFactorTestSuite{})
+}
@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -30,6 +32,16 @@ const (
 	BasePath = "/v1/user"
 	// PasswordChangePath is the path for POSTing a password change request.
 	PasswordChangePath = BasePath + "/password_change"
+	// EmailChangePath is the path for POSTing an email change request.
+	EmailChangePath = BasePath + "/email_change"
+	// TwoFactorPath is the path for GETting the two-factor authentication status.
+	TwoFactorPath = BasePath + "/2fa"
+	// TwoFactorSetupPath is the path for POSTing a request for a new two-factor authentication secret.
+	TwoFactorSetupPath = TwoFactorPath + "/setup"
+	// TwoFactorEnablePath is the path for POSTing the first code of a new two-factor authentication secret, enabling it.
+	TwoFactorEnablePath = TwoFactorPath + "/enable"
+	// TwoFactorDisablePath is the path for POSTing a request to disable two-factor authentication.
+	TwoFactorDisablePath = TwoFactorPath + "/disable"
 )
 
 type Module struct {
@@ -43,5 +55,10 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodPost, PasswordChangePath, m.PasswordChangePOSTHandler)
+	attachHandler(http.MethodPost, PasswordChangePath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.PasswordChangePOSTHandler)
+	attachHandler(http.MethodPost, EmailChangePath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.EmailChangePOSTHandler)
+	attachHandler(http.MethodGet, TwoFactorPath, middleware.RequireScope(oauth.ScopeReadAccounts), m.TwoFactorGETHandler)
+	attachHandler(http.MethodPost, TwoFactorSetupPath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.TwoFactorSetupPOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorEnablePath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.TwoFactorEnablePOSTHandler)
+	attachHandler(http.MethodPost, TwoFactorDisablePath, middleware.RequireScope(oauth.ScopeWriteAccounts), m.TwoFactorDisablePOSTHandler)
 }
@@ -85,6 +85,8 @@ type Account struct {
 	Fields []Field `json:"fields"`
 	// Account has been suspended by our instance.
 	Suspended bool `json:"suspended,omitempty"`
+	// The account that this account has moved to, if it has moved.
+	Moved *Account `json:"moved,omitempty"`
 	// If this account has been muted, when will the mute expire (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	MuteExpiresAt string `json:"mute_expires_at,omitempty"`
@@ -131,6 +133,10 @@ type AccountCreateRequest struct {
 	// example: en
 	// Required: true
 	Locale string `form:"locale" json:"locale" xml:"locale" binding:"required"`
+	// Invite code to sign up with. Lets the account be created even
+	// if registration is closed, and may let it skip admin approval.
+	// swagger:parameters
+	InviteCode string `form:"invite_code" json:"invite_code" xml:"invite_code"`
 	// The IP of the sign up request, will not be parsed from the form.
 	// swagger:parameters
 	// swagger:ignore
@@ -141,7 +147,8 @@ type AccountCreateRequest struct {
 //
 // swagger:ignore
 type UpdateCredentialsRequest struct {
-	// Account should be made discoverable and shown in the profile directory (if enabled).
+	// Account should be made discoverable and shown in the profile directory (if enabled),
+	// and its posts should be included in full-text search results on this instance.
 	Discoverable *bool `form:"discoverable" json:"discoverable" xml:"discoverable"`
 	// Account is flagged as a bot.
 	Bot *bool `form:"bot" json:"bot" xml:"bot"`
@@ -202,6 +209,18 @@ type AccountFollowRequest struct {
 	Notify *bool `form:"notify" json:"notify" xml:"notify"`
 }
 
+// AccountMuteRequest models a request to mute an account.
+//
+// swagger:ignore
+type AccountMuteRequest struct {
+	// The id of the account to mute.
+	ID string `form:"-" json:"-" xml:"-"`
+	// Mute notifications from this account too. Defaults to true.
+	Notifications *bool `form:"notifications" json:"notifications" xml:"notifications"`
+	// How long the mute should last, in seconds. 0 means indefinitely.
+	Duration int `form:"duration" json:"duration" xml:"duration"`
+}
+
 // AccountDeleteRequest models a request to delete an account.
 //
 // swagger:ignore
@@ -213,6 +232,27 @@ type AccountDeleteRequest struct {
 	DeleteOriginID string `form:"-" json:"-" xml:"-"`
 }
 
+// AccountAliasRequest models a request to set the aliases of an account.
+//
+// swagger:ignore
+type AccountAliasRequest struct {
+	// URIs of other accounts that this account is also known as.
+	// Any of these accounts may then move to this account.
+	// An empty list removes all aliases.
+	AlsoKnownAsURIs []string `form:"also_known_as_uris[]" json:"also_known_as_uris" xml:"also_known_as_uris"`
+}
+
+// AccountMoveRequest models a request to move an account to another account.
+//
+// swagger:ignore
+type AccountMoveRequest struct {
+	// Password of the account's user, for confirmation.
+	Password string `form:"password" json:"password" xml:"password"`
+	// URI of the account to move to.
+	// This account must list the moving account as one of its aliases.
+	MovedToURI string `form:"moved_to_uri" json:"moved_to_uri" xml:"moved_to_uri"`
+}
+
 // AccountRole models the role of an account.
 //
 // swagger:enum accountRole
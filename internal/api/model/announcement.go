@@ -61,3 +61,40 @@ type Announcement struct {
 	// Reactions to this announcement.
 	Reactions []AnnouncementReaction `json:"reactions"`
 }
+
+// AnnouncementCreateRequest models a request to create an announcement, made through the admin API.
+//
+// swagger:ignore
+type AnnouncementCreateRequest struct {
+	// Text of the announcement. Markdown is supported.
+	Text string `form:"text" json:"text" xml:"text"`
+	// When the event this announcement is about starts (ISO 8601 Datetime).
+	StartsAt string `form:"starts_at" json:"starts_at" xml:"starts_at"`
+	// When the event this announcement is about ends (ISO 8601 Datetime).
+	// The announcement will be unpublished at this time.
+	EndsAt string `form:"ends_at" json:"ends_at" xml:"ends_at"`
+	// StartsAt and EndsAt are days rather than times.
+	AllDay bool `form:"all_day" json:"all_day" xml:"all_day"`
+	// When to publish the announcement (ISO 8601 Datetime).
+	// If not set, the announcement is published right away.
+	ScheduledAt string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
+}
+
+// AnnouncementUpdateRequest models a request to update an announcement, made through the admin API.
+// Fields that aren't set are left unchanged; time fields set to an empty string are cleared.
+//
+// swagger:ignore
+type AnnouncementUpdateRequest struct {
+	// Text of the announcement. Markdown is supported.
+	Text *string `form:"text" json:"text" xml:"text"`
+	// When the event this announcement is about starts (ISO 8601 Datetime).
+	StartsAt *string `form:"starts_at" json:"starts_at" xml:"starts_at"`
+	// When the event this announcement is about ends (ISO 8601 Datetime).
+	EndsAt *string `form:"ends_at" json:"ends_at" xml:"ends_at"`
+	// StartsAt and EndsAt are days rather than times.
+	AllDay *bool `form:"all_day" json:"all_day" xml:"all_day"`
+	// When to publish the announcement (ISO 8601 Datetime). Only applies to unpublished announcements.
+	ScheduledAt *string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
+	// Publish or unpublish the announcement right away.
+	Published *bool `form:"published" json:"published" xml:"published"`
+}
@@ -19,6 +19,8 @@
 package model
 
 // FeaturedTag represents a hashtag that is featured on a profile.
+//
+// swagger:model featuredTag
 type FeaturedTag struct {
 	// The internal ID of the featured tag in the database.
 	ID string `json:"id"`
@@ -29,5 +31,17 @@ type FeaturedTag struct {
 	// The number of authored statuses containing this hashtag.
 	StatusesCount int `json:"statuses_count"`
 	// The timestamp of the last authored status containing this hashtag. (ISO 8601 Datetime)
-	LastStatusAt string `json:"last_status_at"`
+	// Will be null if the account hasn't used this hashtag in any statuses yet.
+	LastStatusAt *string `json:"last_status_at"`
+}
+
+// FeaturedTagCreateRequest is a form submitted as a POST to /api/v1/featured_tags to feature a hashtag.
+//
+// swagger:model featuredTagCreateRequest
+type FeaturedTagCreateRequest struct {
+	// Name of the hashtag to feature, with or without the leading '#'.
+	// example: gardening
+	// in: formData
+	// required: true
+	Name string `form:"name" json:"name" xml:"name"`
 }
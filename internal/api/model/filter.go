@@ -25,17 +25,20 @@ package model
 // If the phrase starts with a word character, and if the previous character before matched range is a word character, its matched range should be treated to not match.
 // If the phrase ends with a word character, and if the next character after matched range is a word character, its matched range should be treated to not match.
 // Please check app/javascript/mastodon/selectors/index.js and app/lib/feed_manager.rb in the Mastodon source code for more details.
+//
+// swagger:model filterV1
 type Filter struct {
 	// The ID of the filter in the database.
 	ID string `json:"id"`
 	// The text to be filtered.
-	Phrase string `json:"text"`
+	Phrase string `json:"phrase"`
 	// The contexts in which the filter should be applied.
 	// Array of String (Enumerable anyOf)
 	// 	home = home timeline and lists
 	// 	notifications = notifications timeline
 	// 	public = public timelines
 	// 	thread = expanded thread of a detailed status
+	// 	account = when viewing a profile
 	Context []string `json:"context"`
 	// Should the filter consider word boundaries?
 	WholeWord bool `json:"whole_word"`
@@ -44,3 +47,145 @@ type Filter struct {
 	// Should matching entities in home and notifications be dropped by the server?
 	Irreversible bool `json:"irreversible"`
 }
+
+// FilterCreateUpdateRequestV1 is a form submitted as a POST to /api/v1/filters
+// to create a new filter, or as a PUT to /api/v1/filters/{id} to update one.
+//
+// swagger:ignore
+type FilterCreateUpdateRequestV1 struct {
+	// The text to be filtered.
+	Phrase string `form:"phrase" json:"phrase" xml:"phrase"`
+	// The contexts in which the filter should be applied.
+	Context []string `form:"context[]" json:"context" xml:"context"`
+	// Should matching entities in home and notifications be dropped by the server?
+	Irreversible *bool `form:"irreversible" json:"irreversible" xml:"irreversible"`
+	// Should the filter consider word boundaries?
+	WholeWord *bool `form:"whole_word" json:"whole_word" xml:"whole_word"`
+	// Number of seconds from now that the filter should expire.
+	// If not set, or set to 0, the filter will not expire.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
+
+// FilterV2 represents a user-defined filter for determining which statuses should not be shown to the user.
+//
+// swagger:model filterV2
+type FilterV2 struct {
+	// The ID of the filter in the database.
+	ID string `json:"id"`
+	// A title given by the user to name the filter.
+	Title string `json:"title"`
+	// The contexts in which the filter should be applied.
+	// Array of String (Enumerable anyOf)
+	// 	home = home timeline and lists
+	// 	notifications = notifications timeline
+	// 	public = public timelines
+	// 	thread = expanded thread of a detailed status
+	// 	account = when viewing a profile
+	Context []string `json:"context"`
+	// When the filter should no longer be applied (ISO 8601 Datetime), or null if the filter does not expire.
+	// nullable: true
+	ExpiresAt *string `json:"expires_at"`
+	// The action to be taken when a status matches this filter.
+	// 	warn = show a warning that identifies the matching filter by title, and allow the user to expand the filtered status.
+	// 	hide = do not show this status if it is received
+	FilterAction string `json:"filter_action"`
+	// The keywords grouped under this filter.
+	Keywords []FilterKeyword `json:"keywords"`
+	// The statuses grouped under this filter. Always empty, since status filters are not supported.
+	Statuses []FilterStatus `json:"statuses"`
+}
+
+// FilterKeyword represents a keyword that, if matched, should cause the filter action to be taken.
+//
+// swagger:model filterKeyword
+type FilterKeyword struct {
+	// The ID of the filter keyword in the database.
+	ID string `json:"id"`
+	// The phrase to be matched against.
+	Keyword string `json:"keyword"`
+	// Should the filter consider word boundaries?
+	WholeWord bool `json:"whole_word"`
+}
+
+// FilterStatus represents a single status that, if matched, should cause the filter action to be taken.
+//
+// swagger:model filterStatus
+type FilterStatus struct {
+	// The ID of the filter status in the database.
+	ID string `json:"id"`
+	// The ID of the filtered status.
+	StatusID string `json:"status_id"`
+}
+
+// FilterResult is returned on a status to indicate that the
+// status matched one of the requesting account's filters.
+//
+// swagger:model filterResult
+type FilterResult struct {
+	// The filter that was matched.
+	Filter FilterV2 `json:"filter"`
+	// The keywords within the filter that were matched.
+	KeywordMatches []string `json:"keyword_matches"`
+	// The status IDs within the filter that were matched.
+	StatusMatches []string `json:"status_matches"`
+}
+
+// FilterCreateRequestV2 is a form submitted as a POST to /api/v2/filters to create a new filter.
+//
+// swagger:ignore
+type FilterCreateRequestV2 struct {
+	// The name of the filter.
+	Title string `form:"title" json:"title" xml:"title"`
+	// The contexts in which the filter should be applied.
+	Context []string `form:"context[]" json:"context" xml:"context"`
+	// The action to be taken when a status matches this filter.
+	FilterAction string `form:"filter_action" json:"filter_action" xml:"filter_action"`
+	// Number of seconds from now that the filter should expire.
+	// If not set, or set to 0, the filter will not expire.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Keywords to be added to the newly-created filter.
+	KeywordsAttributes []FilterKeywordAttributes `form:"-" json:"keywords_attributes" xml:"keywords_attributes"`
+}
+
+// FilterUpdateRequestV2 is a form submitted as a PUT to /api/v2/filters/{id} to update a filter.
+//
+// swagger:ignore
+type FilterUpdateRequestV2 struct {
+	// The name of the filter.
+	Title *string `form:"title" json:"title" xml:"title"`
+	// The contexts in which the filter should be applied.
+	Context []string `form:"context[]" json:"context" xml:"context"`
+	// The action to be taken when a status matches this filter.
+	FilterAction *string `form:"filter_action" json:"filter_action" xml:"filter_action"`
+	// Number of seconds from now that the filter should expire.
+	// If set to 0, the filter will no longer expire.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Keywords to be added, updated, or removed.
+	KeywordsAttributes []FilterKeywordAttributes `form:"-" json:"keywords_attributes" xml:"keywords_attributes"`
+}
+
+// FilterKeywordAttributes describes a keyword to add to, update
+// on, or remove from a filter as part of a v2 filter request.
+//
+// swagger:ignore
+type FilterKeywordAttributes struct {
+	// ID of an existing keyword, if updating or removing it.
+	ID string `json:"id" xml:"id"`
+	// The keyword to be added to the filter.
+	Keyword string `json:"keyword" xml:"keyword"`
+	// Whether the keyword should consider word boundaries.
+	WholeWord *bool `json:"whole_word" xml:"whole_word"`
+	// If true, remove the keyword with the given ID.
+	Destroy bool `json:"_destroy" xml:"_destroy"`
+}
+
+// FilterKeywordCreateUpdateRequest is a form submitted as a POST to /api/v2/filters/{id}/keywords
+// to add a keyword to a filter, or as a PUT to /api/v2/filters/keywords/{id} to update one.
+//
+// swagger:ignore
+type FilterKeywordCreateUpdateRequest struct {
+	// The keyword to be added to the filter.
+	Keyword string `form:"keyword" json:"keyword" xml:"keyword"`
+	// Whether the keyword should consider word boundaries.
+	WholeWord *bool `form:"whole_word" json:"whole_word" xml:"whole_word"`
+}
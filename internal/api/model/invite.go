@@ -0,0 +1,57 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+// Invite represents an invite code, which lets new users sign up
+// to this instance even when registration is closed.
+//
+// swagger:model invite
+type Invite struct {
+	// ID of the invite.
+	ID string `json:"id"`
+	// Code to give to the person being invited.
+	// They should pass it as `invite_code` when creating their account.
+	Code string `json:"code"`
+	// When the invite was created (ISO 8601 Datetime).
+	CreatedAt string `json:"created_at"`
+	// When the invite stops being valid (ISO 8601 Datetime).
+	// Null if the invite never expires.
+	ExpiresAt *string `json:"expires_at"`
+	// How many times the invite can be used to sign up.
+	// Null if the invite can be used any number of times.
+	MaxUses *int `json:"max_uses"`
+	// How many times the invite has been used to sign up.
+	Uses int `json:"uses"`
+	// Accounts signed up with this invite skip admin approval.
+	SkipApproval bool `json:"skip_approval"`
+	// The invite has expired, been revoked, or has no uses left.
+	Expired bool `json:"expired"`
+}
+
+// InviteCreateRequest models a request to create a new invite code.
+//
+// swagger:ignore
+type InviteCreateRequest struct {
+	// How many times the invite can be used. 0 means no limit.
+	MaxUses int `form:"max_uses" json:"max_uses" xml:"max_uses"`
+	// Number of seconds the invite will be valid for. 0 means it never expires.
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Accounts signed up with the invite skip admin approval. Only admins may set this.
+	SkipApproval bool `form:"skip_approval" json:"skip_approval" xml:"skip_approval"`
+}
@@ -19,6 +19,8 @@
 package model
 
 // List represents a list of some users that the authenticated user follows.
+//
+// swagger:model list
 type List struct {
 	// The internal database ID of the list.
 	ID string `json:"id"`
@@ -29,3 +31,44 @@ type List struct {
 	//	none = Show replies to no one
 	RepliesPolicy string `json:"replies_policy"`
 }
+
+// ListCreateRequest is a form submitted as a POST to /api/v1/lists to create a new list.
+//
+// swagger:model listCreateRequest
+type ListCreateRequest struct {
+	// Title of this list.
+	// example: Cool People
+	// in: formData
+	// required: true
+	Title string `form:"title" json:"title" xml:"title"`
+	// RepliesPolicy for this list.
+	// followed = Show replies to any followed user
+	// list = Show replies to members of the list
+	// none = Show replies to no one
+	// example: list
+	// default: followed
+	// in: formData
+	// enum:
+	//	- followed
+	//	- list
+	//	- none
+	RepliesPolicy string `form:"replies_policy" json:"replies_policy" xml:"replies_policy"`
+}
+
+// ListUpdateRequest is a form submitted as a PUT to /api/v1/lists/{id} to update an existing list.
+//
+// swagger:ignore
+type ListUpdateRequest struct {
+	// Title of this list.
+	Title *string `form:"title" json:"title" xml:"title"`
+	// RepliesPolicy for this list.
+	RepliesPolicy *string `form:"replies_policy" json:"replies_policy" xml:"replies_policy"`
+}
+
+// ListAccountsChangeRequest is a form submitted as a POST or DELETE
+// to /api/v1/lists/{id}/accounts to add or remove accounts from a list.
+//
+// swagger:ignore
+type ListAccountsChangeRequest struct {
+	AccountIDs []string `form:"account_ids[]" json:"account_ids" xml:"account_ids"`
+}
@@ -19,19 +19,68 @@
 package model
 
 // Marker represents the last read position within a user's timelines.
+//
+// swagger:model markers
 type Marker struct {
 	// Information about the user's position in the home timeline.
-	Home *TimelineMarker `json:"home"`
+	Home *TimelineMarker `json:"home,omitempty"`
 	// Information about the user's position in their notifications.
-	Notifications *TimelineMarker `json:"notifications"`
+	Notifications *TimelineMarker `json:"notifications,omitempty"`
 }
 
 // TimelineMarker contains information about a user's progress through a specific timeline.
+//
+// swagger:model markerTimeline
 type TimelineMarker struct {
 	// The ID of the most recently viewed entity.
 	LastReadID string `json:"last_read_id"`
 	// The timestamp of when the marker was set (ISO 8601 Datetime)
 	UpdatedAt string `json:"updated_at"`
 	// Used for locking to prevent write conflicts.
-	Version string `json:"version"`
+	Version int `json:"version"`
+}
+
+// MarkerName is the name of one of the timelines we can store markers for.
+type MarkerName string
+
+const (
+	// MarkerNameHome is the marker for the home timeline.
+	MarkerNameHome MarkerName = "home"
+	// MarkerNameNotifications is the marker for notifications.
+	MarkerNameNotifications MarkerName = "notifications"
+)
+
+// MarkerPostRequest models a request to update one or more markers.
+// This has two sets of fields to support a goofy nested map structure in both form data and JSON bodies.
+//
+// swagger:ignore
+type MarkerPostRequest struct {
+	Home                        *MarkerPostRequestMarker `json:"home"`
+	FormHomeLastReadID          string                   `form:"home[last_read_id]"`
+	Notifications               *MarkerPostRequestMarker `json:"notifications"`
+	FormNotificationsLastReadID string                   `form:"notifications[last_read_id]"`
+}
+
+// MarkerPostRequestMarker models the position in a single timeline, as sent in a JSON request body.
+//
+// swagger:ignore
+type MarkerPostRequestMarker struct {
+	// The ID of the most recently viewed entity.
+	LastReadID string `json:"last_read_id"`
+}
+
+// HomeLastReadID should be used instead of Home or FormHomeLastReadID.
+func (r *MarkerPostRequest) HomeLastReadID() string {
+	if r.Home != nil {
+		return r.Home.LastReadID
+	}
+	return r.FormHomeLastReadID
+}
+
+// NotificationsLastReadID should be used instead of Notifications or FormNotificationsLastReadID.
+func (r *MarkerPostRequest) NotificationsLastReadID() string {
+	if r.Notifications != nil {
+		return r.Notifications.LastReadID
+	}
+	return r.FormNotificationsLastReadID
 }
@@ -34,6 +34,7 @@ type Notification struct {
 	// 	favourite = Someone favourited one of your statuses
 	// 	poll = A poll you have voted in or created has ended
 	// 	status = Someone you enabled notifications for has posted a status
+	// 	admin.sign_up = Someone signed up for a new account on the instance (admins only)
 	Type string `json:"type"`
 	// The timestamp of the notification (ISO 8601 Datetime)
 	CreatedAt string `json:"created_at"`
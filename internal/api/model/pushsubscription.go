@@ -19,6 +19,8 @@
 package model
 
 // PushSubscription represents a subscription to the push streaming server.
+//
+// swagger:model pushSubscription
 type PushSubscription struct {
 	// The id of the push subscription in the database.
 	ID string `json:"id"`
@@ -31,9 +33,13 @@ type PushSubscription struct {
 }
 
 // PushSubscriptionAlerts represents the specific alerts that this push subscription will give.
+//
+// swagger:model pushSubscriptionAlerts
 type PushSubscriptionAlerts struct {
 	// Receive a push notification when someone has followed you?
 	Follow bool `json:"follow"`
+	// Receive a push notification when someone has requested to follow you?
+	FollowRequest bool `json:"follow_request"`
 	// Receive a push notification when a status you created has been favourited by someone else?
 	Favourite bool `json:"favourite"`
 	// Receive a push notification when someone else has mentioned you in a status?
@@ -42,4 +48,57 @@ type PushSubscriptionAlerts struct {
 	Reblog bool `json:"reblog"`
 	// Receive a push notification when a poll you voted in or created has ended?
 	Poll bool `json:"poll"`
+	// Receive a push notification when someone you enabled notifications for has posted a status?
+	Status bool `json:"status"`
+	// Receive a push notification when someone has signed up for a new account on the instance? Only relevant for admins.
+	AdminSignUp bool `json:"admin.sign_up"`
+}
+
+// PushSubscriptionCreateRequest models a request to subscribe to push notifications.
+//
+// swagger:ignore
+type PushSubscriptionCreateRequest struct {
+	// The push subscription, as given by the browser or push service.
+	// name: subscription
+	Subscription *PushSubscriptionRequestSubscription `form:"-" json:"subscription" xml:"subscription"`
+	// Which alerts to receive.
+	// name: data
+	Data *PushSubscriptionRequestData `form:"-" json:"data" xml:"data"`
+}
+
+// PushSubscriptionUpdateRequest models a request to change which alerts a push subscription receives.
+//
+// swagger:ignore
+type PushSubscriptionUpdateRequest struct {
+	// Which alerts to receive.
+	// name: data
+	Data *PushSubscriptionRequestData `form:"-" json:"data" xml:"data"`
+}
+
+// PushSubscriptionRequestSubscription models the push subscription part of a PushSubscriptionCreateRequest.
+//
+// swagger:ignore
+type PushSubscriptionRequestSubscription struct {
+	// Where push alerts will be sent to.
+	Endpoint string `json:"endpoint" xml:"endpoint"`
+	// Keys to encrypt push alerts with.
+	Keys *PushSubscriptionKeys `json:"keys" xml:"keys"`
+}
+
+// PushSubscriptionKeys models the keys of a push subscription.
+//
+// swagger:ignore
+type PushSubscriptionKeys struct {
+	// Base64url encoded P-256 ECDH public key of the subscription.
+	P256dh string `json:"p256dh" xml:"p256dh"`
+	// Base64url encoded auth secret of the subscription.
+	Auth string `json:"auth" xml:"auth"`
+}
+
+// PushSubscriptionRequestData models the data part of a push subscription create or update request.
+//
+// swagger:ignore
+type PushSubscriptionRequestData struct {
+	// Which alerts to receive. Alerts not set will not be received.
+	Alerts *PushSubscriptionAlerts `json:"alerts" xml:"alerts"`
 }
@@ -19,21 +19,50 @@
 package model
 
 // ScheduledStatus represents a status that will be published at a future scheduled date.
+//
+// swagger:model scheduledStatus
 type ScheduledStatus struct {
-	ID               string        `json:"id"`
-	ScheduledAt      string        `json:"scheduled_at"`
-	Params           *StatusParams `json:"params"`
-	MediaAttachments []Attachment  `json:"media_attachments"`
+	// ID of the scheduled status.
+	ID string `json:"id"`
+	// When the status will be published (ISO 8601 Datetime).
+	ScheduledAt string `json:"scheduled_at"`
+	// Parameters the status will be published with.
+	Params *StatusParams `json:"params"`
+	// Media that will be attached to the status.
+	MediaAttachments []Attachment `json:"media_attachments"`
 }
 
 // StatusParams represents parameters for a scheduled status.
+//
+// swagger:model statusParams
 type StatusParams struct {
-	Text          string   `json:"text"`
-	InReplyToID   string   `json:"in_reply_to_id,omitempty"`
-	MediaIDs      []string `json:"media_ids,omitempty"`
-	Sensitive     bool     `json:"sensitive,omitempty"`
-	SpoilerText   string   `json:"spoiler_text,omitempty"`
-	Visibility    string   `json:"visibility"`
-	ScheduledAt   string   `json:"scheduled_at,omitempty"`
-	ApplicationID string   `json:"application_id"`
+	// Text of the status.
+	Text string `json:"text"`
+	// ID of the status being replied to, if any.
+	InReplyToID string `json:"in_reply_to_id,omitempty"`
+	// IDs of the media that will be attached to the status.
+	MediaIDs []string `json:"media_ids,omitempty"`
+	// Poll that will be attached to the status, if any.
+	Poll *PollRequest `json:"poll,omitempty"`
+	// Status and attached media will be marked as sensitive.
+	Sensitive bool `json:"sensitive,omitempty"`
+	// Content warning of the status.
+	SpoilerText string `json:"spoiler_text,omitempty"`
+	// Visibility of the status.
+	Visibility Visibility `json:"visibility"`
+	// ISO 639 language code of the status.
+	Language string `json:"language,omitempty"`
+	// Always empty; the schedule is given on the scheduled status itself.
+	ScheduledAt string `json:"scheduled_at,omitempty"`
+	// ID of the application the status was scheduled with.
+	ApplicationID string `json:"application_id"`
+}
+
+// ScheduledStatusUpdateRequest models a request to change when a scheduled status will be published.
+//
+// swagger:ignore
+type ScheduledStatusUpdateRequest struct {
+	// ISO 8601 Datetime at which the status will be published.
+	// Must be at least 5 minutes in the future.
+	ScheduledAt string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
 }
@@ -58,6 +58,8 @@ type SearchQuery struct {
 	//
 	// For a status, this can be in the format: `https://some.instance.com/@someaccount/SOME_ID_OF_A_STATUS`
 	//
+	// Otherwise, the text of local statuses by discoverable accounts is searched for statuses containing all of the given words.
+	//
 	// required: true
 	// in: query
 	Query string `json:"q"`
@@ -39,4 +39,7 @@ type Source struct {
 	Fields []Field `json:"fields"`
 	// The number of pending follow requests.
 	FollowRequestsCount int `json:"follow_requests_count"`
+	// URIs of other accounts that this account is also known as.
+	// Any of these accounts may move to this account.
+	AlsoKnownAsURIs []string `json:"also_known_as_uris,omitempty"`
 }
@@ -0,0 +1,113 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+// StatusEdit represents one revision of a status, as part of the status's edit history.
+//
+// swagger:model statusEdit
+type StatusEdit struct {
+	// The content of the status at this revision. Should be HTML, but might also be plaintext in some cases.
+	// example: <p>Hey this is a status!</p>
+	Content string `json:"content"`
+	// Subject, summary, or content warning for the status at this revision.
+	// example: warning nsfw
+	SpoilerText string `json:"spoiler_text"`
+	// Status was marked sensitive at this revision.
+	// example: false
+	Sensitive bool `json:"sensitive"`
+	// The date when this revision of the status was posted (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The account that authored this status.
+	Account *Account `json:"account"`
+	// The poll attached to the status at this revision.
+	// Vote counts are not included, only the options.
+	// nullable: true
+	Poll *StatusEditPoll `json:"poll"`
+	// Media that was attached to the status at this revision.
+	MediaAttachments []Attachment `json:"media_attachments"`
+	// Custom emoji to be used when rendering the status at this revision.
+	Emojis []Emoji `json:"emojis"`
+}
+
+// StatusEditPoll represents the options of a poll, as they were at one revision of a status.
+//
+// swagger:model statusEditPoll
+type StatusEditPoll struct {
+	// Options of the poll at this revision.
+	Options []StatusEditPollOption `json:"options"`
+}
+
+// StatusEditPollOption represents one option of a poll, as it was at one revision of a status.
+//
+// swagger:model statusEditPollOption
+type StatusEditPollOption struct {
+	// The text value of the poll option.
+	Title string `json:"title"`
+}
+
+// StatusSource represents the plain-text source of a status,
+// so that its author can edit it without having to reverse-engineer
+// the original text from the HTML content.
+//
+// swagger:model statusSource
+type StatusSource struct {
+	// ID of the status.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// Plain-text source of the status.
+	Text string `json:"text"`
+	// Plain-text source of the status's content warning.
+	SpoilerText string `json:"spoiler_text"`
+}
+
+// StatusEditRequest models status edit parameters.
+//
+// swagger:parameters statusEdit
+type StatusEditRequest struct {
+	// Text content of the status.
+	// If media_ids is provided, this becomes optional.
+	// in: formData
+	Status string `form:"status" json:"status" xml:"status"`
+	// Array of Attachment ids to be attached as media.
+	// Attachments that are not included will be removed from the status.
+	//
+	// If the status is being submitted as a form, the key is 'media_ids[]',
+	// but if it's json or xml, the key is 'media_ids'.
+	//
+	// in: formData
+	MediaIDs []string `form:"media_ids[]" json:"media_ids" xml:"media_ids"`
+	// Poll to include with this status.
+	// If the poll options are changed, all votes in the poll so far will be discarded.
+	// If no poll is provided, any existing poll will be removed.
+	// swagger:ignore
+	Poll *PollRequest `form:"poll" json:"poll" xml:"poll"`
+	// Status and attached media should be marked as sensitive.
+	// in: formData
+	Sensitive bool `form:"sensitive" json:"sensitive" xml:"sensitive"`
+	// Text to be shown as a warning or subject before the actual content.
+	// in: formData
+	SpoilerText string `form:"spoiler_text" json:"spoiler_text" xml:"spoiler_text"`
+	// ISO 639 language code for this status.
+	// in: formData
+	Language string `form:"language" json:"language" xml:"language"`
+	// Format to use when parsing this status.
+	// in: formData
+	Format StatusFormat `form:"format" json:"format" xml:"format"`
+}
@@ -28,4 +28,8 @@ type Tag struct {
 	// Web link to the hashtag.
 	// example: https://example.org/tags/helloworld
 	URL string `json:"url"`
+	// Following is true if the requesting account follows this hashtag.
+	// Only set when the tag is fetched by an authorized account.
+	// example: true
+	Following *bool `json:"following,omitempty"`
 }
@@ -35,3 +35,88 @@ type PasswordChangeRequest struct {
 	// required: true
 	NewPassword string `form:"new_password" json:"new_password" xml:"new_password" validation:"required"`
 }
+
+// EmailChangeRequest models user email change parameters.
+//
+// swagger:parameters userEmailChange
+type EmailChangeRequest struct {
+	// User's current password, for verification.
+	//
+	// in: formData
+	// required: true
+	Password string `form:"password" json:"password" xml:"password" validation:"required"`
+	// Desired new email address.
+	// The new address will only replace the current one once it has been confirmed via the emailed link.
+	// Addresses on blocked email domains, or served by mail servers on blocked email domains, will be rejected.
+	//
+	// in: formData
+	// required: true
+	NewEmail string `form:"new_email" json:"new_email" xml:"new_email" validation:"required"`
+}
+
+// TwoFactorStatus models the two-factor authentication status of a user.
+//
+// swagger:model twoFactorStatus
+type TwoFactorStatus struct {
+	// Two-factor authentication is enabled, and will be asked for when signing in with a password.
+	Enabled bool `json:"enabled"`
+	// When two-factor authentication was enabled (ISO 8601 Datetime). Omitted if it's not enabled.
+	// example: 2021-07-30T09:20:25+00:00
+	EnabledAt string `json:"enabled_at,omitempty"`
+	// Number of recovery codes that haven't been used yet.
+	RecoveryCodesLeft int `json:"recovery_codes_left"`
+}
+
+// TwoFactorSetup models a new, not yet enabled, two-factor authentication secret.
+//
+// swagger:model twoFactorSetup
+type TwoFactorSetup struct {
+	// Base32 encoded TOTP secret, for entering into an authenticator app by hand.
+	// example: JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	Secret string `json:"secret"`
+	// otpauth:// URI of the secret, as understood by authenticator apps.
+	// example: otpauth://totp/example.org:zork?algorithm=SHA1&digits=6&issuer=example.org&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	URI string `json:"uri"`
+	// The otpauth:// URI as a QR code, in the form of a PNG data URL.
+	// example: data:image/png;base64,iVBORw0KGgo...
+	QRCode string `json:"qr_code"`
+}
+
+// TwoFactorRecoveryCodes models the recovery codes returned
+// once when two-factor authentication is enabled.
+//
+// swagger:model twoFactorRecoveryCodes
+type TwoFactorRecoveryCodes struct {
+	// One-time codes that can be used to sign in instead of a TOTP code,
+	// eg., when the device with the authenticator app has been lost.
+	// They are only shown this once.
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorEnableRequest models parameters for enabling two-factor authentication.
+//
+// swagger:parameters userTwoFactorEnable
+type TwoFactorEnableRequest struct {
+	// User's current password, for verification.
+	//
+	// in: formData
+	// required: true
+	Password string `form:"password" json:"password" xml:"password" validation:"required"`
+	// Current TOTP code from the authenticator app,
+	// proving that the secret was stored correctly.
+	//
+	// in: formData
+	// required: true
+	Code string `form:"code" json:"code" xml:"code" validation:"required"`
+}
+
+// TwoFactorDisableRequest models parameters for disabling two-factor authentication.
+//
+// swagger:parameters userTwoFactorDisable
+type TwoFactorDisableRequest struct {
+	// User's current password, for verification.
+	//
+	// in: formData
+	// required: true
+	Password string `form:"password" json:"password" xml:"password" validation:"required"`
+}
@@ -51,23 +51,53 @@ type GTSCaches interface {
 	// EmojiCategory provides access to the gtsmodel EmojiCategory database cache.
 	EmojiCategory() *result.Cache[*gtsmodel.EmojiCategory]
 
+	// FailingInbox provides access to the gtsmodel FailingInbox database cache.
+	FailingInbox() *result.Cache[*gtsmodel.FailingInbox]
+
+	// Filter provides access to the gtsmodel Filter database cache.
+	Filter() *result.Cache[*gtsmodel.Filter]
+
+	// FilterKeyword provides access to the gtsmodel FilterKeyword database cache.
+	FilterKeyword() *result.Cache[*gtsmodel.FilterKeyword]
+
+	// List provides access to the gtsmodel List database cache.
+	List() *result.Cache[*gtsmodel.List]
+
+	// ListEntry provides access to the gtsmodel ListEntry database cache.
+	ListEntry() *result.Cache[*gtsmodel.ListEntry]
+
+	// Marker provides access to the gtsmodel Marker database cache.
+	Marker() *result.Cache[*gtsmodel.Marker]
+
 	// Mention provides access to the gtsmodel Mention database cache.
 	Mention() *result.Cache[*gtsmodel.Mention]
 
 	// Notification provides access to the gtsmodel Notification database cache.
 	Notification() *result.Cache[*gtsmodel.Notification]
 
+	// Poll provides access to the gtsmodel Poll database cache.
+	Poll() *result.Cache[*gtsmodel.Poll]
+
+	// PollVote provides access to the gtsmodel PollVote database cache.
+	PollVote() *result.Cache[*gtsmodel.PollVote]
+
 	// Report provides access to the gtsmodel Report database cache.
 	Report() *result.Cache[*gtsmodel.Report]
 
 	// Status provides access to the gtsmodel Status database cache.
 	Status() *result.Cache[*gtsmodel.Status]
 
+	// Tag provides access to the gtsmodel Tag database cache.
+	Tag() *result.Cache[*gtsmodel.Tag]
+
 	// Tombstone provides access to the gtsmodel Tombstone database cache.
 	Tombstone() *result.Cache[*gtsmodel.Tombstone]
 
 	// User provides access to the gtsmodel User database cache.
 	User() *result.Cache[*gtsmodel.User]
+
+	// UserMute provides access to the gtsmodel UserMute database cache.
+	UserMute() *result.Cache[*gtsmodel.UserMute]
 }
 
 // NewGTS returns a new default implementation of GTSCaches.
@@ -81,12 +111,22 @@ type gtsCaches struct {
 	domainBlock   *domain.BlockCache
 	emoji         *result.Cache[*gtsmodel.Emoji]
 	emojiCategory *result.Cache[*gtsmodel.EmojiCategory]
+	failingInbox  *result.Cache[*gtsmodel.FailingInbox]
+	filter        *result.Cache[*gtsmodel.Filter]
+	filterKeyword *result.Cache[*gtsmodel.FilterKeyword]
+	list          *result.Cache[*gtsmodel.List]
+	listEntry     *result.Cache[*gtsmodel.ListEntry]
+	marker        *result.Cache[*gtsmodel.Marker]
 	mention       *result.Cache[*gtsmodel.Mention]
 	notification  *result.Cache[*gtsmodel.Notification]
+	poll          *result.Cache[*gtsmodel.Poll]
+	pollVote      *result.Cache[*gtsmodel.PollVote]
 	report        *result.Cache[*gtsmodel.Report]
 	status        *result.Cache[*gtsmodel.Status]
+	tag           *result.Cache[*gtsmodel.Tag]
 	tombstone     *result.Cache[*gtsmodel.Tombstone]
 	user          *result.Cache[*gtsmodel.User]
+	userMute      *result.Cache[*gtsmodel.UserMute]
 }
 
 func (c *gtsCaches) Init() {
@@ -95,12 +135,22 @@ func (c *gtsCaches) Init() {
 	c.initDomainBlock()
 	c.initEmoji()
 	c.initEmojiCategory()
+	c.initFailingInbox()
+	c.initFilter()
+	c.initFilterKeyword()
+	c.initList()
+	c.initListEntry()
+	c.initMarker()
 	c.initMention()
 	c.initNotification()
+	c.initPoll()
+	c.initPollVote()
 	c.initReport()
 	c.initStatus()
+	c.initTag()
 	c.initTombstone()
 	c.initUser()
+	c.initUserMute()
 }
 
 func (c *gtsCaches) Start() {
@@ -119,24 +169,54 @@ func (c *gtsCaches) Start() {
 	tryUntil("starting gtsmodel.EmojiCategory cache", 5, func() bool {
 		return c.emojiCategory.Start(config.GetCacheGTSEmojiCategorySweepFreq())
 	})
+	tryUntil("starting gtsmodel.FailingInbox cache", 5, func() bool {
+		return c.failingInbox.Start(config.GetCacheGTSFailingInboxSweepFreq())
+	})
+	tryUntil("starting gtsmodel.Filter cache", 5, func() bool {
+		return c.filter.Start(config.GetCacheGTSFilterSweepFreq())
+	})
+	tryUntil("starting gtsmodel.FilterKeyword cache", 5, func() bool {
+		return c.filterKeyword.Start(config.GetCacheGTSFilterKeywordSweepFreq())
+	})
+	tryUntil("starting gtsmodel.List cache", 5, func() bool {
+		return c.list.Start(config.GetCacheGTSListSweepFreq())
+	})
+	tryUntil("starting gtsmodel.ListEntry cache", 5, func() bool {
+		return c.listEntry.Start(config.GetCacheGTSListEntrySweepFreq())
+	})
+	tryUntil("starting gtsmodel.Marker cache", 5, func() bool {
+		return c.marker.Start(config.GetCacheGTSMarkerSweepFreq())
+	})
 	tryUntil("starting gtsmodel.Mention cache", 5, func() bool {
 		return c.mention.Start(config.GetCacheGTSMentionSweepFreq())
 	})
 	tryUntil("starting gtsmodel.Notification cache", 5, func() bool {
 		return c.notification.Start(config.GetCacheGTSNotificationSweepFreq())
 	})
+	tryUntil("starting gtsmodel.Poll cache", 5, func() bool {
+		return c.poll.Start(config.GetCacheGTSPollSweepFreq())
+	})
+	tryUntil("starting gtsmodel.PollVote cache", 5, func() bool {
+		return c.pollVote.Start(config.GetCacheGTSPollVoteSweepFreq())
+	})
 	tryUntil("starting gtsmodel.Report cache", 5, func() bool {
 		return c.report.Start(config.GetCacheGTSReportSweepFreq())
 	})
 	tryUntil("starting gtsmodel.Status cache", 5, func() bool {
 		return c.status.Start(config.GetCacheGTSStatusSweepFreq())
 	})
+	tryUntil("starting gtsmodel.Tag cache", 5, func() bool {
+		return c.tag.Start(config.GetCacheGTSTagSweepFreq())
+	})
 	tryUntil("starting gtsmodel.Tombstone cache", 5, func() bool {
 		return c.tombstone.Start(config.GetCacheGTSTombstoneSweepFreq())
 	})
 	tryUntil("starting gtsmodel.User cache", 5, func() bool {
 		return c.user.Start(config.GetCacheGTSUserSweepFreq())
 	})
+	tryUntil("starting gtsmodel.UserMute cache", 5, func() bool {
+		return c.userMute.Start(config.GetCacheGTSUserMuteSweepFreq())
+	})
 }
 
 func (c *gtsCaches) Stop() {
@@ -145,12 +225,22 @@ func (c *gtsCaches) Stop() {
 	tryUntil("stopping gtsmodel.DomainBlock cache", 5, c.domainBlock.Stop)
 	tryUntil("stopping gtsmodel.Emoji cache", 5, c.emoji.Stop)
 	tryUntil("stopping gtsmodel.EmojiCategory cache", 5, c.emojiCategory.Stop)
+	tryUntil("stopping gtsmodel.FailingInbox cache", 5, c.failingInbox.Stop)
+	tryUntil("stopping gtsmodel.Filter cache", 5, c.filter.Stop)
+	tryUntil("stopping gtsmodel.FilterKeyword cache", 5, c.filterKeyword.Stop)
+	tryUntil("stopping gtsmodel.List cache", 5, c.list.Stop)
+	tryUntil("stopping gtsmodel.ListEntry cache", 5, c.listEntry.Stop)
+	tryUntil("stopping gtsmodel.Marker cache", 5, c.marker.Stop)
 	tryUntil("stopping gtsmodel.Mention cache", 5, c.mention.Stop)
 	tryUntil("stopping gtsmodel.Notification cache", 5, c.notification.Stop)
+	tryUntil("stopping gtsmodel.Poll cache", 5, c.poll.Stop)
+	tryUntil("stopping gtsmodel.PollVote cache", 5, c.pollVote.Stop)
 	tryUntil("stopping gtsmodel.Report cache", 5, c.report.Stop)
 	tryUntil("stopping gtsmodel.Status cache", 5, c.status.Stop)
+	tryUntil("stopping gtsmodel.Tag cache", 5, c.tag.Stop)
 	tryUntil("stopping gtsmodel.Tombstone cache", 5, c.tombstone.Stop)
 	tryUntil("stopping gtsmodel.User cache", 5, c.user.Stop)
+	tryUntil("stopping gtsmodel.UserMute cache", 5, c.userMute.Stop)
 }
 
 func (c *gtsCaches) Account() *result.Cache[*gtsmodel.Account] {
@@ -173,6 +263,30 @@ func (c *gtsCaches) EmojiCategory() *result.Cache[*gtsmodel.EmojiCategory] {
 	return c.emojiCategory
 }
 
+func (c *gtsCaches) FailingInbox() *result.Cache[*gtsmodel.FailingInbox] {
+	return c.failingInbox
+}
+
+func (c *gtsCaches) Filter() *result.Cache[*gtsmodel.Filter] {
+	return c.filter
+}
+
+func (c *gtsCaches) FilterKeyword() *result.Cache[*gtsmodel.FilterKeyword] {
+	return c.filterKeyword
+}
+
+func (c *gtsCaches) List() *result.Cache[*gtsmodel.List] {
+	return c.list
+}
+
+func (c *gtsCaches) ListEntry() *result.Cache[*gtsmodel.ListEntry] {
+	return c.listEntry
+}
+
+func (c *gtsCaches) Marker() *result.Cache[*gtsmodel.Marker] {
+	return c.marker
+}
+
 func (c *gtsCaches) Mention() *result.Cache[*gtsmodel.Mention] {
 	return c.mention
 }
@@ -181,6 +295,14 @@ func (c *gtsCaches) Notification() *result.Cache[*gtsmodel.Notification] {
 	return c.notification
 }
 
+func (c *gtsCaches) Poll() *result.Cache[*gtsmodel.Poll] {
+	return c.poll
+}
+
+func (c *gtsCaches) PollVote() *result.Cache[*gtsmodel.PollVote] {
+	return c.pollVote
+}
+
 func (c *gtsCaches) Report() *result.Cache[*gtsmodel.Report] {
 	return c.report
 }
@@ -189,6 +311,10 @@ func (c *gtsCaches) Status() *result.Cache[*gtsmodel.Status] {
 	return c.status
 }
 
+func (c *gtsCaches) Tag() *result.Cache[*gtsmodel.Tag] {
+	return c.tag
+}
+
 func (c *gtsCaches) Tombstone() *result.Cache[*gtsmodel.Tombstone] {
 	return c.tombstone
 }
@@ -197,6 +323,10 @@ func (c *gtsCaches) User() *result.Cache[*gtsmodel.User] {
 	return c.user
 }
 
+func (c *gtsCaches) UserMute() *result.Cache[*gtsmodel.UserMute] {
+	return c.userMute
+}
+
 func (c *gtsCaches) initAccount() {
 	c.account = result.New([]result.Lookup{
 		{Name: "ID"},
@@ -258,6 +388,73 @@ func (c *gtsCaches) initEmojiCategory() {
 	c.emojiCategory.SetTTL(config.GetCacheGTSEmojiCategoryTTL(), true)
 }
 
+func (c *gtsCaches) initFailingInbox() {
+	c.failingInbox = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "URI"},
+	}, func(f1 *gtsmodel.FailingInbox) *gtsmodel.FailingInbox {
+		f2 := new(gtsmodel.FailingInbox)
+		*f2 = *f1
+		return f2
+	}, config.GetCacheGTSFailingInboxMaxSize())
+	c.failingInbox.SetTTL(config.GetCacheGTSFailingInboxTTL(), true)
+}
+
+func (c *gtsCaches) initFilter() {
+	c.filter = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(f1 *gtsmodel.Filter) *gtsmodel.Filter {
+		f2 := new(gtsmodel.Filter)
+		*f2 = *f1
+		return f2
+	}, config.GetCacheGTSFilterMaxSize())
+	c.filter.SetTTL(config.GetCacheGTSFilterTTL(), true)
+}
+
+func (c *gtsCaches) initFilterKeyword() {
+	c.filterKeyword = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(f1 *gtsmodel.FilterKeyword) *gtsmodel.FilterKeyword {
+		f2 := new(gtsmodel.FilterKeyword)
+		*f2 = *f1
+		return f2
+	}, config.GetCacheGTSFilterKeywordMaxSize())
+	c.filterKeyword.SetTTL(config.GetCacheGTSFilterKeywordTTL(), true)
+}
+
+func (c *gtsCaches) initList() {
+	c.list = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(l1 *gtsmodel.List) *gtsmodel.List {
+		l2 := new(gtsmodel.List)
+		*l2 = *l1
+		return l2
+	}, config.GetCacheGTSListMaxSize())
+	c.list.SetTTL(config.GetCacheGTSListTTL(), true)
+}
+
+func (c *gtsCaches) initListEntry() {
+	c.listEntry = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(l1 *gtsmodel.ListEntry) *gtsmodel.ListEntry {
+		l2 := new(gtsmodel.ListEntry)
+		*l2 = *l1
+		return l2
+	}, config.GetCacheGTSListEntryMaxSize())
+	c.listEntry.SetTTL(config.GetCacheGTSListEntryTTL(), true)
+}
+
+func (c *gtsCaches) initMarker() {
+	c.marker = result.New([]result.Lookup{
+		{Name: "AccountID.Name"},
+	}, func(m1 *gtsmodel.Marker) *gtsmodel.Marker {
+		m2 := new(gtsmodel.Marker)
+		*m2 = *m1
+		return m2
+	}, config.GetCacheGTSMarkerMaxSize())
+	c.marker.SetTTL(config.GetCacheGTSMarkerTTL(), true)
+}
+
 func (c *gtsCaches) initMention() {
 	c.mention = result.New([]result.Lookup{
 		{Name: "ID"},
@@ -280,6 +477,30 @@ func (c *gtsCaches) initNotification() {
 	c.notification.SetTTL(config.GetCacheGTSNotificationTTL(), true)
 }
 
+func (c *gtsCaches) initPoll() {
+	c.poll = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "StatusID"},
+	}, func(p1 *gtsmodel.Poll) *gtsmodel.Poll {
+		p2 := new(gtsmodel.Poll)
+		*p2 = *p1
+		return p2
+	}, config.GetCacheGTSPollMaxSize())
+	c.poll.SetTTL(config.GetCacheGTSPollTTL(), true)
+}
+
+func (c *gtsCaches) initPollVote() {
+	c.pollVote = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "PollID.AccountID"},
+	}, func(p1 *gtsmodel.PollVote) *gtsmodel.PollVote {
+		p2 := new(gtsmodel.PollVote)
+		*p2 = *p1
+		return p2
+	}, config.GetCacheGTSPollVoteMaxSize())
+	c.pollVote.SetTTL(config.GetCacheGTSPollVoteTTL(), true)
+}
+
 func (c *gtsCaches) initReport() {
 	c.report = result.New([]result.Lookup{
 		{Name: "ID"},
@@ -304,6 +525,18 @@ func (c *gtsCaches) initStatus() {
 	c.status.SetTTL(config.GetCacheGTSStatusTTL(), true)
 }
 
+// initTag will initialize the gtsmodel.Tag cache.
+func (c *gtsCaches) initTag() {
+	c.tag = result.New([]result.Lookup{
+		{Name: "ID"},
+	}, func(t1 *gtsmodel.Tag) *gtsmodel.Tag {
+		t2 := new(gtsmodel.Tag)
+		*t2 = *t1
+		return t2
+	}, config.GetCacheGTSTagMaxSize())
+	c.tag.SetTTL(config.GetCacheGTSTagTTL(), true)
+}
+
 // initTombstone will initialize the gtsmodel.Tombstone cache.
 func (c *gtsCaches) initTombstone() {
 	c.tombstone = result.New([]result.Lookup{
@@ -331,3 +564,15 @@ func (c *gtsCaches) initUser() {
 	}, config.GetCacheGTSUserMaxSize())
 	c.user.SetTTL(config.GetCacheGTSUserTTL(), true)
 }
+
+func (c *gtsCaches) initUserMute() {
+	c.userMute = result.New([]result.Lookup{
+		{Name: "ID"},
+		{Name: "AccountID.TargetAccountID"},
+	}, func(m1 *gtsmodel.UserMute) *gtsmodel.UserMute {
+		m2 := new(gtsmodel.UserMute)
+		*m2 = *m1
+		return m2
+	}, config.GetCacheGTSUserMuteMaxSize())
+	c.userMute.SetTTL(config.GetCacheGTSUserMuteTTL(), true)
+}
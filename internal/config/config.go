@@ -81,6 +81,10 @@ type Configuration struct {
 	InstanceExposePublicTimeline   bool `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
 	InstanceDeliverToSharedInboxes bool `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
 
+	InstanceDeliveryMaxAge           time.Duration `name:"instance-delivery-max-age" usage:"Keep retrying failed deliveries of federated messages for this long before giving up on them."`
+	InstanceDeliveryUnreachableAfter time.Duration `name:"instance-delivery-unreachable-after" usage:"Mark an inbox as unreachable once deliveries to it have been failing for this long."`
+	InstanceDeliveryUnreachableRetry time.Duration `name:"instance-delivery-unreachable-retry" usage:"Only attempt deliveries to an unreachable inbox this often, until one succeeds."`
+
 	AccountsRegistrationOpen bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
 	AccountsApprovalRequired bool `name:"accounts-approval-required" usage:"Do account signups require approval by an admin or moderator before user can log in? If false, new registrations will be automatically approved."`
 	AccountsReasonRequired   bool `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
@@ -108,6 +112,7 @@ type Configuration struct {
 	StatusesPollMaxOptions     int `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
 	StatusesPollOptionMaxChars int `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
 	StatusesMediaMaxFiles      int `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
+	StatusesPinnedMax          int `name:"statuses-pinned-max" usage:"Maximum number of statuses an account can pin to their profile"`
 
 	LetsEncryptEnabled      bool   `name:"letsencrypt-enabled" usage:"Enable letsencrypt TLS certs for this server. If set to true, then cert dir also needs to be set (or take the default)."`
 	LetsEncryptPort         int    `name:"letsencrypt-port" usage:"Port to listen on for letsencrypt certificate challenges. Must not be the same as the GtS webserver/API port."`
@@ -129,6 +134,9 @@ type Configuration struct {
 	SMTPPassword string `name:"smtp-password" usage:"Password to pass to the smtp server."`
 	SMTPFrom     string `name:"smtp-from" usage:"Address to use as the 'from' field of the email. Eg., 'gotosocial@example.org'"`
 
+	WebPushEnabled      bool   `name:"web-push-enabled" usage:"Allow client apps to subscribe to Web Push notifications, so they can be notified without keeping a stream open."`
+	WebPushVAPIDSubject string `name:"web-push-vapid-subject" usage:"Contact URI to give to Web Push services when sending notifications, either 'mailto:' or 'https:'. If not set, the https address of this instance is used."`
+
 	SyslogEnabled  bool   `name:"syslog-enabled" usage:"Enable the syslog logging hook. Logs will be mirrored to the configured destination."`
 	SyslogProtocol string `name:"syslog-protocol" usage:"Protocol to use when directing logs to syslog. Leave empty to connect to local syslog."`
 	SyslogAddress  string `name:"syslog-address" usage:"Address:port to send syslog logs to. Leave empty to connect to local syslog."`
@@ -174,6 +182,30 @@ type GTSCacheConfiguration struct {
 	EmojiCategoryTTL       time.Duration `name:"emoji-category-ttl"`
 	EmojiCategorySweepFreq time.Duration `name:"emoji-category-sweep-freq"`
 
+	FailingInboxMaxSize   int           `name:"failing-inbox-max-size"`
+	FailingInboxTTL       time.Duration `name:"failing-inbox-ttl"`
+	FailingInboxSweepFreq time.Duration `name:"failing-inbox-sweep-freq"`
+
+	FilterMaxSize   int           `name:"filter-max-size"`
+	FilterTTL       time.Duration `name:"filter-ttl"`
+	FilterSweepFreq time.Duration `name:"filter-sweep-freq"`
+
+	FilterKeywordMaxSize   int           `name:"filter-keyword-max-size"`
+	FilterKeywordTTL       time.Duration `name:"filter-keyword-ttl"`
+	FilterKeywordSweepFreq time.Duration `name:"filter-keyword-sweep-freq"`
+
+	ListMaxSize   int           `name:"list-max-size"`
+	ListTTL       time.Duration `name:"list-ttl"`
+	ListSweepFreq time.Duration `name:"list-sweep-freq"`
+
+	ListEntryMaxSize   int           `name:"list-entry-max-size"`
+	ListEntryTTL       time.Duration `name:"list-entry-ttl"`
+	ListEntrySweepFreq time.Duration `name:"list-entry-sweep-freq"`
+
+	MarkerMaxSize   int           `name:"marker-max-size"`
+	MarkerTTL       time.Duration `name:"marker-ttl"`
+	MarkerSweepFreq time.Duration `name:"marker-sweep-freq"`
+
 	MentionMaxSize   int           `name:"mention-max-size"`
 	MentionTTL       time.Duration `name:"mention-ttl"`
 	MentionSweepFreq time.Duration `name:"mention-sweep-freq"`
@@ -182,6 +214,14 @@ type GTSCacheConfiguration struct {
 	NotificationTTL       time.Duration `name:"notification-ttl"`
 	NotificationSweepFreq time.Duration `name:"notification-sweep-freq"`
 
+	PollMaxSize   int           `name:"poll-max-size"`
+	PollTTL       time.Duration `name:"poll-ttl"`
+	PollSweepFreq time.Duration `name:"poll-sweep-freq"`
+
+	PollVoteMaxSize   int           `name:"poll-vote-max-size"`
+	PollVoteTTL       time.Duration `name:"poll-vote-ttl"`
+	PollVoteSweepFreq time.Duration `name:"poll-vote-sweep-freq"`
+
 	ReportMaxSize   int           `name:"report-max-size"`
 	ReportTTL       time.Duration `name:"report-ttl"`
 	ReportSweepFreq time.Duration `name:"report-sweep-freq"`
@@ -190,6 +230,10 @@ type GTSCacheConfiguration struct {
 	StatusTTL       time.Duration `name:"status-ttl"`
 	StatusSweepFreq time.Duration `name:"status-sweep-freq"`
 
+	TagMaxSize   int           `name:"tag-max-size"`
+	TagTTL       time.Duration `name:"tag-ttl"`
+	TagSweepFreq time.Duration `name:"tag-sweep-freq"`
+
 	TombstoneMaxSize   int           `name:"tombstone-max-size"`
 	TombstoneTTL       time.Duration `name:"tombstone-ttl"`
 	TombstoneSweepFreq time.Duration `name:"tombstone-sweep-freq"`
@@ -197,6 +241,10 @@ type GTSCacheConfiguration struct {
 	UserMaxSize   int           `name:"user-max-size"`
 	UserTTL       time.Duration `name:"user-ttl"`
 	UserSweepFreq time.Duration `name:"user-sweep-freq"`
+
+	UserMuteMaxSize   int           `name:"user-mute-max-size"`
+	UserMuteTTL       time.Duration `name:"user-mute-ttl"`
+	UserMuteSweepFreq time.Duration `name:"user-mute-sweep-freq"`
 }
 
 // MarshalMap will marshal current Configuration into a map structure (useful for JSON/TOML/YAML).
@@ -62,6 +62,10 @@ var Defaults = Configuration{
 	InstanceExposeSuspendedWeb:     false,
 	InstanceDeliverToSharedInboxes: true,
 
+	InstanceDeliveryMaxAge:           time.Hour * 48,
+	InstanceDeliveryUnreachableAfter: time.Hour * 24 * 7,
+	InstanceDeliveryUnreachableRetry: time.Hour * 24,
+
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
 	AccountsReasonRequired:   true,
@@ -85,6 +89,7 @@ var Defaults = Configuration{
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
+	StatusesPinnedMax:          5,
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         80,
@@ -106,6 +111,9 @@ var Defaults = Configuration{
 	SMTPPassword: "",
 	SMTPFrom:     "GoToSocial",
 
+	WebPushEnabled:      true,
+	WebPushVAPIDSubject: "",
+
 	SyslogEnabled:  false,
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
@@ -136,6 +144,30 @@ var Defaults = Configuration{
 			EmojiCategoryTTL:       time.Minute * 5,
 			EmojiCategorySweepFreq: time.Second * 10,
 
+			FailingInboxMaxSize:   1000,
+			FailingInboxTTL:       time.Minute * 5,
+			FailingInboxSweepFreq: time.Second * 10,
+
+			FilterMaxSize:   1000,
+			FilterTTL:       time.Minute * 5,
+			FilterSweepFreq: time.Second * 10,
+
+			FilterKeywordMaxSize:   1000,
+			FilterKeywordTTL:       time.Minute * 5,
+			FilterKeywordSweepFreq: time.Second * 10,
+
+			ListMaxSize:   2000,
+			ListTTL:       time.Minute * 5,
+			ListSweepFreq: time.Second * 10,
+
+			ListEntryMaxSize:   2000,
+			ListEntryTTL:       time.Minute * 5,
+			ListEntrySweepFreq: time.Second * 10,
+
+			MarkerMaxSize:   2000,
+			MarkerTTL:       time.Hour * 6,
+			MarkerSweepFreq: time.Minute,
+
 			MentionMaxSize:   500,
 			MentionTTL:       time.Minute * 5,
 			MentionSweepFreq: time.Second * 10,
@@ -144,6 +176,14 @@ var Defaults = Configuration{
 			NotificationTTL:       time.Minute * 5,
 			NotificationSweepFreq: time.Second * 10,
 
+			PollMaxSize:   1000,
+			PollTTL:       time.Minute * 5,
+			PollSweepFreq: time.Second * 10,
+
+			PollVoteMaxSize:   1000,
+			PollVoteTTL:       time.Minute * 5,
+			PollVoteSweepFreq: time.Second * 10,
+
 			ReportMaxSize:   100,
 			ReportTTL:       time.Minute * 5,
 			ReportSweepFreq: time.Second * 10,
@@ -152,6 +192,10 @@ var Defaults = Configuration{
 			StatusTTL:       time.Minute * 5,
 			StatusSweepFreq: time.Second * 10,
 
+			TagMaxSize:   2000,
+			TagTTL:       time.Minute * 5,
+			TagSweepFreq: time.Second * 10,
+
 			TombstoneMaxSize:   100,
 			TombstoneTTL:       time.Minute * 5,
 			TombstoneSweepFreq: time.Second * 10,
@@ -159,6 +203,10 @@ var Defaults = Configuration{
 			UserMaxSize:   100,
 			UserTTL:       time.Minute * 5,
 			UserSweepFreq: time.Second * 10,
+
+			UserMuteMaxSize:   1000,
+			UserMuteTTL:       time.Minute * 5,
+			UserMuteSweepFreq: time.Second * 10,
 		},
 	},
 
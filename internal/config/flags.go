@@ -81,6 +81,9 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Bool(InstanceExposeSuspendedFlag(), cfg.InstanceExposeSuspended, fieldtag("InstanceExposeSuspended", "usage"))
 		cmd.Flags().Bool(InstanceExposeSuspendedWebFlag(), cfg.InstanceExposeSuspendedWeb, fieldtag("InstanceExposeSuspendedWeb", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
+		cmd.Flags().Duration(InstanceDeliveryMaxAgeFlag(), cfg.InstanceDeliveryMaxAge, fieldtag("InstanceDeliveryMaxAge", "usage"))
+		cmd.Flags().Duration(InstanceDeliveryUnreachableAfterFlag(), cfg.InstanceDeliveryUnreachableAfter, fieldtag("InstanceDeliveryUnreachableAfter", "usage"))
+		cmd.Flags().Duration(InstanceDeliveryUnreachableRetryFlag(), cfg.InstanceDeliveryUnreachableRetry, fieldtag("InstanceDeliveryUnreachableRetry", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
@@ -107,6 +110,7 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Int(StatusesPollMaxOptionsFlag(), cfg.StatusesPollMaxOptions, fieldtag("StatusesPollMaxOptions", "usage"))
 		cmd.Flags().Int(StatusesPollOptionMaxCharsFlag(), cfg.StatusesPollOptionMaxChars, fieldtag("StatusesPollOptionMaxChars", "usage"))
 		cmd.Flags().Int(StatusesMediaMaxFilesFlag(), cfg.StatusesMediaMaxFiles, fieldtag("StatusesMediaMaxFiles", "usage"))
+		cmd.Flags().Int(StatusesPinnedMaxFlag(), cfg.StatusesPinnedMax, fieldtag("StatusesPinnedMax", "usage"))
 
 		// LetsEncrypt
 		cmd.Flags().Bool(LetsEncryptEnabledFlag(), cfg.LetsEncryptEnabled, fieldtag("LetsEncryptEnabled", "usage"))
@@ -130,6 +134,10 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(SMTPPasswordFlag(), cfg.SMTPPassword, fieldtag("SMTPPassword", "usage"))
 		cmd.Flags().String(SMTPFromFlag(), cfg.SMTPFrom, fieldtag("SMTPFrom", "usage"))
 
+		// Web Push
+		cmd.Flags().Bool(WebPushEnabledFlag(), cfg.WebPushEnabled, fieldtag("WebPushEnabled", "usage"))
+		cmd.Flags().String(WebPushVAPIDSubjectFlag(), cfg.WebPushVAPIDSubject, fieldtag("WebPushVAPIDSubject", "usage"))
+
 		// Syslog
 		cmd.Flags().Bool(SyslogEnabledFlag(), cfg.SyslogEnabled, fieldtag("SyslogEnabled", "usage"))
 		cmd.Flags().String(SyslogProtocolFlag(), cfg.SyslogProtocol, fieldtag("SyslogProtocol", "usage"))
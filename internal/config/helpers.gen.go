@@ -824,6 +824,89 @@ func GetInstanceDeliverToSharedInboxes() bool { return global.GetInstanceDeliver
 // SetInstanceDeliverToSharedInboxes safely sets the value for global configuration 'InstanceDeliverToSharedInboxes' field
 func SetInstanceDeliverToSharedInboxes(v bool) { global.SetInstanceDeliverToSharedInboxes(v) }
 
+// GetInstanceDeliveryMaxAge safely fetches the Configuration value for state's 'InstanceDeliveryMaxAge' field
+func (st *ConfigState) GetInstanceDeliveryMaxAge() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.InstanceDeliveryMaxAge
+	st.mutex.Unlock()
+	return
+}
+
+// SetInstanceDeliveryMaxAge safely sets the Configuration value for state's 'InstanceDeliveryMaxAge' field
+func (st *ConfigState) SetInstanceDeliveryMaxAge(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceDeliveryMaxAge = v
+	st.reloadToViper()
+}
+
+// InstanceDeliveryMaxAgeFlag returns the flag name for the 'InstanceDeliveryMaxAge' field
+func InstanceDeliveryMaxAgeFlag() string { return "instance-delivery-max-age" }
+
+// GetInstanceDeliveryMaxAge safely fetches the value for global configuration 'InstanceDeliveryMaxAge' field
+func GetInstanceDeliveryMaxAge() time.Duration { return global.GetInstanceDeliveryMaxAge() }
+
+// SetInstanceDeliveryMaxAge safely sets the value for global configuration 'InstanceDeliveryMaxAge' field
+func SetInstanceDeliveryMaxAge(v time.Duration) { global.SetInstanceDeliveryMaxAge(v) }
+
+// GetInstanceDeliveryUnreachableAfter safely fetches the Configuration value for state's 'InstanceDeliveryUnreachableAfter' field
+func (st *ConfigState) GetInstanceDeliveryUnreachableAfter() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.InstanceDeliveryUnreachableAfter
+	st.mutex.Unlock()
+	return
+}
+
+// SetInstanceDeliveryUnreachableAfter safely sets the Configuration value for state's 'InstanceDeliveryUnreachableAfter' field
+func (st *ConfigState) SetInstanceDeliveryUnreachableAfter(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceDeliveryUnreachableAfter = v
+	st.reloadToViper()
+}
+
+// InstanceDeliveryUnreachableAfterFlag returns the flag name for the 'InstanceDeliveryUnreachableAfter' field
+func InstanceDeliveryUnreachableAfterFlag() string { return "instance-delivery-unreachable-after" }
+
+// GetInstanceDeliveryUnreachableAfter safely fetches the value for global configuration 'InstanceDeliveryUnreachableAfter' field
+func GetInstanceDeliveryUnreachableAfter() time.Duration {
+	return global.GetInstanceDeliveryUnreachableAfter()
+}
+
+// SetInstanceDeliveryUnreachableAfter safely sets the value for global configuration 'InstanceDeliveryUnreachableAfter' field
+func SetInstanceDeliveryUnreachableAfter(v time.Duration) {
+	global.SetInstanceDeliveryUnreachableAfter(v)
+}
+
+// GetInstanceDeliveryUnreachableRetry safely fetches the Configuration value for state's 'InstanceDeliveryUnreachableRetry' field
+func (st *ConfigState) GetInstanceDeliveryUnreachableRetry() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.InstanceDeliveryUnreachableRetry
+	st.mutex.Unlock()
+	return
+}
+
+// SetInstanceDeliveryUnreachableRetry safely sets the Configuration value for state's 'InstanceDeliveryUnreachableRetry' field
+func (st *ConfigState) SetInstanceDeliveryUnreachableRetry(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceDeliveryUnreachableRetry = v
+	st.reloadToViper()
+}
+
+// InstanceDeliveryUnreachableRetryFlag returns the flag name for the 'InstanceDeliveryUnreachableRetry' field
+func InstanceDeliveryUnreachableRetryFlag() string { return "instance-delivery-unreachable-retry" }
+
+// GetInstanceDeliveryUnreachableRetry safely fetches the value for global configuration 'InstanceDeliveryUnreachableRetry' field
+func GetInstanceDeliveryUnreachableRetry() time.Duration {
+	return global.GetInstanceDeliveryUnreachableRetry()
+}
+
+// SetInstanceDeliveryUnreachableRetry safely sets the value for global configuration 'InstanceDeliveryUnreachableRetry' field
+func SetInstanceDeliveryUnreachableRetry(v time.Duration) {
+	global.SetInstanceDeliveryUnreachableRetry(v)
+}
+
 // GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
 func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
 	st.mutex.Lock()
@@ -1424,6 +1507,31 @@ func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
 // SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
 func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
 
+// GetStatusesPinnedMax safely fetches the Configuration value for state's 'StatusesPinnedMax' field
+func (st *ConfigState) GetStatusesPinnedMax() (v int) {
+	st.mutex.Lock()
+	v = st.config.StatusesPinnedMax
+	st.mutex.Unlock()
+	return
+}
+
+// SetStatusesPinnedMax safely sets the Configuration value for state's 'StatusesPinnedMax' field
+func (st *ConfigState) SetStatusesPinnedMax(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesPinnedMax = v
+	st.reloadToViper()
+}
+
+// StatusesPinnedMaxFlag returns the flag name for the 'StatusesPinnedMax' field
+func StatusesPinnedMaxFlag() string { return "statuses-pinned-max" }
+
+// GetStatusesPinnedMax safely fetches the value for global configuration 'StatusesPinnedMax' field
+func GetStatusesPinnedMax() int { return global.GetStatusesPinnedMax() }
+
+// SetStatusesPinnedMax safely sets the value for global configuration 'StatusesPinnedMax' field
+func SetStatusesPinnedMax(v int) { global.SetStatusesPinnedMax(v) }
+
 // GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
 func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
 	st.mutex.Lock()
@@ -1849,6 +1957,56 @@ func GetSMTPFrom() string { return global.GetSMTPFrom() }
 // SetSMTPFrom safely sets the value for global configuration 'SMTPFrom' field
 func SetSMTPFrom(v string) { global.SetSMTPFrom(v) }
 
+// GetWebPushEnabled safely fetches the Configuration value for state's 'WebPushEnabled' field
+func (st *ConfigState) GetWebPushEnabled() (v bool) {
+	st.mutex.Lock()
+	v = st.config.WebPushEnabled
+	st.mutex.Unlock()
+	return
+}
+
+// SetWebPushEnabled safely sets the Configuration value for state's 'WebPushEnabled' field
+func (st *ConfigState) SetWebPushEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.WebPushEnabled = v
+	st.reloadToViper()
+}
+
+// WebPushEnabledFlag returns the flag name for the 'WebPushEnabled' field
+func WebPushEnabledFlag() string { return "web-push-enabled" }
+
+// GetWebPushEnabled safely fetches the value for global configuration 'WebPushEnabled' field
+func GetWebPushEnabled() bool { return global.GetWebPushEnabled() }
+
+// SetWebPushEnabled safely sets the value for global configuration 'WebPushEnabled' field
+func SetWebPushEnabled(v bool) { global.SetWebPushEnabled(v) }
+
+// GetWebPushVAPIDSubject safely fetches the Configuration value for state's 'WebPushVAPIDSubject' field
+func (st *ConfigState) GetWebPushVAPIDSubject() (v string) {
+	st.mutex.Lock()
+	v = st.config.WebPushVAPIDSubject
+	st.mutex.Unlock()
+	return
+}
+
+// SetWebPushVAPIDSubject safely sets the Configuration value for state's 'WebPushVAPIDSubject' field
+func (st *ConfigState) SetWebPushVAPIDSubject(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.WebPushVAPIDSubject = v
+	st.reloadToViper()
+}
+
+// WebPushVAPIDSubjectFlag returns the flag name for the 'WebPushVAPIDSubject' field
+func WebPushVAPIDSubjectFlag() string { return "web-push-vapid-subject" }
+
+// GetWebPushVAPIDSubject safely fetches the value for global configuration 'WebPushVAPIDSubject' field
+func GetWebPushVAPIDSubject() string { return global.GetWebPushVAPIDSubject() }
+
+// SetWebPushVAPIDSubject safely sets the value for global configuration 'WebPushVAPIDSubject' field
+func SetWebPushVAPIDSubject(v string) { global.SetWebPushVAPIDSubject(v) }
+
 // GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
 func (st *ConfigState) GetSyslogEnabled() (v bool) {
 	st.mutex.Lock()
@@ -2401,6 +2559,460 @@ func GetCacheGTSEmojiCategorySweepFreq() time.Duration {
 // SetCacheGTSEmojiCategorySweepFreq safely sets the value for global configuration 'Cache.GTS.EmojiCategorySweepFreq' field
 func SetCacheGTSEmojiCategorySweepFreq(v time.Duration) { global.SetCacheGTSEmojiCategorySweepFreq(v) }
 
+// GetCacheGTSFailingInboxMaxSize safely fetches the Configuration value for state's 'Cache.GTS.FailingInboxMaxSize' field
+func (st *ConfigState) GetCacheGTSFailingInboxMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FailingInboxMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFailingInboxMaxSize safely sets the Configuration value for state's 'Cache.GTS.FailingInboxMaxSize' field
+func (st *ConfigState) SetCacheGTSFailingInboxMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FailingInboxMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSFailingInboxMaxSizeFlag returns the flag name for the 'Cache.GTS.FailingInboxMaxSize' field
+func CacheGTSFailingInboxMaxSizeFlag() string { return "cache-gts-failing-inbox-max-size" }
+
+// GetCacheGTSFailingInboxMaxSize safely fetches the value for global configuration 'Cache.GTS.FailingInboxMaxSize' field
+func GetCacheGTSFailingInboxMaxSize() int { return global.GetCacheGTSFailingInboxMaxSize() }
+
+// SetCacheGTSFailingInboxMaxSize safely sets the value for global configuration 'Cache.GTS.FailingInboxMaxSize' field
+func SetCacheGTSFailingInboxMaxSize(v int) { global.SetCacheGTSFailingInboxMaxSize(v) }
+
+// GetCacheGTSFailingInboxTTL safely fetches the Configuration value for state's 'Cache.GTS.FailingInboxTTL' field
+func (st *ConfigState) GetCacheGTSFailingInboxTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FailingInboxTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFailingInboxTTL safely sets the Configuration value for state's 'Cache.GTS.FailingInboxTTL' field
+func (st *ConfigState) SetCacheGTSFailingInboxTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FailingInboxTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSFailingInboxTTLFlag returns the flag name for the 'Cache.GTS.FailingInboxTTL' field
+func CacheGTSFailingInboxTTLFlag() string { return "cache-gts-failing-inbox-ttl" }
+
+// GetCacheGTSFailingInboxTTL safely fetches the value for global configuration 'Cache.GTS.FailingInboxTTL' field
+func GetCacheGTSFailingInboxTTL() time.Duration { return global.GetCacheGTSFailingInboxTTL() }
+
+// SetCacheGTSFailingInboxTTL safely sets the value for global configuration 'Cache.GTS.FailingInboxTTL' field
+func SetCacheGTSFailingInboxTTL(v time.Duration) { global.SetCacheGTSFailingInboxTTL(v) }
+
+// GetCacheGTSFailingInboxSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.FailingInboxSweepFreq' field
+func (st *ConfigState) GetCacheGTSFailingInboxSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FailingInboxSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFailingInboxSweepFreq safely sets the Configuration value for state's 'Cache.GTS.FailingInboxSweepFreq' field
+func (st *ConfigState) SetCacheGTSFailingInboxSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FailingInboxSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSFailingInboxSweepFreqFlag returns the flag name for the 'Cache.GTS.FailingInboxSweepFreq' field
+func CacheGTSFailingInboxSweepFreqFlag() string { return "cache-gts-failing-inbox-sweep-freq" }
+
+// GetCacheGTSFailingInboxSweepFreq safely fetches the value for global configuration 'Cache.GTS.FailingInboxSweepFreq' field
+func GetCacheGTSFailingInboxSweepFreq() time.Duration {
+	return global.GetCacheGTSFailingInboxSweepFreq()
+}
+
+// SetCacheGTSFailingInboxSweepFreq safely sets the value for global configuration 'Cache.GTS.FailingInboxSweepFreq' field
+func SetCacheGTSFailingInboxSweepFreq(v time.Duration) { global.SetCacheGTSFailingInboxSweepFreq(v) }
+
+// GetCacheGTSFilterMaxSize safely fetches the Configuration value for state's 'Cache.GTS.FilterMaxSize' field
+func (st *ConfigState) GetCacheGTSFilterMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterMaxSize safely sets the Configuration value for state's 'Cache.GTS.FilterMaxSize' field
+func (st *ConfigState) SetCacheGTSFilterMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterMaxSizeFlag returns the flag name for the 'Cache.GTS.FilterMaxSize' field
+func CacheGTSFilterMaxSizeFlag() string { return "cache-gts-filter-max-size" }
+
+// GetCacheGTSFilterMaxSize safely fetches the value for global configuration 'Cache.GTS.FilterMaxSize' field
+func GetCacheGTSFilterMaxSize() int { return global.GetCacheGTSFilterMaxSize() }
+
+// SetCacheGTSFilterMaxSize safely sets the value for global configuration 'Cache.GTS.FilterMaxSize' field
+func SetCacheGTSFilterMaxSize(v int) { global.SetCacheGTSFilterMaxSize(v) }
+
+// GetCacheGTSFilterTTL safely fetches the Configuration value for state's 'Cache.GTS.FilterTTL' field
+func (st *ConfigState) GetCacheGTSFilterTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterTTL safely sets the Configuration value for state's 'Cache.GTS.FilterTTL' field
+func (st *ConfigState) SetCacheGTSFilterTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterTTLFlag returns the flag name for the 'Cache.GTS.FilterTTL' field
+func CacheGTSFilterTTLFlag() string { return "cache-gts-filter-ttl" }
+
+// GetCacheGTSFilterTTL safely fetches the value for global configuration 'Cache.GTS.FilterTTL' field
+func GetCacheGTSFilterTTL() time.Duration { return global.GetCacheGTSFilterTTL() }
+
+// SetCacheGTSFilterTTL safely sets the value for global configuration 'Cache.GTS.FilterTTL' field
+func SetCacheGTSFilterTTL(v time.Duration) { global.SetCacheGTSFilterTTL(v) }
+
+// GetCacheGTSFilterSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.FilterSweepFreq' field
+func (st *ConfigState) GetCacheGTSFilterSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterSweepFreq safely sets the Configuration value for state's 'Cache.GTS.FilterSweepFreq' field
+func (st *ConfigState) SetCacheGTSFilterSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterSweepFreqFlag returns the flag name for the 'Cache.GTS.FilterSweepFreq' field
+func CacheGTSFilterSweepFreqFlag() string { return "cache-gts-filter-sweep-freq" }
+
+// GetCacheGTSFilterSweepFreq safely fetches the value for global configuration 'Cache.GTS.FilterSweepFreq' field
+func GetCacheGTSFilterSweepFreq() time.Duration { return global.GetCacheGTSFilterSweepFreq() }
+
+// SetCacheGTSFilterSweepFreq safely sets the value for global configuration 'Cache.GTS.FilterSweepFreq' field
+func SetCacheGTSFilterSweepFreq(v time.Duration) { global.SetCacheGTSFilterSweepFreq(v) }
+
+// GetCacheGTSFilterKeywordMaxSize safely fetches the Configuration value for state's 'Cache.GTS.FilterKeywordMaxSize' field
+func (st *ConfigState) GetCacheGTSFilterKeywordMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterKeywordMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterKeywordMaxSize safely sets the Configuration value for state's 'Cache.GTS.FilterKeywordMaxSize' field
+func (st *ConfigState) SetCacheGTSFilterKeywordMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterKeywordMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterKeywordMaxSizeFlag returns the flag name for the 'Cache.GTS.FilterKeywordMaxSize' field
+func CacheGTSFilterKeywordMaxSizeFlag() string { return "cache-gts-filter-keyword-max-size" }
+
+// GetCacheGTSFilterKeywordMaxSize safely fetches the value for global configuration 'Cache.GTS.FilterKeywordMaxSize' field
+func GetCacheGTSFilterKeywordMaxSize() int { return global.GetCacheGTSFilterKeywordMaxSize() }
+
+// SetCacheGTSFilterKeywordMaxSize safely sets the value for global configuration 'Cache.GTS.FilterKeywordMaxSize' field
+func SetCacheGTSFilterKeywordMaxSize(v int) { global.SetCacheGTSFilterKeywordMaxSize(v) }
+
+// GetCacheGTSFilterKeywordTTL safely fetches the Configuration value for state's 'Cache.GTS.FilterKeywordTTL' field
+func (st *ConfigState) GetCacheGTSFilterKeywordTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterKeywordTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterKeywordTTL safely sets the Configuration value for state's 'Cache.GTS.FilterKeywordTTL' field
+func (st *ConfigState) SetCacheGTSFilterKeywordTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterKeywordTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterKeywordTTLFlag returns the flag name for the 'Cache.GTS.FilterKeywordTTL' field
+func CacheGTSFilterKeywordTTLFlag() string { return "cache-gts-filter-keyword-ttl" }
+
+// GetCacheGTSFilterKeywordTTL safely fetches the value for global configuration 'Cache.GTS.FilterKeywordTTL' field
+func GetCacheGTSFilterKeywordTTL() time.Duration { return global.GetCacheGTSFilterKeywordTTL() }
+
+// SetCacheGTSFilterKeywordTTL safely sets the value for global configuration 'Cache.GTS.FilterKeywordTTL' field
+func SetCacheGTSFilterKeywordTTL(v time.Duration) { global.SetCacheGTSFilterKeywordTTL(v) }
+
+// GetCacheGTSFilterKeywordSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.FilterKeywordSweepFreq' field
+func (st *ConfigState) GetCacheGTSFilterKeywordSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.FilterKeywordSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSFilterKeywordSweepFreq safely sets the Configuration value for state's 'Cache.GTS.FilterKeywordSweepFreq' field
+func (st *ConfigState) SetCacheGTSFilterKeywordSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.FilterKeywordSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSFilterKeywordSweepFreqFlag returns the flag name for the 'Cache.GTS.FilterKeywordSweepFreq' field
+func CacheGTSFilterKeywordSweepFreqFlag() string { return "cache-gts-filter-keyword-sweep-freq" }
+
+// GetCacheGTSFilterKeywordSweepFreq safely fetches the value for global configuration 'Cache.GTS.FilterKeywordSweepFreq' field
+func GetCacheGTSFilterKeywordSweepFreq() time.Duration {
+	return global.GetCacheGTSFilterKeywordSweepFreq()
+}
+
+// SetCacheGTSFilterKeywordSweepFreq safely sets the value for global configuration 'Cache.GTS.FilterKeywordSweepFreq' field
+func SetCacheGTSFilterKeywordSweepFreq(v time.Duration) { global.SetCacheGTSFilterKeywordSweepFreq(v) }
+
+// GetCacheGTSListMaxSize safely fetches the Configuration value for state's 'Cache.GTS.ListMaxSize' field
+func (st *ConfigState) GetCacheGTSListMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListMaxSize safely sets the Configuration value for state's 'Cache.GTS.ListMaxSize' field
+func (st *ConfigState) SetCacheGTSListMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSListMaxSizeFlag returns the flag name for the 'Cache.GTS.ListMaxSize' field
+func CacheGTSListMaxSizeFlag() string { return "cache-gts-list-max-size" }
+
+// GetCacheGTSListMaxSize safely fetches the value for global configuration 'Cache.GTS.ListMaxSize' field
+func GetCacheGTSListMaxSize() int { return global.GetCacheGTSListMaxSize() }
+
+// SetCacheGTSListMaxSize safely sets the value for global configuration 'Cache.GTS.ListMaxSize' field
+func SetCacheGTSListMaxSize(v int) { global.SetCacheGTSListMaxSize(v) }
+
+// GetCacheGTSListTTL safely fetches the Configuration value for state's 'Cache.GTS.ListTTL' field
+func (st *ConfigState) GetCacheGTSListTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListTTL safely sets the Configuration value for state's 'Cache.GTS.ListTTL' field
+func (st *ConfigState) SetCacheGTSListTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSListTTLFlag returns the flag name for the 'Cache.GTS.ListTTL' field
+func CacheGTSListTTLFlag() string { return "cache-gts-list-ttl" }
+
+// GetCacheGTSListTTL safely fetches the value for global configuration 'Cache.GTS.ListTTL' field
+func GetCacheGTSListTTL() time.Duration { return global.GetCacheGTSListTTL() }
+
+// SetCacheGTSListTTL safely sets the value for global configuration 'Cache.GTS.ListTTL' field
+func SetCacheGTSListTTL(v time.Duration) { global.SetCacheGTSListTTL(v) }
+
+// GetCacheGTSListSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.ListSweepFreq' field
+func (st *ConfigState) GetCacheGTSListSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListSweepFreq safely sets the Configuration value for state's 'Cache.GTS.ListSweepFreq' field
+func (st *ConfigState) SetCacheGTSListSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSListSweepFreqFlag returns the flag name for the 'Cache.GTS.ListSweepFreq' field
+func CacheGTSListSweepFreqFlag() string { return "cache-gts-list-sweep-freq" }
+
+// GetCacheGTSListSweepFreq safely fetches the value for global configuration 'Cache.GTS.ListSweepFreq' field
+func GetCacheGTSListSweepFreq() time.Duration { return global.GetCacheGTSListSweepFreq() }
+
+// SetCacheGTSListSweepFreq safely sets the value for global configuration 'Cache.GTS.ListSweepFreq' field
+func SetCacheGTSListSweepFreq(v time.Duration) { global.SetCacheGTSListSweepFreq(v) }
+
+// GetCacheGTSListEntryMaxSize safely fetches the Configuration value for state's 'Cache.GTS.ListEntryMaxSize' field
+func (st *ConfigState) GetCacheGTSListEntryMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListEntryMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListEntryMaxSize safely sets the Configuration value for state's 'Cache.GTS.ListEntryMaxSize' field
+func (st *ConfigState) SetCacheGTSListEntryMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListEntryMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSListEntryMaxSizeFlag returns the flag name for the 'Cache.GTS.ListEntryMaxSize' field
+func CacheGTSListEntryMaxSizeFlag() string { return "cache-gts-list-entry-max-size" }
+
+// GetCacheGTSListEntryMaxSize safely fetches the value for global configuration 'Cache.GTS.ListEntryMaxSize' field
+func GetCacheGTSListEntryMaxSize() int { return global.GetCacheGTSListEntryMaxSize() }
+
+// SetCacheGTSListEntryMaxSize safely sets the value for global configuration 'Cache.GTS.ListEntryMaxSize' field
+func SetCacheGTSListEntryMaxSize(v int) { global.SetCacheGTSListEntryMaxSize(v) }
+
+// GetCacheGTSListEntryTTL safely fetches the Configuration value for state's 'Cache.GTS.ListEntryTTL' field
+func (st *ConfigState) GetCacheGTSListEntryTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListEntryTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListEntryTTL safely sets the Configuration value for state's 'Cache.GTS.ListEntryTTL' field
+func (st *ConfigState) SetCacheGTSListEntryTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListEntryTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSListEntryTTLFlag returns the flag name for the 'Cache.GTS.ListEntryTTL' field
+func CacheGTSListEntryTTLFlag() string { return "cache-gts-list-entry-ttl" }
+
+// GetCacheGTSListEntryTTL safely fetches the value for global configuration 'Cache.GTS.ListEntryTTL' field
+func GetCacheGTSListEntryTTL() time.Duration { return global.GetCacheGTSListEntryTTL() }
+
+// SetCacheGTSListEntryTTL safely sets the value for global configuration 'Cache.GTS.ListEntryTTL' field
+func SetCacheGTSListEntryTTL(v time.Duration) { global.SetCacheGTSListEntryTTL(v) }
+
+// GetCacheGTSListEntrySweepFreq safely fetches the Configuration value for state's 'Cache.GTS.ListEntrySweepFreq' field
+func (st *ConfigState) GetCacheGTSListEntrySweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.ListEntrySweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSListEntrySweepFreq safely sets the Configuration value for state's 'Cache.GTS.ListEntrySweepFreq' field
+func (st *ConfigState) SetCacheGTSListEntrySweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.ListEntrySweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSListEntrySweepFreqFlag returns the flag name for the 'Cache.GTS.ListEntrySweepFreq' field
+func CacheGTSListEntrySweepFreqFlag() string { return "cache-gts-list-entry-sweep-freq" }
+
+// GetCacheGTSListEntrySweepFreq safely fetches the value for global configuration 'Cache.GTS.ListEntrySweepFreq' field
+func GetCacheGTSListEntrySweepFreq() time.Duration { return global.GetCacheGTSListEntrySweepFreq() }
+
+// SetCacheGTSListEntrySweepFreq safely sets the value for global configuration 'Cache.GTS.ListEntrySweepFreq' field
+func SetCacheGTSListEntrySweepFreq(v time.Duration) { global.SetCacheGTSListEntrySweepFreq(v) }
+
+// GetCacheGTSMarkerMaxSize safely fetches the Configuration value for state's 'Cache.GTS.MarkerMaxSize' field
+func (st *ConfigState) GetCacheGTSMarkerMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.MarkerMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSMarkerMaxSize safely sets the Configuration value for state's 'Cache.GTS.MarkerMaxSize' field
+func (st *ConfigState) SetCacheGTSMarkerMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.MarkerMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSMarkerMaxSizeFlag returns the flag name for the 'Cache.GTS.MarkerMaxSize' field
+func CacheGTSMarkerMaxSizeFlag() string { return "cache-gts-marker-max-size" }
+
+// GetCacheGTSMarkerMaxSize safely fetches the value for global configuration 'Cache.GTS.MarkerMaxSize' field
+func GetCacheGTSMarkerMaxSize() int { return global.GetCacheGTSMarkerMaxSize() }
+
+// SetCacheGTSMarkerMaxSize safely sets the value for global configuration 'Cache.GTS.MarkerMaxSize' field
+func SetCacheGTSMarkerMaxSize(v int) { global.SetCacheGTSMarkerMaxSize(v) }
+
+// GetCacheGTSMarkerTTL safely fetches the Configuration value for state's 'Cache.GTS.MarkerTTL' field
+func (st *ConfigState) GetCacheGTSMarkerTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.MarkerTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSMarkerTTL safely sets the Configuration value for state's 'Cache.GTS.MarkerTTL' field
+func (st *ConfigState) SetCacheGTSMarkerTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.MarkerTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSMarkerTTLFlag returns the flag name for the 'Cache.GTS.MarkerTTL' field
+func CacheGTSMarkerTTLFlag() string { return "cache-gts-marker-ttl" }
+
+// GetCacheGTSMarkerTTL safely fetches the value for global configuration 'Cache.GTS.MarkerTTL' field
+func GetCacheGTSMarkerTTL() time.Duration { return global.GetCacheGTSMarkerTTL() }
+
+// SetCacheGTSMarkerTTL safely sets the value for global configuration 'Cache.GTS.MarkerTTL' field
+func SetCacheGTSMarkerTTL(v time.Duration) { global.SetCacheGTSMarkerTTL(v) }
+
+// GetCacheGTSMarkerSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.MarkerSweepFreq' field
+func (st *ConfigState) GetCacheGTSMarkerSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.MarkerSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSMarkerSweepFreq safely sets the Configuration value for state's 'Cache.GTS.MarkerSweepFreq' field
+func (st *ConfigState) SetCacheGTSMarkerSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.MarkerSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSMarkerSweepFreqFlag returns the flag name for the 'Cache.GTS.MarkerSweepFreq' field
+func CacheGTSMarkerSweepFreqFlag() string { return "cache-gts-marker-sweep-freq" }
+
+// GetCacheGTSMarkerSweepFreq safely fetches the value for global configuration 'Cache.GTS.MarkerSweepFreq' field
+func GetCacheGTSMarkerSweepFreq() time.Duration { return global.GetCacheGTSMarkerSweepFreq() }
+
+// SetCacheGTSMarkerSweepFreq safely sets the value for global configuration 'Cache.GTS.MarkerSweepFreq' field
+func SetCacheGTSMarkerSweepFreq(v time.Duration) { global.SetCacheGTSMarkerSweepFreq(v) }
+
 // GetCacheGTSMentionMaxSize safely fetches the Configuration value for state's 'Cache.GTS.MentionMaxSize' field
 func (st *ConfigState) GetCacheGTSMentionMaxSize() (v int) {
 	st.mutex.Lock()
@@ -2553,6 +3165,156 @@ func GetCacheGTSNotificationSweepFreq() time.Duration {
 // SetCacheGTSNotificationSweepFreq safely sets the value for global configuration 'Cache.GTS.NotificationSweepFreq' field
 func SetCacheGTSNotificationSweepFreq(v time.Duration) { global.SetCacheGTSNotificationSweepFreq(v) }
 
+// GetCacheGTSPollMaxSize safely fetches the Configuration value for state's 'Cache.GTS.PollMaxSize' field
+func (st *ConfigState) GetCacheGTSPollMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollMaxSize safely sets the Configuration value for state's 'Cache.GTS.PollMaxSize' field
+func (st *ConfigState) SetCacheGTSPollMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollMaxSizeFlag returns the flag name for the 'Cache.GTS.PollMaxSize' field
+func CacheGTSPollMaxSizeFlag() string { return "cache-gts-poll-max-size" }
+
+// GetCacheGTSPollMaxSize safely fetches the value for global configuration 'Cache.GTS.PollMaxSize' field
+func GetCacheGTSPollMaxSize() int { return global.GetCacheGTSPollMaxSize() }
+
+// SetCacheGTSPollMaxSize safely sets the value for global configuration 'Cache.GTS.PollMaxSize' field
+func SetCacheGTSPollMaxSize(v int) { global.SetCacheGTSPollMaxSize(v) }
+
+// GetCacheGTSPollTTL safely fetches the Configuration value for state's 'Cache.GTS.PollTTL' field
+func (st *ConfigState) GetCacheGTSPollTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollTTL safely sets the Configuration value for state's 'Cache.GTS.PollTTL' field
+func (st *ConfigState) SetCacheGTSPollTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollTTLFlag returns the flag name for the 'Cache.GTS.PollTTL' field
+func CacheGTSPollTTLFlag() string { return "cache-gts-poll-ttl" }
+
+// GetCacheGTSPollTTL safely fetches the value for global configuration 'Cache.GTS.PollTTL' field
+func GetCacheGTSPollTTL() time.Duration { return global.GetCacheGTSPollTTL() }
+
+// SetCacheGTSPollTTL safely sets the value for global configuration 'Cache.GTS.PollTTL' field
+func SetCacheGTSPollTTL(v time.Duration) { global.SetCacheGTSPollTTL(v) }
+
+// GetCacheGTSPollSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.PollSweepFreq' field
+func (st *ConfigState) GetCacheGTSPollSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollSweepFreq safely sets the Configuration value for state's 'Cache.GTS.PollSweepFreq' field
+func (st *ConfigState) SetCacheGTSPollSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollSweepFreqFlag returns the flag name for the 'Cache.GTS.PollSweepFreq' field
+func CacheGTSPollSweepFreqFlag() string { return "cache-gts-poll-sweep-freq" }
+
+// GetCacheGTSPollSweepFreq safely fetches the value for global configuration 'Cache.GTS.PollSweepFreq' field
+func GetCacheGTSPollSweepFreq() time.Duration { return global.GetCacheGTSPollSweepFreq() }
+
+// SetCacheGTSPollSweepFreq safely sets the value for global configuration 'Cache.GTS.PollSweepFreq' field
+func SetCacheGTSPollSweepFreq(v time.Duration) { global.SetCacheGTSPollSweepFreq(v) }
+
+// GetCacheGTSPollVoteMaxSize safely fetches the Configuration value for state's 'Cache.GTS.PollVoteMaxSize' field
+func (st *ConfigState) GetCacheGTSPollVoteMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollVoteMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollVoteMaxSize safely sets the Configuration value for state's 'Cache.GTS.PollVoteMaxSize' field
+func (st *ConfigState) SetCacheGTSPollVoteMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollVoteMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollVoteMaxSizeFlag returns the flag name for the 'Cache.GTS.PollVoteMaxSize' field
+func CacheGTSPollVoteMaxSizeFlag() string { return "cache-gts-poll-vote-max-size" }
+
+// GetCacheGTSPollVoteMaxSize safely fetches the value for global configuration 'Cache.GTS.PollVoteMaxSize' field
+func GetCacheGTSPollVoteMaxSize() int { return global.GetCacheGTSPollVoteMaxSize() }
+
+// SetCacheGTSPollVoteMaxSize safely sets the value for global configuration 'Cache.GTS.PollVoteMaxSize' field
+func SetCacheGTSPollVoteMaxSize(v int) { global.SetCacheGTSPollVoteMaxSize(v) }
+
+// GetCacheGTSPollVoteTTL safely fetches the Configuration value for state's 'Cache.GTS.PollVoteTTL' field
+func (st *ConfigState) GetCacheGTSPollVoteTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollVoteTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollVoteTTL safely sets the Configuration value for state's 'Cache.GTS.PollVoteTTL' field
+func (st *ConfigState) SetCacheGTSPollVoteTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollVoteTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollVoteTTLFlag returns the flag name for the 'Cache.GTS.PollVoteTTL' field
+func CacheGTSPollVoteTTLFlag() string { return "cache-gts-poll-vote-ttl" }
+
+// GetCacheGTSPollVoteTTL safely fetches the value for global configuration 'Cache.GTS.PollVoteTTL' field
+func GetCacheGTSPollVoteTTL() time.Duration { return global.GetCacheGTSPollVoteTTL() }
+
+// SetCacheGTSPollVoteTTL safely sets the value for global configuration 'Cache.GTS.PollVoteTTL' field
+func SetCacheGTSPollVoteTTL(v time.Duration) { global.SetCacheGTSPollVoteTTL(v) }
+
+// GetCacheGTSPollVoteSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.PollVoteSweepFreq' field
+func (st *ConfigState) GetCacheGTSPollVoteSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.PollVoteSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSPollVoteSweepFreq safely sets the Configuration value for state's 'Cache.GTS.PollVoteSweepFreq' field
+func (st *ConfigState) SetCacheGTSPollVoteSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.PollVoteSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSPollVoteSweepFreqFlag returns the flag name for the 'Cache.GTS.PollVoteSweepFreq' field
+func CacheGTSPollVoteSweepFreqFlag() string { return "cache-gts-poll-vote-sweep-freq" }
+
+// GetCacheGTSPollVoteSweepFreq safely fetches the value for global configuration 'Cache.GTS.PollVoteSweepFreq' field
+func GetCacheGTSPollVoteSweepFreq() time.Duration { return global.GetCacheGTSPollVoteSweepFreq() }
+
+// SetCacheGTSPollVoteSweepFreq safely sets the value for global configuration 'Cache.GTS.PollVoteSweepFreq' field
+func SetCacheGTSPollVoteSweepFreq(v time.Duration) { global.SetCacheGTSPollVoteSweepFreq(v) }
+
 // GetCacheGTSReportMaxSize safely fetches the Configuration value for state's 'Cache.GTS.ReportMaxSize' field
 func (st *ConfigState) GetCacheGTSReportMaxSize() (v int) {
 	st.mutex.Lock()
@@ -2703,6 +3465,81 @@ func GetCacheGTSStatusSweepFreq() time.Duration { return global.GetCacheGTSStatu
 // SetCacheGTSStatusSweepFreq safely sets the value for global configuration 'Cache.GTS.StatusSweepFreq' field
 func SetCacheGTSStatusSweepFreq(v time.Duration) { global.SetCacheGTSStatusSweepFreq(v) }
 
+// GetCacheGTSTagMaxSize safely fetches the Configuration value for state's 'Cache.GTS.TagMaxSize' field
+func (st *ConfigState) GetCacheGTSTagMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.TagMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSTagMaxSize safely sets the Configuration value for state's 'Cache.GTS.TagMaxSize' field
+func (st *ConfigState) SetCacheGTSTagMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.TagMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSTagMaxSizeFlag returns the flag name for the 'Cache.GTS.TagMaxSize' field
+func CacheGTSTagMaxSizeFlag() string { return "cache-gts-tag-max-size" }
+
+// GetCacheGTSTagMaxSize safely fetches the value for global configuration 'Cache.GTS.TagMaxSize' field
+func GetCacheGTSTagMaxSize() int { return global.GetCacheGTSTagMaxSize() }
+
+// SetCacheGTSTagMaxSize safely sets the value for global configuration 'Cache.GTS.TagMaxSize' field
+func SetCacheGTSTagMaxSize(v int) { global.SetCacheGTSTagMaxSize(v) }
+
+// GetCacheGTSTagTTL safely fetches the Configuration value for state's 'Cache.GTS.TagTTL' field
+func (st *ConfigState) GetCacheGTSTagTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.TagTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSTagTTL safely sets the Configuration value for state's 'Cache.GTS.TagTTL' field
+func (st *ConfigState) SetCacheGTSTagTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.TagTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSTagTTLFlag returns the flag name for the 'Cache.GTS.TagTTL' field
+func CacheGTSTagTTLFlag() string { return "cache-gts-tag-ttl" }
+
+// GetCacheGTSTagTTL safely fetches the value for global configuration 'Cache.GTS.TagTTL' field
+func GetCacheGTSTagTTL() time.Duration { return global.GetCacheGTSTagTTL() }
+
+// SetCacheGTSTagTTL safely sets the value for global configuration 'Cache.GTS.TagTTL' field
+func SetCacheGTSTagTTL(v time.Duration) { global.SetCacheGTSTagTTL(v) }
+
+// GetCacheGTSTagSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.TagSweepFreq' field
+func (st *ConfigState) GetCacheGTSTagSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.TagSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSTagSweepFreq safely sets the Configuration value for state's 'Cache.GTS.TagSweepFreq' field
+func (st *ConfigState) SetCacheGTSTagSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.TagSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSTagSweepFreqFlag returns the flag name for the 'Cache.GTS.TagSweepFreq' field
+func CacheGTSTagSweepFreqFlag() string { return "cache-gts-tag-sweep-freq" }
+
+// GetCacheGTSTagSweepFreq safely fetches the value for global configuration 'Cache.GTS.TagSweepFreq' field
+func GetCacheGTSTagSweepFreq() time.Duration { return global.GetCacheGTSTagSweepFreq() }
+
+// SetCacheGTSTagSweepFreq safely sets the value for global configuration 'Cache.GTS.TagSweepFreq' field
+func SetCacheGTSTagSweepFreq(v time.Duration) { global.SetCacheGTSTagSweepFreq(v) }
+
 // GetCacheGTSTombstoneMaxSize safely fetches the Configuration value for state's 'Cache.GTS.TombstoneMaxSize' field
 func (st *ConfigState) GetCacheGTSTombstoneMaxSize() (v int) {
 	st.mutex.Lock()
@@ -2853,6 +3690,81 @@ func GetCacheGTSUserSweepFreq() time.Duration { return global.GetCacheGTSUserSwe
 // SetCacheGTSUserSweepFreq safely sets the value for global configuration 'Cache.GTS.UserSweepFreq' field
 func SetCacheGTSUserSweepFreq(v time.Duration) { global.SetCacheGTSUserSweepFreq(v) }
 
+// GetCacheGTSUserMuteMaxSize safely fetches the Configuration value for state's 'Cache.GTS.UserMuteMaxSize' field
+func (st *ConfigState) GetCacheGTSUserMuteMaxSize() (v int) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.UserMuteMaxSize
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSUserMuteMaxSize safely sets the Configuration value for state's 'Cache.GTS.UserMuteMaxSize' field
+func (st *ConfigState) SetCacheGTSUserMuteMaxSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.UserMuteMaxSize = v
+	st.reloadToViper()
+}
+
+// CacheGTSUserMuteMaxSizeFlag returns the flag name for the 'Cache.GTS.UserMuteMaxSize' field
+func CacheGTSUserMuteMaxSizeFlag() string { return "cache-gts-user-mute-max-size" }
+
+// GetCacheGTSUserMuteMaxSize safely fetches the value for global configuration 'Cache.GTS.UserMuteMaxSize' field
+func GetCacheGTSUserMuteMaxSize() int { return global.GetCacheGTSUserMuteMaxSize() }
+
+// SetCacheGTSUserMuteMaxSize safely sets the value for global configuration 'Cache.GTS.UserMuteMaxSize' field
+func SetCacheGTSUserMuteMaxSize(v int) { global.SetCacheGTSUserMuteMaxSize(v) }
+
+// GetCacheGTSUserMuteTTL safely fetches the Configuration value for state's 'Cache.GTS.UserMuteTTL' field
+func (st *ConfigState) GetCacheGTSUserMuteTTL() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.UserMuteTTL
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSUserMuteTTL safely sets the Configuration value for state's 'Cache.GTS.UserMuteTTL' field
+func (st *ConfigState) SetCacheGTSUserMuteTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.UserMuteTTL = v
+	st.reloadToViper()
+}
+
+// CacheGTSUserMuteTTLFlag returns the flag name for the 'Cache.GTS.UserMuteTTL' field
+func CacheGTSUserMuteTTLFlag() string { return "cache-gts-user-mute-ttl" }
+
+// GetCacheGTSUserMuteTTL safely fetches the value for global configuration 'Cache.GTS.UserMuteTTL' field
+func GetCacheGTSUserMuteTTL() time.Duration { return global.GetCacheGTSUserMuteTTL() }
+
+// SetCacheGTSUserMuteTTL safely sets the value for global configuration 'Cache.GTS.UserMuteTTL' field
+func SetCacheGTSUserMuteTTL(v time.Duration) { global.SetCacheGTSUserMuteTTL(v) }
+
+// GetCacheGTSUserMuteSweepFreq safely fetches the Configuration value for state's 'Cache.GTS.UserMuteSweepFreq' field
+func (st *ConfigState) GetCacheGTSUserMuteSweepFreq() (v time.Duration) {
+	st.mutex.Lock()
+	v = st.config.Cache.GTS.UserMuteSweepFreq
+	st.mutex.Unlock()
+	return
+}
+
+// SetCacheGTSUserMuteSweepFreq safely sets the Configuration value for state's 'Cache.GTS.UserMuteSweepFreq' field
+func (st *ConfigState) SetCacheGTSUserMuteSweepFreq(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.GTS.UserMuteSweepFreq = v
+	st.reloadToViper()
+}
+
+// CacheGTSUserMuteSweepFreqFlag returns the flag name for the 'Cache.GTS.UserMuteSweepFreq' field
+func CacheGTSUserMuteSweepFreqFlag() string { return "cache-gts-user-mute-sweep-freq" }
+
+// GetCacheGTSUserMuteSweepFreq safely fetches the value for global configuration 'Cache.GTS.UserMuteSweepFreq' field
+func GetCacheGTSUserMuteSweepFreq() time.Duration { return global.GetCacheGTSUserMuteSweepFreq() }
+
+// SetCacheGTSUserMuteSweepFreq safely sets the value for global configuration 'Cache.GTS.UserMuteSweepFreq' field
+func SetCacheGTSUserMuteSweepFreq(v time.Duration) { global.SetCacheGTSUserMuteSweepFreq(v) }
+
 // GetAdminAccountUsername safely fetches the Configuration value for state's 'AdminAccountUsername' field
 func (st *ConfigState) GetAdminAccountUsername() (v string) {
 	st.mutex.Lock()
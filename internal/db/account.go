@@ -62,6 +62,13 @@ type Account interface {
 	// GetAccountStatusesCount is a shortcut for the common action of counting statuses produced by accountID.
 	CountAccountStatuses(ctx context.Context, accountID string) (int, Error)
 
+	// CountAccountPinned is a shortcut for counting the statuses that accountID has pinned to their profile.
+	CountAccountPinned(ctx context.Context, accountID string) (int, Error)
+
+	// GetAccountPinnedStatuses returns all statuses that accountID has pinned to their profile, newest first.
+	// In case of no entries, a 'no entries' error will be returned
+	GetAccountPinnedStatuses(ctx context.Context, accountID string) ([]*gtsmodel.Status, Error)
+
 	// GetAccountStatuses is a shortcut for getting the most recent statuses. accountID is optional, if not provided
 	// then all statuses will be returned. If limit is set to 0, the size of the returned slice will not be limited. This can
 	// be very memory intensive so you probably shouldn't do this!
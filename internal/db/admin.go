@@ -42,6 +42,16 @@ type Admin interface {
 	// By the time this function is called, it should be assumed that all the parameters have passed validation!
 	NewSignup(ctx context.Context, username string, reason string, requireApproval bool, email string, password string, signUpIP net.IP, locale string, appID string, emailVerified bool, externalID string, admin bool) (*gtsmodel.User, Error)
 
+	// GetLocalAccounts gets limit n local accounts (ie., accounts with a user on this instance),
+	// newest first, optionally filtered by the status of their user:
+	//
+	//   - "pending": user has signed up but not yet been approved
+	//   - "active": user has been approved and is not disabled
+	//   - "disabled": user has been disabled
+	//
+	// An empty status returns accounts regardless of user status.
+	GetLocalAccounts(ctx context.Context, status string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Account, Error)
+
 	// CreateInstanceAccount creates an account in the database with the same username as the instance host value.
 	// Ie., if the instance is hosted at 'example.org' the instance user will have a username of 'example.org'.
 	// This is needed for things like serving files that belong to the instance and not an individual user/account.
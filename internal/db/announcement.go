@@ -0,0 +1,81 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Announcement contains functions for getting and storing instance announcements, and users' interactions with them.
+type Announcement interface {
+	// GetAnnouncementByID gets one announcement with the given id.
+	GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, Error)
+
+	// GetAnnouncements gets all announcements, published or not, newest first.
+	GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, Error)
+
+	// GetActiveAnnouncements gets published announcements that haven't ended
+	// before the given time, in the order in which they were published.
+	GetActiveAnnouncements(ctx context.Context, now time.Time) ([]*gtsmodel.Announcement, Error)
+
+	// GetDueAnnouncements gets unpublished announcements scheduled to be published at or before the given time.
+	GetDueAnnouncements(ctx context.Context, now time.Time) ([]*gtsmodel.Announcement, Error)
+
+	// GetExpiredAnnouncements gets published announcements that ended at or before the given time.
+	GetExpiredAnnouncements(ctx context.Context, now time.Time) ([]*gtsmodel.Announcement, Error)
+
+	// PutAnnouncement puts a new announcement in the database.
+	PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) Error
+
+	// UpdateAnnouncement updates the given announcement.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) Error
+
+	// DeleteAnnouncementByID deletes one announcement with the given id, along with its reactions and dismissals.
+	DeleteAnnouncementByID(ctx context.Context, id string) Error
+
+	// GetAnnouncementReactions gets all reactions to the given announcement, oldest first.
+	GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, Error)
+
+	// PutAnnouncementReaction puts a new announcement reaction in the database.
+	// If the account already reacted with the same name, ErrAlreadyExists is returned.
+	PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) Error
+
+	// DeleteAnnouncementReaction deletes the reaction with the given name of the given account to the given announcement.
+	// If there's no such reaction, ErrNoEntries is returned.
+	DeleteAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) Error
+
+	// DeleteAnnouncementReactionsByAccountID deletes all announcement reactions of the given account.
+	DeleteAnnouncementReactionsByAccountID(ctx context.Context, accountID string) Error
+
+	// IsAnnouncementDismissed checks whether the given account has dismissed the given announcement.
+	IsAnnouncementDismissed(ctx context.Context, announcementID string, accountID string) (bool, Error)
+
+	// PutAnnouncementDismissal marks an announcement as dismissed by an account. Dismissing twice is not an error.
+	PutAnnouncementDismissal(ctx context.Context, dismissal *gtsmodel.AnnouncementDismissal) Error
+
+	// DeleteAnnouncementDismissalsByAccountID deletes all announcement dismissals of the given account.
+	DeleteAnnouncementDismissalsByAccountID(ctx context.Context, accountID string) Error
+}
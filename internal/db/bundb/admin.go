@@ -66,13 +66,8 @@ func (a *adminDB) IsEmailAvailable(ctx context.Context, email string) (bool, db.
 	}
 	domain := strings.Split(m.Address, "@")[1] // domain will always be the second part after @
 
-	// check if the email domain is blocked
-	emailDomainBlockedQ := a.conn.
-		NewSelect().
-		TableExpr("? AS ?", bun.Ident("email_domain_blocks"), bun.Ident("email_domain_block")).
-		Column("email_domain_block.id").
-		Where("? = ?", bun.Ident("email_domain_block.domain"), domain)
-	emailDomainBlocked, err := a.conn.Exists(ctx, emailDomainBlockedQ)
+	// check if the email domain (or a parent domain) is blocked
+	emailDomainBlocked, err := a.state.DB.IsEmailDomainBlocked(ctx, domain)
 	if err != nil {
 		return false, err
 	}
@@ -192,6 +187,87 @@ func (a *adminDB) NewSignup(ctx context.Context, username string, reason string,
 	return u, nil
 }
 
+func (a *adminDB) GetLocalAccounts(ctx context.Context, status string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Account, db.Error) {
+	accountIDs := []string{}
+
+	q := a.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+		Column("user.account_id")
+
+	// assume we want to sort DESC (newest first) unless informed otherwise
+	order := "DESC"
+
+	switch status {
+	case "":
+		// no filter
+	case "pending":
+		q = q.Where("? = ?", bun.Ident("user.approved"), false)
+	case "active":
+		q = q.
+			Where("? = ?", bun.Ident("user.approved"), true).
+			Where("? = ?", bun.Ident("user.disabled"), false)
+	case "disabled":
+		q = q.Where("? = ?", bun.Ident("user.disabled"), true)
+	default:
+		return nil, fmt.Errorf("GetLocalAccounts: status %q not recognized", status)
+	}
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("user.account_id"), maxID)
+	}
+
+	if sinceID != "" {
+		q = q.Where("? > ?", bun.Ident("user.account_id"), sinceID)
+	}
+
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("user.account_id"), minID)
+		// if we have a minID we're paging upwards/backwards,
+		// so we want the accounts immediately above minID
+		order = "ASC"
+	}
+
+	q = q.Order("user.account_id " + order)
+
+	if limit != 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	if order == "ASC" {
+		// Reverse the slice order so the caller
+		// still gets accounts newest first.
+		//
+		// See https://github.com/golang/go/wiki/SliceTricks#reversing
+		for i := len(accountIDs)/2 - 1; i >= 0; i-- {
+			opp := len(accountIDs) - 1 - i
+			accountIDs[i], accountIDs[opp] = accountIDs[opp], accountIDs[i]
+		}
+	}
+
+	// Catch case of no accounts early
+	if len(accountIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	accounts := make([]*gtsmodel.Account, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		account, err := a.state.DB.GetAccountByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetLocalAccounts: error getting account %q: %v", id, err)
+			continue
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
 func (a *adminDB) CreateInstanceAccount(ctx context.Context) db.Error {
 	username := config.GetHost()
 
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	gtsmodel "github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations/20211113114307_init"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
@@ -69,6 +70,56 @@ func (suite *AdminTestSuite) TestIsEmailAvailableDomainBlocked() {
 	suite.False(available)
 }
 
+func (suite *AdminTestSuite) TestIsEmailAvailableParentDomainBlocked() {
+	available, err := suite.db.IsEmailAvailable(context.Background(), "someone@mail.spam-mail.org")
+	suite.EqualError(err, "email domain mail.spam-mail.org is blocked")
+	suite.False(available)
+}
+
+func (suite *AdminTestSuite) TestGetLocalAccounts() {
+	accounts, err := suite.db.GetLocalAccounts(context.Background(), "", "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(accounts, 4)
+
+	// newest first
+	suite.Equal("01F8MH5NBDF2MV7CTC4Q5128HF", accounts[0].ID)
+	suite.Equal("01F8MH0BBE4FHXPH513MBVFHB0", accounts[3].ID)
+}
+
+func (suite *AdminTestSuite) TestGetLocalAccountsPending() {
+	accounts, err := suite.db.GetLocalAccounts(context.Background(), "pending", "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(accounts, 1)
+	suite.Equal("weed_lord420", accounts[0].Username)
+}
+
+func (suite *AdminTestSuite) TestGetLocalAccountsPaged() {
+	accounts, err := suite.db.GetLocalAccounts(context.Background(), "active", "01F8MH5NBDF2MV7CTC4Q5128HF", "", "", 1)
+	suite.NoError(err)
+	suite.Len(accounts, 1)
+	suite.Equal("the_mighty_zork", accounts[0].Username)
+}
+
+func (suite *AdminTestSuite) TestGetLocalAccountsMinID() {
+	all, err := suite.db.GetLocalAccounts(context.Background(), "", "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(all, 4)
+
+	// paging up from the oldest gives the accounts
+	// immediately above it, still newest first
+	accounts, err := suite.db.GetLocalAccounts(context.Background(), "", "", "", all[3].ID, 2)
+	suite.NoError(err)
+	suite.Len(accounts, 2)
+	suite.Equal(all[1].ID, accounts[0].ID)
+	suite.Equal(all[2].ID, accounts[1].ID)
+}
+
+func (suite *AdminTestSuite) TestGetLocalAccountsNone() {
+	accounts, err := suite.db.GetLocalAccounts(context.Background(), "disabled", "", "", "", 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(accounts)
+}
+
 func (suite *AdminTestSuite) TestCreateInstanceAccount() {
 	// reinitialize test DB to clear caches
 	suite.db = testrig.NewTestDB()
@@ -0,0 +1,253 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type announcementDB struct {
+	conn *DBConn
+}
+
+func (a *announcementDB) GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, db.Error) {
+	var announcement gtsmodel.Announcement
+
+	if err := a.conn.
+		NewSelect().
+		Model(&announcement).
+		Where("? = ?", bun.Ident("announcement.id"), id).
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return &announcement, nil
+}
+
+func (a *announcementDB) GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, db.Error) {
+	announcements := []*gtsmodel.Announcement{}
+
+	if err := a.conn.
+		NewSelect().
+		Model(&announcements).
+		Order("announcement.id DESC").
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) GetActiveAnnouncements(ctx context.Context, now time.Time) ([]*gtsmodel.Announcement, db.Error) {
+	announcements := []*gtsmodel.Announcement{}
+
+	if err := a.conn.
+		NewSelect().
+		Model(&announcements).
+		Where("? = ?", bun.Ident("announcement.published"), true).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("announcement.ends_at")).
+				WhereOr("? > ?", bun.Ident("announcement.ends_at"), now)
+		}).
+		Order("announcement.published_at ASC").
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) GetDueAnnouncements(ctx context.Context, now time.Time) ([]*gtsmodel.Announcement, db.Error) {
+	announcements := []*gtsmodel.Announcement{}
+
+	if err := a.conn.
+		NewSelect().
+		Model(&announcements).
+		Where("? = ?", bun.Ident("announcement.published"), false).
+		Where("? <= ?", bun.Ident("announcement.scheduled_at"), now).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			// don't publish announcements that have already ended
+			return q.
+				Where("? IS NULL", bun.Ident("announcement.ends_at")).
+				WhereOr("? > ?", bun.Ident("announcement.ends_at"), now)
+		}).
+		Order("announcement.scheduled_at ASC").
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) GetExpiredAnnouncements(ctx context.Context, now time.Time) ([]*gtsmodel.Announcement, db.Error) {
+	announcements := []*gtsmodel.Announcement{}
+
+	if err := a.conn.
+		NewSelect().
+		Model(&announcements).
+		Where("? = ?", bun.Ident("announcement.published"), true).
+		Where("? <= ?", bun.Ident("announcement.ends_at"), now).
+		Order("announcement.ends_at ASC").
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) db.Error {
+	_, err := a.conn.NewInsert().Model(announcement).Exec(ctx)
+	return a.conn.ProcessError(err)
+}
+
+func (a *announcementDB) UpdateAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, columns ...string) db.Error {
+	announcement.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := a.conn.
+		NewUpdate().
+		Model(announcement).
+		Where("? = ?", bun.Ident("announcement.id"), announcement.ID).
+		Column(columns...).
+		Exec(ctx)
+	return a.conn.ProcessError(err)
+}
+
+func (a *announcementDB) DeleteAnnouncementByID(ctx context.Context, id string) db.Error {
+	return a.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// delete reactions to this announcement
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("announcement_reactions"), bun.Ident("announcement_reaction")).
+			Where("? = ?", bun.Ident("announcement_reaction.announcement_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// delete dismissals of this announcement
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("announcement_dismissals"), bun.Ident("announcement_dismissal")).
+			Where("? = ?", bun.Ident("announcement_dismissal.announcement_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// delete the announcement itself
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("announcements"), bun.Ident("announcement")).
+			Where("? = ?", bun.Ident("announcement.id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (a *announcementDB) GetAnnouncementReactions(ctx context.Context, announcementID string) ([]*gtsmodel.AnnouncementReaction, db.Error) {
+	reactions := []*gtsmodel.AnnouncementReaction{}
+
+	if err := a.conn.
+		NewSelect().
+		Model(&reactions).
+		Where("? = ?", bun.Ident("announcement_reaction.announcement_id"), announcementID).
+		Order("announcement_reaction.id ASC").
+		Scan(ctx); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	return reactions, nil
+}
+
+func (a *announcementDB) PutAnnouncementReaction(ctx context.Context, reaction *gtsmodel.AnnouncementReaction) db.Error {
+	_, err := a.conn.NewInsert().Model(reaction).Exec(ctx)
+	return a.conn.ProcessError(err)
+}
+
+func (a *announcementDB) DeleteAnnouncementReaction(ctx context.Context, announcementID string, accountID string, name string) db.Error {
+	result, err := a.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("announcement_reactions"), bun.Ident("announcement_reaction")).
+		Where("? = ?", bun.Ident("announcement_reaction.announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("announcement_reaction.account_id"), accountID).
+		Where("? = ?", bun.Ident("announcement_reaction.name"), name).
+		Exec(ctx)
+	if err != nil {
+		return a.conn.ProcessError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return a.conn.ProcessError(err)
+	}
+
+	if rowsAffected == 0 {
+		return db.ErrNoEntries
+	}
+
+	return nil
+}
+
+func (a *announcementDB) DeleteAnnouncementReactionsByAccountID(ctx context.Context, accountID string) db.Error {
+	_, err := a.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("announcement_reactions"), bun.Ident("announcement_reaction")).
+		Where("? = ?", bun.Ident("announcement_reaction.account_id"), accountID).
+		Exec(ctx)
+	return a.conn.ProcessError(err)
+}
+
+func (a *announcementDB) IsAnnouncementDismissed(ctx context.Context, announcementID string, accountID string) (bool, db.Error) {
+	q := a.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("announcement_dismissals"), bun.Ident("announcement_dismissal")).
+		Where("? = ?", bun.Ident("announcement_dismissal.announcement_id"), announcementID).
+		Where("? = ?", bun.Ident("announcement_dismissal.account_id"), accountID)
+
+	return a.conn.Exists(ctx, q)
+}
+
+func (a *announcementDB) PutAnnouncementDismissal(ctx context.Context, dismissal *gtsmodel.AnnouncementDismissal) db.Error {
+	_, err := a.conn.
+		NewInsert().
+		Model(dismissal).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("announcement_id"), bun.Ident("account_id")).
+		Exec(ctx)
+	return a.conn.ProcessError(err)
+}
+
+func (a *announcementDB) DeleteAnnouncementDismissalsByAccountID(ctx context.Context, accountID string) db.Error {
+	_, err := a.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("announcement_dismissals"), bun.Ident("announcement_dismissal")).
+		Where("? = ?", bun.Ident("announcement_dismissal.account_id"), accountID).
+		Exec(ctx)
+	return a.conn.ProcessError(err)
+}
@@ -0,0 +1,166 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type AnnouncementTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *AnnouncementTestSuite) TestGetAnnouncements() {
+	announcements, err := suite.db.GetAnnouncements(context.Background())
+	suite.NoError(err)
+	suite.Len(announcements, 2)
+
+	// newest first
+	suite.Equal(suite.testAnnouncements["announcement_2_scheduled"].ID, announcements[0].ID)
+	suite.Equal(suite.testAnnouncements["announcement_1"].ID, announcements[1].ID)
+}
+
+func (suite *AnnouncementTestSuite) TestGetActiveAnnouncements() {
+	ctx := context.Background()
+
+	announcements, err := suite.db.GetActiveAnnouncements(ctx, time.Now())
+	suite.NoError(err)
+	suite.Len(announcements, 1)
+	suite.Equal(suite.testAnnouncements["announcement_1"].ID, announcements[0].ID)
+
+	// an announcement that has ended isn't active anymore
+	announcement := suite.testAnnouncements["announcement_1"]
+	announcement.EndsAt = time.Now().Add(-1 * time.Minute)
+	suite.NoError(suite.db.UpdateAnnouncement(ctx, announcement, "ends_at"))
+
+	announcements, err = suite.db.GetActiveAnnouncements(ctx, time.Now())
+	suite.NoError(err)
+	suite.Empty(announcements)
+
+	expired, err := suite.db.GetExpiredAnnouncements(ctx, time.Now())
+	suite.NoError(err)
+	suite.Len(expired, 1)
+	suite.Equal(announcement.ID, expired[0].ID)
+}
+
+func (suite *AnnouncementTestSuite) TestGetDueAnnouncements() {
+	ctx := context.Background()
+	scheduled := suite.testAnnouncements["announcement_2_scheduled"]
+
+	announcements, err := suite.db.GetDueAnnouncements(ctx, time.Now())
+	suite.NoError(err)
+	suite.Empty(announcements)
+
+	announcements, err = suite.db.GetDueAnnouncements(ctx, scheduled.ScheduledAt)
+	suite.NoError(err)
+	suite.Len(announcements, 1)
+	suite.Equal(scheduled.ID, announcements[0].ID)
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementReactions() {
+	ctx := context.Background()
+	announcement := suite.testAnnouncements["announcement_1"]
+	account := suite.testAccounts["local_account_1"]
+
+	reaction := &gtsmodel.AnnouncementReaction{
+		ID:             "01H2MB5S1XZYVQ8W6GKQ3C1V4F",
+		AnnouncementID: announcement.ID,
+		AccountID:      account.ID,
+		Name:           "👍",
+	}
+	suite.NoError(suite.db.PutAnnouncementReaction(ctx, reaction))
+
+	reactions, err := suite.db.GetAnnouncementReactions(ctx, announcement.ID)
+	suite.NoError(err)
+	suite.Len(reactions, 2)
+	suite.Equal(reaction.ID, reactions[1].ID)
+
+	// reacting twice with the same emoji isn't possible
+	err = suite.db.PutAnnouncementReaction(ctx, &gtsmodel.AnnouncementReaction{
+		ID:             "01H2MB6Q7YE3K1RYMB2B7VRE9N",
+		AnnouncementID: announcement.ID,
+		AccountID:      account.ID,
+		Name:           "👍",
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	suite.NoError(suite.db.DeleteAnnouncementReaction(ctx, announcement.ID, account.ID, "👍"))
+	suite.ErrorIs(suite.db.DeleteAnnouncementReaction(ctx, announcement.ID, account.ID, "👍"), db.ErrNoEntries)
+
+	reactions, err = suite.db.GetAnnouncementReactions(ctx, announcement.ID)
+	suite.NoError(err)
+	suite.Len(reactions, 1)
+}
+
+func (suite *AnnouncementTestSuite) TestAnnouncementDismissals() {
+	ctx := context.Background()
+	announcement := suite.testAnnouncements["announcement_1"]
+	account := suite.testAccounts["local_account_1"]
+
+	dismissed, err := suite.db.IsAnnouncementDismissed(ctx, announcement.ID, account.ID)
+	suite.NoError(err)
+	suite.False(dismissed)
+
+	// dismissing twice is fine
+	for i := 0; i < 2; i++ {
+		suite.NoError(suite.db.PutAnnouncementDismissal(ctx, &gtsmodel.AnnouncementDismissal{
+			AnnouncementID: announcement.ID,
+			AccountID:      account.ID,
+		}))
+	}
+
+	dismissed, err = suite.db.IsAnnouncementDismissed(ctx, announcement.ID, account.ID)
+	suite.NoError(err)
+	suite.True(dismissed)
+
+	suite.NoError(suite.db.DeleteAnnouncementDismissalsByAccountID(ctx, account.ID))
+
+	dismissed, err = suite.db.IsAnnouncementDismissed(ctx, announcement.ID, account.ID)
+	suite.NoError(err)
+	suite.False(dismissed)
+}
+
+func (suite *AnnouncementTestSuite) TestDeleteAnnouncement() {
+	ctx := context.Background()
+	announcement := suite.testAnnouncements["announcement_1"]
+
+	suite.NoError(suite.db.DeleteAnnouncementByID(ctx, announcement.ID))
+
+	_, err := suite.db.GetAnnouncementByID(ctx, announcement.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// reactions and dismissals should be gone too
+	reactions, err := suite.db.GetAnnouncementReactions(ctx, announcement.ID)
+	suite.NoError(err)
+	suite.Empty(reactions)
+
+	dismissed, err := suite.db.IsAnnouncementDismissed(ctx, announcement.ID, suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	suite.False(dismissed)
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementTestSuite))
+}
@@ -23,6 +23,7 @@ import (
 	"errors"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/uptrace/bun"
@@ -117,8 +118,17 @@ func (b *basicDB) UpdateWhere(ctx context.Context, where []db.Where, key string,
 }
 
 func (b *basicDB) CreateTable(ctx context.Context, i interface{}) db.Error {
-	_, err := b.conn.NewCreateTable().Model(i).IfNotExists().Exec(ctx)
-	return err
+	if _, err := b.conn.NewCreateTable().Model(i).IfNotExists().Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, ok := i.(*gtsmodel.Status); ok {
+		// statuses are searched using a separate
+		// full-text index which must be created too
+		return migrations.CreateStatusSearchIndex(ctx, b.conn.DB)
+	}
+
+	return nil
 }
 
 func (b *basicDB) CreateAllTables(ctx context.Context) db.Error {
@@ -156,6 +166,12 @@ func (b *basicDB) CreateAllTables(ctx context.Context) db.Error {
 }
 
 func (b *basicDB) DropTable(ctx context.Context, i interface{}) db.Error {
+	if _, ok := i.(*gtsmodel.Status); ok {
+		if err := migrations.DropStatusSearchIndex(ctx, b.conn.DB); err != nil {
+			return b.conn.ProcessError(err)
+		}
+	}
+
 	_, err := b.conn.NewDropTable().Model(i).IfExists().Exec(ctx)
 	return b.conn.ProcessError(err)
 }
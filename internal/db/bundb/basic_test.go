@@ -78,7 +78,7 @@ func (suite *BasicTestSuite) TestPutAccountWithBunDefaultFields() {
 	suite.Empty(a.Note)
 	suite.Empty(a.NoteRaw)
 	suite.False(*a.Memorial)
-	suite.Empty(a.AlsoKnownAs)
+	suite.Empty(a.AlsoKnownAsURIs)
 	suite.Empty(a.MovedToAccountID)
 	suite.False(*a.Bot)
 	suite.Empty(a.Reason)
@@ -61,20 +61,33 @@ var registerTables = []interface{}{
 type DBService struct {
 	db.Account
 	db.Admin
+	db.Announcement
 	db.Basic
+	db.Conversation
+	db.Delivery
 	db.Domain
 	db.Emoji
+	db.Filter
 	db.Instance
+	db.Invite
+	db.List
+	db.Marker
 	db.Media
 	db.Mention
 	db.Notification
+	db.Poll
 	db.Relationship
 	db.Report
+	db.ScheduledStatus
+	db.Search
 	db.Session
 	db.Status
+	db.StatusEdit
+	db.Tag
 	db.Timeline
 	db.User
 	db.Tombstone
+	db.WebPush
 	conn *DBConn
 }
 
@@ -163,9 +176,19 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		Announcement: &announcementDB{
+			conn: conn,
+		},
 		Basic: &basicDB{
 			conn: conn,
 		},
+		Conversation: &conversationDB{
+			conn: conn,
+		},
+		Delivery: &deliveryDB{
+			conn:  conn,
+			state: state,
+		},
 		Domain: &domainDB{
 			conn:  conn,
 			state: state,
@@ -174,9 +197,24 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		Filter: &filterDB{
+			conn:  conn,
+			state: state,
+		},
 		Instance: &instanceDB{
 			conn: conn,
 		},
+		Invite: &inviteDB{
+			conn: conn,
+		},
+		List: &listDB{
+			conn:  conn,
+			state: state,
+		},
+		Marker: &markerDB{
+			conn:  conn,
+			state: state,
+		},
 		Media: &mediaDB{
 			conn: conn,
 		},
@@ -188,6 +226,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		Poll: &pollDB{
+			conn:  conn,
+			state: state,
+		},
 		Relationship: &relationshipDB{
 			conn:  conn,
 			state: state,
@@ -196,6 +238,13 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		ScheduledStatus: &scheduledStatusDB{
+			conn: conn,
+		},
+		Search: &searchDB{
+			conn:  conn,
+			state: state,
+		},
 		Session: &sessionDB{
 			conn: conn,
 		},
@@ -203,6 +252,13 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		StatusEdit: &statusEditDB{
+			conn: conn,
+		},
+		Tag: &tagDB{
+			conn:  conn,
+			state: state,
+		},
 		Timeline: &timelineDB{
 			conn:  conn,
 			state: state,
@@ -215,6 +271,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		WebPush: &webPushDB{
+			conn: conn,
+		},
 		conn: conn,
 	}
 
@@ -478,29 +537,29 @@ func (dbService *DBService) TagStringToTag(ctx context.Context, t string, origin
 	host := config.GetHost()
 	now := time.Now()
 
-	tag := &gtsmodel.Tag{}
-	// we can use selectorinsert here to create the new tag if it doesn't exist already
-	// inserted will be true if this is a new tag we just created
-	if err := dbService.conn.NewSelect().Model(tag).Where("LOWER(?) = LOWER(?)", bun.Ident("name"), t).Scan(ctx); err != nil && err != sql.ErrNoRows {
+	tag, err := dbService.GetTagByName(ctx, t)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return nil, fmt.Errorf("error getting tag with name %s: %s", t, err)
 	}
 
-	if tag.ID == "" {
+	if tag == nil {
 		// tag doesn't exist yet so populate it
 		newID, err := id.NewRandomULID()
 		if err != nil {
 			return nil, err
 		}
-		tag.ID = newID
-		tag.URL = protocol + "://" + host + "/tags/" + t
-		tag.Name = t
-		tag.FirstSeenFromAccountID = originAccountID
-		tag.CreatedAt = now
-		tag.UpdatedAt = now
 		useable := true
-		tag.Useable = &useable
 		listable := true
-		tag.Listable = &listable
+		tag = &gtsmodel.Tag{
+			ID:                     newID,
+			URL:                    protocol + "://" + host + "/tags/" + t,
+			Name:                   t,
+			FirstSeenFromAccountID: originAccountID,
+			CreatedAt:              now,
+			UpdatedAt:              now,
+			Useable:                &useable,
+			Listable:               &listable,
+		}
 	}
 
 	// bail already if the tag isn't useable
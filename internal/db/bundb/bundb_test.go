@@ -31,18 +31,28 @@ type BunDBStandardTestSuite struct {
 	db db.DB
 
 	// standard suite models
-	testTokens       map[string]*gtsmodel.Token
-	testClients      map[string]*gtsmodel.Client
-	testApplications map[string]*gtsmodel.Application
-	testUsers        map[string]*gtsmodel.User
-	testAccounts     map[string]*gtsmodel.Account
-	testAttachments  map[string]*gtsmodel.MediaAttachment
-	testStatuses     map[string]*gtsmodel.Status
-	testTags         map[string]*gtsmodel.Tag
-	testMentions     map[string]*gtsmodel.Mention
-	testFollows      map[string]*gtsmodel.Follow
-	testEmojis       map[string]*gtsmodel.Emoji
-	testReports      map[string]*gtsmodel.Report
+	testTokens        map[string]*gtsmodel.Token
+	testClients       map[string]*gtsmodel.Client
+	testApplications  map[string]*gtsmodel.Application
+	testUsers         map[string]*gtsmodel.User
+	testAccounts      map[string]*gtsmodel.Account
+	testAttachments   map[string]*gtsmodel.MediaAttachment
+	testStatuses      map[string]*gtsmodel.Status
+	testTags          map[string]*gtsmodel.Tag
+	testFollowedTags  map[string]*gtsmodel.FollowedTag
+	testFeaturedTags  map[string]*gtsmodel.FeaturedTag
+	testMentions      map[string]*gtsmodel.Mention
+	testFollows       map[string]*gtsmodel.Follow
+	testEmojis        map[string]*gtsmodel.Emoji
+	testReports       map[string]*gtsmodel.Report
+	testLists         map[string]*gtsmodel.List
+	testFilters       map[string]*gtsmodel.Filter
+	testListEntries   map[string]*gtsmodel.ListEntry
+	testPolls         map[string]*gtsmodel.Poll
+	testPollVotes     map[string]*gtsmodel.PollVote
+	testAnnouncements map[string]*gtsmodel.Announcement
+	testUserMutes     map[string]*gtsmodel.UserMute
+	testInvites       map[string]*gtsmodel.Invite
 }
 
 func (suite *BunDBStandardTestSuite) SetupSuite() {
@@ -54,10 +64,20 @@ func (suite *BunDBStandardTestSuite) SetupSuite() {
 	suite.testAttachments = testrig.NewTestAttachments()
 	suite.testStatuses = testrig.NewTestStatuses()
 	suite.testTags = testrig.NewTestTags()
+	suite.testFollowedTags = testrig.NewTestFollowedTags()
+	suite.testFeaturedTags = testrig.NewTestFeaturedTags()
 	suite.testMentions = testrig.NewTestMentions()
 	suite.testFollows = testrig.NewTestFollows()
 	suite.testEmojis = testrig.NewTestEmojis()
 	suite.testReports = testrig.NewTestReports()
+	suite.testLists = testrig.NewTestLists()
+	suite.testFilters = testrig.NewTestFilters()
+	suite.testListEntries = testrig.NewTestListEntries()
+	suite.testPolls = testrig.NewTestPolls()
+	suite.testPollVotes = testrig.NewTestPollVotes()
+	suite.testAnnouncements = testrig.NewTestAnnouncements()
+	suite.testUserMutes = testrig.NewTestUserMutes()
+	suite.testInvites = testrig.NewTestInvites()
 }
 
 func (suite *BunDBStandardTestSuite) SetupTest() {
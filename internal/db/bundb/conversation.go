@@ -0,0 +1,252 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type conversationDB struct {
+	conn *DBConn
+}
+
+func (c *conversationDB) GetConversationByID(ctx context.Context, id string) (*gtsmodel.Conversation, db.Error) {
+	var conversation gtsmodel.Conversation
+
+	if err := c.conn.
+		NewSelect().
+		Model(&conversation).
+		Where("? = ?", bun.Ident("conversation.id"), id).
+		Scan(ctx); err != nil {
+		return nil, c.conn.ProcessError(err)
+	}
+
+	return &conversation, nil
+}
+
+func (c *conversationDB) GetConversationByThreadAndAccountIDs(ctx context.Context, accountID string, threadID string, otherAccountIDs []string) (*gtsmodel.Conversation, db.Error) {
+	var conversation gtsmodel.Conversation
+
+	if err := c.conn.
+		NewSelect().
+		Model(&conversation).
+		Where("? = ?", bun.Ident("conversation.account_id"), accountID).
+		Where("? = ?", bun.Ident("conversation.thread_id"), threadID).
+		Where("? = ?", bun.Ident("conversation.other_accounts_key"), gtsmodel.ConversationOtherAccountsKey(otherAccountIDs)).
+		Scan(ctx); err != nil {
+		return nil, c.conn.ProcessError(err)
+	}
+
+	return &conversation, nil
+}
+
+func (c *conversationDB) GetConversations(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Conversation, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	conversations := make([]*gtsmodel.Conversation, 0, limit)
+
+	q := c.conn.
+		NewSelect().
+		Model(&conversations).
+		Where("? = ?", bun.Ident("conversation.account_id"), accountID).
+		// Sort by most recent last status first
+		Order("conversation.last_status_id DESC")
+
+	if maxID != "" {
+		// return only entries with a last status LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("conversation.last_status_id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only entries with a last status HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("conversation.last_status_id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only entries with a last status HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("conversation.last_status_id"), minID)
+	}
+
+	if limit > 0 {
+		// limit amount of entries returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, c.conn.ProcessError(err)
+	}
+
+	return conversations, nil
+}
+
+func (c *conversationDB) PutConversation(ctx context.Context, conversation *gtsmodel.Conversation) db.Error {
+	conversation.OtherAccountsKey = gtsmodel.ConversationOtherAccountsKey(conversation.OtherAccountIDs)
+	_, err := c.conn.NewInsert().Model(conversation).Exec(ctx)
+	return c.conn.ProcessError(err)
+}
+
+func (c *conversationDB) UpdateConversation(ctx context.Context, conversation *gtsmodel.Conversation, columns ...string) db.Error {
+	conversation.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := c.conn.
+		NewUpdate().
+		Model(conversation).
+		Where("? = ?", bun.Ident("conversation.id"), conversation.ID).
+		Column(columns...).
+		Exec(ctx)
+	return c.conn.ProcessError(err)
+}
+
+func (c *conversationDB) DeleteConversationByID(ctx context.Context, id string) db.Error {
+	return c.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// delete links between this conversation and its statuses
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+			Where("? = ?", bun.Ident("conversation_to_status.conversation_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// delete the conversation itself
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("conversations"), bun.Ident("conversation")).
+			Where("? = ?", bun.Ident("conversation.id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (c *conversationDB) DeleteConversationsByAccountID(ctx context.Context, accountID string) db.Error {
+	conversationIDs := []string{}
+
+	if err := c.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("conversations"), bun.Ident("conversation")).
+		Column("conversation.id").
+		Where("? = ?", bun.Ident("conversation.account_id"), accountID).
+		Scan(ctx, &conversationIDs); err != nil {
+		return c.conn.ProcessError(err)
+	}
+
+	for _, id := range conversationIDs {
+		if err := c.DeleteConversationByID(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *conversationDB) AddStatusToConversation(ctx context.Context, conversationID string, statusID string) db.Error {
+	_, err := c.conn.
+		NewInsert().
+		Model(&gtsmodel.ConversationToStatus{
+			ConversationID: conversationID,
+			StatusID:       statusID,
+		}).
+		On("CONFLICT (?, ?) DO NOTHING", bun.Ident("conversation_id"), bun.Ident("status_id")).
+		Exec(ctx)
+	return c.conn.ProcessError(err)
+}
+
+func (c *conversationDB) RemoveStatusFromConversations(ctx context.Context, statusID string) db.Error {
+	conversationIDs := []string{}
+
+	if err := c.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+		Column("conversation_to_status.conversation_id").
+		Where("? = ?", bun.Ident("conversation_to_status.status_id"), statusID).
+		Scan(ctx, &conversationIDs); err != nil {
+		return c.conn.ProcessError(err)
+	}
+
+	if _, err := c.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+		Where("? = ?", bun.Ident("conversation_to_status.status_id"), statusID).
+		Exec(ctx); err != nil {
+		return c.conn.ProcessError(err)
+	}
+
+	for _, conversationID := range conversationIDs {
+		conversation, err := c.GetConversationByID(ctx, conversationID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				continue
+			}
+			return err
+		}
+
+		if conversation.LastStatusID != statusID {
+			// an older status was removed,
+			// the conversation is unchanged
+			continue
+		}
+
+		// find the newest remaining status in the conversation
+		var lastStatusID string
+		if err := c.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+			Column("conversation_to_status.status_id").
+			Where("? = ?", bun.Ident("conversation_to_status.conversation_id"), conversationID).
+			Order("conversation_to_status.status_id DESC").
+			Limit(1).
+			Scan(ctx, &lastStatusID); err != nil {
+			if err := c.conn.ProcessError(err); !errors.Is(err, db.ErrNoEntries) {
+				return err
+			}
+		}
+
+		if lastStatusID == "" {
+			// nothing left in this conversation
+			if err := c.DeleteConversationByID(ctx, conversationID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		conversation.LastStatusID = lastStatusID
+		if err := c.UpdateConversation(ctx, conversation, "last_status_id"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
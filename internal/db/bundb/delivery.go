@@ -0,0 +1,143 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type deliveryDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+func (d *deliveryDB) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*gtsmodel.Delivery, db.Error) {
+	deliveries := []*gtsmodel.Delivery{}
+
+	q := d.conn.
+		NewSelect().
+		Model(&deliveries).
+		Where("? <= ?", bun.Ident("delivery.next_attempt_at"), now).
+		Order("delivery.next_attempt_at ASC")
+
+	if limit != 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return deliveries, nil
+}
+
+func (d *deliveryDB) PutDelivery(ctx context.Context, delivery *gtsmodel.Delivery) db.Error {
+	_, err := d.conn.
+		NewInsert().
+		Model(delivery).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *deliveryDB) UpdateDelivery(ctx context.Context, delivery *gtsmodel.Delivery, columns ...string) db.Error {
+	delivery.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := d.conn.
+		NewUpdate().
+		Model(delivery).
+		Where("? = ?", bun.Ident("delivery.id"), delivery.ID).
+		Column(columns...).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *deliveryDB) DeleteDeliveryByID(ctx context.Context, id string) db.Error {
+	_, err := d.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery")).
+		Where("? = ?", bun.Ident("delivery.id"), id).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *deliveryDB) GetFailingInboxByURI(ctx context.Context, uri string) (*gtsmodel.FailingInbox, db.Error) {
+	return d.state.Caches.GTS.FailingInbox().Load("URI", func() (*gtsmodel.FailingInbox, error) {
+		var inbox gtsmodel.FailingInbox
+
+		// Not cached! Perform database query
+		if err := d.conn.
+			NewSelect().
+			Model(&inbox).
+			Where("? = ?", bun.Ident("failing_inbox.uri"), uri).
+			Scan(ctx); err != nil {
+			return nil, d.conn.ProcessError(err)
+		}
+
+		return &inbox, nil
+	}, uri)
+}
+
+func (d *deliveryDB) PutFailingInbox(ctx context.Context, inbox *gtsmodel.FailingInbox) db.Error {
+	return d.state.Caches.GTS.FailingInbox().Store(inbox, func() error {
+		_, err := d.conn.
+			NewInsert().
+			Model(inbox).
+			Exec(ctx)
+		return d.conn.ProcessError(err)
+	})
+}
+
+func (d *deliveryDB) UpdateFailingInbox(ctx context.Context, inbox *gtsmodel.FailingInbox, columns ...string) db.Error {
+	inbox.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return d.state.Caches.GTS.FailingInbox().Store(inbox, func() error {
+		_, err := d.conn.
+			NewUpdate().
+			Model(inbox).
+			Where("? = ?", bun.Ident("failing_inbox.id"), inbox.ID).
+			Column(columns...).
+			Exec(ctx)
+		return d.conn.ProcessError(err)
+	})
+}
+
+func (d *deliveryDB) DeleteFailingInboxByURI(ctx context.Context, uri string) db.Error {
+	defer d.state.Caches.GTS.FailingInbox().Invalidate("URI", uri)
+
+	_, err := d.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("failing_inboxes"), bun.Ident("failing_inbox")).
+		Where("? = ?", bun.Ident("failing_inbox.uri"), uri).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
@@ -174,3 +174,126 @@ func (d *domainDB) AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, d
 	}
 	return false, nil
 }
+
+func (d *domainDB) CreateEmailDomainBlock(ctx context.Context, block *gtsmodel.EmailDomainBlock) db.Error {
+	var err error
+
+	// Normalize the domain as punycode
+	block.Domain, err = normalizeDomain(block.Domain)
+	if err != nil {
+		return err
+	}
+
+	if _, err := d.conn.NewInsert().
+		Model(block).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (d *domainDB) GetEmailDomainBlockByID(ctx context.Context, id string) (*gtsmodel.EmailDomainBlock, db.Error) {
+	var block gtsmodel.EmailDomainBlock
+
+	q := d.conn.
+		NewSelect().
+		Model(&block).
+		Where("? = ?", bun.Ident("email_domain_block.id"), id)
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return &block, nil
+}
+
+func (d *domainDB) GetEmailDomainBlock(ctx context.Context, domain string) (*gtsmodel.EmailDomainBlock, db.Error) {
+	var err error
+
+	// Normalize the domain as punycode
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var block gtsmodel.EmailDomainBlock
+
+	q := d.conn.
+		NewSelect().
+		Model(&block).
+		Where("? = ?", bun.Ident("email_domain_block.domain"), domain)
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return &block, nil
+}
+
+func (d *domainDB) GetEmailDomainBlocks(ctx context.Context) ([]*gtsmodel.EmailDomainBlock, db.Error) {
+	blocks := []*gtsmodel.EmailDomainBlock{}
+
+	q := d.conn.
+		NewSelect().
+		Model(&blocks).
+		Order("email_domain_block.domain ASC")
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return blocks, nil
+}
+
+func (d *domainDB) DeleteEmailDomainBlock(ctx context.Context, id string) db.Error {
+	if _, err := d.conn.NewDelete().
+		TableExpr("? AS ?", bun.Ident("email_domain_blocks"), bun.Ident("email_domain_block")).
+		Where("? = ?", bun.Ident("email_domain_block.id"), id).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (d *domainDB) IsEmailDomainBlocked(ctx context.Context, domain string) (bool, db.Error) {
+	// Normalize the domain as punycode, and
+	// drop any trailing dot from DNS names
+	domain, err := normalizeDomain(strings.TrimSuffix(domain, "."))
+	if err != nil {
+		return false, err
+	}
+
+	if domain == "" {
+		return false, nil
+	}
+
+	// Check the domain itself plus each of its
+	// parent domains, eg., for `mx.mail.example.org`
+	// check `mail.example.org` and `example.org` too.
+	domains := []string{domain}
+	for {
+		i := strings.IndexByte(domain, '.')
+		if i == -1 {
+			break
+		}
+		domain = domain[i+1:]
+		domains = append(domains, domain)
+	}
+
+	q := d.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("email_domain_blocks"), bun.Ident("email_domain_block")).
+		Column("email_domain_block.id").
+		Where("? IN (?)", bun.Ident("email_domain_block.domain"), bun.In(domains))
+	return d.conn.Exists(ctx, q)
+}
+
+func (d *domainDB) AreEmailDomainsBlocked(ctx context.Context, domains []string) (bool, db.Error) {
+	for _, domain := range domains {
+		if blocked, err := d.IsEmailDomainBlocked(ctx, domain); err != nil {
+			return false, err
+		} else if blocked {
+			return blocked, nil
+		}
+	}
+	return false, nil
+}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
@@ -160,6 +161,65 @@ func (suite *DomainTestSuite) TestIsDomainBlockedNonASCII2() {
 	suite.True(blocked)
 }
 
+func (suite *DomainTestSuite) TestIsEmailDomainBlocked() {
+	ctx := context.Background()
+
+	// the fixture block is on spam-mail.org
+	for domain, expected := range map[string]bool{
+		"spam-mail.org":         true,
+		"SPAM-MAIL.ORG":         true,
+		"mx1.spam-mail.org.":    true,
+		"deep.mx.spam-mail.org": true,
+		"not-spam-mail.org":     false,
+		"example.org":           false,
+		"org":                   false,
+	} {
+		blocked, err := suite.db.IsEmailDomainBlocked(ctx, domain)
+		suite.NoError(err)
+		suite.Equal(expected, blocked, domain)
+	}
+
+	blocked, err := suite.db.AreEmailDomainsBlocked(ctx, []string{"example.org", "mx2.spam-mail.org."})
+	suite.NoError(err)
+	suite.True(blocked)
+}
+
+func (suite *DomainTestSuite) TestCreateGetDeleteEmailDomainBlock() {
+	ctx := context.Background()
+
+	block := &gtsmodel.EmailDomainBlock{
+		ID:                 "01GYQP3AZ1G8XWTK06ZM2B1W3F",
+		Domain:             "Bad.Apples",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	err := suite.db.CreateEmailDomainBlock(ctx, block)
+	suite.NoError(err)
+	suite.Equal("bad.apples", block.Domain)
+
+	dbBlock, err := suite.db.GetEmailDomainBlock(ctx, "BAD.apples")
+	suite.NoError(err)
+	suite.Equal(block.ID, dbBlock.ID)
+
+	blocks, err := suite.db.GetEmailDomainBlocks(ctx)
+	suite.NoError(err)
+	if suite.Len(blocks, 2) {
+		// ordered by domain
+		suite.Equal("bad.apples", blocks[0].Domain)
+		suite.Equal("spam-mail.org", blocks[1].Domain)
+	}
+
+	err = suite.db.DeleteEmailDomainBlock(ctx, block.ID)
+	suite.NoError(err)
+
+	_, err = suite.db.GetEmailDomainBlockByID(ctx, block.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	blocked, err := suite.db.IsEmailDomainBlocked(ctx, "bad.apples")
+	suite.NoError(err)
+	suite.False(blocked)
+}
+
 func TestDomainTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainTestSuite))
 }
@@ -0,0 +1,313 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type filterDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+/*
+	FILTER FUNCTIONS
+*/
+
+func (f *filterDB) GetFilterByID(ctx context.Context, id string) (*gtsmodel.Filter, db.Error) {
+	// Fetch filter from database cache with loader callback
+	filter, err := f.state.Caches.GTS.Filter().Load("ID", func() (*gtsmodel.Filter, error) {
+		var filter gtsmodel.Filter
+
+		// Not cached! Perform database query.
+		if err := f.conn.
+			NewSelect().
+			Model(&filter).
+			Where("? = ?", bun.Ident("filter.id"), id).
+			Scan(ctx); err != nil {
+			return nil, f.conn.ProcessError(err)
+		}
+
+		return &filter, nil
+	}, id)
+	if err != nil {
+		// error already processed
+		return nil, err
+	}
+
+	// Set the keywords belonging to this filter.
+	filter.Keywords, err = f.GetFilterKeywordsForFilterID(ctx, filter.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting filter keywords: %w", err)
+	}
+
+	return filter, nil
+}
+
+func (f *filterDB) GetFiltersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Filter, db.Error) {
+	// Fetch IDs of all filters owned by this account.
+	var filterIDs []string
+	if err := f.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("filters"), bun.Ident("filter")).
+		Column("filter.id").
+		Where("? = ?", bun.Ident("filter.account_id"), accountID).
+		Order("filter.id DESC").
+		Scan(ctx, &filterIDs); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	if len(filterIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each filter using its ID to ensure cache used.
+	filters := make([]*gtsmodel.Filter, 0, len(filterIDs))
+	for _, id := range filterIDs {
+		filter, err := f.GetFilterByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetFiltersForAccountID: error fetching filter %q: %v", id, err)
+			continue
+		}
+
+		// Append filter.
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func (f *filterDB) PutFilter(ctx context.Context, filter *gtsmodel.Filter) db.Error {
+	// Compile keywords before insert, so that
+	// we don't store anything we can't apply.
+	for _, keyword := range filter.Keywords {
+		if err := keyword.Compile(); err != nil {
+			return fmt.Errorf("PutFilter: error compiling filter keyword %q: %w", keyword.Keyword, err)
+		}
+	}
+
+	if err := f.state.Caches.GTS.Filter().Store(filter, func() error {
+		return f.conn.RunInTx(ctx, func(tx bun.Tx) error {
+			if _, err := tx.
+				NewInsert().
+				Model(filter).
+				Exec(ctx); err != nil {
+				return err
+			}
+
+			for _, keyword := range filter.Keywords {
+				if _, err := tx.
+					NewInsert().
+					Model(keyword).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (f *filterDB) UpdateFilter(ctx context.Context, filter *gtsmodel.Filter, columns ...string) db.Error {
+	filter.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := f.conn.
+		NewUpdate().
+		Model(filter).
+		Where("? = ?", bun.Ident("filter.id"), filter.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	f.state.Caches.GTS.Filter().Invalidate("ID", filter.ID)
+	return nil
+}
+
+func (f *filterDB) DeleteFilterByID(ctx context.Context, id string) db.Error {
+	// Select all keywords that belong to this filter, so
+	// that we can invalidate them once the delete is done.
+	var keywordIDs []string
+	if err := f.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("filter_keywords"), bun.Ident("filter_keyword")).
+		Column("filter_keyword.id").
+		Where("? = ?", bun.Ident("filter_keyword.filter_id"), id).
+		Scan(ctx, &keywordIDs); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	if err := f.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// Delete all keywords attached to filter.
+		if _, err := tx.NewDelete().
+			TableExpr("? AS ?", bun.Ident("filter_keywords"), bun.Ident("filter_keyword")).
+			Where("? = ?", bun.Ident("filter_keyword.filter_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delete the filter itself.
+		_, err := tx.NewDelete().
+			TableExpr("? AS ?", bun.Ident("filters"), bun.Ident("filter")).
+			Where("? = ?", bun.Ident("filter.id"), id).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	// Invalidate the filter + keywords from cache.
+	f.state.Caches.GTS.Filter().Invalidate("ID", id)
+	for _, keywordID := range keywordIDs {
+		f.state.Caches.GTS.FilterKeyword().Invalidate("ID", keywordID)
+	}
+
+	return nil
+}
+
+/*
+	FILTER KEYWORD FUNCTIONS
+*/
+
+func (f *filterDB) GetFilterKeywordByID(ctx context.Context, id string) (*gtsmodel.FilterKeyword, db.Error) {
+	// Fetch filter keyword from database cache with loader callback
+	return f.state.Caches.GTS.FilterKeyword().Load("ID", func() (*gtsmodel.FilterKeyword, error) {
+		var filterKeyword gtsmodel.FilterKeyword
+
+		// Not cached! Perform database query.
+		if err := f.conn.
+			NewSelect().
+			Model(&filterKeyword).
+			Where("? = ?", bun.Ident("filter_keyword.id"), id).
+			Scan(ctx); err != nil {
+			return nil, f.conn.ProcessError(err)
+		}
+
+		// Compile the keyword regexp now, so
+		// that the cached copy is ready to use.
+		if err := filterKeyword.Compile(); err != nil {
+			return nil, fmt.Errorf("error compiling filter keyword %q: %w", filterKeyword.Keyword, err)
+		}
+
+		return &filterKeyword, nil
+	}, id)
+}
+
+func (f *filterDB) GetFilterKeywordsForFilterID(ctx context.Context, filterID string) ([]*gtsmodel.FilterKeyword, db.Error) {
+	return f.getFilterKeywords(ctx, "filter_keyword.filter_id", filterID)
+}
+
+func (f *filterDB) GetFilterKeywordsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FilterKeyword, db.Error) {
+	return f.getFilterKeywords(ctx, "filter_keyword.account_id", accountID)
+}
+
+func (f *filterDB) getFilterKeywords(ctx context.Context, idColumn string, id string) ([]*gtsmodel.FilterKeyword, db.Error) {
+	// Fetch IDs of all keywords that match the given column.
+	var keywordIDs []string
+	if err := f.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("filter_keywords"), bun.Ident("filter_keyword")).
+		Column("filter_keyword.id").
+		Where("? = ?", bun.Ident(idColumn), id).
+		Order("filter_keyword.id ASC").
+		Scan(ctx, &keywordIDs); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	if len(keywordIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each keyword using its ID to ensure cache used.
+	keywords := make([]*gtsmodel.FilterKeyword, 0, len(keywordIDs))
+	for _, id := range keywordIDs {
+		keyword, err := f.GetFilterKeywordByID(ctx, id)
+		if err != nil {
+			log.Errorf("getFilterKeywords: error fetching filter keyword %q: %v", id, err)
+			continue
+		}
+
+		// Append keyword.
+		keywords = append(keywords, keyword)
+	}
+
+	return keywords, nil
+}
+
+func (f *filterDB) PutFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) db.Error {
+	if err := filterKeyword.Compile(); err != nil {
+		return fmt.Errorf("PutFilterKeyword: error compiling filter keyword %q: %w", filterKeyword.Keyword, err)
+	}
+
+	return f.state.Caches.GTS.FilterKeyword().Store(filterKeyword, func() error {
+		_, err := f.conn.NewInsert().Model(filterKeyword).Exec(ctx)
+		return f.conn.ProcessError(err)
+	})
+}
+
+func (f *filterDB) UpdateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, columns ...string) db.Error {
+	filterKeyword.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := f.conn.
+		NewUpdate().
+		Model(filterKeyword).
+		Where("? = ?", bun.Ident("filter_keyword.id"), filterKeyword.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	f.state.Caches.GTS.FilterKeyword().Invalidate("ID", filterKeyword.ID)
+	return nil
+}
+
+func (f *filterDB) DeleteFilterKeywordByID(ctx context.Context, id string) db.Error {
+	if _, err := f.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("filter_keywords"), bun.Ident("filter_keyword")).
+		Where("? = ?", bun.Ident("filter_keyword.id"), id).
+		Exec(ctx); err != nil {
+		return f.conn.ProcessError(err)
+	}
+
+	f.state.Caches.GTS.FilterKeyword().Invalidate("ID", id)
+	return nil
+}
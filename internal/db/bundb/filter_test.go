@@ -0,0 +1,121 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FilterTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *FilterTestSuite) TestGetFilterByID() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+
+	filter, err := suite.db.GetFilterByID(context.Background(), testFilter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testFilter.Title, filter.Title)
+	suite.Equal(gtsmodel.FilterActionWarn, filter.Action)
+	suite.Len(filter.Keywords, 1)
+	suite.NotNil(filter.Keywords[0].Regexp)
+	suite.True(filter.Keywords[0].Regexp.MatchString("the FNORD is here"))
+	suite.False(filter.Keywords[0].Regexp.MatchString("fnords everywhere"))
+}
+
+func (suite *FilterTestSuite) TestGetFiltersForAccountID() {
+	filters, err := suite.db.GetFiltersForAccountID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(filters, 1)
+}
+
+func (suite *FilterTestSuite) TestPutUpdateDeleteFilter() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["admin_account"].ID
+
+	filter := &gtsmodel.Filter{
+		ID:                   "01HN2BMHWSH2G8H7NGKQT6HQWF",
+		AccountID:            accountID,
+		Title:                "cats",
+		Action:               gtsmodel.FilterActionHide,
+		ContextHome:          testrig.TrueBool(),
+		ContextNotifications: testrig.FalseBool(),
+		ContextPublic:        testrig.FalseBool(),
+		ContextThread:        testrig.FalseBool(),
+		ContextAccount:       testrig.FalseBool(),
+		Keywords: []*gtsmodel.FilterKeyword{
+			{
+				ID:        "01HN2BN6WTAQWDE4K4PZMGQDX0",
+				AccountID: accountID,
+				FilterID:  "01HN2BMHWSH2G8H7NGKQT6HQWF",
+				Keyword:   "#cats",
+				WholeWord: testrig.TrueBool(),
+			},
+		},
+	}
+
+	if err := suite.db.PutFilter(ctx, filter); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbFilter, err := suite.db.GetFilterByID(ctx, filter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(dbFilter.Keywords, 1)
+	suite.True(dbFilter.Keywords[0].Regexp.MatchString("look at my #cats!"))
+
+	dbFilter.Title = "no more cats"
+	if err := suite.db.UpdateFilter(ctx, dbFilter, "title"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbFilter, err = suite.db.GetFilterByID(ctx, filter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("no more cats", dbFilter.Title)
+
+	if err := suite.db.DeleteFilterByID(ctx, filter.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetFilterByID(ctx, filter.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetFilterKeywordByID(ctx, "01HN2BN6WTAQWDE4K4PZMGQDX0")
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(FilterTestSuite))
+}
@@ -0,0 +1,149 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type inviteDB struct {
+	conn *DBConn
+}
+
+func (i *inviteDB) GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, db.Error) {
+	return i.getInvite(ctx, "invite.id", id)
+}
+
+func (i *inviteDB) GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, db.Error) {
+	return i.getInvite(ctx, "invite.code", code)
+}
+
+func (i *inviteDB) getInvite(ctx context.Context, column string, value string) (*gtsmodel.Invite, db.Error) {
+	var invite gtsmodel.Invite
+
+	if err := i.conn.
+		NewSelect().
+		Model(&invite).
+		Where("? = ?", bun.Ident(column), value).
+		Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return &invite, nil
+}
+
+func (i *inviteDB) GetInvites(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Invite, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	invites := make([]*gtsmodel.Invite, 0, limit)
+
+	q := i.conn.
+		NewSelect().
+		Model(&invites).
+		Where("? = ?", bun.Ident("invite.account_id"), accountID).
+		// Sort by highest ID (newest) to lowest ID (oldest)
+		Order("invite.id DESC")
+
+	if maxID != "" {
+		// return only entries LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("invite.id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only entries HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("invite.id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only entries HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("invite.id"), minID)
+	}
+
+	if limit > 0 {
+		// limit amount of entries returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return invites, nil
+}
+
+func (i *inviteDB) PutInvite(ctx context.Context, invite *gtsmodel.Invite) db.Error {
+	_, err := i.conn.NewInsert().Model(invite).Exec(ctx)
+	return i.conn.ProcessError(err)
+}
+
+func (i *inviteDB) UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) db.Error {
+	invite.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.conn.
+		NewUpdate().
+		Model(invite).
+		Where("? = ?", bun.Ident("invite.id"), invite.ID).
+		Column(columns...).
+		Exec(ctx)
+	return i.conn.ProcessError(err)
+}
+
+func (i *inviteDB) UseInvite(ctx context.Context, id string) db.Error {
+	// Increment uses in one statement, so that
+	// concurrent sign-ups can't go over max uses.
+	res, err := i.conn.
+		NewUpdate().
+		TableExpr("? AS ?", bun.Ident("invites"), bun.Ident("invite")).
+		Set("? = ? + 1", bun.Ident("uses"), bun.Ident("uses")).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("invite.id"), id).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? = 0", bun.Ident("invite.max_uses")).
+				WhereOr("? < ?", bun.Ident("invite.uses"), bun.Ident("invite.max_uses"))
+		}).
+		Exec(ctx)
+	if err != nil {
+		return i.conn.ProcessError(err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return i.conn.ProcessError(err)
+	}
+
+	if rows == 0 {
+		return db.ErrNoEntries
+	}
+
+	return nil
+}
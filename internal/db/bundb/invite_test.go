@@ -0,0 +1,123 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type InviteTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *InviteTestSuite) TestGetInviteByCode() {
+	testInvite := suite.testInvites["local_account_1_invite"]
+
+	invite, err := suite.db.GetInviteByCode(context.Background(), testInvite.Code)
+	suite.NoError(err)
+	suite.Equal(testInvite.ID, invite.ID)
+	suite.Equal(testInvite.AccountID, invite.AccountID)
+}
+
+func (suite *InviteTestSuite) TestGetInviteByCodeNotFound() {
+	invite, err := suite.db.GetInviteByCode(context.Background(), "nope")
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(invite)
+}
+
+func (suite *InviteTestSuite) TestGetInvites() {
+	testAccount := suite.testAccounts["admin_account"]
+
+	invites, err := suite.db.GetInvites(context.Background(), testAccount.ID, "", "", "", 10)
+	suite.NoError(err)
+	if suite.Len(invites, 2) {
+		// newest first
+		suite.Equal(suite.testInvites["admin_account_invite_expired"].ID, invites[0].ID)
+		suite.Equal(suite.testInvites["admin_account_invite"].ID, invites[1].ID)
+	}
+}
+
+func (suite *InviteTestSuite) TestGetInvitesPaged() {
+	testAccount := suite.testAccounts["admin_account"]
+
+	invites, err := suite.db.GetInvites(context.Background(), testAccount.ID, suite.testInvites["admin_account_invite_expired"].ID, "", "", 10)
+	suite.NoError(err)
+	if suite.Len(invites, 1) {
+		suite.Equal(suite.testInvites["admin_account_invite"].ID, invites[0].ID)
+	}
+}
+
+func (suite *InviteTestSuite) TestUseInvite() {
+	ctx := context.Background()
+	testInvite := suite.testInvites["admin_account_invite"]
+
+	// invite has one use left
+	err := suite.db.UseInvite(ctx, testInvite.ID)
+	suite.NoError(err)
+
+	invite, err := suite.db.GetInviteByID(ctx, testInvite.ID)
+	suite.NoError(err)
+	suite.Equal(1, invite.Uses)
+
+	// now it has none
+	err = suite.db.UseInvite(ctx, testInvite.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	invite, err = suite.db.GetInviteByID(ctx, testInvite.ID)
+	suite.NoError(err)
+	suite.Equal(1, invite.Uses)
+}
+
+func (suite *InviteTestSuite) TestUseInviteUnlimited() {
+	ctx := context.Background()
+	testInvite := suite.testInvites["admin_account_invite_expired"]
+
+	err := suite.db.UseInvite(ctx, testInvite.ID)
+	suite.NoError(err)
+
+	invite, err := suite.db.GetInviteByID(ctx, testInvite.ID)
+	suite.NoError(err)
+	suite.Equal(testInvite.Uses+1, invite.Uses)
+}
+
+func (suite *InviteTestSuite) TestUpdateInvite() {
+	ctx := context.Background()
+	testInvite := suite.testInvites["local_account_1_invite"]
+
+	invite := &gtsmodel.Invite{}
+	*invite = *testInvite
+	invite.MaxUses = 10
+
+	err := suite.db.UpdateInvite(ctx, invite, "max_uses")
+	suite.NoError(err)
+
+	invite, err = suite.db.GetInviteByID(ctx, testInvite.ID)
+	suite.NoError(err)
+	suite.Equal(10, invite.MaxUses)
+	suite.True(invite.UpdatedAt.After(testInvite.UpdatedAt))
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteTestSuite))
+}
@@ -0,0 +1,392 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type listDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+/*
+	LIST FUNCTIONS
+*/
+
+func (l *listDB) GetListByID(ctx context.Context, id string) (*gtsmodel.List, db.Error) {
+	return l.getList(
+		ctx,
+		"ID",
+		func(list *gtsmodel.List) error {
+			return l.conn.NewSelect().
+				Model(list).
+				Where("? = ?", bun.Ident("list.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (l *listDB) getList(ctx context.Context, lookup string, dbQuery func(*gtsmodel.List) error, keyParts ...any) (*gtsmodel.List, db.Error) {
+	// Fetch list from database cache with loader callback
+	list, err := l.state.Caches.GTS.List().Load(lookup, func() (*gtsmodel.List, error) {
+		var list gtsmodel.List
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&list); err != nil {
+			return nil, l.conn.ProcessError(err)
+		}
+
+		return &list, nil
+	}, keyParts...)
+	if err != nil {
+		// error already processed
+		return nil, err
+	}
+
+	// Set the list owner account
+	list.Account, err = l.state.DB.GetAccountByID(ctx, list.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting list account: %w", err)
+	}
+
+	return list, nil
+}
+
+func (l *listDB) GetListsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.List, db.Error) {
+	// Fetch IDs of all lists owned by this account.
+	var listIDs []string
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("lists"), bun.Ident("list")).
+		Column("list.id").
+		Where("? = ?", bun.Ident("list.account_id"), accountID).
+		Order("list.id DESC").
+		Scan(ctx, &listIDs); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if len(listIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each list using its ID to ensure cache used.
+	lists := make([]*gtsmodel.List, 0, len(listIDs))
+	for _, id := range listIDs {
+		list, err := l.GetListByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetListsForAccountID: error fetching list %q: %v", id, err)
+			continue
+		}
+
+		// Append list.
+		lists = append(lists, list)
+	}
+
+	return lists, nil
+}
+
+func (l *listDB) PutList(ctx context.Context, list *gtsmodel.List) db.Error {
+	return l.state.Caches.GTS.List().Store(list, func() error {
+		_, err := l.conn.NewInsert().Model(list).Exec(ctx)
+		return l.conn.ProcessError(err)
+	})
+}
+
+func (l *listDB) UpdateList(ctx context.Context, list *gtsmodel.List, columns ...string) db.Error {
+	list.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := l.conn.
+		NewUpdate().
+		Model(list).
+		Where("? = ?", bun.Ident("list.id"), list.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	l.state.Caches.GTS.List().Invalidate("ID", list.ID)
+	return nil
+}
+
+func (l *listDB) DeleteListByID(ctx context.Context, id string) db.Error {
+	// Select all entries that belong to this list, so that
+	// we can invalidate them once the delete has gone through.
+	var entryIDs []string
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+		Column("list_entry.id").
+		Where("? = ?", bun.Ident("list_entry.list_id"), id).
+		Scan(ctx, &entryIDs); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	if err := l.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// Delete all entries attached to list.
+		if _, err := tx.NewDelete().
+			TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+			Where("? = ?", bun.Ident("list_entry.list_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delete the list itself.
+		_, err := tx.NewDelete().
+			TableExpr("? AS ?", bun.Ident("lists"), bun.Ident("list")).
+			Where("? = ?", bun.Ident("list.id"), id).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	// Invalidate the list + entries from cache.
+	l.state.Caches.GTS.List().Invalidate("ID", id)
+	for _, entryID := range entryIDs {
+		l.state.Caches.GTS.ListEntry().Invalidate("ID", entryID)
+	}
+
+	return nil
+}
+
+/*
+	LIST ENTRY functions
+*/
+
+func (l *listDB) GetListEntryByID(ctx context.Context, id string) (*gtsmodel.ListEntry, db.Error) {
+	return l.getListEntry(
+		ctx,
+		"ID",
+		func(listEntry *gtsmodel.ListEntry) error {
+			return l.conn.NewSelect().
+				Model(listEntry).
+				Where("? = ?", bun.Ident("list_entry.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (l *listDB) getListEntry(ctx context.Context, lookup string, dbQuery func(*gtsmodel.ListEntry) error, keyParts ...any) (*gtsmodel.ListEntry, db.Error) {
+	// Fetch list entry from database cache with loader callback
+	listEntry, err := l.state.Caches.GTS.ListEntry().Load(lookup, func() (*gtsmodel.ListEntry, error) {
+		var listEntry gtsmodel.ListEntry
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&listEntry); err != nil {
+			return nil, l.conn.ProcessError(err)
+		}
+
+		return &listEntry, nil
+	}, keyParts...)
+	if err != nil {
+		// error already processed
+		return nil, err
+	}
+
+	// Set the follow that this entry pertains to.
+	listEntry.Follow, err = l.getFollow(ctx, listEntry.FollowID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting list entry follow: %w", err)
+	}
+
+	return listEntry, nil
+}
+
+// getFollow fetches the follow with the given ID, and
+// populates the target account of the follow.
+func (l *listDB) getFollow(ctx context.Context, id string) (*gtsmodel.Follow, db.Error) {
+	follow := new(gtsmodel.Follow)
+	if err := l.conn.
+		NewSelect().
+		Model(follow).
+		Where("? = ?", bun.Ident("follow.id"), id).
+		Scan(ctx); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	var err error
+	follow.TargetAccount, err = l.state.DB.GetAccountByID(ctx, follow.TargetAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return follow, nil
+}
+
+func (l *listDB) GetListEntries(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ListEntry, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	entryIDs := make([]string, 0, limit)
+
+	q := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+		// Select only IDs from table
+		Column("list_entry.id").
+		// Select only entries belonging to listID
+		Where("? = ?", bun.Ident("list_entry.list_id"), listID).
+		// Sort by highest ID (newest) to lowest ID (oldest)
+		Order("list_entry.id DESC")
+
+	if maxID != "" {
+		// return only entries LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("list_entry.id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only entries HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("list_entry.id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only entries HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("list_entry.id"), minID)
+	}
+
+	if limit > 0 {
+		// limit amount of entries returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &entryIDs); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if len(entryIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each list entry using its ID to ensure cache used.
+	listEntries := make([]*gtsmodel.ListEntry, 0, len(entryIDs))
+	for _, id := range entryIDs {
+		listEntry, err := l.GetListEntryByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetListEntries: error fetching list entry %q: %v", id, err)
+			continue
+		}
+
+		// Append list entry.
+		listEntries = append(listEntries, listEntry)
+	}
+
+	return listEntries, nil
+}
+
+func (l *listDB) GetListEntriesForFollowID(ctx context.Context, followID string) ([]*gtsmodel.ListEntry, db.Error) {
+	entryIDs := []string{}
+
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+		// Select only IDs from table
+		Column("list_entry.id").
+		// Select only entries belonging with given followID.
+		Where("? = ?", bun.Ident("list_entry.follow_id"), followID).
+		Scan(ctx, &entryIDs); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if len(entryIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each list entry using its ID to ensure cache used.
+	listEntries := make([]*gtsmodel.ListEntry, 0, len(entryIDs))
+	for _, id := range entryIDs {
+		listEntry, err := l.GetListEntryByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetListEntriesForFollowID: error fetching list entry %q: %v", id, err)
+			continue
+		}
+
+		// Append list entry.
+		listEntries = append(listEntries, listEntry)
+	}
+
+	return listEntries, nil
+}
+
+func (l *listDB) PutListEntries(ctx context.Context, listEntries []*gtsmodel.ListEntry) db.Error {
+	return l.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		for _, listEntry := range listEntries {
+			if _, err := tx.
+				NewInsert().
+				Model(listEntry).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (l *listDB) DeleteListEntry(ctx context.Context, id string) db.Error {
+	if _, err := l.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+		Where("? = ?", bun.Ident("list_entry.id"), id).
+		Exec(ctx); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	l.state.Caches.GTS.ListEntry().Invalidate("ID", id)
+	return nil
+}
+
+func (l *listDB) DeleteListEntriesForFollowID(ctx context.Context, followID string) db.Error {
+	// Fetch IDs of all entries that pertain to this follow.
+	var entryIDs []string
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+		Column("list_entry.id").
+		Where("? = ?", bun.Ident("list_entry.follow_id"), followID).
+		Scan(ctx, &entryIDs); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	for _, id := range entryIDs {
+		if err := l.DeleteListEntry(ctx, id); err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+	}
+
+	return nil
+}
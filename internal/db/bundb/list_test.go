@@ -0,0 +1,191 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ListTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *ListTestSuite) TestGetListByID() {
+	testList := suite.testLists["local_account_1_list_1"]
+
+	list, err := suite.db.GetListByID(context.Background(), testList.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testList.Title, list.Title)
+	suite.Equal(testList.AccountID, list.AccountID)
+	suite.NotNil(list.Account)
+	suite.Equal(gtsmodel.RepliesPolicyFollowed, list.RepliesPolicy)
+}
+
+func (suite *ListTestSuite) TestGetListsForAccountID() {
+	lists, err := suite.db.GetListsForAccountID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(lists, 1)
+}
+
+func (suite *ListTestSuite) TestGetListsForAccountIDNoLists() {
+	lists, err := suite.db.GetListsForAccountID(context.Background(), suite.testAccounts["admin_account"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(lists)
+}
+
+func (suite *ListTestSuite) TestGetListEntries() {
+	testList := suite.testLists["local_account_1_list_1"]
+
+	entries, err := suite.db.GetListEntries(context.Background(), testList.ID, "", "", "", 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(entries, 2)
+	for _, entry := range entries {
+		suite.Equal(testList.ID, entry.ListID)
+		suite.NotNil(entry.Follow)
+		suite.NotNil(entry.Follow.TargetAccount)
+	}
+
+	// Newest entry first.
+	suite.Equal(suite.testListEntries["local_account_1_list_1_entry_2"].ID, entries[0].ID)
+}
+
+func (suite *ListTestSuite) TestGetListEntriesPaged() {
+	testList := suite.testLists["local_account_1_list_1"]
+
+	entries, err := suite.db.GetListEntries(context.Background(), testList.ID, suite.testListEntries["local_account_1_list_1_entry_2"].ID, "", "", 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(entries, 1)
+	suite.Equal(suite.testListEntries["local_account_1_list_1_entry_1"].ID, entries[0].ID)
+}
+
+func (suite *ListTestSuite) TestUpdateList() {
+	ctx := context.Background()
+	testList := suite.testLists["local_account_1_list_1"]
+
+	list, err := suite.db.GetListByID(ctx, testList.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	list.Title = "Cool Ass Posters From This Instance (updated)"
+	list.RepliesPolicy = gtsmodel.RepliesPolicyNone
+	if err := suite.db.UpdateList(ctx, list, "title", "replies_policy"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbList, err := suite.db.GetListByID(ctx, testList.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("Cool Ass Posters From This Instance (updated)", dbList.Title)
+	suite.Equal(gtsmodel.RepliesPolicyNone, dbList.RepliesPolicy)
+}
+
+func (suite *ListTestSuite) TestDeleteListByID() {
+	ctx := context.Background()
+	testList := suite.testLists["local_account_1_list_1"]
+
+	if err := suite.db.DeleteListByID(ctx, testList.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetListByID(ctx, testList.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Entries should be gone too.
+	_, err = suite.db.GetListEntryByID(ctx, suite.testListEntries["local_account_1_list_1_entry_1"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *ListTestSuite) TestPutAndDeleteListEntriesForFollowID() {
+	ctx := context.Background()
+	testList := suite.testLists["local_account_1_list_1"]
+	followID := suite.testFollows["local_account_1_local_account_2"].ID
+
+	// Remove the entries that already exist for this follow.
+	if err := suite.db.DeleteListEntriesForFollowID(ctx, followID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	entries, err := suite.db.GetListEntriesForFollowID(ctx, followID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(entries)
+
+	// Put it back in again.
+	if err := suite.db.PutListEntries(ctx, []*gtsmodel.ListEntry{
+		{
+			ID:       "01H0MKMQY69HWDSDR2SWGA17R4",
+			ListID:   testList.ID,
+			FollowID: followID,
+		},
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	entries, err = suite.db.GetListEntriesForFollowID(ctx, followID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(entries, 1)
+}
+
+func (suite *ListTestSuite) TestGetListTimeline() {
+	testList := suite.testLists["local_account_1_list_1"]
+
+	statuses, err := suite.db.GetListTimeline(context.Background(), testList.ID, "", "", "", 20)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(statuses)
+	for _, s := range statuses {
+		// Only statuses from admin + local_account_2 should be included.
+		suite.Contains([]string{
+			suite.testAccounts["admin_account"].ID,
+			suite.testAccounts["local_account_2"].ID,
+		}, s.AccountID)
+	}
+}
+
+func TestListTestSuite(t *testing.T) {
+	suite.Run(t, new(ListTestSuite))
+}
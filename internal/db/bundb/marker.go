@@ -0,0 +1,120 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type markerDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+func (m *markerDB) GetMarker(ctx context.Context, accountID string, name gtsmodel.MarkerName) (*gtsmodel.Marker, db.Error) {
+	// Fetch marker from database cache with loader callback
+	return m.state.Caches.GTS.Marker().Load("AccountID.Name", func() (*gtsmodel.Marker, error) {
+		var marker gtsmodel.Marker
+
+		// Not cached! Perform database query.
+		if err := m.conn.
+			NewSelect().
+			Model(&marker).
+			Where("? = ? AND ? = ?", bun.Ident("marker.account_id"), accountID, bun.Ident("marker.name"), name).
+			Scan(ctx); err != nil {
+			return nil, m.conn.ProcessError(err)
+		}
+
+		return &marker, nil
+	}, accountID, name)
+}
+
+func (m *markerDB) UpdateMarker(ctx context.Context, marker *gtsmodel.Marker) db.Error {
+	prevMarker, err := m.GetMarker(ctx, marker.AccountID, marker.Name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("UpdateMarker: error fetching previous version of marker: %w", err)
+	}
+
+	marker.UpdatedAt = time.Now()
+	if prevMarker != nil {
+		marker.Version = prevMarker.Version + 1
+	}
+
+	return m.state.Caches.GTS.Marker().Store(marker, func() error {
+		if prevMarker == nil {
+			// First time this marker is set. If someone else
+			// got there first, this fails with ErrAlreadyExists.
+			_, err := m.conn.NewInsert().Model(marker).Exec(ctx)
+			return m.conn.ProcessError(err)
+		}
+
+		// Only update the row if it's still at the version we read;
+		// if it isn't, another update happened concurrently and this
+		// one should be retried by the caller.
+		result, err := m.conn.
+			NewUpdate().
+			Model(marker).
+			WherePK().
+			Where("? = ?", bun.Ident("marker.version"), prevMarker.Version).
+			Exec(ctx)
+		if err != nil {
+			return m.conn.ProcessError(err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return m.conn.ProcessError(err)
+		}
+
+		if rowsAffected == 0 {
+			// Don't let the cache store our version of the marker.
+			m.state.Caches.GTS.Marker().Invalidate("AccountID.Name", marker.AccountID, marker.Name)
+			return db.ErrAlreadyExists
+		}
+
+		return nil
+	})
+}
+
+func (m *markerDB) DeleteMarkersByAccountID(ctx context.Context, accountID string) db.Error {
+	if _, err := m.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("markers"), bun.Ident("marker")).
+		Where("? = ?", bun.Ident("marker.account_id"), accountID).
+		Exec(ctx); err != nil {
+		return m.conn.ProcessError(err)
+	}
+
+	for _, name := range []gtsmodel.MarkerName{
+		gtsmodel.MarkerNameHome,
+		gtsmodel.MarkerNameNotifications,
+	} {
+		m.state.Caches.GTS.Marker().Invalidate("AccountID.Name", accountID, name)
+	}
+
+	return nil
+}
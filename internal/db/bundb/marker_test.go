@@ -0,0 +1,145 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type MarkerTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *MarkerTestSuite) TestGetMarker() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	marker, err := suite.db.GetMarker(context.Background(), testAccount.ID, gtsmodel.MarkerNameNotifications)
+	suite.NoError(err)
+	suite.Equal(4, marker.Version)
+	suite.Equal("01F8Q0ANPTWW10DAKTX7BRPBJP", marker.LastReadID)
+}
+
+func (suite *MarkerTestSuite) TestGetMarkerNotFound() {
+	testAccount := suite.testAccounts["local_account_2"]
+
+	marker, err := suite.db.GetMarker(context.Background(), testAccount.ID, gtsmodel.MarkerNameHome)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(marker)
+}
+
+func (suite *MarkerTestSuite) TestUpdateMarkerNew() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_2"]
+
+	err := suite.db.UpdateMarker(ctx, &gtsmodel.Marker{
+		AccountID:  testAccount.ID,
+		Name:       gtsmodel.MarkerNameHome,
+		LastReadID: "01F8MHAAY43M6RJ473VQFCVH37",
+	})
+	suite.NoError(err)
+
+	marker, err := suite.db.GetMarker(ctx, testAccount.ID, gtsmodel.MarkerNameHome)
+	suite.NoError(err)
+	suite.Equal(0, marker.Version)
+	suite.Equal("01F8MHAAY43M6RJ473VQFCVH37", marker.LastReadID)
+}
+
+func (suite *MarkerTestSuite) TestUpdateMarkerExisting() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	err := suite.db.UpdateMarker(ctx, &gtsmodel.Marker{
+		AccountID:  testAccount.ID,
+		Name:       gtsmodel.MarkerNameNotifications,
+		LastReadID: "01F8QA4K6GHS0M1VW9RJ3HMKH5",
+	})
+	suite.NoError(err)
+
+	marker, err := suite.db.GetMarker(ctx, testAccount.ID, gtsmodel.MarkerNameNotifications)
+	suite.NoError(err)
+	suite.Equal(5, marker.Version)
+	suite.Equal("01F8QA4K6GHS0M1VW9RJ3HMKH5", marker.LastReadID)
+}
+
+func (suite *MarkerTestSuite) TestUpdateMarkerConflict() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// load the marker into the cache at its current version
+	_, err := suite.db.GetMarker(ctx, testAccount.ID, gtsmodel.MarkerNameNotifications)
+	suite.NoError(err)
+
+	// update the version behind the cache's back,
+	// as if another instance had updated it meanwhile
+	err = suite.db.UpdateWhere(ctx, []db.Where{
+		{Key: "account_id", Value: testAccount.ID},
+		{Key: "name", Value: gtsmodel.MarkerNameNotifications},
+	}, "version", 5, &gtsmodel.Marker{})
+	suite.NoError(err)
+
+	err = suite.db.UpdateMarker(ctx, &gtsmodel.Marker{
+		AccountID:  testAccount.ID,
+		Name:       gtsmodel.MarkerNameNotifications,
+		LastReadID: "01F8QA4K6GHS0M1VW9RJ3HMKH5",
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	// the failed update should not have been cached,
+	// so we get the row as it is in the database
+	marker, err := suite.db.GetMarker(ctx, testAccount.ID, gtsmodel.MarkerNameNotifications)
+	suite.NoError(err)
+	suite.Equal(5, marker.Version)
+	suite.Equal("01F8Q0ANPTWW10DAKTX7BRPBJP", marker.LastReadID)
+
+	// retrying should now work
+	err = suite.db.UpdateMarker(ctx, &gtsmodel.Marker{
+		AccountID:  testAccount.ID,
+		Name:       gtsmodel.MarkerNameNotifications,
+		LastReadID: "01F8QA4K6GHS0M1VW9RJ3HMKH5",
+	})
+	suite.NoError(err)
+
+	marker, err = suite.db.GetMarker(ctx, testAccount.ID, gtsmodel.MarkerNameNotifications)
+	suite.NoError(err)
+	suite.Equal(6, marker.Version)
+}
+
+func (suite *MarkerTestSuite) TestDeleteMarkersByAccountID() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	suite.NoError(suite.db.DeleteMarkersByAccountID(ctx, testAccount.ID))
+
+	for _, name := range []gtsmodel.MarkerName{
+		gtsmodel.MarkerNameHome,
+		gtsmodel.MarkerNameNotifications,
+	} {
+		_, err := suite.db.GetMarker(ctx, testAccount.ID, name)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+}
+
+func TestMarkerTestSuite(t *testing.T) {
+	suite.Run(t, new(MarkerTestSuite))
+}
@@ -125,6 +125,7 @@ func (m *mediaDB) GetLocalUnattachedOlderThan(ctx context.Context, olderThan tim
 		Where("? < ?", bun.Ident("media_attachment.created_at"), olderThan).
 		Where("? IS NULL", bun.Ident("media_attachment.remote_url")).
 		Where("? IS NULL", bun.Ident("media_attachment.status_id")).
+		Where("? IS NULL", bun.Ident("media_attachment.scheduled_status_id")).
 		Order("media_attachment.created_at DESC")
 
 	if limit != 0 {
@@ -148,7 +149,8 @@ func (m *mediaDB) CountLocalUnattachedOlderThan(ctx context.Context, olderThan t
 		Where("? = ?", bun.Ident("media_attachment.header"), false).
 		Where("? < ?", bun.Ident("media_attachment.created_at"), olderThan).
 		Where("? IS NULL", bun.Ident("media_attachment.remote_url")).
-		Where("? IS NULL", bun.Ident("media_attachment.status_id"))
+		Where("? IS NULL", bun.Ident("media_attachment.status_id")).
+		Where("? IS NULL", bun.Ident("media_attachment.scheduled_status_id"))
 
 	count, err := q.Count(ctx)
 	if err != nil {
@@ -0,0 +1,78 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package migrations
+
+import (
+	"context"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Users can now enable two-factor
+			// authentication for password sign-in.
+			var recoveryCodesType string
+			switch tx.Dialect().Name() {
+			case dialect.PG:
+				recoveryCodesType = "VARCHAR[]"
+			case dialect.SQLite:
+				recoveryCodesType = "VARCHAR"
+			default:
+				log.Panic("db dialect was neither pg nor sqlite")
+			}
+
+			for _, column := range []struct {
+				name    string
+				sqlType string
+			}{
+				{"two_factor_secret", "VARCHAR"},
+				{"two_factor_enabled_at", "TIMESTAMPTZ"},
+				{"two_factor_recovery_codes", recoveryCodesType},
+				{"two_factor_last_used_step", "BIGINT"},
+			} {
+				if _, err := tx.
+					NewAddColumn().
+					Model(&gtsmodel.User{}).
+					ColumnExpr("? "+column.sqlType, bun.Ident(column.name)).
+					Exec(ctx); err != nil &&
+					!(strings.Contains(err.Error(), "already exists") || strings.Contains(err.Error(), "duplicate column name") || strings.Contains(err.Error(), "SQLSTATE 42701")) {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
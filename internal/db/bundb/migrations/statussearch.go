@@ -0,0 +1,103 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// StatusSearchDocument is the expression indexed for full-text
+// search of statuses on postgres, with placeholders for the
+// content warning and text columns. It's used both to create
+// the index and to query it, since postgres only uses an
+// expression index when the query repeats the expression.
+const StatusSearchDocument = "to_tsvector('simple', COALESCE(?, '') || ' ' || COALESCE(?, ''))"
+
+// statusSearchIndexSQLite creates the FTS5 table used for full-text search of local
+// statuses on sqlite, along with the triggers which keep it in sync with the statuses
+// table. Boosts are not indexed, as they just repeat the text of the boosted status.
+//
+// Triggers are used rather than bun query hooks because hooks only see the row
+// data of writes made through a status model, and statuses are also written
+// without one: DeleteStatusByID, for example, deletes by TableExpr.
+// Triggers also run in the same transaction as the write, so the index can't get
+// out of step with the statuses table if the process dies in between.
+var statusSearchIndexSQLite = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS "status_fts" USING fts5("status_id" UNINDEXED, "content_warning", "text")`,
+	`CREATE TRIGGER IF NOT EXISTS "statuses_fts_insert" AFTER INSERT ON "statuses"
+	WHEN NEW."local" AND NEW."boost_of_id" IS NULL
+	BEGIN
+		INSERT INTO "status_fts" ("status_id", "content_warning", "text") VALUES (NEW."id", NEW."content_warning", NEW."text");
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS "statuses_fts_update" AFTER UPDATE OF "content_warning", "text", "local", "boost_of_id" ON "statuses"
+	WHEN OLD."local" OR NEW."local"
+	BEGIN
+		DELETE FROM "status_fts" WHERE "status_id" = OLD."id";
+		INSERT INTO "status_fts" ("status_id", "content_warning", "text") SELECT NEW."id", NEW."content_warning", NEW."text" WHERE NEW."local" AND NEW."boost_of_id" IS NULL;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS "statuses_fts_delete" AFTER DELETE ON "statuses"
+	WHEN OLD."local"
+	BEGIN
+		DELETE FROM "status_fts" WHERE "status_id" = OLD."id";
+	END`,
+}
+
+// CreateStatusSearchIndex creates the full-text search index of local
+// statuses if it doesn't exist yet. The statuses table must exist.
+//
+// On postgres this is an index on StatusSearchDocument, while sqlite
+// needs a separate FTS5 table kept up to date with triggers.
+func CreateStatusSearchIndex(ctx context.Context, conn bun.IDB) error {
+	switch conn.Dialect().Name() {
+	case dialect.PG:
+		_, err := conn.ExecContext(ctx,
+			"CREATE INDEX IF NOT EXISTS ? ON ? USING GIN (("+StatusSearchDocument+")) WHERE ?",
+			bun.Ident("statuses_text_search_idx"), bun.Ident("statuses"),
+			bun.Ident("content_warning"), bun.Ident("text"),
+			bun.Ident("local"),
+		)
+		return err
+	case dialect.SQLite:
+		for _, stmt := range statusSearchIndexSQLite {
+			if _, err := conn.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		log.Panic("db dialect was neither pg nor sqlite")
+		return nil
+	}
+}
+
+// DropStatusSearchIndex drops the full-text search index of statuses,
+// if it exists. On postgres the index is dropped along with the statuses
+// table, so this is only necessary for the separate sqlite FTS5 table.
+func DropStatusSearchIndex(ctx context.Context, conn bun.IDB) error {
+	if conn.Dialect().Name() != dialect.SQLite {
+		return nil
+	}
+
+	_, err := conn.ExecContext(ctx, `DROP TABLE IF EXISTS "status_fts"`)
+	return err
+}
@@ -0,0 +1,270 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type pollDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+/*
+	POLL FUNCTIONS
+*/
+
+func (p *pollDB) GetPollByID(ctx context.Context, id string) (*gtsmodel.Poll, db.Error) {
+	// Fetch poll from database cache with loader callback
+	return p.state.Caches.GTS.Poll().Load("ID", func() (*gtsmodel.Poll, error) {
+		var poll gtsmodel.Poll
+
+		// Not cached! Perform database query.
+		if err := p.conn.
+			NewSelect().
+			Model(&poll).
+			Where("? = ?", bun.Ident("poll.id"), id).
+			Scan(ctx); err != nil {
+			return nil, p.conn.ProcessError(err)
+		}
+
+		return &poll, nil
+	}, id)
+}
+
+func (p *pollDB) GetExpiredOpenPolls(ctx context.Context, now time.Time) ([]*gtsmodel.Poll, db.Error) {
+	// Fetch IDs of all open polls that should now be closed.
+	var pollIDs []string
+	if err := p.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("polls"), bun.Ident("poll")).
+		Column("poll.id").
+		Where("? IS NULL", bun.Ident("poll.closed_at")).
+		Where("? IS NOT NULL", bun.Ident("poll.expires_at")).
+		Where("? <= ?", bun.Ident("poll.expires_at"), now).
+		Order("poll.expires_at ASC").
+		Scan(ctx, &pollIDs); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	if len(pollIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each poll using its ID to ensure cache used.
+	polls := make([]*gtsmodel.Poll, 0, len(pollIDs))
+	for _, id := range pollIDs {
+		poll, err := p.GetPollByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetExpiredOpenPolls: error fetching poll %q: %v", id, err)
+			continue
+		}
+
+		// Append poll.
+		polls = append(polls, poll)
+	}
+
+	return polls, nil
+}
+
+func (p *pollDB) PutPoll(ctx context.Context, poll *gtsmodel.Poll) db.Error {
+	return p.state.Caches.GTS.Poll().Store(poll, func() error {
+		_, err := p.conn.NewInsert().Model(poll).Exec(ctx)
+		return p.conn.ProcessError(err)
+	})
+}
+
+func (p *pollDB) UpdatePoll(ctx context.Context, poll *gtsmodel.Poll, columns ...string) db.Error {
+	poll.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := p.conn.
+		NewUpdate().
+		Model(poll).
+		Where("? = ?", bun.Ident("poll.id"), poll.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return p.conn.ProcessError(err)
+	}
+
+	p.state.Caches.GTS.Poll().Invalidate("ID", poll.ID)
+	return nil
+}
+
+func (p *pollDB) DeletePollByID(ctx context.Context, id string) db.Error {
+	// Select all votes cast in this poll, so that
+	// we can invalidate them once the delete is done.
+	var voteIDs []string
+	if err := p.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+		Column("poll_vote.id").
+		Where("? = ?", bun.Ident("poll_vote.poll_id"), id).
+		Scan(ctx, &voteIDs); err != nil {
+		return p.conn.ProcessError(err)
+	}
+
+	if err := p.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// Delete all votes cast in poll.
+		if _, err := tx.NewDelete().
+			TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+			Where("? = ?", bun.Ident("poll_vote.poll_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delete the poll itself.
+		_, err := tx.NewDelete().
+			TableExpr("? AS ?", bun.Ident("polls"), bun.Ident("poll")).
+			Where("? = ?", bun.Ident("poll.id"), id).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return p.conn.ProcessError(err)
+	}
+
+	// Invalidate the poll + votes from cache.
+	p.state.Caches.GTS.Poll().Invalidate("ID", id)
+	for _, voteID := range voteIDs {
+		p.state.Caches.GTS.PollVote().Invalidate("ID", voteID)
+	}
+
+	return nil
+}
+
+/*
+	POLL VOTE FUNCTIONS
+*/
+
+func (p *pollDB) GetPollVoteByID(ctx context.Context, id string) (*gtsmodel.PollVote, db.Error) {
+	return p.getPollVote(
+		ctx,
+		"ID",
+		func(vote *gtsmodel.PollVote) error {
+			return p.conn.
+				NewSelect().
+				Model(vote).
+				Where("? = ?", bun.Ident("poll_vote.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (p *pollDB) GetPollVoteBy(ctx context.Context, pollID string, accountID string) (*gtsmodel.PollVote, db.Error) {
+	return p.getPollVote(
+		ctx,
+		"PollID.AccountID",
+		func(vote *gtsmodel.PollVote) error {
+			return p.conn.
+				NewSelect().
+				Model(vote).
+				Where("? = ?", bun.Ident("poll_vote.poll_id"), pollID).
+				Where("? = ?", bun.Ident("poll_vote.account_id"), accountID).
+				Scan(ctx)
+		},
+		pollID,
+		accountID,
+	)
+}
+
+func (p *pollDB) getPollVote(ctx context.Context, lookup string, dbQuery func(*gtsmodel.PollVote) error, keyParts ...any) (*gtsmodel.PollVote, db.Error) {
+	// Fetch poll vote from database cache with loader callback
+	return p.state.Caches.GTS.PollVote().Load(lookup, func() (*gtsmodel.PollVote, error) {
+		var vote gtsmodel.PollVote
+
+		// Not cached! Perform database query.
+		if err := dbQuery(&vote); err != nil {
+			return nil, p.conn.ProcessError(err)
+		}
+
+		return &vote, nil
+	}, keyParts...)
+}
+
+func (p *pollDB) GetPollVotes(ctx context.Context, pollID string) ([]*gtsmodel.PollVote, db.Error) {
+	// Fetch IDs of all votes cast in this poll.
+	var voteIDs []string
+	if err := p.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+		Column("poll_vote.id").
+		Where("? = ?", bun.Ident("poll_vote.poll_id"), pollID).
+		Order("poll_vote.id ASC").
+		Scan(ctx, &voteIDs); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	if len(voteIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each vote using its ID to ensure cache used.
+	votes := make([]*gtsmodel.PollVote, 0, len(voteIDs))
+	for _, id := range voteIDs {
+		vote, err := p.GetPollVoteByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetPollVotes: error fetching poll vote %q: %v", id, err)
+			continue
+		}
+
+		// Append vote.
+		votes = append(votes, vote)
+	}
+
+	return votes, nil
+}
+
+func (p *pollDB) PutPollVote(ctx context.Context, vote *gtsmodel.PollVote) db.Error {
+	return p.state.Caches.GTS.PollVote().Store(vote, func() error {
+		_, err := p.conn.NewInsert().Model(vote).Exec(ctx)
+		return p.conn.ProcessError(err)
+	})
+}
+
+func (p *pollDB) UpdatePollVote(ctx context.Context, vote *gtsmodel.PollVote, columns ...string) db.Error {
+	vote.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := p.conn.
+		NewUpdate().
+		Model(vote).
+		Where("? = ?", bun.Ident("poll_vote.id"), vote.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return p.conn.ProcessError(err)
+	}
+
+	p.state.Caches.GTS.PollVote().Invalidate("ID", vote.ID)
+	return nil
+}
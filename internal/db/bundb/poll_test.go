@@ -0,0 +1,235 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PollTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *PollTestSuite) TestGetPollByID() {
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	poll, err := suite.db.GetPollByID(context.Background(), testPoll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testPoll.StatusID, poll.StatusID)
+	suite.Equal([]string{"yes", "no", "i'm a turtle too"}, poll.Options)
+	suite.Equal([]int{1, 0, 0}, poll.Votes)
+	suite.Equal(1, poll.Voters)
+	suite.False(*poll.Multiple)
+	suite.True(poll.ExpiresAt.IsZero())
+	suite.False(poll.Closed())
+}
+
+func (suite *PollTestSuite) TestGetStatusWithPoll() {
+	testStatus := suite.testStatuses["local_account_2_status_7"]
+
+	status, err := suite.db.GetStatusByID(context.Background(), testStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotNil(status.Poll)
+	suite.Equal(suite.testPolls["local_account_2_status_7_poll"].ID, status.Poll.ID)
+}
+
+func (suite *PollTestSuite) TestGetPollVotes() {
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+	testVote := suite.testPollVotes["local_account_2_status_7_poll_vote_local_account_1"]
+
+	votes, err := suite.db.GetPollVotes(context.Background(), testPoll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(votes, 1)
+	suite.Equal(testVote.ID, votes[0].ID)
+
+	vote, err := suite.db.GetPollVoteBy(context.Background(), testPoll.ID, testVote.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{0}, vote.Choices)
+
+	_, err = suite.db.GetPollVoteBy(context.Background(), testPoll.ID, suite.testAccounts["admin_account"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *PollTestSuite) TestPutPollVoteAndTally() {
+	ctx := context.Background()
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	if err := suite.db.PutPollVote(ctx, &gtsmodel.PollVote{
+		ID:        "01GWCVJ2XTQ3X5XJ9PGN8CAK6E",
+		PollID:    testPoll.ID,
+		AccountID: suite.testAccounts["admin_account"].ID,
+		Choices:   []int{2},
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	votes, err := suite.db.GetPollVotes(ctx, testPoll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	poll, err := suite.db.GetPollByID(ctx, testPoll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	poll.Tally(votes)
+	if err := suite.db.UpdatePoll(ctx, poll, "votes", "voters"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	poll, err = suite.db.GetPollByID(ctx, testPoll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal([]int{1, 0, 1}, poll.Votes)
+	suite.Equal(2, poll.Voters)
+	suite.Equal(2, poll.TotalVotes())
+}
+
+func (suite *PollTestSuite) TestPutPollVoteTwice() {
+	testVote := suite.testPollVotes["local_account_2_status_7_poll_vote_local_account_1"]
+
+	// a second vote by the same account is a conflict,
+	// even if it didn't go through GetPollVoteBy first
+	err := suite.db.PutPollVote(context.Background(), &gtsmodel.PollVote{
+		ID:        "01GWCVJ2XTQ3X5XJ9PGN8CAK6F",
+		PollID:    testVote.PollID,
+		AccountID: testVote.AccountID,
+		Choices:   []int{1},
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+}
+
+func (suite *PollTestSuite) TestGetExpiredOpenPolls() {
+	ctx := context.Background()
+	now := time.Now()
+
+	// The test poll never expires.
+	polls, err := suite.db.GetExpiredOpenPolls(ctx, now)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(polls)
+
+	poll := &gtsmodel.Poll{}
+	*poll = *suite.testPolls["local_account_2_status_7_poll"]
+	poll.ExpiresAt = now.Add(-1 * time.Minute)
+	if err := suite.db.UpdatePoll(ctx, poll, "expires_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	polls, err = suite.db.GetExpiredOpenPolls(ctx, now)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(polls, 1)
+
+	// Closed polls should no longer be returned.
+	poll.ClosedAt = now
+	if err := suite.db.UpdatePoll(ctx, poll, "closed_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	polls, err = suite.db.GetExpiredOpenPolls(ctx, now)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(polls)
+}
+
+func (suite *PollTestSuite) TestDeletePollByID() {
+	ctx := context.Background()
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+	testVote := suite.testPollVotes["local_account_2_status_7_poll_vote_local_account_1"]
+
+	if err := suite.db.DeletePollByID(ctx, testPoll.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetPollByID(ctx, testPoll.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+
+	_, err = suite.db.GetPollVoteByID(ctx, testVote.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *PollTestSuite) TestPutStatusWithPoll() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	status := &gtsmodel.Status{
+		ID:                  "01GWCW0F6JF6W57QMMDWKXJDBK",
+		URI:                 "http://localhost:8080/users/the_mighty_zork/statuses/01GWCW0F6JF6W57QMMDWKXJDBK",
+		Local:               testrig.TrueBool(),
+		AccountID:           testAccount.ID,
+		AccountURI:          testAccount.URI,
+		Visibility:          gtsmodel.VisibilityPublic,
+		ActivityStreamsType: "Question",
+		Federated:           testrig.TrueBool(),
+		Boostable:           testrig.TrueBool(),
+		Replyable:           testrig.TrueBool(),
+		Likeable:            testrig.TrueBool(),
+		PollID:              "01GWCW1A8Y8N0C8SJW8V1N1W1T",
+		Poll: &gtsmodel.Poll{
+			ID:        "01GWCW1A8Y8N0C8SJW8V1N1W1T",
+			Multiple:  testrig.TrueBool(),
+			Options:   []string{"cats", "dogs"},
+			Votes:     []int{0, 0},
+			ExpiresAt: time.Now().Add(time.Hour),
+		},
+	}
+
+	if err := suite.db.PutStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	poll, err := suite.db.GetPollByID(ctx, status.PollID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(status.ID, poll.StatusID)
+	suite.Equal([]string{"cats", "dogs"}, poll.Options)
+	suite.True(*poll.Multiple)
+}
+
+func TestPollTestSuite(t *testing.T) {
+	suite.Run(t, new(PollTestSuite))
+}
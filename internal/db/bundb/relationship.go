@@ -23,6 +23,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -183,6 +184,170 @@ func (r *relationshipDB) DeleteBlocksByTargetAccountID(ctx context.Context, targ
 	return nil
 }
 
+func (r *relationshipDB) IsMuted(ctx context.Context, account1 string, account2 string) (bool, db.Error) {
+	mute, err := r.GetMute(ctx, account1, account2)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, err
+	}
+
+	return (mute != nil), nil
+}
+
+func (r *relationshipDB) GetMute(ctx context.Context, account1 string, account2 string) (*gtsmodel.UserMute, db.Error) {
+	mute, err := r.getMute(ctx, account1, account2)
+	if err != nil {
+		return nil, err
+	}
+
+	if mute.Expired(time.Now()) {
+		// Expired, but not yet removed by the
+		// periodic cleanup: treat it as gone.
+		return nil, db.ErrNoEntries
+	}
+
+	return mute, nil
+}
+
+// getMute fetches the mute from account1 targeting account2 through the cache,
+// whether or not it has expired; that's checked by the caller, since cached
+// mutes can expire while they're in the cache.
+func (r *relationshipDB) getMute(ctx context.Context, account1 string, account2 string) (*gtsmodel.UserMute, db.Error) {
+	return r.state.Caches.GTS.UserMute().Load("AccountID.TargetAccountID", func() (*gtsmodel.UserMute, error) {
+		var mute gtsmodel.UserMute
+
+		q := r.conn.NewSelect().Model(&mute).
+			Where("? = ?", bun.Ident("user_mute.account_id"), account1).
+			Where("? = ?", bun.Ident("user_mute.target_account_id"), account2)
+		if err := q.Scan(ctx); err != nil {
+			return nil, r.conn.ProcessError(err)
+		}
+
+		return &mute, nil
+	}, account1, account2)
+}
+
+// newMuteQ returns a query selecting into the given model mutes
+// owned by the given accountID, which haven't expired at the given time.
+func (r *relationshipDB) newMuteQ(mute interface{}, accountID string, now time.Time) *bun.SelectQuery {
+	return r.conn.
+		NewSelect().
+		Model(mute).
+		Where("? = ?", bun.Ident("user_mute.account_id"), accountID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				WhereOr("? IS NULL", bun.Ident("user_mute.expires_at")).
+				WhereOr("? > ?", bun.Ident("user_mute.expires_at"), now)
+		})
+}
+
+func (r *relationshipDB) PutMute(ctx context.Context, mute *gtsmodel.UserMute) db.Error {
+	// Drop any existing mute from account to target, since
+	// it's replaced by this one (possibly under another ID).
+	r.state.Caches.GTS.UserMute().Invalidate("AccountID.TargetAccountID", mute.AccountID, mute.TargetAccountID)
+
+	return r.state.Caches.GTS.UserMute().Store(mute, func() error {
+		// if a mute already exists from account to target, it's replaced by the new one
+		_, err := r.conn.
+			NewInsert().
+			Model(mute).
+			On("CONFLICT (?, ?) DO UPDATE", bun.Ident("account_id"), bun.Ident("target_account_id")).
+			Set("? = EXCLUDED.?", bun.Ident("id"), bun.Ident("id")).
+			Set("? = EXCLUDED.?", bun.Ident("created_at"), bun.Ident("created_at")).
+			Set("? = EXCLUDED.?", bun.Ident("updated_at"), bun.Ident("updated_at")).
+			Set("? = EXCLUDED.?", bun.Ident("expires_at"), bun.Ident("expires_at")).
+			Set("? = EXCLUDED.?", bun.Ident("notifications"), bun.Ident("notifications")).
+			Exec(ctx)
+		return r.conn.ProcessError(err)
+	})
+}
+
+func (r *relationshipDB) DeleteMuteByID(ctx context.Context, id string) db.Error {
+	if _, err := r.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("user_mutes"), bun.Ident("user_mute")).
+		Where("? = ?", bun.Ident("user_mute.id"), id).
+		Exec(ctx); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	// Drop any old value from cache by this ID
+	r.state.Caches.GTS.UserMute().Invalidate("ID", id)
+	return nil
+}
+
+func (r *relationshipDB) DeleteMutesByOriginAccountID(ctx context.Context, originAccountID string) db.Error {
+	return r.deleteMutesWhere(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("? = ?", bun.Ident("user_mute.account_id"), originAccountID)
+	})
+}
+
+func (r *relationshipDB) DeleteMutesByTargetAccountID(ctx context.Context, targetAccountID string) db.Error {
+	return r.deleteMutesWhere(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("? = ?", bun.Ident("user_mute.target_account_id"), targetAccountID)
+	})
+}
+
+func (r *relationshipDB) DeleteExpiredMutes(ctx context.Context, now time.Time) db.Error {
+	return r.deleteMutesWhere(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? IS NOT NULL", bun.Ident("user_mute.expires_at")).
+			Where("? <= ?", bun.Ident("user_mute.expires_at"), now)
+	})
+}
+
+// deleteMutesWhere deletes each mute selected by the given
+// where function, so that they're dropped from the cache too.
+func (r *relationshipDB) deleteMutesWhere(ctx context.Context, where func(*bun.SelectQuery) *bun.SelectQuery) db.Error {
+	muteIDs := []string{}
+
+	q := r.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("user_mutes"), bun.Ident("user_mute")).
+		Column("user_mute.id")
+
+	if err := where(q).Scan(ctx, &muteIDs); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	for _, muteID := range muteIDs {
+		if err := r.DeleteMuteByID(ctx, muteID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *relationshipDB) GetAccountMutes(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.UserMute, db.Error) {
+	mutes := []*gtsmodel.UserMute{}
+
+	q := r.newMuteQ(&mutes, accountID, time.Now()).
+		Relation("TargetAccount").
+		Order("user_mute.id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("user_mute.id"), maxID)
+	}
+
+	if sinceID != "" {
+		q = q.Where("? > ?", bun.Ident("user_mute.id"), sinceID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, r.conn.ProcessError(err)
+	}
+
+	if len(mutes) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return mutes, nil
+}
+
 func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount string, targetAccount string) (*gtsmodel.Relationship, db.Error) {
 	rel := &gtsmodel.Relationship{
 		ID: targetAccount,
@@ -252,6 +417,16 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 	}
 	rel.BlockedBy = (blockT2A != nil)
 
+	// check if the requesting account is muting the target account
+	mute, err := r.GetMute(ctx, requestingAccount, targetAccount)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("GetRelationship: error checking muting: %s", err)
+	}
+	if mute != nil {
+		rel.Muting = true
+		rel.MutingNotifications = *mute.Notifications
+	}
+
 	return rel, nil
 }
 
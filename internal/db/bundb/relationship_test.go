@@ -21,10 +21,12 @@ package bundb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type RelationshipTestSuite struct {
@@ -242,6 +244,155 @@ func (suite *RelationshipTestSuite) TestGetRelationship() {
 	suite.Empty(relationship.Note)
 }
 
+func (suite *RelationshipTestSuite) TestGetRelationshipMuting() {
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["remote_account_3"]
+
+	relationship, err := suite.db.GetRelationship(context.Background(), requestingAccount.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.True(relationship.Muting)
+	suite.True(relationship.MutingNotifications)
+
+	// the admin's mute of local_account_2 has expired
+	relationship, err = suite.db.GetRelationship(context.Background(), suite.testAccounts["admin_account"].ID, suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	suite.False(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+}
+
+func (suite *RelationshipTestSuite) TestIsMuted() {
+	ctx := context.Background()
+
+	muted, err := suite.db.IsMuted(ctx, suite.testAccounts["local_account_1"].ID, suite.testAccounts["remote_account_3"].ID)
+	suite.NoError(err)
+	suite.True(muted)
+
+	// mutes aren't bidirectional
+	muted, err = suite.db.IsMuted(ctx, suite.testAccounts["remote_account_3"].ID, suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	suite.False(muted)
+
+	// expired mutes don't count
+	muted, err = suite.db.IsMuted(ctx, suite.testAccounts["admin_account"].ID, suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	suite.False(muted)
+
+	_, err = suite.db.GetMute(ctx, suite.testAccounts["admin_account"].ID, suite.testAccounts["local_account_2"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *RelationshipTestSuite) TestPutMuteReplacesExisting() {
+	ctx := context.Background()
+	account := suite.testAccounts["admin_account"]
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	mute := &gtsmodel.UserMute{
+		ID:              "01H3E0Q6G6W7K4X9TSPBY1C2ZD",
+		ExpiresAt:       time.Now().Add(time.Hour),
+		AccountID:       account.ID,
+		TargetAccountID: targetAccount.ID,
+		Notifications:   testrig.TrueBool(),
+	}
+	suite.NoError(suite.db.PutMute(ctx, mute))
+
+	dbMute, err := suite.db.GetMute(ctx, account.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.Equal(mute.ID, dbMute.ID)
+	suite.True(*dbMute.Notifications)
+	suite.WithinDuration(mute.ExpiresAt, dbMute.ExpiresAt, time.Second)
+
+	// the old, expired mute should be gone
+	oldMute := &gtsmodel.UserMute{}
+	err = suite.db.GetByID(ctx, suite.testUserMutes["admin_account_mute_local_account_2_expired"].ID, oldMute)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *RelationshipTestSuite) TestDeleteExpiredMutes() {
+	ctx := context.Background()
+
+	suite.NoError(suite.db.DeleteExpiredMutes(ctx, time.Now()))
+
+	mute := &gtsmodel.UserMute{}
+	err := suite.db.GetByID(ctx, suite.testUserMutes["admin_account_mute_local_account_2_expired"].ID, mute)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// mutes without expiry should be left alone
+	err = suite.db.GetByID(ctx, suite.testUserMutes["local_account_1_mute_remote_account_3"].ID, mute)
+	suite.NoError(err)
+}
+
+func (suite *RelationshipTestSuite) TestGetAccountMutes() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	mutes, err := suite.db.GetAccountMutes(ctx, account.ID, "", "", 0)
+	suite.NoError(err)
+	suite.Len(mutes, 1)
+	suite.Equal(suite.testAccounts["remote_account_3"].ID, mutes[0].TargetAccount.ID)
+
+	_, err = suite.db.GetAccountMutes(ctx, account.ID, mutes[0].ID, "", 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// expired mutes aren't returned
+	_, err = suite.db.GetAccountMutes(ctx, suite.testAccounts["admin_account"].ID, "", "", 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *RelationshipTestSuite) TestDeleteMutesByTargetAccountID() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["remote_account_3"]
+
+	suite.NoError(suite.db.DeleteMutesByTargetAccountID(ctx, targetAccount.ID))
+
+	muted, err := suite.db.IsMuted(ctx, account.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.False(muted)
+}
+
+func (suite *RelationshipTestSuite) TestMuteCacheInvalidation() {
+	ctx := context.Background()
+	account := suite.testAccounts["remote_account_3"]
+	targetAccount := suite.testAccounts["local_account_1"]
+
+	// cache the fact there's no mute
+	muted, err := suite.db.IsMuted(ctx, account.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.False(muted)
+
+	mute := &gtsmodel.UserMute{
+		ID:              "01H3E4M3WQ6XKQ2YVX4ZT1RJ8B",
+		AccountID:       account.ID,
+		TargetAccountID: targetAccount.ID,
+		Notifications:   testrig.FalseBool(),
+	}
+	suite.NoError(suite.db.PutMute(ctx, mute))
+
+	muted, err = suite.db.IsMuted(ctx, account.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.True(muted)
+
+	suite.NoError(suite.db.DeleteMuteByID(ctx, mute.ID))
+
+	muted, err = suite.db.IsMuted(ctx, account.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.False(muted)
+
+	// a cached mute is dropped when it's removed along with other expired mutes
+	mute.ExpiresAt = time.Now().Add(time.Hour)
+	suite.NoError(suite.db.PutMute(ctx, mute))
+
+	muted, err = suite.db.IsMuted(ctx, account.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.True(muted)
+
+	suite.NoError(suite.db.DeleteExpiredMutes(ctx, time.Now().Add(2*time.Hour)))
+
+	muted, err = suite.db.IsMuted(ctx, account.ID, targetAccount.ID)
+	suite.NoError(err)
+	suite.False(muted)
+}
+
 func (suite *RelationshipTestSuite) TestIsFollowingYes() {
 	requestingAccount := suite.testAccounts["local_account_1"]
 	targetAccount := suite.testAccounts["admin_account"]
@@ -0,0 +1,124 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// ftsQuery converts a user-provided search query into an FTS5 query
+// which matches rows containing all of the given terms, by quoting each
+// term so that any FTS5 query syntax within it is treated literally.
+func ftsQuery(query string) string {
+	terms := strings.Fields(query)
+	for i, term := range terms {
+		terms[i] = `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+	}
+	return strings.Join(terms, " ")
+}
+
+type searchDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+func (s *searchDB) SearchStatuses(ctx context.Context, requestingAccountID string, query string, accountID string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, db.Error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, db.ErrNoEntries
+	}
+
+	q := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("status.account_id"), bun.Ident("account.id")).
+		Where("? = ?", bun.Ident("status.local"), true).
+		WhereGroup(" AND ", whereEmptyOrNull("status.boost_of_id")).
+		Order("status.id DESC")
+
+	switch s.conn.Dialect().Name() {
+	case dialect.PG:
+		q = q.Where(migrations.StatusSearchDocument+" @@ plainto_tsquery('simple', ?)", bun.Ident("status.content_warning"), bun.Ident("status.text"), query)
+	case dialect.SQLite:
+		q = q.Where("? IN (SELECT ? FROM ? WHERE ? MATCH ?)", bun.Ident("status.id"), bun.Ident("status_id"), bun.Ident("status_fts"), bun.Ident("status_fts"), ftsQuery(query))
+	default:
+		log.Panic("db dialect was neither pg nor sqlite")
+	}
+
+	// Only include statuses of accounts that opted
+	// in to being discoverable, or the requester's own.
+	q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			WhereOr("? = ?", bun.Ident("status.account_id"), requestingAccountID).
+			WhereOr("? = ?", bun.Ident("account.discoverable"), true)
+	})
+
+	if accountID != "" {
+		q = q.Where("? = ?", bun.Ident("status.account_id"), accountID)
+	}
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	var statusIDs []string
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	if len(statusIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each status using its ID to ensure cache used.
+	statuses := make([]*gtsmodel.Status, 0, len(statusIDs))
+	for _, id := range statusIDs {
+		status, err := s.state.DB.GetStatusByID(ctx, id)
+		if err != nil {
+			log.Errorf("SearchStatuses: error fetching status %q: %v", id, err)
+			continue
+		}
+
+		// Append status.
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
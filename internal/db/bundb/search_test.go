@@ -0,0 +1,173 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type SearchTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func statusIDs(statuses []*gtsmodel.Status) []string {
+	ids := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		ids = append(ids, status.ID)
+	}
+	return ids
+}
+
+func (suite *SearchTestSuite) TestSearchStatuses() {
+	requester := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchStatuses(context.Background(), requester.ID, "hello", "", "", "", 10, 0)
+	suite.NoError(err)
+
+	// The admin's boost of zork's status isn't indexed.
+	suite.Equal([]string{
+		suite.testStatuses["local_account_1_status_1"].ID,
+		suite.testStatuses["admin_account_status_1"].ID,
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesDiscoverable() {
+	// local_account_2 isn't discoverable, so only their own
+	// search should turn up their statuses.
+	for accountKey, expected := range map[string][]string{
+		"local_account_1": {
+			suite.testStatuses["admin_account_status_3"].ID,
+			suite.testStatuses["local_account_1_status_5"].ID,
+		},
+		"local_account_2": {
+			suite.testStatuses["local_account_2_status_7"].ID,
+			suite.testStatuses["local_account_2_status_6"].ID,
+			suite.testStatuses["admin_account_status_3"].ID,
+			suite.testStatuses["local_account_1_status_5"].ID,
+			suite.testStatuses["local_account_2_status_5"].ID,
+			suite.testStatuses["local_account_2_status_1"].ID,
+		},
+	} {
+		requester := suite.testAccounts[accountKey]
+
+		statuses, err := suite.db.SearchStatuses(context.Background(), requester.ID, "hi", "", "", "", 10, 0)
+		suite.NoError(err)
+		suite.Equal(expected, statusIDs(statuses), accountKey)
+	}
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesPaging() {
+	requester := suite.testAccounts["local_account_2"]
+
+	statuses, err := suite.db.SearchStatuses(context.Background(), requester.ID, "hi", "", "", "", 2, 0)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["local_account_2_status_7"].ID,
+		suite.testStatuses["local_account_2_status_6"].ID,
+	}, statusIDs(statuses))
+
+	statuses, err = suite.db.SearchStatuses(context.Background(), requester.ID, "hi", "", statuses[1].ID, "", 2, 0)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["admin_account_status_3"].ID,
+		suite.testStatuses["local_account_1_status_5"].ID,
+	}, statusIDs(statuses))
+
+	statuses, err = suite.db.SearchStatuses(context.Background(), requester.ID, "hi", "", "", suite.testStatuses["local_account_1_status_5"].ID, 10, 0)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["local_account_2_status_7"].ID,
+		suite.testStatuses["local_account_2_status_6"].ID,
+		suite.testStatuses["admin_account_status_3"].ID,
+	}, statusIDs(statuses))
+
+	statuses, err = suite.db.SearchStatuses(context.Background(), requester.ID, "hi", "", "", "", 2, 4)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["local_account_2_status_5"].ID,
+		suite.testStatuses["local_account_2_status_1"].ID,
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesByAccount() {
+	requester := suite.testAccounts["local_account_2"]
+	author := suite.testAccounts["admin_account"]
+
+	statuses, err := suite.db.SearchStatuses(context.Background(), requester.ID, "hi", author.ID, "", "", 10, 0)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["admin_account_status_3"].ID,
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesMatchesAllTerms() {
+	requester := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchStatuses(context.Background(), requester.ID, "HELLO World", "", "", "", 10, 0)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["admin_account_status_1"].ID,
+	}, statusIDs(statuses))
+
+	// Search syntax should be treated literally.
+	statuses, err = suite.db.SearchStatuses(context.Background(), requester.ID, `hello" OR "puppies`, "", "", "", 10, 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesContentWarning() {
+	requester := suite.testAccounts["local_account_1"]
+
+	statuses, err := suite.db.SearchStatuses(context.Background(), requester.ID, "puppies", "", "", "", 10, 0)
+	suite.NoError(err)
+	suite.Equal([]string{
+		suite.testStatuses["admin_account_status_2"].ID,
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchStatusesUpdateDelete() {
+	ctx := context.Background()
+	requester := suite.testAccounts["local_account_1"]
+
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.Text = "goodbye everyone!"
+	suite.NoError(suite.db.UpdateStatus(ctx, status))
+
+	_, err := suite.db.SearchStatuses(ctx, requester.ID, "hello everyone", "", "", "", 10, 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	statuses, err := suite.db.SearchStatuses(ctx, requester.ID, "goodbye", "", "", "", 10, 0)
+	suite.NoError(err)
+	suite.Equal([]string{status.ID}, statusIDs(statuses))
+
+	suite.NoError(suite.db.DeleteStatusByID(ctx, status.ID))
+
+	_, err = suite.db.SearchStatuses(ctx, requester.ID, "goodbye", "", "", "", 10, 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
@@ -168,6 +168,22 @@ func (s *statusDB) getStatus(ctx context.Context, lookup string, dbQuery func(*g
 		}
 	}
 
+	if len(status.Attachments) != len(status.AttachmentIDs) {
+		// Attachments removed from the status by an edit are kept
+		// for the status's edit history, so drop any that aren't
+		// attached to the current revision of the status anymore.
+		attachments := make([]*gtsmodel.MediaAttachment, 0, len(status.AttachmentIDs))
+		for _, attachment := range status.Attachments {
+			for _, id := range status.AttachmentIDs {
+				if attachment.ID == id {
+					attachments = append(attachments, attachment)
+					break
+				}
+			}
+		}
+		status.Attachments = attachments
+	}
+
 	if len(status.EmojiIDs) > 0 {
 		// Fetch status emojis
 		status.Emojis, err = s.state.DB.GetEmojisByIDs(ctx, status.EmojiIDs)
@@ -184,6 +200,14 @@ func (s *statusDB) getStatus(ctx context.Context, lookup string, dbQuery func(*g
 		}
 	}
 
+	if id := status.PollID; id != "" {
+		// Fetch status poll
+		status.Poll, err = s.state.DB.GetPollByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting status poll: %w", err)
+		}
+	}
+
 	return status, nil
 }
 
@@ -239,6 +263,17 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) db.Er
 				}
 			}
 
+			// insert the poll attached to this status, if any
+			if status.Poll != nil {
+				status.Poll.StatusID = status.ID
+				if _, err := tx.
+					NewInsert().
+					Model(status.Poll).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
 			// Finally, insert the status
 			_, err := tx.NewInsert().Model(status).Exec(ctx)
 			return err
@@ -263,6 +298,18 @@ func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status) db
 			}
 		}
 
+		// remove links between this status and any tags it no longer uses
+		deleteQ := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+			Where("? = ?", bun.Ident("status_to_tag.status_id"), status.ID)
+		if len(status.TagIDs) > 0 {
+			deleteQ = deleteQ.Where("? NOT IN (?)", bun.Ident("status_to_tag.tag_id"), bun.In(status.TagIDs))
+		}
+		if _, err := deleteQ.Exec(ctx); err != nil {
+			return err
+		}
+
 		// create links between this status and any tags it uses
 		for _, i := range status.TagIDs {
 			if _, err := tx.
@@ -490,16 +537,106 @@ func (s *statusDB) IsStatusRebloggedBy(ctx context.Context, status *gtsmodel.Sta
 	return s.conn.Exists(ctx, q)
 }
 
+// maxThreadDepth is the furthest we'll walk up a thread of replies,
+// which also stops us going round forever if the replies form a loop.
+const maxThreadDepth = 1000
+
+func (s *statusDB) GetStatusThreadRoot(ctx context.Context, status *gtsmodel.Status) (*gtsmodel.Status, db.Error) {
+	root := status
+	seen := map[string]struct{}{status.ID: {}}
+
+	for depth := 0; root.InReplyToID != "" && depth < maxThreadDepth; depth++ {
+		if _, ok := seen[root.InReplyToID]; ok {
+			log.Warnf("GetStatusThreadRoot: reply cycle found at status %s", root.InReplyToID)
+			break
+		}
+		seen[root.InReplyToID] = struct{}{}
+
+		parent, err := s.GetStatusByID(ctx, root.InReplyToID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// we don't have the rest of the thread,
+				// so this is as far up as we can go
+				break
+			}
+			return nil, err
+		}
+
+		root = parent
+	}
+
+	return root, nil
+}
+
+// statusThreadIDs returns the ID of the given status, plus the IDs of all of its
+// ancestors. Since a thread mute is created on whatever was the thread root at the
+// time, and more of the thread may have been fetched (or the root deleted) since then,
+// mutes are checked against every status up the thread rather than just the current root.
+//
+// The walk stops at maxThreadDepth ancestors, or if a status is seen twice.
+func (s *statusDB) statusThreadIDs(ctx context.Context, status *gtsmodel.Status) ([]string, db.Error) {
+	ids := []string{status.ID}
+	seen := map[string]struct{}{status.ID: {}}
+
+	for id := status.InReplyToID; id != "" && len(ids) <= maxThreadDepth; {
+		if _, ok := seen[id]; ok {
+			log.Warnf("statusThreadIDs: reply cycle found at status %s", id)
+			break
+		}
+		seen[id] = struct{}{}
+
+		// include the parent ID even if we don't
+		// have the parent, since it may have been
+		// muted before it was deleted
+		ids = append(ids, id)
+
+		parent, err := s.GetStatusByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				break
+			}
+			return nil, err
+		}
+
+		id = parent.InReplyToID
+	}
+
+	return ids, nil
+}
+
 func (s *statusDB) IsStatusMutedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, db.Error) {
+	threadIDs, err := s.statusThreadIDs(ctx, status)
+	if err != nil {
+		return false, err
+	}
+
 	q := s.conn.
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("status_mutes"), bun.Ident("status_mute")).
-		Where("? = ?", bun.Ident("status_mute.status_id"), status.ID).
+		Where("? IN (?)", bun.Ident("status_mute.status_id"), bun.In(threadIDs)).
 		Where("? = ?", bun.Ident("status_mute.account_id"), accountID)
 
 	return s.conn.Exists(ctx, q)
 }
 
+func (s *statusDB) DeleteStatusThreadMutes(ctx context.Context, status *gtsmodel.Status, accountID string) db.Error {
+	threadIDs, err := s.statusThreadIDs(ctx, status)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_mutes"), bun.Ident("status_mute")).
+		Where("? IN (?)", bun.Ident("status_mute.status_id"), bun.In(threadIDs)).
+		Where("? = ?", bun.Ident("status_mute.account_id"), accountID).
+		Exec(ctx); err != nil {
+		return s.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
 func (s *statusDB) IsStatusBookmarkedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, db.Error) {
 	q := s.conn.
 		NewSelect().
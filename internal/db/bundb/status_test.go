@@ -26,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type StatusTestSuite struct {
@@ -184,6 +185,80 @@ func (suite *StatusTestSuite) TestDeleteStatus() {
 	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
+func (suite *StatusTestSuite) TestGetStatusThreadRoot() {
+	ctx := context.Background()
+	rootStatus := suite.testStatuses["local_account_1_status_1"]
+
+	root, err := suite.db.GetStatusThreadRoot(ctx, suite.testStatuses["admin_account_status_3"])
+	suite.NoError(err)
+	suite.Equal(rootStatus.ID, root.ID)
+
+	// a status that isn't a reply is its own root
+	root, err = suite.db.GetStatusThreadRoot(ctx, rootStatus)
+	suite.NoError(err)
+	suite.Equal(rootStatus.ID, root.ID)
+}
+
+func (suite *StatusTestSuite) TestIsStatusMutedByThread() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	rootStatus := suite.testStatuses["local_account_1_status_1"]
+	replyStatus := suite.testStatuses["admin_account_status_3"]
+
+	muted, err := suite.db.IsStatusMutedBy(ctx, replyStatus, account.ID)
+	suite.NoError(err)
+	suite.False(muted)
+
+	if err := suite.db.Put(ctx, &gtsmodel.StatusMute{
+		ID:              "01H3F6W1N9T4S2BZ3KXQ8R5V7C",
+		AccountID:       account.ID,
+		TargetAccountID: rootStatus.AccountID,
+		StatusID:        rootStatus.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// replies in the thread are muted too
+	for _, status := range []*gtsmodel.Status{rootStatus, replyStatus, suite.testStatuses["local_account_2_status_5"]} {
+		muted, err := suite.db.IsStatusMutedBy(ctx, status, account.ID)
+		suite.NoError(err)
+		suite.True(muted)
+	}
+
+	// but not for anyone else
+	muted, err = suite.db.IsStatusMutedBy(ctx, replyStatus, suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	suite.False(muted)
+
+	// unmuting from a reply removes the mute on the root
+	suite.NoError(suite.db.DeleteStatusThreadMutes(ctx, replyStatus, account.ID))
+	muted, err = suite.db.IsStatusMutedBy(ctx, rootStatus, account.ID)
+	suite.NoError(err)
+	suite.False(muted)
+}
+
+func (suite *StatusTestSuite) TestStatusThreadCycle() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	replyStatus := suite.testStatuses["admin_account_status_3"]
+
+	// make the root of the thread a reply to its own reply
+	rootStatus := &gtsmodel.Status{}
+	*rootStatus = *suite.testStatuses["local_account_1_status_1"]
+	rootStatus.InReplyToID = replyStatus.ID
+	rootStatus.InReplyToAccountID = replyStatus.AccountID
+	suite.NoError(suite.db.UpdateStatus(ctx, rootStatus))
+
+	// walking up the thread should still finish
+	root, err := suite.db.GetStatusThreadRoot(ctx, replyStatus)
+	suite.NoError(err)
+	suite.Equal(rootStatus.ID, root.ID)
+
+	muted, err := suite.db.IsStatusMutedBy(ctx, replyStatus, account.ID)
+	suite.NoError(err)
+	suite.False(muted)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
@@ -0,0 +1,63 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type statusEditDB struct {
+	conn *DBConn
+}
+
+func (s *statusEditDB) GetStatusEdits(ctx context.Context, statusID string) ([]*gtsmodel.StatusEdit, db.Error) {
+	edits := []*gtsmodel.StatusEdit{}
+
+	if err := s.conn.
+		NewSelect().
+		Model(&edits).
+		Where("? = ?", bun.Ident("status_edit.status_id"), statusID).
+		Order("status_edit.created_at ASC").
+		Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	return edits, nil
+}
+
+func (s *statusEditDB) PutStatusEdit(ctx context.Context, edit *gtsmodel.StatusEdit) db.Error {
+	_, err := s.conn.
+		NewInsert().
+		Model(edit).
+		Exec(ctx)
+	return s.conn.ProcessError(err)
+}
+
+func (s *statusEditDB) DeleteStatusEdits(ctx context.Context, statusID string) db.Error {
+	_, err := s.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_edits"), bun.Ident("status_edit")).
+		Where("? = ?", bun.Ident("status_edit.status_id"), statusID).
+		Exec(ctx)
+	return s.conn.ProcessError(err)
+}
@@ -0,0 +1,94 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatusEditTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) TestPutGetDeleteStatusEdits() {
+	testStatus := suite.testStatuses["local_account_1_status_1"]
+	sensitive := false
+
+	// put two revisions of the status,
+	// newer one first to check ordering
+	for _, edit := range []*gtsmodel.StatusEdit{
+		{
+			ID:        "01GTZ6Y6VAG6D4QY3EZ1JKPXA7",
+			CreatedAt: testStatus.CreatedAt.Add(1 * time.Hour),
+			StatusID:  testStatus.ID,
+			Content:   "<p>second revision</p>",
+			Text:      "second revision",
+			Language:  "en",
+			Sensitive: &sensitive,
+		},
+		{
+			ID:        "01GTZ6XVM8PRVQNBXV8B5Q4D4A",
+			CreatedAt: testStatus.CreatedAt,
+			StatusID:  testStatus.ID,
+			Content:   "<p>first revision</p>",
+			Text:      "first revision",
+			Language:  "en",
+			Sensitive: &sensitive,
+		},
+	} {
+		if err := suite.db.PutStatusEdit(context.Background(), edit); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	edits, err := suite.db.GetStatusEdits(context.Background(), testStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(edits, 2)
+	suite.Equal("first revision", edits[0].Text)
+	suite.Equal("second revision", edits[1].Text)
+
+	if err := suite.db.DeleteStatusEdits(context.Background(), testStatus.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	edits, err = suite.db.GetStatusEdits(context.Background(), testStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(edits)
+}
+
+func (suite *StatusEditTestSuite) TestGetStatusEditsNone() {
+	edits, err := suite.db.GetStatusEdits(context.Background(), suite.testStatuses["local_account_1_status_2"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(edits)
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
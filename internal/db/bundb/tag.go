@@ -0,0 +1,359 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type tagDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+func (t *tagDB) GetTag(ctx context.Context, id string) (*gtsmodel.Tag, db.Error) {
+	// Fetch tag from database cache with loader callback
+	return t.state.Caches.GTS.Tag().Load("ID", func() (*gtsmodel.Tag, error) {
+		var tag gtsmodel.Tag
+
+		// Not cached! Perform database query.
+		if err := t.conn.
+			NewSelect().
+			Model(&tag).
+			Where("? = ?", bun.Ident("tag.id"), id).
+			Scan(ctx); err != nil {
+			return nil, t.conn.ProcessError(err)
+		}
+
+		return &tag, nil
+	}, id)
+}
+
+func (t *tagDB) GetTagByName(ctx context.Context, name string) (*gtsmodel.Tag, db.Error) {
+	// Tags keep the case they were first seen
+	// with, but should be matched regardless
+	// of case, so we can't look them up in the
+	// cache by name; instead, select the tag ID.
+	var tagID string
+	if err := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("tags"), bun.Ident("tag")).
+		Column("tag.id").
+		Where("LOWER(?) = LOWER(?)", bun.Ident("tag.name"), name).
+		Scan(ctx, &tagID); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	return t.GetTag(ctx, tagID)
+}
+
+func (t *tagDB) PutTag(ctx context.Context, tag *gtsmodel.Tag) db.Error {
+	return t.state.Caches.GTS.Tag().Store(tag, func() error {
+		_, err := t.conn.NewInsert().Model(tag).Exec(ctx)
+		return t.conn.ProcessError(err)
+	})
+}
+
+func (t *tagDB) UpdateTag(ctx context.Context, tag *gtsmodel.Tag, columns ...string) db.Error {
+	tag.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := t.conn.
+		NewUpdate().
+		Model(tag).
+		Where("? = ?", bun.Ident("tag.id"), tag.ID).
+		Column(columns...).
+		Exec(ctx); err != nil {
+		return t.conn.ProcessError(err)
+	}
+
+	t.state.Caches.GTS.Tag().Invalidate("ID", tag.ID)
+	return nil
+}
+
+func (t *tagDB) SearchTags(ctx context.Context, prefix string, limit int) ([]*gtsmodel.Tag, db.Error) {
+	// Escape any LIKE wildcards in the prefix;
+	// underscores are valid hashtag characters.
+	prefix = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(prefix))
+
+	q := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("tags"), bun.Ident("tag")).
+		Column("tag.id").
+		Where("LOWER(?) LIKE ? ESCAPE '\\'", bun.Ident("tag.name"), prefix+"%").
+		Where("? = ?", bun.Ident("tag.listable"), true).
+		Order("tag.last_status_at DESC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	var tagIDs []string
+	if err := q.Scan(ctx, &tagIDs); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	if len(tagIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each tag using its ID to ensure cache used.
+	tags := make([]*gtsmodel.Tag, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		tag, err := t.GetTag(ctx, id)
+		if err != nil {
+			log.Errorf("SearchTags: error fetching tag %q: %v", id, err)
+			continue
+		}
+
+		// Append tag.
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+func (t *tagDB) GetFollowedTag(ctx context.Context, accountID string, tagID string) (*gtsmodel.FollowedTag, db.Error) {
+	var followedTag gtsmodel.FollowedTag
+
+	if err := t.conn.
+		NewSelect().
+		Model(&followedTag).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Where("? = ?", bun.Ident("followed_tag.tag_id"), tagID).
+		Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	tag, err := t.GetTag(ctx, followedTag.TagID)
+	if err != nil {
+		return nil, err
+	}
+	followedTag.Tag = tag
+
+	return &followedTag, nil
+}
+
+func (t *tagDB) GetFollowedTags(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.FollowedTag, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	followedTags := make([]*gtsmodel.FollowedTag, 0, limit)
+
+	q := t.conn.
+		NewSelect().
+		Model(&followedTags).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		// Sort by highest ID (newest) to lowest ID (oldest)
+		Order("followed_tag.id DESC")
+
+	if maxID != "" {
+		// return only entries LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("followed_tag.id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only entries HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("followed_tag.id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only entries HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("followed_tag.id"), minID)
+	}
+
+	if limit > 0 {
+		// limit amount of entries returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	// Populate each entry's tag using the cache.
+	populated := make([]*gtsmodel.FollowedTag, 0, len(followedTags))
+	for _, followedTag := range followedTags {
+		tag, err := t.GetTag(ctx, followedTag.TagID)
+		if err != nil {
+			log.Errorf("GetFollowedTags: error fetching tag %q: %v", followedTag.TagID, err)
+			continue
+		}
+		followedTag.Tag = tag
+
+		populated = append(populated, followedTag)
+	}
+
+	return populated, nil
+}
+
+func (t *tagDB) PutFollowedTag(ctx context.Context, followedTag *gtsmodel.FollowedTag) db.Error {
+	_, err := t.conn.NewInsert().Model(followedTag).Exec(ctx)
+	return t.conn.ProcessError(err)
+}
+
+func (t *tagDB) DeleteFollowedTag(ctx context.Context, accountID string, tagID string) db.Error {
+	_, err := t.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Where("? = ?", bun.Ident("followed_tag.tag_id"), tagID).
+		Exec(ctx)
+	return t.conn.ProcessError(err)
+}
+
+func (t *tagDB) GetTagFollowerIDs(ctx context.Context, tagIDs []string) ([]string, db.Error) {
+	accountIDs := []string{}
+
+	if len(tagIDs) == 0 {
+		return accountIDs, nil
+	}
+
+	if err := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		ColumnExpr("DISTINCT ?", bun.Ident("followed_tag.account_id")).
+		Where("? IN (?)", bun.Ident("followed_tag.tag_id"), bun.In(tagIDs)).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	return accountIDs, nil
+}
+
+func (t *tagDB) GetFeaturedTag(ctx context.Context, id string) (*gtsmodel.FeaturedTag, db.Error) {
+	var featuredTag gtsmodel.FeaturedTag
+
+	if err := t.conn.
+		NewSelect().
+		Model(&featuredTag).
+		Where("? = ?", bun.Ident("featured_tag.id"), id).
+		Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	if err := t.populateFeaturedTag(ctx, &featuredTag); err != nil {
+		return nil, err
+	}
+
+	return &featuredTag, nil
+}
+
+func (t *tagDB) GetAccountFeaturedTags(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, db.Error) {
+	featuredTags := []*gtsmodel.FeaturedTag{}
+
+	if err := t.conn.
+		NewSelect().
+		Model(&featuredTags).
+		Where("? = ?", bun.Ident("featured_tag.account_id"), accountID).
+		// Sort by lowest ID (oldest) to highest ID (newest)
+		Order("featured_tag.id ASC").
+		Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	populated := make([]*gtsmodel.FeaturedTag, 0, len(featuredTags))
+	for _, featuredTag := range featuredTags {
+		if err := t.populateFeaturedTag(ctx, featuredTag); err != nil {
+			log.Errorf("GetAccountFeaturedTags: error populating featured tag %q: %v", featuredTag.ID, err)
+			continue
+		}
+
+		populated = append(populated, featuredTag)
+	}
+
+	return populated, nil
+}
+
+// populateFeaturedTag sets the tag of the given featured tag entry, and counts
+// the public + unlisted statuses of the featuring account that use the tag.
+func (t *tagDB) populateFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) db.Error {
+	tag, err := t.GetTag(ctx, featuredTag.TagID)
+	if err != nil {
+		return err
+	}
+	featuredTag.Tag = tag
+
+	q := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status_to_tag.status_id"), bun.Ident("status.id"),
+		).
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), featuredTag.TagID).
+		Where("? = ?", bun.Ident("status.account_id"), featuredTag.AccountID).
+		Where("? IN (?)", bun.Ident("status.visibility"), bun.In([]gtsmodel.Visibility{
+			gtsmodel.VisibilityPublic,
+			gtsmodel.VisibilityUnlocked,
+		})).
+		Where("? IS NULL", bun.Ident("status.boost_of_id"))
+
+	count, err := q.Count(ctx)
+	if err != nil {
+		return t.conn.ProcessError(err)
+	}
+	featuredTag.StatusesCount = count
+
+	if count == 0 {
+		// Nothing else to do.
+		featuredTag.LastStatusAt = time.Time{}
+		return nil
+	}
+
+	var lastStatusAt time.Time
+	if err := q.
+		Column("status.created_at").
+		Order("status.created_at DESC").
+		Limit(1).
+		Scan(ctx, &lastStatusAt); err != nil {
+		return t.conn.ProcessError(err)
+	}
+	featuredTag.LastStatusAt = lastStatusAt
+
+	return nil
+}
+
+func (t *tagDB) PutFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) db.Error {
+	_, err := t.conn.NewInsert().Model(featuredTag).Exec(ctx)
+	return t.conn.ProcessError(err)
+}
+
+func (t *tagDB) DeleteFeaturedTagByID(ctx context.Context, id string) db.Error {
+	_, err := t.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("featured_tags"), bun.Ident("featured_tag")).
+		Where("? = ?", bun.Ident("featured_tag.id"), id).
+		Exec(ctx)
+	return t.conn.ProcessError(err)
+}
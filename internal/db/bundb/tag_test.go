@@ -0,0 +1,221 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TagTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *TagTestSuite) TestGetTag() {
+	testTag := suite.testTags["welcome"]
+
+	tag, err := suite.db.GetTag(context.Background(), testTag.ID)
+	suite.NoError(err)
+	suite.Equal(testTag.ID, tag.ID)
+	suite.Equal("welcome", tag.Name)
+}
+
+func (suite *TagTestSuite) TestGetTagByName() {
+	testTag := suite.testTags["Hashtag"]
+
+	for _, name := range []string{"Hashtag", "hashtag", "HASHTAG"} {
+		tag, err := suite.db.GetTagByName(context.Background(), name)
+		suite.NoError(err)
+		suite.Equal(testTag.ID, tag.ID)
+	}
+}
+
+func (suite *TagTestSuite) TestGetTagByNameNotFound() {
+	tag, err := suite.db.GetTagByName(context.Background(), "nonexistent")
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(tag)
+}
+
+func (suite *TagTestSuite) TestPutTag() {
+	ctx := context.Background()
+
+	tag := &gtsmodel.Tag{
+		ID:       "01H1Y6BRW2RV0RXQZ6WGV3AJ9X",
+		URL:      "http://localhost:8080/tags/welcomeback",
+		Name:     "welcomeback",
+		Useable:  testrig.TrueBool(),
+		Listable: testrig.TrueBool(),
+	}
+	suite.NoError(suite.db.PutTag(ctx, tag))
+
+	dbTag, err := suite.db.GetTagByName(ctx, "WelcomeBack")
+	suite.NoError(err)
+	suite.Equal(tag.ID, dbTag.ID)
+
+	// names are unique, so a second
+	// tag with the same name should fail
+	err = suite.db.PutTag(ctx, &gtsmodel.Tag{
+		ID:       "01H1Y6CA8EVZ2TZ4X7BHY80CAH",
+		URL:      "http://localhost:8080/tags/welcomeback",
+		Name:     "welcomeback",
+		Useable:  testrig.TrueBool(),
+		Listable: testrig.TrueBool(),
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+}
+
+func (suite *TagTestSuite) TestSearchTags() {
+	ctx := context.Background()
+
+	tags, err := suite.db.SearchTags(ctx, "WEL", 10)
+	suite.NoError(err)
+	suite.Len(tags, 1)
+	suite.Equal(suite.testTags["welcome"].ID, tags[0].ID)
+
+	tags, err = suite.db.SearchTags(ctx, "hash", 10)
+	suite.NoError(err)
+	suite.Len(tags, 1)
+	suite.Equal(suite.testTags["Hashtag"].ID, tags[0].ID)
+
+	tags, err = suite.db.SearchTags(ctx, "nope", 10)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(tags)
+}
+
+func (suite *TagTestSuite) TestSearchTagsUnlisted() {
+	ctx := context.Background()
+
+	tag, err := suite.db.GetTag(ctx, suite.testTags["welcome"].ID)
+	suite.NoError(err)
+
+	tag.Listable = testrig.FalseBool()
+	suite.NoError(suite.db.UpdateTag(ctx, tag, "listable"))
+
+	tags, err := suite.db.SearchTags(ctx, "welcome", 10)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(tags)
+}
+
+func (suite *TagTestSuite) TestGetFollowedTag() {
+	testFollowedTag := suite.testFollowedTags["local_account_2_Hashtag"]
+
+	followedTag, err := suite.db.GetFollowedTag(context.Background(), testFollowedTag.AccountID, testFollowedTag.TagID)
+	suite.NoError(err)
+	suite.Equal(testFollowedTag.ID, followedTag.ID)
+	suite.Equal("Hashtag", followedTag.Tag.Name)
+
+	followedTag, err = suite.db.GetFollowedTag(context.Background(), suite.testAccounts["local_account_1"].ID, testFollowedTag.TagID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(followedTag)
+}
+
+func (suite *TagTestSuite) TestFollowUnfollowTag() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_2"]
+
+	suite.NoError(suite.db.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+		ID:        "01H1Y7M5FEXTZ6SZ14DQ1ZMZ2S",
+		AccountID: account.ID,
+		TagID:     suite.testTags["welcome"].ID,
+	}))
+
+	followedTags, err := suite.db.GetFollowedTags(ctx, account.ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(followedTags, 2)
+	// newest first
+	suite.Equal("welcome", followedTags[0].Tag.Name)
+	suite.Equal("Hashtag", followedTags[1].Tag.Name)
+
+	// paging
+	followedTags, err = suite.db.GetFollowedTags(ctx, account.ID, "01H1Y7M5FEXTZ6SZ14DQ1ZMZ2S", "", "", 0)
+	suite.NoError(err)
+	suite.Len(followedTags, 1)
+	suite.Equal("Hashtag", followedTags[0].Tag.Name)
+
+	followerIDs, err := suite.db.GetTagFollowerIDs(ctx, []string{suite.testTags["welcome"].ID, suite.testTags["Hashtag"].ID})
+	suite.NoError(err)
+	suite.Equal([]string{account.ID}, followerIDs)
+
+	suite.NoError(suite.db.DeleteFollowedTag(ctx, account.ID, suite.testTags["welcome"].ID))
+
+	// deleting again is a no-op
+	suite.NoError(suite.db.DeleteFollowedTag(ctx, account.ID, suite.testTags["welcome"].ID))
+
+	followerIDs, err = suite.db.GetTagFollowerIDs(ctx, []string{suite.testTags["welcome"].ID})
+	suite.NoError(err)
+	suite.Empty(followerIDs)
+}
+
+func (suite *TagTestSuite) TestGetFeaturedTag() {
+	testFeaturedTag := suite.testFeaturedTags["admin_account_welcome"]
+
+	featuredTag, err := suite.db.GetFeaturedTag(context.Background(), testFeaturedTag.ID)
+	suite.NoError(err)
+	suite.Equal(testFeaturedTag.ID, featuredTag.ID)
+	suite.Equal("welcome", featuredTag.Tag.Name)
+	suite.Equal(1, featuredTag.StatusesCount)
+	suite.Equal(suite.testStatuses["admin_account_status_1"].CreatedAt.Unix(), featuredTag.LastStatusAt.Unix())
+}
+
+func (suite *TagTestSuite) TestFeatureUnfeatureTag() {
+	ctx := context.Background()
+	account := suite.testAccounts["admin_account"]
+
+	suite.NoError(suite.db.PutFeaturedTag(ctx, &gtsmodel.FeaturedTag{
+		ID:        "01H2WR1T9ZYYJ4GFV1XKWZ1C0Z",
+		AccountID: account.ID,
+		TagID:     suite.testTags["Hashtag"].ID,
+	}))
+
+	featuredTags, err := suite.db.GetAccountFeaturedTags(ctx, account.ID)
+	suite.NoError(err)
+	suite.Len(featuredTags, 2)
+	// oldest first
+	suite.Equal("welcome", featuredTags[0].Tag.Name)
+	suite.Equal("Hashtag", featuredTags[1].Tag.Name)
+	// admin hasn't used this one
+	suite.Zero(featuredTags[1].StatusesCount)
+	suite.True(featuredTags[1].LastStatusAt.IsZero())
+
+	// featuring the same tag twice isn't allowed
+	err = suite.db.PutFeaturedTag(ctx, &gtsmodel.FeaturedTag{
+		ID:        "01H2WR4RWS9QW6Q1KKDQ6V3C2W",
+		AccountID: account.ID,
+		TagID:     suite.testTags["Hashtag"].ID,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	suite.NoError(suite.db.DeleteFeaturedTagByID(ctx, "01H2WR1T9ZYYJ4GFV1XKWZ1C0Z"))
+
+	// deleting again is a no-op
+	suite.NoError(suite.db.DeleteFeaturedTagByID(ctx, "01H2WR1T9ZYYJ4GFV1XKWZ1C0Z"))
+
+	featuredTags, err = suite.db.GetAccountFeaturedTags(ctx, account.ID)
+	suite.NoError(err)
+	suite.Len(featuredTags, 1)
+}
+
+func TestTagTestSuite(t *testing.T) {
+	suite.Run(t, new(TagTestSuite))
+}
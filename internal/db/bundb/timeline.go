@@ -93,15 +93,31 @@ func (t *timelineDB) GetHomeTimeline(ctx context.Context, accountID string, maxI
 		q = q.Limit(limit)
 	}
 
+	// Select IDs of statuses using any of the tags that accountID follows.
+	followedTagStatusIDs := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Column("status_to_tag.status_id").
+		Join("INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("followed_tags"),
+			bun.Ident("followed_tag"),
+			bun.Ident("followed_tag.tag_id"),
+			bun.Ident("status_to_tag.tag_id")).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID)
+
 	// Use a WhereGroup here to specify that we want EITHER statuses posted by accounts that accountID follows,
-	// OR statuses posted by accountID itself (since a user should be able to see their own statuses).
+	// OR statuses posted by accountID itself (since a user should be able to see their own statuses),
+	// OR public statuses using a tag that accountID follows.
 	//
 	// This is equivalent to something like WHERE ... AND (... OR ...)
 	// See: https://bun.uptrace.dev/guide/queries.html#select
 	q = q.WhereGroup(" AND ", func(*bun.SelectQuery) *bun.SelectQuery {
 		return q.
 			WhereOr("? = ?", bun.Ident("follow.account_id"), accountID).
-			WhereOr("? = ?", bun.Ident("status.account_id"), accountID)
+			WhereOr("? = ?", bun.Ident("status.account_id"), accountID).
+			WhereOr("? = ? AND ? IN (?)",
+				bun.Ident("status.visibility"), gtsmodel.VisibilityPublic,
+				bun.Ident("status.id"), followedTagStatusIDs)
 	})
 
 	if err := q.Scan(ctx, &statusIDs); err != nil {
@@ -254,3 +270,178 @@ func (t *timelineDB) GetFavedTimeline(ctx context.Context, accountID string, max
 	prevMinID := faves[0].ID
 	return statuses, nextMaxID, prevMinID, nil
 }
+
+func (t *timelineDB) GetListTimeline(
+	ctx context.Context,
+	listID string,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+) ([]*gtsmodel.Status, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	statusIDs := make([]string, 0, limit)
+
+	// Select target account IDs of follows
+	// which are entries in the given list.
+	followQ := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		Column("follow.target_account_id").
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("list_entries"),
+			bun.Ident("list_entry"),
+			bun.Ident("list_entry.follow_id"),
+			bun.Ident("follow.id")).
+		Where("? = ?", bun.Ident("list_entry.list_id"), listID)
+
+	q := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		// Select only IDs from table
+		Column("status.id").
+		// Select only statuses authored by
+		// accounts with entries in the list.
+		Where("? IN (?)", bun.Ident("status.account_id"), followQ).
+		// Sort by highest ID (newest) to lowest ID (oldest)
+		Order("status.id DESC")
+
+	if maxID == "" {
+		var err error
+		// don't return statuses more than five minutes in the future
+		maxID, err = id.NewULIDFromTime(time.Now().Add(5 * time.Minute))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// return only statuses LOWER (ie., older) than maxID
+	q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+
+	if sinceID != "" {
+		// return only statuses HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("status.id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only statuses HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	if limit > 0 {
+		// limit amount of statuses returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	statuses := make([]*gtsmodel.Status, 0, len(statusIDs))
+
+	for _, id := range statusIDs {
+		// Fetch status from db for ID
+		status, err := t.state.DB.GetStatusByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetListTimeline: error fetching status %q: %v", id, err)
+			continue
+		}
+
+		// Append status to slice
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func (t *timelineDB) GetTagTimeline(
+	ctx context.Context,
+	tagID string,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+	local bool,
+) ([]*gtsmodel.Status, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	statusIDs := make([]string, 0, limit)
+
+	q := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Column("status.id").
+		// Join with statuses for filtering.
+		Join("INNER JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"),
+			bun.Ident("status"),
+			bun.Ident("status.id"),
+			bun.Ident("status_to_tag.status_id")).
+		// Public only.
+		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
+		// This tag only.
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID).
+		// Sort by highest ID (newest) to lowest ID (oldest)
+		Order("status.id DESC")
+
+	if maxID == "" {
+		var err error
+		// don't return statuses more than five minutes in the future
+		maxID, err = id.NewULIDFromTime(time.Now().Add(5 * time.Minute))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// return only statuses LOWER (ie., older) than maxID
+	q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+
+	if sinceID != "" {
+		// return only statuses HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("status.id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only statuses HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	if local {
+		// return only statuses posted by local accounts
+		q = q.Where("? = ?", bun.Ident("status.local"), local)
+	}
+
+	if limit > 0 {
+		// limit amount of statuses returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	statuses := make([]*gtsmodel.Status, 0, len(statusIDs))
+
+	for _, id := range statusIDs {
+		// Fetch status from db for ID
+		status, err := t.state.DB.GetStatusByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetTagTimeline: error fetching status %q: %v", id, err)
+			continue
+		}
+
+		// Append status to slice
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
@@ -84,6 +84,29 @@ func (suite *TimelineTestSuite) TestGetHomeTimelineWithFutureStatus() {
 	suite.Len(s, 16)
 }
 
+func (suite *TimelineTestSuite) TestGetTagTimeline() {
+	ctx := context.Background()
+
+	tag := suite.testTags["welcome"]
+
+	s, err := suite.db.GetTagTimeline(ctx, tag.ID, "", "", "", 20, false)
+	suite.NoError(err)
+
+	suite.Len(s, 1)
+	suite.Equal(suite.testStatuses["admin_account_status_1"].ID, s[0].ID)
+}
+
+func (suite *TimelineTestSuite) TestGetTagTimelineNoStatuses() {
+	ctx := context.Background()
+
+	tag := suite.testTags["Hashtag"]
+
+	s, err := suite.db.GetTagTimeline(ctx, tag.ID, "", "", "", 20, false)
+	suite.NoError(err)
+
+	suite.Empty(s)
+}
+
 func getFutureStatus() *gtsmodel.Status {
 	theDistantFuture := time.Now().Add(876600 * time.Hour)
 	id, err := id.NewULIDFromTime(theDistantFuture)
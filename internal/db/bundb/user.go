@@ -24,6 +24,7 @@ import (
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/uptrace/bun"
 )
@@ -123,6 +124,33 @@ func (u *userDB) GetUserByConfirmationToken(ctx context.Context, confirmationTok
 	}, confirmationToken)
 }
 
+func (u *userDB) GetAdminUsers(ctx context.Context) ([]*gtsmodel.User, db.Error) {
+	userIDs := []string{}
+
+	if err := u.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+		Column("user.id").
+		Where("? = ?", bun.Ident("user.admin"), true).
+		Order("user.id ASC").
+		Scan(ctx, &userIDs); err != nil {
+		return nil, u.conn.ProcessError(err)
+	}
+
+	users := make([]*gtsmodel.User, 0, len(userIDs))
+	for _, id := range userIDs {
+		user, err := u.GetUserByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetAdminUsers: error getting user %q: %v", id, err)
+			continue
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
 func (u *userDB) PutUser(ctx context.Context, user *gtsmodel.User) db.Error {
 	return u.state.Caches.GTS.User().Store(user, func() error {
 		_, err := u.conn.
@@ -48,6 +48,13 @@ func (suite *UserTestSuite) TestGetUserByAccountID() {
 	suite.NotNil(user)
 }
 
+func (suite *UserTestSuite) TestGetAdminUsers() {
+	users, err := suite.db.GetAdminUsers(context.Background())
+	suite.NoError(err)
+	suite.Len(users, 1)
+	suite.Equal(suite.testUsers["admin_account"].ID, users[0].ID)
+}
+
 func (suite *UserTestSuite) TestUpdateUserSelectedColumns() {
 	testUser := suite.testUsers["local_account_1"]
 
@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type webPushDB struct {
+	conn *DBConn
+}
+
+func (w *webPushDB) GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, db.Error) {
+	var keyPair gtsmodel.VAPIDKeyPair
+
+	if err := w.conn.
+		NewSelect().
+		Model(&keyPair).
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, w.conn.ProcessError(err)
+	}
+
+	return &keyPair, nil
+}
+
+func (w *webPushDB) PutVAPIDKeyPair(ctx context.Context, keyPair *gtsmodel.VAPIDKeyPair) db.Error {
+	_, err := w.conn.NewInsert().Model(keyPair).Exec(ctx)
+	return w.conn.ProcessError(err)
+}
+
+func (w *webPushDB) GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, db.Error) {
+	var subscription gtsmodel.WebPushSubscription
+
+	if err := w.conn.
+		NewSelect().
+		Model(&subscription).
+		Where("? = ?", bun.Ident("web_push_subscription.token_id"), tokenID).
+		Scan(ctx); err != nil {
+		return nil, w.conn.ProcessError(err)
+	}
+
+	return &subscription, nil
+}
+
+func (w *webPushDB) GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, db.Error) {
+	subscriptions := []*gtsmodel.WebPushSubscription{}
+
+	if err := w.conn.
+		NewSelect().
+		Model(&subscriptions).
+		Where("? = ?", bun.Ident("web_push_subscription.account_id"), accountID).
+		Scan(ctx); err != nil {
+		return nil, w.conn.ProcessError(err)
+	}
+
+	return subscriptions, nil
+}
+
+func (w *webPushDB) PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) db.Error {
+	_, err := w.conn.NewInsert().Model(subscription).Exec(ctx)
+	return w.conn.ProcessError(err)
+}
+
+func (w *webPushDB) UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) db.Error {
+	subscription.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := w.conn.
+		NewUpdate().
+		Model(subscription).
+		Where("? = ?", bun.Ident("web_push_subscription.id"), subscription.ID).
+		Column(columns...).
+		Exec(ctx)
+	return w.conn.ProcessError(err)
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByID(ctx context.Context, id string) db.Error {
+	_, err := w.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("web_push_subscriptions"), bun.Ident("web_push_subscription")).
+		Where("? = ?", bun.Ident("web_push_subscription.id"), id).
+		Exec(ctx)
+	return w.conn.ProcessError(err)
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) db.Error {
+	_, err := w.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("web_push_subscriptions"), bun.Ident("web_push_subscription")).
+		Where("? = ?", bun.Ident("web_push_subscription.token_id"), tokenID).
+		Exec(ctx)
+	return w.conn.ProcessError(err)
+}
@@ -0,0 +1,63 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Conversation contains functions for getting and storing direct message conversations.
+type Conversation interface {
+	// GetConversationByID gets one conversation with the given id.
+	GetConversationByID(ctx context.Context, id string) (*gtsmodel.Conversation, Error)
+
+	// GetConversationByThreadAndAccountIDs gets the conversation owned by the given account,
+	// in the given thread, with exactly the given other participants (in any order).
+	GetConversationByThreadAndAccountIDs(ctx context.Context, accountID string, threadID string, otherAccountIDs []string) (*gtsmodel.Conversation, Error)
+
+	// GetConversations gets the conversations owned by the given account, ordered by
+	// most recent last status first. The paging parameters refer to last status IDs.
+	GetConversations(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Conversation, Error)
+
+	// PutConversation puts a new conversation in the database.
+	PutConversation(ctx context.Context, conversation *gtsmodel.Conversation) Error
+
+	// UpdateConversation updates the given conversation.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateConversation(ctx context.Context, conversation *gtsmodel.Conversation, columns ...string) Error
+
+	// DeleteConversationByID deletes one conversation with the given id.
+	DeleteConversationByID(ctx context.Context, id string) Error
+
+	// DeleteConversationsByAccountID deletes all conversations owned by the given account.
+	DeleteConversationsByAccountID(ctx context.Context, accountID string) Error
+
+	// AddStatusToConversation records the given status as part of the given conversation.
+	AddStatusToConversation(ctx context.Context, conversationID string, statusID string) Error
+
+	// RemoveStatusFromConversations removes the given status from every conversation it's in.
+	// Conversations whose last status it was are moved back to their previous status,
+	// and conversations left with no statuses at all are deleted.
+	RemoveStatusFromConversations(ctx context.Context, statusID string) Error
+}
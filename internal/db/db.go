@@ -33,20 +33,33 @@ const (
 type DB interface {
 	Account
 	Admin
+	Announcement
 	Basic
+	Conversation
+	Delivery
 	Domain
 	Emoji
+	Filter
 	Instance
+	Invite
+	List
+	Marker
 	Media
 	Mention
 	Notification
+	Poll
 	Relationship
 	Report
+	ScheduledStatus
+	Search
 	Session
 	Status
+	StatusEdit
+	Tag
 	Timeline
 	User
 	Tombstone
+	WebPush
 
 	/*
 		USEFUL CONVERSION FUNCTIONS
@@ -0,0 +1,58 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Delivery contains functions for queueing failed deliveries to remote inboxes, and for keeping track of failing inboxes.
+type Delivery interface {
+	// GetDueDeliveries gets up to limit queued deliveries which are due
+	// to be attempted again at the given time, ordered from oldest to newest.
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*gtsmodel.Delivery, Error)
+
+	// PutDelivery queues a new delivery in the database.
+	PutDelivery(ctx context.Context, delivery *gtsmodel.Delivery) Error
+
+	// UpdateDelivery updates the given delivery in the database.
+	// If any columns are specified, only those will be updated.
+	UpdateDelivery(ctx context.Context, delivery *gtsmodel.Delivery, columns ...string) Error
+
+	// DeleteDeliveryByID removes the queued delivery with the given ID from the database.
+	DeleteDeliveryByID(ctx context.Context, id string) Error
+
+	// GetFailingInboxByURI gets the failing inbox with the given URI.
+	// Returns ErrNoEntries if deliveries to the inbox are not currently failing.
+	GetFailingInboxByURI(ctx context.Context, uri string) (*gtsmodel.FailingInbox, Error)
+
+	// PutFailingInbox puts a new failing inbox in the database.
+	PutFailingInbox(ctx context.Context, inbox *gtsmodel.FailingInbox) Error
+
+	// UpdateFailingInbox updates the given failing inbox in the database.
+	// If any columns are specified, only those will be updated.
+	UpdateFailingInbox(ctx context.Context, inbox *gtsmodel.FailingInbox, columns ...string) Error
+
+	// DeleteFailingInboxByURI removes the failing inbox with the given URI from the database,
+	// ie., marks it as working again. It is not an error if there is no such failing inbox.
+	DeleteFailingInboxByURI(ctx context.Context, uri string) Error
+}
@@ -47,4 +47,26 @@ type Domain interface {
 
 	// AreURIsBlocked checks if an instance-level domain block exists for any `host` in the given URI slice, and returns true if even one is found.
 	AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, Error)
+
+	// CreateEmailDomainBlock puts the given email domain block in the database, normalizing its domain first.
+	CreateEmailDomainBlock(ctx context.Context, block *gtsmodel.EmailDomainBlock) Error
+
+	// GetEmailDomainBlockByID returns the email domain block with the given id.
+	GetEmailDomainBlockByID(ctx context.Context, id string) (*gtsmodel.EmailDomainBlock, Error)
+
+	// GetEmailDomainBlock returns the email domain block for exactly the given domain (eg., `example.org`).
+	GetEmailDomainBlock(ctx context.Context, domain string) (*gtsmodel.EmailDomainBlock, Error)
+
+	// GetEmailDomainBlocks returns all email domain blocks, ordered by domain.
+	GetEmailDomainBlocks(ctx context.Context) ([]*gtsmodel.EmailDomainBlock, Error)
+
+	// DeleteEmailDomainBlock deletes the email domain block with the given id.
+	DeleteEmailDomainBlock(ctx context.Context, id string) Error
+
+	// IsEmailDomainBlocked checks if an email domain block exists for the given domain string or any of its
+	// parent domains, so that a block on `example.org` also covers addresses at `mail.example.org`.
+	IsEmailDomainBlocked(ctx context.Context, domain string) (bool, Error)
+
+	// AreEmailDomainsBlocked checks if an email domain block exists for any of the given domain strings, and returns true if even one is found.
+	AreEmailDomainsBlocked(ctx context.Context, domains []string) (bool, Error)
 }
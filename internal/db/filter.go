@@ -0,0 +1,74 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Filter contains functions for getting, creating, updating and deleting filters + filter keywords.
+type Filter interface {
+	// GetFilterByID gets one filter with the given id,
+	// with its keywords populated and ready to be applied.
+	GetFilterByID(ctx context.Context, id string) (*gtsmodel.Filter, Error)
+
+	// GetFiltersForAccountID gets all filters owned by the given accountID,
+	// including expired filters. Keywords will be populated.
+	GetFiltersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Filter, Error)
+
+	// PutFilter puts a new filter in the database, along with
+	// any keywords set on the filter. It uses a transaction to
+	// ensure no partial updates.
+	PutFilter(ctx context.Context, filter *gtsmodel.Filter) Error
+
+	// UpdateFilter updates the given filter.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateFilter(ctx context.Context, filter *gtsmodel.Filter, columns ...string) Error
+
+	// DeleteFilterByID deletes one filter with the given ID,
+	// along with all keywords belonging to that filter.
+	DeleteFilterByID(ctx context.Context, id string) Error
+
+	// GetFilterKeywordByID gets one filter keyword with the given ID.
+	GetFilterKeywordByID(ctx context.Context, id string) (*gtsmodel.FilterKeyword, Error)
+
+	// GetFilterKeywordsForFilterID gets all filter keywords belonging to the given filterID.
+	GetFilterKeywordsForFilterID(ctx context.Context, filterID string) ([]*gtsmodel.FilterKeyword, Error)
+
+	// GetFilterKeywordsForAccountID gets all filter keywords owned by the given accountID.
+	GetFilterKeywordsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FilterKeyword, Error)
+
+	// PutFilterKeyword puts a new filter keyword in the database.
+	PutFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) Error
+
+	// UpdateFilterKeyword updates the given filter keyword.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, columns ...string) Error
+
+	// DeleteFilterKeywordByID deletes one filter keyword with the given id.
+	DeleteFilterKeywordByID(ctx context.Context, id string) Error
+}
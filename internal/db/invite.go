@@ -0,0 +1,51 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Invite contains functions for getting, storing and using invite codes.
+type Invite interface {
+	// GetInviteByID gets one invite with the given id.
+	GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, Error)
+
+	// GetInviteByCode gets one invite with the given code.
+	GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, Error)
+
+	// GetInvites gets the invites created by the given account, newest first, using the given paging parameters.
+	GetInvites(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Invite, Error)
+
+	// PutInvite puts a new invite in the database.
+	PutInvite(ctx context.Context, invite *gtsmodel.Invite) Error
+
+	// UpdateInvite updates the given invite.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) Error
+
+	// UseInvite increments the use count of the invite with the given id,
+	// if it still has uses left. Returns ErrNoEntries if it doesn't.
+	UseInvite(ctx context.Context, id string) Error
+}
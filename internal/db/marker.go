@@ -0,0 +1,39 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Marker contains functions for getting and storing timeline read markers.
+type Marker interface {
+	// GetMarker gets the marker of the given account for the timeline with the given name.
+	GetMarker(ctx context.Context, accountID string, name gtsmodel.MarkerName) (*gtsmodel.Marker, Error)
+
+	// UpdateMarker creates or updates the given marker, incrementing its version.
+	// If the marker was changed by someone else since it was last read from the
+	// database, ErrAlreadyExists is returned and the caller should try again.
+	UpdateMarker(ctx context.Context, marker *gtsmodel.Marker) Error
+
+	// DeleteMarkersByAccountID deletes all markers of the given account.
+	DeleteMarkersByAccountID(ctx context.Context, accountID string) Error
+}
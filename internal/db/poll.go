@@ -0,0 +1,70 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Poll contains functions for getting, creating, updating and deleting polls + poll votes.
+type Poll interface {
+	// GetPollByID gets one poll with the given id.
+	GetPollByID(ctx context.Context, id string) (*gtsmodel.Poll, Error)
+
+	// GetExpiredOpenPolls gets all polls that have not yet
+	// been closed, but which expired before the given time.
+	GetExpiredOpenPolls(ctx context.Context, now time.Time) ([]*gtsmodel.Poll, Error)
+
+	// PutPoll puts a new poll in the database.
+	PutPoll(ctx context.Context, poll *gtsmodel.Poll) Error
+
+	// UpdatePoll updates the given poll.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdatePoll(ctx context.Context, poll *gtsmodel.Poll, columns ...string) Error
+
+	// DeletePollByID deletes one poll with the given
+	// ID, along with all votes cast in that poll.
+	DeletePollByID(ctx context.Context, id string) Error
+
+	// GetPollVoteByID gets one poll vote with the given id.
+	GetPollVoteByID(ctx context.Context, id string) (*gtsmodel.PollVote, Error)
+
+	// GetPollVoteBy gets the vote cast by the given account in the given poll.
+	GetPollVoteBy(ctx context.Context, pollID string, accountID string) (*gtsmodel.PollVote, Error)
+
+	// GetPollVotes gets all votes cast in the given poll.
+	GetPollVotes(ctx context.Context, pollID string) ([]*gtsmodel.PollVote, Error)
+
+	// PutPollVote puts a new poll vote in the database.
+	// If the account already voted in the poll, ErrAlreadyExists is returned.
+	PutPollVote(ctx context.Context, vote *gtsmodel.PollVote) Error
+
+	// UpdatePollVote updates the given poll vote.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdatePollVote(ctx context.Context, vote *gtsmodel.PollVote, columns ...string) Error
+}
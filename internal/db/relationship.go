@@ -20,6 +20,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -51,6 +52,33 @@ type Relationship interface {
 	// DeleteBlocksByTargetAccountID removes any blocks with given targetAccountID.
 	DeleteBlocksByTargetAccountID(ctx context.Context, targetAccountID string) Error
 
+	// IsMuted checks whether account1 has a mute in place against account2 which hasn't expired yet.
+	IsMuted(ctx context.Context, account1 string, account2 string) (bool, Error)
+
+	// GetMute returns the unexpired mute from account1 targeting account2, if it exists, or an error if it doesn't.
+	GetMute(ctx context.Context, account1 string, account2 string) (*gtsmodel.UserMute, Error)
+
+	// PutMute attempts to place the given account mute in the database.
+	// If account1 already mutes account2, the existing mute's expiry and
+	// notifications settings will be updated instead, and its ID kept.
+	PutMute(ctx context.Context, mute *gtsmodel.UserMute) Error
+
+	// DeleteMuteByID removes mute with given ID from the database.
+	DeleteMuteByID(ctx context.Context, id string) Error
+
+	// DeleteMutesByOriginAccountID removes any mutes with accountID equal to originAccountID.
+	DeleteMutesByOriginAccountID(ctx context.Context, originAccountID string) Error
+
+	// DeleteMutesByTargetAccountID removes any mutes with given targetAccountID.
+	DeleteMutesByTargetAccountID(ctx context.Context, targetAccountID string) Error
+
+	// DeleteExpiredMutes removes any mutes which expired at or before the given time.
+	DeleteExpiredMutes(ctx context.Context, now time.Time) Error
+
+	// GetAccountMutes returns unexpired mutes owned by the given accountID, newest first,
+	// with their target accounts populated. It will return ErrNoEntries if there are none.
+	GetAccountMutes(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.UserMute, Error)
+
 	// GetRelationship retrieves the relationship of the targetAccount to the requestingAccount.
 	GetRelationship(ctx context.Context, requestingAccount string, targetAccount string) (*gtsmodel.Relationship, Error)
 
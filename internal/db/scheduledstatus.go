@@ -0,0 +1,51 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// ScheduledStatus contains functions for getting and storing statuses scheduled to be published later.
+type ScheduledStatus interface {
+	// GetScheduledStatusByID gets one scheduled status with the given id.
+	GetScheduledStatusByID(ctx context.Context, id string) (*gtsmodel.ScheduledStatus, Error)
+
+	// GetScheduledStatuses gets the scheduled statuses of the given account, newest first, using the given paging parameters.
+	GetScheduledStatuses(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ScheduledStatus, Error)
+
+	// GetDueScheduledStatuses gets all scheduled statuses (of any account) that should be published at the given time, oldest first.
+	GetDueScheduledStatuses(ctx context.Context, now time.Time) ([]*gtsmodel.ScheduledStatus, Error)
+
+	// PutScheduledStatus puts a new scheduled status in the database.
+	PutScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) Error
+
+	// UpdateScheduledStatus updates the given scheduled status.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus, columns ...string) Error
+
+	// DeleteScheduledStatusByID deletes one scheduled status with the given id.
+	DeleteScheduledStatusByID(ctx context.Context, id string) Error
+}
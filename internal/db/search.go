@@ -0,0 +1,37 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Search contains functions for full-text searching of database content.
+type Search interface {
+	// SearchStatuses returns up to limit local statuses whose text or content
+	// warning match the given full-text query, newest first. Only statuses
+	// authored by the requesting account, or by accounts that have opted in
+	// to being discoverable, are returned. If accountID is set, only statuses
+	// authored by that account are returned.
+	//
+	// Callers must still check the visibility of the returned statuses.
+	SearchStatuses(ctx context.Context, requestingAccountID string, query string, accountID string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, Error)
+}
@@ -72,9 +72,17 @@ type Status interface {
 	// IsStatusRebloggedBy checks if a given status has been reblogged/boosted by a given account ID
 	IsStatusRebloggedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
-	// IsStatusMutedBy checks if a given status has been muted by a given account ID
+	// GetStatusThreadRoot returns the root of the thread that the given status is part of, found by following
+	// InReplyToID up the thread. If the thread can't be followed any further up because a parent isn't in
+	// the database, the last status that was found is returned. The given status is returned if it isn't a reply.
+	GetStatusThreadRoot(ctx context.Context, status *gtsmodel.Status) (*gtsmodel.Status, Error)
+
+	// IsStatusMutedBy checks if the thread that the given status is part of has been muted by a given account ID
 	IsStatusMutedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
+	// DeleteStatusThreadMutes removes any mutes created by the given account ID of the thread that the given status is part of.
+	DeleteStatusThreadMutes(ctx context.Context, status *gtsmodel.Status, accountID string) Error
+
 	// IsStatusBookmarkedBy checks if a given status has been bookmarked by a given account ID
 	IsStatusBookmarkedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
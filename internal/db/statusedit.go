@@ -0,0 +1,39 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// StatusEdit contains functions for getting, creating and deleting earlier revisions of statuses.
+type StatusEdit interface {
+	// GetStatusEdits gets all earlier revisions of the status
+	// with the given ID, ordered from oldest to newest.
+	GetStatusEdits(ctx context.Context, statusID string) ([]*gtsmodel.StatusEdit, Error)
+
+	// PutStatusEdit puts a new status revision in the database.
+	PutStatusEdit(ctx context.Context, edit *gtsmodel.StatusEdit) Error
+
+	// DeleteStatusEdits deletes all earlier
+	// revisions of the status with the given ID.
+	DeleteStatusEdits(ctx context.Context, statusID string) Error
+}
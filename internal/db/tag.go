@@ -0,0 +1,87 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Tag contains functions for getting and creating hashtags.
+type Tag interface {
+	// GetTag gets one tag with the given id.
+	GetTag(ctx context.Context, id string) (*gtsmodel.Tag, Error)
+
+	// GetTagByName gets one tag with the given name. The name
+	// should be given without the leading '#', and is matched
+	// case-insensitively.
+	GetTagByName(ctx context.Context, name string) (*gtsmodel.Tag, Error)
+
+	// PutTag puts a new tag in the database.
+	PutTag(ctx context.Context, tag *gtsmodel.Tag) Error
+
+	// UpdateTag updates the given tag.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateTag(ctx context.Context, tag *gtsmodel.Tag, columns ...string) Error
+
+	// SearchTags returns up to limit listable tags whose
+	// names begin with the given (case-insensitive) prefix,
+	// ordered with the most recently used tags first.
+	SearchTags(ctx context.Context, prefix string, limit int) ([]*gtsmodel.Tag, Error)
+
+	// GetFollowedTag gets the followed tag entry for the given
+	// account and tag IDs, or ErrNoEntries if the account
+	// doesn't follow the tag.
+	GetFollowedTag(ctx context.Context, accountID string, tagID string) (*gtsmodel.FollowedTag, Error)
+
+	// GetFollowedTags returns followed tag entries owned by the given
+	// account ID, newest first, paged using the given parameters.
+	GetFollowedTags(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.FollowedTag, Error)
+
+	// PutFollowedTag puts a new followed tag entry in the database.
+	PutFollowedTag(ctx context.Context, followedTag *gtsmodel.FollowedTag) Error
+
+	// DeleteFollowedTag deletes the followed tag entry for the given
+	// account and tag IDs. It won't return an error if no entry existed.
+	DeleteFollowedTag(ctx context.Context, accountID string, tagID string) Error
+
+	// GetTagFollowerIDs returns the deduplicated IDs of all
+	// accounts following at least one of the given tag IDs.
+	GetTagFollowerIDs(ctx context.Context, tagIDs []string) ([]string, Error)
+
+	// GetFeaturedTag gets one featured tag entry with the given id,
+	// with its tag and statuses count + last status time populated.
+	GetFeaturedTag(ctx context.Context, id string) (*gtsmodel.FeaturedTag, Error)
+
+	// GetAccountFeaturedTags returns all featured tag entries owned
+	// by the given account ID, oldest first, with their tags and
+	// statuses counts + last status times populated.
+	GetAccountFeaturedTags(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, Error)
+
+	// PutFeaturedTag puts a new featured tag entry in the database.
+	PutFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) Error
+
+	// DeleteFeaturedTagByID deletes the featured tag entry with the
+	// given ID. It won't return an error if no entry existed.
+	DeleteFeaturedTagByID(ctx context.Context, id string) Error
+}
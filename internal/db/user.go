@@ -36,6 +36,8 @@ type User interface {
 	GetUserByExternalID(ctx context.Context, id string) (*gtsmodel.User, Error)
 	// GetUserByConfirmationToken returns one user by its confirmation token, or an error if something goes wrong.
 	GetUserByConfirmationToken(ctx context.Context, confirmationToken string) (*gtsmodel.User, Error)
+	// GetAdminUsers returns all users with admin privileges on this instance, or an error if something goes wrong.
+	GetAdminUsers(ctx context.Context) ([]*gtsmodel.User, Error)
 	// PutUser will attempt to place user in the database
 	PutUser(ctx context.Context, user *gtsmodel.User) Error
 	// UpdateUser updates one user by its primary key, updating either only the specified columns, or all of them.
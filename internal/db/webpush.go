@@ -0,0 +1,56 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// WebPush contains functions for getting and storing Web Push subscriptions and keys.
+type WebPush interface {
+	// GetVAPIDKeyPair gets the instance VAPID key pair, or ErrNoEntries if it hasn't been generated yet.
+	GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, Error)
+
+	// PutVAPIDKeyPair puts the instance VAPID key pair in the database.
+	PutVAPIDKeyPair(ctx context.Context, keyPair *gtsmodel.VAPIDKeyPair) Error
+
+	// GetWebPushSubscriptionByTokenID gets the Web Push subscription created with the given token ID.
+	GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, Error)
+
+	// GetWebPushSubscriptionsByAccountID gets all Web Push subscriptions owned by the given account ID.
+	GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, Error)
+
+	// PutWebPushSubscription puts a new Web Push subscription in the database.
+	PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) Error
+
+	// UpdateWebPushSubscription updates the given Web Push subscription.
+	// The given columns will be updated; if no columns are
+	// provided, then all columns will be updated.
+	// updated_at will also be updated, no need to pass this
+	// as a specific column.
+	UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) Error
+
+	// DeleteWebPushSubscriptionByID deletes the Web Push subscription with the given ID.
+	DeleteWebPushSubscriptionByID(ctx context.Context, id string) Error
+
+	// DeleteWebPushSubscriptionByTokenID deletes the Web Push subscription created with the given token ID.
+	DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) Error
+}
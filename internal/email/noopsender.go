@@ -89,3 +89,45 @@ func (s *noopSender) SendResetEmail(toAddress string, data ResetData) error {
 
 	return nil
 }
+
+func (s *noopSender) SendApproveEmail(toAddress string, data ApproveData) error {
+	buf := &bytes.Buffer{}
+	if err := s.template.ExecuteTemplate(buf, approveTemplate, data); err != nil {
+		return err
+	}
+	approveBody := buf.String()
+
+	msg, err := assembleMessage(approveSubject, approveBody, toAddress, "test@example.org")
+	if err != nil {
+		return err
+	}
+
+	log.Tracef("NOT SENDING approval email to %s with contents: %s", toAddress, msg)
+
+	if s.sendCallback != nil {
+		s.sendCallback(toAddress, string(msg))
+	}
+
+	return nil
+}
+
+func (s *noopSender) SendRejectEmail(toAddress string, data RejectData) error {
+	buf := &bytes.Buffer{}
+	if err := s.template.ExecuteTemplate(buf, rejectTemplate, data); err != nil {
+		return err
+	}
+	rejectBody := buf.String()
+
+	msg, err := assembleMessage(rejectSubject, rejectBody, toAddress, "test@example.org")
+	if err != nil {
+		return err
+	}
+
+	log.Tracef("NOT SENDING rejection email to %s with contents: %s", toAddress, msg)
+
+	if s.sendCallback != nil {
+		s.sendCallback(toAddress, string(msg))
+	}
+
+	return nil
+}
@@ -33,6 +33,12 @@ type Sender interface {
 
 	// SendResetEmail sends a 'reset your password' style email to the given toAddress, with the given data.
 	SendResetEmail(toAddress string, data ResetData) error
+
+	// SendApproveEmail sends a 'your sign-up has been approved' style email to the given toAddress, with the given data.
+	SendApproveEmail(toAddress string, data ApproveData) error
+
+	// SendRejectEmail sends a 'your sign-up has been rejected' style email to the given toAddress, with the given data.
+	SendRejectEmail(toAddress string, data RejectData) error
 }
 
 // NewSender returns a new email Sender interface with the given configuration, or an error if something goes wrong.
@@ -55,6 +55,30 @@ func (suite *UtilTestSuite) TestTemplateReset() {
 	suite.Equal("To: user@example.org\r\nSubject: GoToSocial Password Reset\r\n\r\nHello test!\r\n\r\nYou are receiving this mail because a password reset has been requested for your account on https://example.org.\r\n\r\nTo reset your password, paste the following in your browser's address bar:\r\n\r\nhttps://example.org/reset_email?token=ee24f71d-e615-43f9-afae-385c0799b7fa\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org.\r\n\r\n", suite.sentEmails["user@example.org"])
 }
 
+func (suite *UtilTestSuite) TestTemplateApprove() {
+	approveData := email.ApproveData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+	}
+
+	suite.sender.SendApproveEmail("user@example.org", approveData)
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nSubject: GoToSocial Sign-Up Approved\r\n\r\nHello test!\r\n\r\nYou are receiving this mail because your request for an account on https://example.org has been approved.\r\n\r\nYou can now log in to your account at https://example.org.\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org.\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *UtilTestSuite) TestTemplateReject() {
+	rejectData := email.RejectData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+	}
+
+	suite.sender.SendRejectEmail("user@example.org", rejectData)
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nSubject: GoToSocial Sign-Up Rejected\r\n\r\nHello test!\r\n\r\nYou are receiving this mail because your request for an account on https://example.org has been rejected.\r\n\r\nAny data associated with your sign-up has been removed.\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org.\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
 func TestUtilTestSuite(t *testing.T) {
 	suite.Run(t, &UtilTestSuite{})
 }
@@ -0,0 +1,120 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package emaildomain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Resolver looks up the MX records of a domain.
+//
+// *net.Resolver satisfies this interface, so net.DefaultResolver
+// can be used in production, while tests can use a stub instead.
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// Checker checks email addresses against the email domain blocks of this instance.
+type Checker interface {
+	// Blocked returns true if the domain of the given email address, or the domain
+	// of any mail server that accepts mail for it (according to its MX records),
+	// is covered by an email domain block. An error is returned if the address
+	// can't be parsed, or something goes wrong in the database.
+	//
+	// Failing MX lookups are not treated as an error, since a lot of
+	// legitimate mail setups have flaky or slow DNS; in that case only
+	// the domain of the email address itself is checked.
+	Blocked(ctx context.Context, email string) (bool, error)
+}
+
+type checker struct {
+	db       db.DB
+	resolver Resolver
+}
+
+// NewChecker returns a new email domain block checker, using
+// the given resolver to look up MX records for email domains.
+func NewChecker(db db.DB, resolver Resolver) Checker {
+	return &checker{
+		db:       db,
+		resolver: resolver,
+	}
+}
+
+func (c *checker) Blocked(ctx context.Context, email string) (bool, error) {
+	domain, err := Domain(email)
+	if err != nil {
+		return false, err
+	}
+
+	// check the domain of the address itself first, that way
+	// we don't need to do any DNS lookups for the easy case
+	blocked, err := c.db.IsEmailDomainBlocked(ctx, domain)
+	if err != nil {
+		return false, fmt.Errorf("db error checking email domain %s: %w", domain, err)
+	}
+	if blocked {
+		return true, nil
+	}
+
+	records, err := c.resolver.LookupMX(ctx, domain)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			log.Debugf("error looking up mx records for %s: %s", domain, err)
+		}
+		return false, nil
+	}
+
+	hosts := make([]string, 0, len(records))
+	for _, record := range records {
+		hosts = append(hosts, record.Host)
+	}
+
+	blocked, err = c.db.AreEmailDomainsBlocked(ctx, hosts)
+	if err != nil {
+		return false, fmt.Errorf("db error checking mx hosts of email domain %s: %w", domain, err)
+	}
+
+	return blocked, nil
+}
+
+// Domain parses the given email address, and returns the
+// lowercased domain part of it (everything after the last @).
+func Domain(email string) (string, error) {
+	m, err := mail.ParseAddress(email)
+	if err != nil {
+		return "", fmt.Errorf("error parsing email address %s: %w", email, err)
+	}
+
+	i := strings.LastIndexByte(m.Address, '@')
+	if i == -1 || i == len(m.Address)-1 {
+		return "", fmt.Errorf("email address %s has no domain", email)
+	}
+
+	return strings.ToLower(m.Address[i+1:]), nil
+}
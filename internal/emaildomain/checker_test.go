@@ -0,0 +1,104 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package emaildomain_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/emaildomain"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// brokenResolver fails every lookup,
+// as if DNS was unreachable.
+type brokenResolver struct{}
+
+func (brokenResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	return nil, errors.New("i/o timeout")
+}
+
+type CheckerTestSuite struct {
+	suite.Suite
+	db db.DB
+}
+
+func (suite *CheckerTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB()
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *CheckerTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+func (suite *CheckerTestSuite) TestBlocked() {
+	checker := emaildomain.NewChecker(suite.db, testrig.NewTestMXResolver())
+
+	for email, expected := range map[string]bool{
+		// blocked directly
+		"someone@spam-mail.org": true,
+		"Someone@SPAM-MAIL.ORG": true,
+		// blocked via parent domain
+		"someone@eu.spam-mail.org": true,
+		// blocked via mx records
+		"someone@sneaky-inbox.com": true,
+		// mx records on an unblocked domain
+		"zork@example.org": false,
+		// no mx records at all
+		"someone@somewhere.com": false,
+	} {
+		blocked, err := checker.Blocked(context.Background(), email)
+		suite.NoError(err)
+		suite.Equal(expected, blocked, email)
+	}
+}
+
+func (suite *CheckerTestSuite) TestBlockedResolverError() {
+	checker := emaildomain.NewChecker(suite.db, brokenResolver{})
+
+	// direct blocks still apply...
+	blocked, err := checker.Blocked(context.Background(), "someone@spam-mail.org")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	// ...but failing lookups don't block anything
+	blocked, err = checker.Blocked(context.Background(), "someone@sneaky-inbox.com")
+	suite.NoError(err)
+	suite.False(blocked)
+}
+
+func (suite *CheckerTestSuite) TestBlockedBadAddress() {
+	checker := emaildomain.NewChecker(suite.db, testrig.NewTestMXResolver())
+
+	blocked, err := checker.Blocked(context.Background(), "not an email address")
+	suite.EqualError(err, "error parsing email address not an email address: mail: no angle-addr")
+	suite.False(blocked)
+}
+
+func TestCheckerTestSuite(t *testing.T) {
+	suite.Run(t, &CheckerTestSuite{})
+}
@@ -294,6 +294,15 @@ func (d *deref) enrichAccount(ctx context.Context, requestUser string, uri *url.
 		}
 	}
 
+	if latestAcc.FeaturedCollectionURI != "" {
+		// Fetch the account's pinned statuses only now that the account is in the
+		// database, otherwise dereferencing the statuses would lead straight back
+		// here to dereference their author again.
+		if err := d.fetchRemoteAccountFeatured(ctx, requestUser, latestAcc); err != nil {
+			log.Errorf("error fetching featured collection for account %s: %v", uri, err)
+		}
+	}
+
 	return latestAcc, nil
 }
 
@@ -547,3 +556,129 @@ func (d *deref) fetchRemoteAccountEmojis(ctx context.Context, targetAccount *gts
 
 	return changed, nil
 }
+
+// fetchRemoteAccountFeatured dereferences the featured collection of the given remote account,
+// and updates which of the account's statuses are pinned so that they match the collection.
+func (d *deref) fetchRemoteAccountFeatured(ctx context.Context, requestUser string, account *gtsmodel.Account) error {
+	featuredURI, err := url.Parse(account.FeaturedCollectionURI)
+	if err != nil {
+		return fmt.Errorf("fetchRemoteAccountFeatured: invalid uri %q: %w", account.FeaturedCollectionURI, err)
+	}
+
+	tsport, err := d.transportController.NewTransportForUsername(ctx, requestUser)
+	if err != nil {
+		return fmt.Errorf("fetchRemoteAccountFeatured: couldn't create transport: %w", err)
+	}
+
+	b, err := tsport.Dereference(ctx, featuredURI)
+	if err != nil {
+		return fmt.Errorf("fetchRemoteAccountFeatured: error dereferencing %s: %w", featuredURI, err)
+	}
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("fetchRemoteAccountFeatured: error unmarshalling bytes into json: %w", err)
+	}
+
+	t, err := streams.ToType(ctx, m)
+	if err != nil {
+		return fmt.Errorf("fetchRemoteAccountFeatured: error resolving json into ap vocab type: %w", err)
+	}
+
+	// Mastodon serves an OrderedCollection here,
+	// but plain Collections are just as usable.
+	var statusURIs []*url.URL
+	switch t.GetTypeName() {
+	case ap.ObjectOrderedCollection:
+		collection, ok := t.(vocab.ActivityStreamsOrderedCollection)
+		if !ok {
+			return errors.New("fetchRemoteAccountFeatured: error resolving type as activitystreams ordered collection")
+		}
+
+		if items := collection.GetActivityStreamsOrderedItems(); items != nil {
+			for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+				statusURIs = appendFeaturedItemURI(statusURIs, iter.GetIRI(), iter.GetActivityStreamsNote())
+			}
+		}
+	case ap.ObjectCollection:
+		collection, ok := t.(vocab.ActivityStreamsCollection)
+		if !ok {
+			return errors.New("fetchRemoteAccountFeatured: error resolving type as activitystreams collection")
+		}
+
+		if items := collection.GetActivityStreamsItems(); items != nil {
+			for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+				statusURIs = appendFeaturedItemURI(statusURIs, iter.GetIRI(), iter.GetActivityStreamsNote())
+			}
+		}
+	default:
+		return fmt.Errorf("fetchRemoteAccountFeatured: type name %s not supported", t.GetTypeName())
+	}
+
+	// Dereference each of the featured statuses,
+	// making sure they really belong to this account.
+	pinnedIDs := make(map[string]struct{}, len(statusURIs))
+	for _, statusURI := range statusURIs {
+		if statusURI.Host != featuredURI.Host {
+			// Statuses can only be featured by the account that
+			// posted them, so this can't be legit; skip it.
+			continue
+		}
+
+		status, _, err := d.GetStatus(ctx, requestUser, statusURI, false, false)
+		if err != nil {
+			log.Errorf("fetchRemoteAccountFeatured: error dereferencing featured status %s: %v", statusURI, err)
+			continue
+		}
+
+		if status.AccountID != account.ID {
+			continue
+		}
+
+		pinnedIDs[status.ID] = struct{}{}
+
+		if !*status.Pinned {
+			pinned := true
+			status.Pinned = &pinned
+			if err := d.db.UpdateStatus(ctx, status); err != nil {
+				return fmt.Errorf("fetchRemoteAccountFeatured: error pinning status %s: %w", status.ID, err)
+			}
+		}
+	}
+
+	// Unpin any statuses that are no longer featured.
+	previouslyPinned, err := d.db.GetAccountPinnedStatuses(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("fetchRemoteAccountFeatured: error getting pinned statuses: %w", err)
+	}
+
+	for _, status := range previouslyPinned {
+		if _, ok := pinnedIDs[status.ID]; ok {
+			continue
+		}
+
+		pinned := false
+		status.Pinned = &pinned
+		if err := d.db.UpdateStatus(ctx, status); err != nil {
+			return fmt.Errorf("fetchRemoteAccountFeatured: error unpinning status %s: %w", status.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// appendFeaturedItemURI appends the URI of a featured collection item
+// to uris, whether the item is given as an IRI or an embedded Note.
+func appendFeaturedItemURI(uris []*url.URL, iri *url.URL, note vocab.ActivityStreamsNote) []*url.URL {
+	if iri != nil {
+		return append(uris, iri)
+	}
+
+	if note != nil {
+		if id := note.GetJSONLDId(); id != nil && id.GetIRI() != nil {
+			return append(uris, id.GetIRI())
+		}
+	}
+
+	return uris
+}
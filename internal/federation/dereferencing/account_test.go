@@ -220,6 +220,27 @@ func (suite *AccountTestSuite) TestDereferenceLocalAccountWithUnknownUserURI() {
 	suite.Nil(fetchedAccount)
 }
 
+func (suite *AccountTestSuite) TestDereferenceAccountFeatured() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	fetchedAccount, err := suite.dereferencer.GetAccountByURI(
+		context.Background(),
+		fetchingAccount.Username,
+		testrig.URLMustParse("https://turnip.farm/users/turniplover6969"),
+		false,
+	)
+	suite.NoError(err)
+	suite.NotNil(fetchedAccount)
+	suite.Equal("https://turnip.farm/users/turniplover6969/collections/featured", fetchedAccount.FeaturedCollectionURI)
+
+	// the status from the featured collection should now be stored + pinned
+	pinned, err := suite.db.GetAccountPinnedStatuses(context.Background(), fetchedAccount.ID)
+	suite.NoError(err)
+	suite.Len(pinned, 1)
+	suite.Equal("https://turnip.farm/users/turniplover6969/statuses/2f1195a6-5cb0-4475-adf5-92ab9a0147fe", pinned[0].URI)
+	suite.Equal(fetchedAccount.ID, pinned[0].AccountID)
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
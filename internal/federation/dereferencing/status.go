@@ -35,6 +35,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 )
 
 // EnrichRemoteStatus takes a remote status that's already been inserted into the database in a minimal form,
@@ -138,12 +139,28 @@ func (d *deref) GetStatus(ctx context.Context, username string, statusURI *url.U
 	}
 	status.ID = ulid
 
+	if status.Poll != nil {
+		// id the poll too, it's stored alongside the status
+		status.Poll.ID = id.NewULID()
+		status.PollID = status.Poll.ID
+	}
+
 	if err := d.populateStatusFields(ctx, status, username, includeParent); err != nil {
 		return nil, nil, newErrOther(fmt.Errorf("GetRemoteStatus: error populating status fields: %s", err))
 	}
 
-	if err := d.db.PutStatus(ctx, status); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
-		return nil, nil, newErrDB(fmt.Errorf("GetRemoteStatus: error putting new status: %s", err))
+	if err := d.db.PutStatus(ctx, status); err != nil {
+		if !errors.Is(err, db.ErrAlreadyExists) {
+			return nil, nil, newErrDB(fmt.Errorf("GetRemoteStatus: error putting new status: %s", err))
+		}
+
+		// the status was stored while we were dereferencing it (eg., while
+		// fetching the featured statuses of its author), so use that one
+		existing, err := d.db.GetStatusByURI(ctx, uriString)
+		if err != nil {
+			return nil, nil, newErrDB(fmt.Errorf("GetRemoteStatus: error getting already existing status: %s", err))
+		}
+		status = existing
 	}
 
 	return status, statusable, nil
@@ -174,7 +191,7 @@ func (d *deref) dereferenceStatusable(ctx context.Context, username string, remo
 		return nil, fmt.Errorf("DereferenceStatusable: error resolving json into ap vocab type: %s", err)
 	}
 
-	// Article, Document, Image, Video, Note, Page, Event, Place, Mention, Profile
+	// Article, Document, Image, Video, Note, Page, Event, Place, Mention, Profile, Question
 	switch t.GetTypeName() {
 	case ap.ObjectArticle:
 		p, ok := t.(vocab.ActivityStreamsArticle)
@@ -230,6 +247,12 @@ func (d *deref) dereferenceStatusable(ctx context.Context, username string, remo
 			return nil, errors.New("DereferenceStatusable: error resolving type as ActivityStreamsProfile")
 		}
 		return p, nil
+	case ap.ActivityQuestion:
+		p, ok := t.(vocab.ActivityStreamsQuestion)
+		if !ok {
+			return nil, errors.New("DereferenceStatusable: error resolving type as ActivityStreamsQuestion")
+		}
+		return p, nil
 	}
 
 	return nil, newErrWrongType(fmt.Errorf("DereferenceStatusable: type name %s not supported as Statusable", t.GetTypeName()))
@@ -286,7 +309,9 @@ func (d *deref) populateStatusFields(ctx context.Context, status *gtsmodel.Statu
 	}
 
 	// 2. Hashtags
-	// TODO
+	if err := d.populateStatusTags(ctx, status); err != nil {
+		return fmt.Errorf("populateStatusFields: error populating status tags: %s", err)
+	}
 
 	// 3. Emojis
 	if err := d.populateStatusEmojis(ctx, status, requestingUsername); err != nil {
@@ -408,6 +433,13 @@ func (d *deref) populateStatusAttachments(ctx context.Context, status *gtsmodel.
 	attachments := []*gtsmodel.MediaAttachment{}
 
 	for _, a := range status.Attachments {
+		if a.ID != "" {
+			// we've already got this attachment, since it has an ID
+			attachmentIDs = append(attachmentIDs, a.ID)
+			attachments = append(attachments, a)
+			continue
+		}
+
 		a.AccountID = status.AccountID
 		a.StatusID = status.ID
 
@@ -439,6 +471,66 @@ func (d *deref) populateStatusAttachments(ctx context.Context, status *gtsmodel.
 	return nil
 }
 
+func (d *deref) populateStatusTags(ctx context.Context, status *gtsmodel.Status) error {
+	// At this point, tags should just have the name
+	// and href set on them, as taken from the status.
+	// We don't trust the href, since tags should be
+	// served from our own instance, so we only use
+	// the name to look up or create the tag.
+
+	tagIDs := make([]string, 0, len(status.Tags))
+	tags := make([]*gtsmodel.Tag, 0, len(status.Tags))
+	for _, t := range status.Tags {
+		if t.ID == "" {
+			name, ok := text.NormalizeHashtag(t.Name)
+			if !ok {
+				log.Debugf("populateStatusTags: skipping invalid hashtag %q", t.Name)
+				continue
+			}
+
+			tag, err := d.db.TagStringToTag(ctx, name, status.AccountID)
+			if err != nil {
+				log.Debugf("populateStatusTags: skipping hashtag %q: %s", name, err)
+				continue
+			}
+
+			if err := d.db.PutTag(ctx, tag); err != nil {
+				if !errors.Is(err, db.ErrAlreadyExists) {
+					return fmt.Errorf("populateStatusTags: error putting tag %q: %s", name, err)
+				}
+
+				// tag already existed, so just
+				// mark it as having been used now
+				if err := d.db.UpdateTag(ctx, tag, "last_status_at"); err != nil {
+					return fmt.Errorf("populateStatusTags: error updating tag %q: %s", name, err)
+				}
+			}
+
+			t = tag
+		}
+
+		// don't include the same tag twice
+		duplicate := false
+		for _, id := range tagIDs {
+			if id == t.ID {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+
+		tagIDs = append(tagIDs, t.ID)
+		tags = append(tags, t)
+	}
+
+	status.TagIDs = tagIDs
+	status.Tags = tags
+
+	return nil
+}
+
 func (d *deref) populateStatusEmojis(ctx context.Context, status *gtsmodel.Status, requestingUsername string) error {
 	emojis, err := d.populateEmojis(ctx, status.Emojis, requestingUsername)
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
@@ -150,6 +151,11 @@ func (f *federatingDB) activityCreate(ctx context.Context, asType vocab.Type, re
 			if err := f.createNote(ctx, objectIter.GetActivityStreamsNote(), receivingAccount, requestingAccount); err != nil {
 				errs = append(errs, err.Error())
 			}
+		case ap.ActivityQuestion:
+			// CREATE A QUESTION (NOTE WITH A POLL)
+			if err := f.createNote(ctx, objectIter.GetActivityStreamsQuestion(), receivingAccount, requestingAccount); err != nil {
+				errs = append(errs, err.Error())
+			}
 		default:
 			errs = append(errs, fmt.Sprintf("received an object on a Create that we couldn't handle: %s", asObjectType.GetTypeName()))
 		}
@@ -162,8 +168,8 @@ func (f *federatingDB) activityCreate(ctx context.Context, asType vocab.Type, re
 	return nil
 }
 
-// createNote handles a Create activity with a Note type.
-func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStreamsNote, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) error {
+// createNote handles a Create activity with a Note or Question type.
+func (f *federatingDB) createNote(ctx context.Context, note ap.Statusable, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) error {
 	l := log.WithFields(kv.Fields{
 		{"receivingAccount", receivingAccount.URI},
 		{"requestingAccount", requestingAccount.URI},
@@ -212,6 +218,11 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 
 	// if we reach this point, we know it's not a forwarded status, so proceed with processing it as normal
 
+	// votes in polls are federated as notes too, so check this isn't one of those first
+	if isVote, err := f.createPollVote(ctx, note, requestingAccount); isVote {
+		return err
+	}
+
 	status, err := f.typeConverter.ASStatusToStatus(ctx, note)
 	if err != nil {
 		return fmt.Errorf("createNote: error converting note to status: %s", err)
@@ -224,6 +235,11 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	}
 	status.ID = statusID
 
+	if status.Poll != nil {
+		status.Poll.ID = id.NewULID()
+		status.PollID = status.Poll.ID
+	}
+
 	if err := f.db.PutStatus(ctx, status); err != nil {
 		if errors.Is(err, db.ErrAlreadyExists) {
 			// the status already exists in the database, which means we've already handled everything else,
@@ -244,6 +260,105 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	return nil
 }
 
+// createPollVote checks whether the given note is a vote in one of our polls,
+// which is a note with a name (the chosen option), but no content, in reply to
+// the status the poll is attached to. If it is, the vote will be stored and the
+// poll counts updated, and true will be returned along with any error.
+func (f *federatingDB) createPollVote(ctx context.Context, note ap.Statusable, requestingAccount *gtsmodel.Account) (bool, error) {
+	choiceName := ap.ExtractName(note)
+	if choiceName == "" || ap.ExtractContent(note) != "" {
+		// not a vote
+		return false, nil
+	}
+
+	inReplyTo := ap.ExtractInReplyToURI(note)
+	if inReplyTo == nil {
+		// not a vote
+		return false, nil
+	}
+
+	status, err := f.db.GetStatusByURI(ctx, inReplyTo.String())
+	if err != nil || !*status.Local || status.PollID == "" {
+		// not a vote in one of our polls
+		return false, nil
+	}
+
+	poll, err := f.db.GetPollByID(ctx, status.PollID)
+	if err != nil {
+		return true, fmt.Errorf("createPollVote: error getting poll %s: %s", status.PollID, err)
+	}
+
+	if poll.Closed() || poll.Expired(time.Now()) {
+		// too late to vote, ignore it
+		return true, nil
+	}
+
+	choice := -1
+	for i, option := range poll.Options {
+		if option == choiceName {
+			choice = i
+			break
+		}
+	}
+	if choice == -1 {
+		return true, fmt.Errorf("createPollVote: %q is not an option of poll %s", choiceName, poll.ID)
+	}
+
+	// remote accounts send one note per choice, so for multiple
+	// choice polls we may need to add to an existing vote
+	vote, err := f.db.GetPollVoteBy(ctx, poll.ID, requestingAccount.ID)
+	switch {
+	case errors.Is(err, db.ErrNoEntries):
+		vote = &gtsmodel.PollVote{
+			ID:        id.NewULID(),
+			PollID:    poll.ID,
+			AccountID: requestingAccount.ID,
+			Choices:   []int{choice},
+		}
+		if err := f.db.PutPollVote(ctx, vote); err != nil {
+			if errors.Is(err, db.ErrAlreadyExists) {
+				return true, nil
+			}
+			return true, fmt.Errorf("createPollVote: error putting vote: %s", err)
+		}
+	case err != nil:
+		return true, fmt.Errorf("createPollVote: error checking for existing vote: %s", err)
+	default:
+		if !*poll.Multiple {
+			// can only vote once
+			return true, nil
+		}
+
+		for _, c := range vote.Choices {
+			if c == choice {
+				// already counted
+				return true, nil
+			}
+		}
+
+		// copy rather than append, so we don't
+		// modify the slice of the cached vote
+		choices := make([]int, len(vote.Choices), len(vote.Choices)+1)
+		copy(choices, vote.Choices)
+		vote.Choices = append(choices, choice)
+		if err := f.db.UpdatePollVote(ctx, vote, "choices"); err != nil {
+			return true, fmt.Errorf("createPollVote: error updating vote: %s", err)
+		}
+	}
+
+	votes, err := f.db.GetPollVotes(ctx, poll.ID)
+	if err != nil {
+		return true, fmt.Errorf("createPollVote: error getting votes: %s", err)
+	}
+
+	poll.Tally(votes)
+	if err := f.db.UpdatePoll(ctx, poll, "votes", "voters"); err != nil {
+		return true, fmt.Errorf("createPollVote: error updating poll counts: %s", err)
+	}
+
+	return true, nil
+}
+
 /*
 	FOLLOW HANDLERS
 */
@@ -27,6 +27,7 @@ import (
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type CreateTestSuite struct {
@@ -129,6 +130,43 @@ func (suite *CreateTestSuite) TestCreateFlag1() {
 	}
 }
 
+func (suite *CreateTestSuite) TestCreatePollVote() {
+	receivingAccount := suite.testAccounts["local_account_2"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	poll := testrig.NewTestPolls()["local_account_2_status_7_poll"]
+	poll.Status = suite.testStatuses["local_account_2_status_7"]
+
+	// use the converter to generate a vote for
+	// the second option, as a remote would send it
+	creates, err := suite.tc.PollVoteToASCreates(context.Background(), &gtsmodel.PollVote{
+		ID:        "01GWD6S2TFFBN5TS2YFK5WQHNE",
+		PollID:    poll.ID,
+		Poll:      poll,
+		AccountID: requestingAccount.ID,
+		Account:   requestingAccount,
+		Choices:   []int{1},
+	})
+	suite.NoError(err)
+	suite.Len(creates, 1)
+
+	err = suite.federatingDB.Create(ctx, creates[0])
+	suite.NoError(err)
+
+	// the vote should be stored, and not processed as a status
+	vote, err := suite.db.GetPollVoteBy(context.Background(), poll.ID, requestingAccount.ID)
+	suite.NoError(err)
+	suite.Equal([]int{1}, vote.Choices)
+	suite.Empty(suite.fromFederator)
+
+	// the poll counts should have been updated
+	dbPoll, err := suite.db.GetPollByID(context.Background(), poll.ID)
+	suite.NoError(err)
+	suite.Equal([]int{1, 1, 0}, dbPoll.Votes)
+	suite.Equal(2, dbPoll.Voters)
+}
+
 func TestCreateTestSuite(t *testing.T) {
 	suite.Run(t, &CreateTestSuite{})
 }
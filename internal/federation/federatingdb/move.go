@@ -0,0 +1,104 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package federatingdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"codeberg.org/gruf/go-logger/v2/level"
+	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// Move handles a Move activity, in which the requesting account announces that it has moved to a new account.
+//
+// The actual verification of the target account, and the moving of followers, happens later in the processor.
+func (f *federatingDB) Move(ctx context.Context, move vocab.ActivityStreamsMove) error {
+	if log.Level() >= level.DEBUG {
+		i, err := marshalItem(move)
+		if err != nil {
+			return err
+		}
+		l := log.WithField("move", i)
+		l.Debug("entering Move")
+	}
+
+	receivingAccount, requestingAccount := extractFromCtx(ctx)
+	if receivingAccount == nil {
+		// If the receiving account wasn't set on the context, that means this request didn't pass
+		// through the API, but came from inside GtS as the result of another activity on this instance. That being so,
+		// we can safely just ignore this activity, since we know we've already processed it elsewhere.
+		return nil
+	}
+
+	if requestingAccount == nil {
+		return errors.New("Move: requesting account wasn't set on context")
+	}
+
+	var objectIRI *url.URL
+	if objectProp := move.GetActivityStreamsObject(); objectProp != nil {
+		for iter := objectProp.Begin(); iter != objectProp.End(); iter = iter.Next() {
+			if id, err := pub.ToId(iter); err == nil {
+				objectIRI = id
+				break
+			}
+		}
+	}
+	if objectIRI == nil {
+		return errors.New("Move: no object set on vocab.ActivityStreamsMove")
+	}
+
+	// accounts can only move themselves
+	if objectIRI.String() != requestingAccount.URI {
+		return fmt.Errorf("Move: object %s was not the same as requesting account %s", objectIRI, requestingAccount.URI)
+	}
+
+	var targetIRI *url.URL
+	if targetProp := move.GetActivityStreamsTarget(); targetProp != nil {
+		for iter := targetProp.Begin(); iter != targetProp.End(); iter = iter.Next() {
+			if id, err := pub.ToId(iter); err == nil {
+				targetIRI = id
+				break
+			}
+		}
+	}
+	if targetIRI == nil {
+		return errors.New("Move: no target set on vocab.ActivityStreamsMove")
+	}
+
+	if targetIRI.String() == requestingAccount.URI {
+		return errors.New("Move: account can't move to itself")
+	}
+
+	f.fedWorker.Queue(messages.FromFederator{
+		APObjectType:     ap.ObjectProfile,
+		APActivityType:   ap.ActivityMove,
+		GTSModel:         requestingAccount,
+		APIri:            targetIRI,
+		ReceivingAccount: receivingAccount,
+	})
+
+	return nil
+}
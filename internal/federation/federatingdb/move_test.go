@@ -0,0 +1,114 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package federatingdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type MoveTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *MoveTestSuite) move(actor string, object string, target string) vocab.ActivityStreamsMove {
+	raw := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + actor + `#moves/1",
+  "type": "Move",
+  "actor": "` + actor + `",
+  "object": "` + object + `",
+  "target": "` + target + `",
+  "to": "` + actor + `/followers"
+}`
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	move, ok := t.(vocab.ActivityStreamsMove)
+	if !ok {
+		suite.FailNow("type was not a move")
+	}
+
+	return move
+}
+
+func (suite *MoveTestSuite) TestMove() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+	targetURI := suite.testAccounts["remote_account_2"].URI
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	move := suite.move(requestingAccount.URI, requestingAccount.URI, targetURI)
+	if err := suite.federatingDB.Move(ctx, move); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// should be a message heading to the processor now, which we can intercept here
+	msg := <-suite.fromFederator
+	suite.Equal(ap.ObjectProfile, msg.APObjectType)
+	suite.Equal(ap.ActivityMove, msg.APActivityType)
+	suite.Equal(requestingAccount.ID, msg.GTSModel.(*gtsmodel.Account).ID)
+	suite.Equal(targetURI, msg.APIri.String())
+	suite.Equal(receivingAccount.ID, msg.ReceivingAccount.ID)
+}
+
+func (suite *MoveTestSuite) TestMoveSomeoneElse() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+	otherAccount := suite.testAccounts["remote_account_2"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	move := suite.move(requestingAccount.URI, otherAccount.URI, requestingAccount.URI)
+	err := suite.federatingDB.Move(ctx, move)
+	suite.EqualError(err, "Move: object http://example.org/users/Some_User was not the same as requesting account http://fossbros-anonymous.io/users/foss_satan")
+	suite.Empty(suite.fromFederator)
+}
+
+func (suite *MoveTestSuite) TestMoveToSelf() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	move := suite.move(requestingAccount.URI, requestingAccount.URI, requestingAccount.URI)
+	err := suite.federatingDB.Move(ctx, move)
+	suite.EqualError(err, "Move: account can't move to itself")
+	suite.Empty(suite.fromFederator)
+}
+
+func TestMoveTestSuite(t *testing.T) {
+	suite.Run(t, &MoveTestSuite{})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
@@ -146,5 +147,168 @@ func (f *federatingDB) Update(ctx context.Context, asType vocab.Type) error {
 		})
 	}
 
+	if typeName == ap.ActivityQuestion {
+		// it's an UPDATE to a poll, most likely with new vote counts
+		l.Debug("got update for QUESTION")
+		question, ok := asType.(vocab.ActivityStreamsQuestion)
+		if !ok {
+			return errors.New("UPDATE: could not convert type to question")
+		}
+
+		if err := f.updatePoll(ctx, question, requestingAcct); err != nil {
+			return err
+		}
+
+		// the question itself may also have been edited
+		return f.updateStatus(ctx, question, requestingAcct, receivingAccount)
+	}
+
+	if typeName == ap.ObjectNote {
+		// it's an UPDATE to a status, ie., an edit
+		l.Debug("got update for NOTE")
+		note, ok := asType.(vocab.ActivityStreamsNote)
+		if !ok {
+			return errors.New("UPDATE: could not convert type to note")
+		}
+
+		return f.updateStatus(ctx, note, requestingAcct, receivingAccount)
+	}
+
+	return nil
+}
+
+// updateStatus checks whether the given statusable is an edited version of
+// a status we already have. If so, the edited status is passed to the processor,
+// which stores the previous version as a revision and updates the status in place.
+func (f *federatingDB) updateStatus(ctx context.Context, statusable ap.Statusable, requestingAcct *gtsmodel.Account, receivingAccount *gtsmodel.Account) error {
+	idProp := statusable.GetJSONLDId()
+	if idProp == nil || !idProp.IsIRI() {
+		return errors.New("UPDATE: status had no id")
+	}
+
+	status, err := f.db.GetStatusByURI(ctx, idProp.GetIRI().String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// we don't know this status, nothing to update
+			return nil
+		}
+		return fmt.Errorf("UPDATE: error getting status %s: %s", idProp.GetIRI(), err)
+	}
+
+	if *status.Local {
+		// no need to update local statuses
+		return nil
+	}
+
+	if requestingAcct.URI != status.AccountURI {
+		return fmt.Errorf("UPDATE: update for status %s was requested by account %s, this is not valid", status.URI, requestingAcct.URI)
+	}
+
+	editedStatus, err := f.typeConverter.ASStatusToStatus(ctx, statusable)
+	if err != nil {
+		return fmt.Errorf("UPDATE: error converting to status: %s", err)
+	}
+
+	if !statusEdited(status, editedStatus) {
+		// nothing we care about has changed
+		return nil
+	}
+
+	// the processor will update the existing status
+	editedStatus.ID = status.ID
+
+	f.fedWorker.Queue(messages.FromFederator{
+		APObjectType:     ap.ObjectNote,
+		APActivityType:   ap.ActivityUpdate,
+		GTSModel:         editedStatus,
+		ReceivingAccount: receivingAccount,
+	})
+
 	return nil
 }
+
+// statusEdited returns true if the edited representation of a
+// status differs from the status in any of its editable fields.
+func statusEdited(status *gtsmodel.Status, editedStatus *gtsmodel.Status) bool {
+	if editedStatus.EditedAt.After(status.EditedAt) {
+		return true
+	}
+
+	if editedStatus.Content != status.Content ||
+		editedStatus.ContentWarning != status.ContentWarning ||
+		status.Sensitive == nil || *editedStatus.Sensitive != *status.Sensitive {
+		return true
+	}
+
+	if len(editedStatus.Attachments) != len(status.Attachments) {
+		return true
+	}
+
+	for i, a := range editedStatus.Attachments {
+		if a.RemoteURL != status.Attachments[i].RemoteURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// updatePoll updates the vote counts of the poll attached to the
+// status with the same URI as the given question. If the question has
+// been closed early, then the poll's expiry is brought forward, so that
+// it gets closed (and its voters notified) with the other expired polls.
+func (f *federatingDB) updatePoll(ctx context.Context, question vocab.ActivityStreamsQuestion, requestingAcct *gtsmodel.Account) error {
+	idProp := question.GetJSONLDId()
+	if idProp == nil || !idProp.IsIRI() {
+		return errors.New("UPDATE: question had no id")
+	}
+
+	status, err := f.db.GetStatusByURI(ctx, idProp.GetIRI().String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// we don't know this status, nothing to update
+			return nil
+		}
+		return fmt.Errorf("UPDATE: error getting status %s: %s", idProp.GetIRI(), err)
+	}
+
+	if *status.Local || status.PollID == "" {
+		// no need to update local polls,
+		// or statuses without a poll
+		return nil
+	}
+
+	if requestingAcct.URI != status.AccountURI {
+		return fmt.Errorf("UPDATE: update for status %s was requested by account %s, this is not valid", status.URI, requestingAcct.URI)
+	}
+
+	poll, err := f.db.GetPollByID(ctx, status.PollID)
+	if err != nil {
+		return fmt.Errorf("UPDATE: error getting poll %s: %s", status.PollID, err)
+	}
+
+	updatedPoll, err := f.typeConverter.ASQuestionToPoll(ctx, question)
+	if err != nil {
+		return fmt.Errorf("UPDATE: error converting question to poll: %s", err)
+	}
+
+	columns := []string{}
+
+	if len(updatedPoll.Votes) == len(poll.Options) {
+		poll.Votes = updatedPoll.Votes
+		poll.Voters = updatedPoll.Voters
+		columns = append(columns, "votes", "voters")
+	}
+
+	if updatedPoll.Closed() && !poll.Closed() &&
+		(poll.ExpiresAt.IsZero() || updatedPoll.ClosedAt.Before(poll.ExpiresAt)) {
+		poll.ExpiresAt = updatedPoll.ClosedAt
+		columns = append(columns, "expires_at")
+	}
+
+	if len(columns) == 0 {
+		return nil
+	}
+
+	return f.db.UpdatePoll(ctx, poll, columns...)
+}
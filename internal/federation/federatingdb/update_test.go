@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package federatingdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type UpdateTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *UpdateTestSuite) editedNote(id string, attributedTo string, content string) vocab.Type {
+	raw := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + id + `",
+  "type": "Note",
+  "attributedTo": "` + attributedTo + `",
+  "content": "` + content + `",
+  "published": "2021-09-20T10:40:37Z",
+  "updated": "2022-11-05T11:00:00Z",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "cc": "` + attributedTo + `/followers"
+}`
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return t
+}
+
+func (suite *UpdateTestSuite) TestUpdateNote() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+	targetStatus := suite.testStatuses["remote_account_1_status_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	note := suite.editedNote(targetStatus.URI, requestingAccount.URI, "dark souls status bot: thoughts of cat")
+	if err := suite.federatingDB.Update(ctx, note); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// should be a message heading to the processor now, which we can intercept here
+	msg := <-suite.fromFederator
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityUpdate, msg.APActivityType)
+
+	// the edited status should be defined on the
+	// message, with the id of the existing status
+	editedStatus := msg.GTSModel.(*gtsmodel.Status)
+	suite.Equal(targetStatus.ID, editedStatus.ID)
+	suite.Equal("dark souls status bot: thoughts of cat", editedStatus.Content)
+	suite.False(editedStatus.EditedAt.IsZero())
+}
+
+func (suite *UpdateTestSuite) TestUpdateNoteWrongAccount() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_2"]
+	targetStatus := suite.testStatuses["remote_account_1_status_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	note := suite.editedNote(targetStatus.URI, suite.testAccounts["remote_account_1"].URI, "i'm not foss satan")
+	err := suite.federatingDB.Update(ctx, note)
+	suite.EqualError(err, "UPDATE: update for status http://fossbros-anonymous.io/users/foss_satan/statuses/01FVW7JHQFSFK166WWKR8CBA6M was requested by account http://example.org/users/Some_User, this is not valid")
+	suite.Empty(suite.fromFederator)
+}
+
+func (suite *UpdateTestSuite) TestUpdateNoteUnknown() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	note := suite.editedNote("http://fossbros-anonymous.io/users/foss_satan/statuses/01GQ5J3XCFS8XRBGT0SZ1VGPSC", requestingAccount.URI, "we've never seen this one")
+	if err := suite.federatingDB.Update(ctx, note); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// nothing to update so nothing should be queued
+	suite.Empty(suite.fromFederator)
+}
+
+func TestUpdateTestSuite(t *testing.T) {
+	suite.Run(t, &UpdateTestSuite{})
+}
@@ -51,7 +51,7 @@ type Account struct {
 	Note                    string           `validate:"-" bun:""`                                                                                                   // A note that this account has on their profile (ie., the account's bio/description of themselves)
 	NoteRaw                 string           `validate:"-" bun:""`                                                                                                   // The raw contents of .Note without conversion to HTML, only available when requester = target
 	Memorial                *bool            `validate:"-" bun:",default:false"`                                                                                     // Is this a memorial account, ie., has the user passed away?
-	AlsoKnownAs             string           `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                // This account is associated with x account id (TODO: migrate to be AlsoKnownAsID)
+	AlsoKnownAsURIs         []string         `validate:"dive,url" bun:"also_known_as_uris,array"`                                                                    // URIs of accounts that this account is also known as, ie., accounts that are allowed to move to this account
 	MovedToAccountID        string           `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                // This account has moved this account id in the database
 	Bot                     *bool            `validate:"-" bun:",default:false"`                                                                                     // Does this account identify itself as a bot?
 	Reason                  string           `validate:"-" bun:""`                                                                                                   // What reason was given for signing up when this account was created?
@@ -92,6 +92,17 @@ func (a Account) IsRemote() bool {
 	return !a.IsLocal()
 }
 
+// HasAlias returns whether the account with the given URI
+// is one of the accounts that this account is also known as.
+func (a Account) HasAlias(uri string) bool {
+	for _, alias := range a.AlsoKnownAsURIs {
+		if alias == uri {
+			return true
+		}
+	}
+	return false
+}
+
 // IsInstance returns whether account is an instance internal actor account.
 func (a Account) IsInstance() bool {
 	return a.Username == a.Domain ||
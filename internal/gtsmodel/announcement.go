@@ -0,0 +1,58 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Announcement represents an admin announcement for the instance,
+// shown to local users while it's published and not yet over.
+type Announcement struct {
+	ID                  string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt           time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt           time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Text                string    `validate:"required" bun:",nullzero,notnull"`                                    // markdown text of the announcement, as submitted by the admin
+	Content             string    `validate:"-" bun:""`                                                            // html-formatted content of the announcement
+	StartsAt            time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when the event this announcement is about starts, if any
+	EndsAt              time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when the event this announcement is about ends, if any; the announcement is unpublished at this time
+	AllDay              *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // startsAt and endsAt are days rather than times
+	ScheduledAt         time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when the announcement should be published, if it wasn't published right away
+	Published           *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // is the announcement visible to users?
+	PublishedAt         time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when was the announcement (last) published
+	MentionedAccountIDs []string  `validate:"dive,ulid" bun:"mentioned_accounts,array"`                            // ids of accounts mentioned in the text
+	TagIDs              []string  `validate:"dive,ulid" bun:"tags,array"`                                          // ids of hashtags used in the text
+	EmojiIDs            []string  `validate:"dive,ulid" bun:"emojis,array"`                                        // ids of custom emojis used in the text
+}
+
+// AnnouncementReaction represents an emoji reaction of one account to an announcement.
+type AnnouncementReaction struct {
+	ID             string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                              // id of this item in the database
+	CreatedAt      time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                       // when was item created
+	AnnouncementID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:announcementreactionaccountname"` // id of the announcement reacted to
+	AccountID      string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:announcementreactionaccountname"` // id of the account that reacted
+	Name           string    `validate:"required" bun:",nullzero,notnull,unique:announcementreactionaccountname"`                   // unicode emoji, or shortcode of a custom emoji
+	EmojiID        string    `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                               // id of the custom emoji, if name is a shortcode
+	Emoji          *Emoji    `validate:"-" bun:"-"`                                                                                 // custom emoji corresponding to emojiID
+}
+
+// AnnouncementDismissal marks an announcement as read/dismissed by one account.
+type AnnouncementDismissal struct {
+	AnnouncementID string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull"`               // id of the dismissed announcement
+	AccountID      string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull"`               // id of the account that dismissed it
+	CreatedAt      time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+}
@@ -0,0 +1,61 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Conversation represents direct messages between a local account and
+// a set of other accounts, within one thread. Each local participant in
+// a direct message thread has their own conversation entry, so that read
+// state can be tracked and conversations deleted per account.
+type Conversation struct {
+	ID               string     `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                    // id of this item in the database
+	CreatedAt        time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                             // when was item created
+	UpdatedAt        time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                             // when was item last updated
+	AccountID        string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:conversationaccountthreadparticipants"` // id of the local account that owns this conversation
+	Account          *Account   `validate:"-" bun:"-"`                                                                                       // account corresponding to accountID
+	OtherAccountIDs  []string   `validate:"dive,ulid" bun:"other_accounts,array"`                                                            // ids of the other participants in this conversation
+	OtherAccounts    []*Account `validate:"-" bun:"-"`                                                                                       // accounts corresponding to otherAccountIDs
+	OtherAccountsKey string     `validate:"-" bun:",notnull,unique:conversationaccountthreadparticipants"`                                   // sorted, comma-joined otherAccountIDs, for looking up a conversation by participants
+	ThreadID         string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:conversationaccountthreadparticipants"` // id of the status at the root of the thread this conversation is in
+	LastStatusID     string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                                              // id of the latest status in this conversation
+	LastStatus       *Status    `validate:"-" bun:"-"`                                                                                       // status corresponding to lastStatusID
+	Read             *bool      `validate:"-" bun:",nullzero,notnull,default:false"`                                                         // has the account read the latest status in this conversation?
+}
+
+// ConversationToStatus is an intermediate struct to facilitate the many2many relationship between a conversation and the statuses in it.
+type ConversationToStatus struct {
+	ConversationID string        `validate:"ulid,required" bun:"type:CHAR(26),unique:conversationstatus,nullzero,notnull"`
+	Conversation   *Conversation `validate:"-" bun:"rel:belongs-to"`
+	StatusID       string        `validate:"ulid,required" bun:"type:CHAR(26),unique:conversationstatus,nullzero,notnull"`
+	Status         *Status       `validate:"-" bun:"rel:belongs-to"`
+}
+
+// ConversationOtherAccountsKey returns the key used to look up a conversation
+// by its other participants; the order of the given account IDs doesn't matter.
+func ConversationOtherAccountsKey(otherAccountIDs []string) string {
+	ids := make([]string, len(otherAccountIDs))
+	copy(ids, otherAccountIDs)
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
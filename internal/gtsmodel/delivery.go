@@ -0,0 +1,54 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Delivery represents a delivery of an ActivityStreams message to a remote inbox which
+// has failed, and which is queued to be retried with backoff until it succeeds or expires.
+type Delivery struct {
+	ID            string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created (ie., when did the first delivery attempt fail)
+	UpdatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	PubKeyID      string    `validate:"required,url" bun:",nullzero,notnull"`                                // URI of the public key of the local account that the delivery should be signed with
+	InboxURI      string    `validate:"required,url" bun:",nullzero,notnull"`                                // URI of the inbox to deliver to
+	Payload       []byte    `validate:"required" bun:"type:bytea,nullzero,notnull"`                          // serialized ActivityStreams message to deliver
+	Attempts      int       `validate:"-" bun:",notnull,default:0"`                                          // number of times delivery has been attempted so far
+	LastError     string    `validate:"-" bun:",nullzero"`                                                   // error returned by the last delivery attempt
+	NextAttemptAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull"`                           // when should the delivery next be attempted
+}
+
+// FailingInbox represents a remote inbox that deliveries have been failing to.
+//
+// Inboxes that keep failing for long enough are marked as unreachable, and
+// deliveries to them are then only attempted every so often. As soon as
+// a delivery succeeds, the inbox is no longer considered to be failing.
+type FailingInbox struct {
+	ID            string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created (ie., when did deliveries to the inbox start failing)
+	UpdatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	URI           string    `validate:"required,url" bun:",nullzero,notnull,unique"`                         // URI of the inbox
+	LastFailedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull"`                           // when did the most recent delivery to this inbox fail
+	UnreachableAt time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when was this inbox marked as unreachable, if at all
+}
+
+// Unreachable returns whether this inbox has been marked as unreachable.
+func (f *FailingInbox) Unreachable() bool {
+	return !f.UnreachableAt.IsZero()
+}
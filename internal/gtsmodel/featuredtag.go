@@ -0,0 +1,37 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// FeaturedTagsMax is the maximum amount of hashtags
+// that one account can feature on their profile.
+const FeaturedTagsMax = 10
+
+// FeaturedTag represents one account featuring a hashtag on their profile.
+type FeaturedTag struct {
+	ID            string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item created
+	UpdatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item last updated
+	AccountID     string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:featuredtagaccounttag"` // id of the account featuring the tag
+	TagID         string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:featuredtagaccounttag"` // id of the featured tag
+	Tag           *Tag      `validate:"-" bun:"-"`                                                                       // the featured tag
+	StatusesCount int       `validate:"-" bun:"-"`                                                                       // amount of public/unlisted statuses by the account using the tag; populated by the database
+	LastStatusAt  time.Time `validate:"-" bun:"-"`                                                                       // creation time of the latest public/unlisted status by the account using the tag; populated by the database
+}
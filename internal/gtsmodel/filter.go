@@ -0,0 +1,136 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+// Filter stores a filter created by a local account.
+type Filter struct {
+	ID                   string           `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	ExpiresAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero"`                                   // Time filter should expire. If null, should not expire.
+	AccountID            string           `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                  // ID of the local account that created the filter.
+	Title                string           `validate:"required" bun:",nullzero,notnull"`                                    // The name of the filter.
+	Action               FilterAction     `validate:"oneof=warn hide" bun:",nullzero,notnull,default:'warn'"`              // The action to take.
+	Keywords             []*FilterKeyword `validate:"-" bun:"-"`                                                           // Keywords for this filter.
+	ContextHome          *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter to home timeline and lists.
+	ContextNotifications *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter to notifications.
+	ContextPublic        *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter to public timelines.
+	ContextThread        *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter when viewing a status's associated thread.
+	ContextAccount       *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter when viewing an account profile.
+}
+
+// AppliesTo returns true if the filter is set to
+// be applied in the given context, false otherwise.
+func (f *Filter) AppliesTo(filterContext FilterContext) bool {
+	var setting *bool
+
+	switch filterContext {
+	case FilterContextHome:
+		setting = f.ContextHome
+	case FilterContextNotifications:
+		setting = f.ContextNotifications
+	case FilterContextPublic:
+		setting = f.ContextPublic
+	case FilterContextThread:
+		setting = f.ContextThread
+	case FilterContextAccount:
+		setting = f.ContextAccount
+	}
+
+	return setting != nil && *setting
+}
+
+// Expired returns true if the filter has an expiry time set, and that time has passed.
+func (f *Filter) Expired(now time.Time) bool {
+	return !f.ExpiresAt.IsZero() && !f.ExpiresAt.After(now)
+}
+
+// FilterKeyword stores a single keyword to filter statuses against.
+type FilterKeyword struct {
+	ID        string         `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                     // id of this item in the database
+	CreatedAt time.Time      `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                              // when was item created
+	UpdatedAt time.Time      `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                              // when was item last updated
+	AccountID string         `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                                               // ID of the local account that created the filter keyword.
+	FilterID  string         `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero,unique:filter_keywords_filter_id_keyword_uniq"` // ID of the filter that this keyword belongs to.
+	Filter    *Filter        `validate:"-" bun:"-"`                                                                                        // Filter corresponding to FilterID
+	Keyword   string         `validate:"required" bun:",nullzero,notnull,unique:filter_keywords_filter_id_keyword_uniq"`                   // The keyword or phrase to filter against.
+	WholeWord *bool          `validate:"-" bun:",nullzero,notnull,default:false"`                                                          // Should the filter consider word boundaries?
+	Regexp    *regexp.Regexp `validate:"-" bun:"-"`                                                                                        // pre-prepared regular expression
+}
+
+// Compile will compile this FilterKeyword as a prepared regular expression.
+func (k *FilterKeyword) Compile() (err error) {
+	var wordBreakStart, wordBreakEnd string
+
+	if k.Keyword == "" {
+		return errors.New("cannot compile empty keyword")
+	}
+
+	if k.WholeWord != nil && *k.WholeWord {
+		// Only add word boundaries where the keyword
+		// itself starts or ends with a word character,
+		// otherwise \b would never match next to it.
+		if isWordByte(k.Keyword[0]) {
+			wordBreakStart = `\b`
+		}
+		if isWordByte(k.Keyword[len(k.Keyword)-1]) {
+			wordBreakEnd = `\b`
+		}
+	}
+
+	// Compile keyword filter regexp.
+	quoted := regexp.QuoteMeta(k.Keyword)
+	k.Regexp, err = regexp.Compile(`(?i)` + wordBreakStart + quoted + wordBreakEnd)
+	return // caller is expected to wrap this error
+}
+
+// isWordByte returns whether b is a word
+// character, as per regexp `\w` definition.
+func isWordByte(b byte) bool {
+	return b == '_' ||
+		('0' <= b && b <= '9') ||
+		('a' <= b && b <= 'z') ||
+		('A' <= b && b <= 'Z')
+}
+
+// FilterAction represents the action to take
+// when a status matches one of a filter's keywords.
+type FilterAction string
+
+const (
+	FilterActionWarn FilterAction = "warn" // Show the status behind a warning.
+	FilterActionHide FilterAction = "hide" // Don't show the status at all.
+)
+
+// FilterContext represents the context in which a filter is applied.
+type FilterContext string
+
+const (
+	FilterContextHome          FilterContext = "home"          // Home timeline and lists.
+	FilterContextNotifications FilterContext = "notifications" // Notifications timeline.
+	FilterContextPublic        FilterContext = "public"        // Public timelines.
+	FilterContextThread        FilterContext = "thread"        // Expanded thread of a detailed status.
+	FilterContextAccount       FilterContext = "account"       // Viewing an account profile.
+)
@@ -0,0 +1,40 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Marker stores a local account's read position in one of their timelines.
+type Marker struct {
+	AccountID  string     `validate:"required,ulid" bun:"type:CHAR(26),pk,unique:markers_account_id_timeline_uniq,notnull,nullzero"` // id of the local account that owns the marker
+	Name       MarkerName `validate:"oneof=home notifications" bun:",pk,unique:markers_account_id_timeline_uniq,notnull,nullzero"`   // name of the marked timeline
+	UpdatedAt  time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                           // when marker was last updated
+	Version    int        `validate:"-" bun:",notnull"`                                                                              // incremented on every update, to detect concurrent updates
+	LastReadID string     `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                                            // id of the last read status or notification
+}
+
+// MarkerName is the name of one of the timelines we can store markers for.
+type MarkerName string
+
+const (
+	// MarkerNameHome is the marker for the home timeline.
+	MarkerNameHome MarkerName = "home"
+	// MarkerNameNotifications is the marker for notifications.
+	MarkerNameNotifications MarkerName = "notifications"
+)
@@ -108,9 +108,9 @@ type Original struct {
 	Height    int      `validate:"required_with=Width Size Aspect"`   // height in pixels
 	Size      int      `validate:"required_with=Width Height Aspect"` // size in pixels (width * height)
 	Aspect    float32  `validate:"required_with=Width Height Size"`   // aspect ratio (width / height)
-	Duration  *float32 `validate:"-"`                                 // video-specific: duration of the video in seconds
+	Duration  *float32 `validate:"-"`                                 // video/audio-specific: duration of the media in seconds
 	Framerate *float32 `validate:"-"`                                 // video-specific: fps
-	Bitrate   *uint64  `validate:"-"`                                 // video-specific: bitrate
+	Bitrate   *uint64  `validate:"-"`                                 // video/audio-specific: bitrate
 }
 
 // Focus describes the 'center' of the image for display purposes.
@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+const (
+	// PollMinExpiry is the shortest duration a poll may be open for.
+	PollMinExpiry = 5 * time.Minute
+	// PollMaxExpiry is the longest duration a poll may be open for (roughly one month).
+	PollMaxExpiry = 2629746 * time.Second
+)
+
+// Poll represents a poll attached to a status, either local or remote.
+type Poll struct {
+	ID         string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	StatusID   string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`           // ID of the status this poll is attached to
+	Status     *Status   `validate:"-" bun:"-"`                                                           // Status corresponding to StatusID
+	Multiple   *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Can voters choose more than one option?
+	HideCounts *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Hide vote counts until the poll has closed?
+	Options    []string  `validate:"min=1" bun:",array,nullzero,notnull"`                                 // Titles of the options voters can choose between
+	Votes      []int     `validate:"-" bun:",array"`                                                      // Number of votes received by each option, indexed the same as Options
+	Voters     int       `validate:"min=0" bun:",notnull,default:0"`                                      // Number of unique accounts that have voted in this poll
+	ExpiresAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // When does this poll stop accepting votes? Null if it never expires
+	ClosedAt   time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // When was this poll closed? Null while it is still open
+}
+
+// Expired returns true if the poll has an
+// expiry time set, and that time has passed.
+func (p *Poll) Expired(now time.Time) bool {
+	return !p.ExpiresAt.IsZero() && !p.ExpiresAt.After(now)
+}
+
+// Closed returns true if the poll has been closed.
+func (p *Poll) Closed() bool {
+	return !p.ClosedAt.IsZero()
+}
+
+// TotalVotes returns the sum of votes
+// received across all options of the poll.
+func (p *Poll) TotalVotes() int {
+	var total int
+	for _, count := range p.Votes {
+		total += count
+	}
+	return total
+}
+
+// Tally recounts the votes and voters of the poll
+// from the given votes, which should be all votes
+// that have been cast in this poll.
+func (p *Poll) Tally(votes []*PollVote) {
+	p.Votes = make([]int, len(p.Options))
+	p.Voters = len(votes)
+
+	for _, vote := range votes {
+		for _, choice := range vote.Choices {
+			if choice >= 0 && choice < len(p.Votes) {
+				p.Votes[choice]++
+			}
+		}
+	}
+}
+
+// PollVote represents the choice(s) made by one account in a poll.
+type PollVote struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                 // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                          // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                          // when was item last updated
+	PollID    string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:poll_votes_poll_id_account_id_uniq"` // ID of the poll this vote was cast in
+	Poll      *Poll     `validate:"-" bun:"-"`                                                                                    // Poll corresponding to PollID
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:poll_votes_poll_id_account_id_uniq"` // ID of the account that cast this vote
+	Account   *Account  `validate:"-" bun:"-"`                                                                                    // Account corresponding to AccountID
+	Choices   []int     `validate:"min=1" bun:",array,nullzero,notnull"`                                                          // Indices of the chosen options
+}
@@ -0,0 +1,52 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// ScheduledStatusMinDelay is how far in the future
+// a status must at least be scheduled for.
+const ScheduledStatusMinDelay = 5 * time.Minute
+
+// ScheduledStatus represents a status that a local account
+// has scheduled to be published at a later time. It holds
+// the parameters the status will be created with.
+type ScheduledStatus struct {
+	ID              string             `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                     // id of this item in the database
+	CreatedAt       time.Time          `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item created
+	UpdatedAt       time.Time          `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item last updated
+	AccountID       string             `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                               // ID of the local account that scheduled the status
+	Account         *Account           `validate:"-" bun:"-"`                                                                        // Account corresponding to AccountID
+	ApplicationID   string             `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                               // ID of the application the status was scheduled with
+	ScheduledAt     time.Time          `validate:"required" bun:"type:timestamptz,nullzero,notnull"`                                 // When should the status be published?
+	Text            string             `validate:"-" bun:""`                                                                         // Text of the status, as submitted
+	Format          string             `validate:"-" bun:",nullzero"`                                                                // Format to parse the text with
+	ContentWarning  string             `validate:"-" bun:",nullzero"`                                                                // Content warning / spoiler text of the status
+	Sensitive       *bool              `validate:"-" bun:",nullzero,notnull,default:false"`                                          // Mark the status and its media as sensitive?
+	Visibility      Visibility         `validate:"oneof=public unlocked followers_only mutuals_only direct" bun:",nullzero,notnull"` // Visibility of the status
+	Language        string             `validate:"-" bun:",nullzero"`                                                                // Language of the status
+	InReplyToID     string             `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                      // ID of the status being replied to, if any
+	AttachmentIDs   []string           `validate:"dive,ulid" bun:"attachments,array"`                                                // IDs of media attachments reserved for this status
+	Attachments     []*MediaAttachment `validate:"-" bun:"-"`                                                                        // Attachments corresponding to AttachmentIDs
+	PollOptions     []string           `validate:"-" bun:",array"`                                                                   // Options of the poll to attach, if any
+	PollExpiresIn   int                `validate:"-" bun:",nullzero"`                                                                // Seconds the poll will be open for after publishing
+	PollMultiple    *bool              `validate:"-" bun:",nullzero,notnull,default:false"`                                          // Can poll voters choose more than one option?
+	PollHideTotals  *bool              `validate:"-" bun:",nullzero,notnull,default:false"`                                          // Hide poll vote counts until the poll has closed?
+	PublishAttempts int                `validate:"-" bun:",notnull,default:0"`                                                       // Number of times publishing has failed so far
+}
@@ -0,0 +1,37 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// StatusEdit represents one earlier revision of a status,
+// as it was before the status was edited by its author.
+type StatusEdit struct {
+	ID             string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt      time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when this revision of the status was posted (ie., when the status was created, or last edited before this revision)
+	StatusID       string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // ID of the status this is a revision of
+	Content        string    `validate:"-" bun:""`                                                            // content of the status at this revision
+	ContentWarning string    `validate:"-" bun:",nullzero"`                                                   // cw string of the status at this revision
+	Text           string    `validate:"-" bun:""`                                                            // original text of the status at this revision, without formatting
+	Language       string    `validate:"-" bun:",nullzero"`                                                   // language of the status at this revision
+	Sensitive      *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // was the status marked as sensitive at this revision?
+	AttachmentIDs  []string  `validate:"dive,ulid" bun:"attachments,array"`                                   // Database IDs of media attachments of the status at this revision
+	EmojiIDs       []string  `validate:"dive,ulid" bun:"emojis,array"`                                        // Database IDs of emojis used in the status at this revision
+	PollOptions    []string  `validate:"-" bun:",array"`                                                      // Titles of the poll options of the status at this revision, if it had a poll
+}
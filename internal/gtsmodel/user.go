@@ -39,7 +39,7 @@ type User struct {
 	LastSignInAt           time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                   // When did this user last sign in?
 	LastSignInIP           net.IP       `validate:"-" bun:",nullzero"`                                                   // What's the previous IP of this user?
 	SignInCount            int          `validate:"min=0" bun:",notnull,default:0"`                                      // How many times has this user signed in?
-	InviteID               string       `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                         // id of the user who invited this user (who let this joker in?)
+	InviteID               string       `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                         // id of the invite this user signed up with (who let this joker in?)
 	ChosenLanguages        []string     `validate:"-" bun:",nullzero"`                                                   // What languages does this user want to see?
 	FilteredLanguages      []string     `validate:"-" bun:",nullzero"`                                                   // What languages does this user not want to see?
 	Locale                 string       `validate:"-" bun:",nullzero"`                                                   // In what timezone/locale is this user located?
@@ -57,4 +57,8 @@ type User struct {
 	ResetPasswordToken     string       `validate:"required_with=ResetPasswordSentAt" bun:",nullzero"`                   // The generated token that the user can use to reset their password
 	ResetPasswordSentAt    time.Time    `validate:"required_with=ResetPasswordToken" bun:"type:timestamptz,nullzero"`    // When did we email the user their reset-password email?
 	ExternalID             string       `validate:"-" bun:",nullzero,unique"`                                            // If the login for the user is managed externally (e.g OIDC), we need to keep a stable reference to the external object (e.g OIDC sub claim)
+	TwoFactorSecret        string       `validate:"required_with=TwoFactorEnabledAt" bun:",nullzero"`                    // base32 TOTP secret of this user; set during two-factor setup, and only enforced once TwoFactorEnabledAt is set
+	TwoFactorEnabledAt     time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                   // When did the user enable two-factor authentication? Zero if it's not enabled.
+	TwoFactorRecoveryCodes []string     `validate:"-" bun:",array"`                                                      // bcrypt hashes of the unused one-time recovery codes that can be used instead of a TOTP code
+	TwoFactorLastUsedStep  int64        `validate:"-" bun:",nullzero"`                                                   // TOTP step (periods since the unix epoch) of the last code accepted for this user, so codes can't be replayed
 }
@@ -0,0 +1,43 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// UserMute refers to one account muting another account.
+//
+// Statuses from a muted account are hidden from the home timeline of the
+// account that muted it, and notifications from the muted account can be
+// hidden too. Unlike blocks, mutes are never federated.
+type UserMute struct {
+	ID              string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                // id of this item in the database
+	CreatedAt       time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`         // when was item created
+	UpdatedAt       time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`         // when was item last updated
+	ExpiresAt       time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                           // when does this mute expire? zero time means never
+	AccountID       string    `validate:"required,ulid" bun:"type:CHAR(26),unique:usermutesrctarget,notnull,nullzero"` // Who does this mute originate from?
+	Account         *Account  `validate:"-" bun:"rel:belongs-to"`                                                      // Account corresponding to accountID
+	TargetAccountID string    `validate:"required,ulid" bun:"type:CHAR(26),unique:usermutesrctarget,notnull,nullzero"` // Who is the target of this mute?
+	TargetAccount   *Account  `validate:"-" bun:"rel:belongs-to"`                                                      // Account corresponding to targetAccountID
+	Notifications   *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                                     // Also hide notifications from the target account?
+}
+
+// Expired returns true if the mute has an expiry time, and it has passed.
+func (m *UserMute) Expired(now time.Time) bool {
+	return !m.ExpiresAt.IsZero() && !m.ExpiresAt.After(now)
+}
@@ -0,0 +1,78 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// WebPushSubscription represents one OAuth token's subscription to Web Push notifications.
+// See https://www.w3.org/TR/push-api/ and https://docs.joinmastodon.org/methods/push/
+type WebPushSubscription struct {
+	ID                  string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt           time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt           time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID           string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // id of the account that owns this subscription
+	TokenID             string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`           // id of the token this subscription was created with; each token can have only one subscription
+	Endpoint            string    `validate:"required,url" bun:",nullzero,notnull"`                                // push service endpoint to deliver notifications to
+	Auth                string    `validate:"required" bun:",nullzero,notnull"`                                    // base64url encoded auth secret of the subscription
+	P256dh              string    `validate:"required" bun:",nullzero,notnull"`                                    // base64url encoded P-256 ECDH public key of the subscription
+	NotifyFollow        *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // push 'follow' notifications?
+	NotifyFollowRequest *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // push 'follow_request' notifications?
+	NotifyFavourite     *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // push 'favourite' notifications?
+	NotifyMention       *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // push 'mention' notifications?
+	NotifyReblog        *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // push 'reblog' notifications?
+	NotifyPoll          *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // push 'poll' notifications?
+	NotifyStatus        *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // push 'status' notifications?
+	NotifyAdminSignUp   *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // push 'admin.sign_up' notifications?
+}
+
+// Notifies returns true if this subscription
+// wants notifications of the given type.
+func (s *WebPushSubscription) Notifies(notificationType NotificationType) bool {
+	var notify *bool
+
+	switch notificationType {
+	case NotificationFollow:
+		notify = s.NotifyFollow
+	case NotificationFollowRequest:
+		notify = s.NotifyFollowRequest
+	case NotificationFave:
+		notify = s.NotifyFavourite
+	case NotificationMention:
+		notify = s.NotifyMention
+	case NotificationReblog:
+		notify = s.NotifyReblog
+	case NotificationPoll:
+		notify = s.NotifyPoll
+	case NotificationStatus:
+		notify = s.NotifyStatus
+	case NotificationSignup:
+		notify = s.NotifyAdminSignUp
+	}
+
+	return notify != nil && *notify
+}
+
+// VAPIDKeyPair is the key pair this instance uses to identify itself
+// to Web Push services. There is only ever one key pair per instance.
+// See https://datatracker.ietf.org/doc/html/rfc8292
+type VAPIDKeyPair struct {
+	ID      int    `validate:"-" bun:",pk,notnull"`              // id of this item in the database; always 1
+	Public  string `validate:"required" bun:",nullzero,notnull"` // base64url encoded uncompressed P-256 public key
+	Private string `validate:"required" bun:",nullzero,notnull"` // base64url encoded P-256 private key
+}
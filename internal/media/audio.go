@@ -0,0 +1,344 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// audioThumbnailSize is the width and height
+// of the placeholder image generated for audio.
+const audioThumbnailSize = 512
+
+type gtsAudio struct {
+	duration float32 // in seconds
+	bitrate  uint64
+}
+
+// decodeAudio reads the duration and bitrate from the given audio stream of the given
+// content type; size is the total size of the stream in bytes, for bitrate calculations.
+func decodeAudio(r io.Reader, contentType string, size int64) (*gtsAudio, error) {
+	var (
+		audio gtsAudio
+		err   error
+	)
+
+	br := bufio.NewReader(r)
+
+	switch contentType {
+	case mimeAudioMpeg:
+		err = decodeMP3(br, size, &audio)
+	case mimeAudioOgg:
+		err = decodeOgg(br, &audio)
+	case mimeAudioFlac:
+		err = decodeFLAC(br, &audio)
+	default:
+		err = fmt.Errorf("unsupported audio type %s", contentType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if audio.duration <= 0 {
+		return nil, errors.New("error determining audio metadata: [duration]")
+	}
+
+	if audio.bitrate == 0 {
+		// not in the stream, so
+		// use the average bitrate
+		audio.bitrate = uint64(float64(size*8) / float64(audio.duration))
+	}
+
+	return &audio, nil
+}
+
+var (
+	// mp3BitratesV1 and mp3BitratesV2 are the bitrates in kbps for each
+	// bitrate index of MPEG-1, and MPEG-2 (and 2.5) audio, for layers I-III.
+	mp3BitratesV1 = [3][15]uint64{
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+	}
+	mp3BitratesV2 = [3][15]uint64{
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	}
+
+	// mp3SampleRates are the sample rates for each sample
+	// rate index of MPEG-1, MPEG-2 and MPEG-2.5 audio.
+	mp3SampleRates = [3][3]uint64{
+		{44100, 48000, 32000},
+		{22050, 24000, 16000},
+		{11025, 12000, 8000},
+	}
+)
+
+// mp3Frame is a parsed MPEG audio frame header.
+type mp3Frame struct {
+	version    int // 0 for MPEG-1, 1 for MPEG-2, 2 for MPEG-2.5
+	layer      int // 1-3
+	bitrate    uint64
+	sampleRate uint64
+	mono       bool
+}
+
+// parseMP3Frame parses the MPEG audio frame header at
+// the start of b, returning false if it's not valid.
+func parseMP3Frame(b []byte) (mp3Frame, bool) {
+	if len(b) < 4 || b[0] != 0xff || b[1]&0xe0 != 0xe0 {
+		return mp3Frame{}, false
+	}
+
+	var f mp3Frame
+	switch (b[1] >> 3) & 3 {
+	case 0:
+		f.version = 2
+	case 2:
+		f.version = 1
+	case 3:
+		f.version = 0
+	default:
+		return mp3Frame{}, false
+	}
+
+	f.layer = 4 - int((b[1]>>1)&3)
+	if f.layer == 4 {
+		return mp3Frame{}, false
+	}
+
+	bitrateIdx, sampleRateIdx := b[2]>>4, (b[2]>>2)&3
+	if bitrateIdx == 0 || bitrateIdx == 15 || sampleRateIdx == 3 {
+		// free format or invalid
+		return mp3Frame{}, false
+	}
+
+	if f.version == 0 {
+		f.bitrate = mp3BitratesV1[f.layer-1][bitrateIdx] * 1000
+	} else {
+		f.bitrate = mp3BitratesV2[f.layer-1][bitrateIdx] * 1000
+	}
+	f.sampleRate = mp3SampleRates[f.version][sampleRateIdx]
+	f.mono = b[3]>>6 == 3
+
+	return f, true
+}
+
+// samplesPerFrame returns the number of samples in each frame.
+func (f mp3Frame) samplesPerFrame() uint64 {
+	switch {
+	case f.layer == 1:
+		return 384
+	case f.layer == 3 && f.version != 0:
+		return 576
+	default:
+		return 1152
+	}
+}
+
+// decodeMP3 reads metadata from an MPEG audio (layer III, or I and II) stream,
+// using the frame count from a Xing / Info or VBRI header in the first frame if
+// present, and otherwise assuming a constant bitrate.
+func decodeMP3(br *bufio.Reader, size int64, audio *gtsAudio) error {
+	var offset int64
+
+	// skip any ID3v2 tag
+	if hdr, err := br.Peek(10); err == nil && bytes.HasPrefix(hdr, []byte("ID3")) {
+		tagSize := int64(hdr[6]&0x7f)<<21 | int64(hdr[7]&0x7f)<<14 | int64(hdr[8]&0x7f)<<7 | int64(hdr[9]&0x7f)
+		tagSize += 10
+		if hdr[5]&0x10 != 0 {
+			// footer present
+			tagSize += 10
+		}
+		if _, err := br.Discard(int(tagSize)); err != nil {
+			return fmt.Errorf("error skipping id3 tag: %w", err)
+		}
+		offset += tagSize
+	}
+
+	// find the first frame; this should be
+	// right away, but allow for some junk
+	const maxScan = 64 * 1024
+	var (
+		frame mp3Frame
+		found bool
+	)
+	for i := 0; i < maxScan; i++ {
+		hdr, err := br.Peek(4)
+		if err != nil {
+			return fmt.Errorf("error finding mp3 frame: %w", err)
+		}
+		if frame, found = parseMP3Frame(hdr); found {
+			break
+		}
+		_, _ = br.Discard(1)
+		offset++
+	}
+	if !found {
+		return errors.New("no mp3 frame found")
+	}
+
+	// look for a Xing / Info header after the side
+	// information, or a VBRI header at a fixed offset
+	var frames uint64
+	if b, _ := br.Peek(4 + 32 + 16); len(b) == 4+32+16 {
+		sideInfo := 32
+		switch {
+		case frame.version == 0 && frame.mono:
+			sideInfo = 17
+		case frame.version != 0 && frame.mono:
+			sideInfo = 9
+		case frame.version != 0:
+			sideInfo = 17
+		}
+
+		xing := b[4+sideInfo:]
+		vbri := b[4+32:]
+		switch {
+		case frame.layer == 3 && (bytes.HasPrefix(xing, []byte("Xing")) || bytes.HasPrefix(xing, []byte("Info"))):
+			if flags := binary.BigEndian.Uint32(xing[4:]); flags&1 != 0 {
+				frames = uint64(binary.BigEndian.Uint32(xing[8:]))
+			}
+		case bytes.HasPrefix(vbri, []byte("VBRI")):
+			frames = uint64(binary.BigEndian.Uint32(vbri[14:]))
+		}
+	}
+
+	if frames > 0 {
+		audio.duration = float32(float64(frames*frame.samplesPerFrame()) / float64(frame.sampleRate))
+		return nil
+	}
+
+	// constant bitrate
+	audio.bitrate = frame.bitrate
+	audio.duration = float32(float64((size-offset)*8) / float64(frame.bitrate))
+	return nil
+}
+
+// decodeOgg reads metadata from an Ogg stream containing Opus or Vorbis audio, using
+// the granule position of the last page of the first logical bitstream as its length.
+func decodeOgg(br *bufio.Reader, audio *gtsAudio) error {
+	var (
+		serial   uint32
+		rate     uint64
+		preSkip  uint64
+		granule  uint64
+		hdr      [27]byte
+		segments [255]byte
+	)
+
+	for first := true; ; first = false {
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			if errors.Is(err, io.EOF) && !first {
+				break
+			}
+			return fmt.Errorf("error reading ogg page: %w", err)
+		}
+
+		if !bytes.Equal(hdr[:4], []byte("OggS")) || hdr[4] != 0 {
+			return errors.New("invalid ogg page")
+		}
+
+		nSegments := int(hdr[26])
+		if _, err := io.ReadFull(br, segments[:nSegments]); err != nil {
+			return fmt.Errorf("error reading ogg page: %w", err)
+		}
+		var pageSize int
+		for _, s := range segments[:nSegments] {
+			pageSize += int(s)
+		}
+
+		pageSerial := binary.LittleEndian.Uint32(hdr[14:])
+		if first {
+			// identify the codec from the first packet,
+			// which must be the first on the first page
+			serial = pageSerial
+			peek := pageSize
+			if peek > 32 {
+				peek = 32
+			}
+			packet, err := br.Peek(peek)
+			if err != nil {
+				return fmt.Errorf("error reading ogg page: %w", err)
+			}
+
+			switch {
+			case bytes.HasPrefix(packet, []byte("OpusHead")) && len(packet) >= 19:
+				// granule positions are always at 48kHz
+				rate = 48000
+				preSkip = uint64(binary.LittleEndian.Uint16(packet[10:]))
+			case bytes.HasPrefix(packet, []byte("\x01vorbis")) && len(packet) >= 30:
+				rate = uint64(binary.LittleEndian.Uint32(packet[12:]))
+			default:
+				return errors.New("unsupported ogg codec")
+			}
+		}
+
+		// granule position is -1 for pages
+		// with no packets ending on them
+		if g := binary.LittleEndian.Uint64(hdr[6:]); pageSerial == serial && g != ^uint64(0) {
+			granule = g
+		}
+
+		if _, err := br.Discard(pageSize); err != nil {
+			return fmt.Errorf("error reading ogg page: %w", err)
+		}
+	}
+
+	if rate == 0 || granule <= preSkip {
+		return errors.New("error determining ogg duration")
+	}
+
+	audio.duration = float32(float64(granule-preSkip) / float64(rate))
+	return nil
+}
+
+// decodeFLAC reads metadata from the STREAMINFO block of a FLAC stream.
+func decodeFLAC(br *bufio.Reader, audio *gtsAudio) error {
+	// "fLaC", metadata block header, then
+	// STREAMINFO which is always first.
+	var b [4 + 4 + 34]byte
+	if _, err := io.ReadFull(br, b[:]); err != nil {
+		return fmt.Errorf("error reading flac header: %w", err)
+	}
+
+	if !bytes.Equal(b[:4], []byte("fLaC")) || b[4]&0x7f != 0 {
+		return errors.New("invalid flac header")
+	}
+
+	// 20 bits sample rate, 3 bits channels,
+	// 5 bits bits per sample, then 36 bits
+	// of total samples.
+	info := binary.BigEndian.Uint64(b[8+10:])
+	rate := info >> 44
+	samples := info & (1<<36 - 1)
+	if rate == 0 {
+		return errors.New("invalid flac sample rate")
+	}
+
+	audio.duration = float32(float64(samples) / float64(rate))
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+import "errors"
+
+// errEOS is returned when reading past the end of the bitstream.
+var errEOS = errors.New("unexpected end of bitstream")
+
+// bitReader reads bits and exp-golomb codes from
+// a raw byte sequence payload (ie., a NAL unit with
+// emulation prevention bytes already removed).
+type bitReader struct {
+	buf []byte
+	pos int // position in bits
+	err error
+}
+
+// unescapeRBSP removes the emulation prevention bytes from
+// the given NAL unit payload, returning the raw byte sequence.
+func unescapeRBSP(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	zeros := 0
+	for _, c := range b {
+		if zeros >= 2 && c == 0x03 {
+			// emulation_prevention_three_byte
+			zeros = 0
+			continue
+		}
+		if c == 0 {
+			zeros++
+		} else {
+			zeros = 0
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// u reads n bits (n <= 32) as an unsigned integer.
+func (r *bitReader) u(n int) uint32 {
+	var v uint32
+	for i := 0; i < n; i++ {
+		v = v<<1 | r.bit()
+	}
+	return v
+}
+
+// bit reads a single bit.
+func (r *bitReader) bit() uint32 {
+	if r.pos >= len(r.buf)*8 {
+		r.err = errEOS
+		return 0
+	}
+	b := r.buf[r.pos>>3] >> (7 - r.pos&7) & 1
+	r.pos++
+	return uint32(b)
+}
+
+// flag reads a single bit as a boolean.
+func (r *bitReader) flag() bool {
+	return r.bit() == 1
+}
+
+// ue reads an unsigned exp-golomb coded integer.
+func (r *bitReader) ue() uint32 {
+	zeros := 0
+	for r.bit() == 0 {
+		if r.err != nil || zeros >= 32 {
+			r.err = errors.New("invalid exp-golomb code")
+			return 0
+		}
+		zeros++
+	}
+	return (1<<zeros - 1) + r.u(zeros)
+}
+
+// se reads a signed exp-golomb coded integer.
+func (r *bitReader) se() int32 {
+	k := r.ue()
+	if k&1 == 1 {
+		return int32(k/2) + 1
+	}
+	return -int32(k / 2)
+}
+
+// peek returns the next n bits (n <= 32) without advancing,
+// padding with zero bits past the end of the bitstream.
+func (r *bitReader) peek(n int) uint32 {
+	var v uint32
+	for i := 0; i < n; i++ {
+		p := r.pos + i
+		v <<= 1
+		if p < len(r.buf)*8 {
+			v |= uint32(r.buf[p>>3] >> (7 - p&7) & 1)
+		}
+	}
+	return v
+}
+
+// skip advances n bits.
+func (r *bitReader) skip(n int) {
+	r.pos += n
+	if r.pos > len(r.buf)*8 {
+		r.err = errEOS
+	}
+}
+
+// aligned returns whether the reader is at a byte boundary.
+func (r *bitReader) aligned() bool {
+	return r.pos&7 == 0
+}
+
+// moreRBSPData returns whether there is more data before the rbsp_trailing_bits.
+func (r *bitReader) moreRBSPData() bool {
+	// find the last set bit, ie. the rbsp_stop_one_bit
+	last := len(r.buf) - 1
+	for last >= 0 && r.buf[last] == 0 {
+		last--
+	}
+	if last < 0 {
+		return false
+	}
+	stop := last*8 + 7
+	for c := r.buf[last]; c&1 == 0; c >>= 1 {
+		stop--
+	}
+	return r.pos < stop
+}
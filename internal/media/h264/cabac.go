@@ -0,0 +1,213 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+import "errors"
+
+// cabac is a CABAC arithmetic decoding engine,
+// along with its context variables, as specified
+// in ITU-T H.264 9.3.
+type cabac struct {
+	r      *bitReader
+	rng    uint32
+	offset uint32
+
+	// context variables; pStateIdx
+	// in the low 6 bits, valMPS above
+	ctx [numCtx]uint8
+}
+
+// initContexts initialises the context variables for an I slice with the given QP.
+func (c *cabac) initContexts(sliceQP int) {
+	qp := clip3(0, 51, sliceQP)
+	for _, init := range cabacInit {
+		for i, mn := range init.mn {
+			pre := clip3(1, 126, ((int(mn[0])*qp)>>4)+int(mn[1]))
+			if pre <= 63 {
+				c.ctx[init.start+i] = uint8(63 - pre)
+			} else {
+				c.ctx[init.start+i] = uint8(pre-64) | 64
+			}
+		}
+	}
+}
+
+// initEngine initialises the arithmetic decoding engine.
+func (c *cabac) initEngine() error {
+	c.rng = 510
+	c.offset = c.r.u(9)
+	if c.offset >= 510 {
+		return errors.New("invalid cabac offset")
+	}
+	return c.r.err
+}
+
+// decision decodes a bin using the context variable with the given ctxIdx.
+func (c *cabac) decision(ctxIdx int) uint32 {
+	state := c.ctx[ctxIdx]
+	pState, mps := state&63, uint32(state>>6)
+
+	lps := uint32(rangeTabLPS[pState][(c.rng>>6)&3])
+	c.rng -= lps
+
+	var bin uint32
+	if c.offset >= c.rng {
+		bin = 1 - mps
+		c.offset -= c.rng
+		c.rng = lps
+		if pState == 0 {
+			mps = 1 - mps
+		}
+		pState = transIdxLPS[pState]
+	} else {
+		bin = mps
+		if pState < 62 {
+			pState++
+		}
+	}
+	c.ctx[ctxIdx] = pState | uint8(mps<<6)
+
+	for c.rng < 256 {
+		c.rng <<= 1
+		c.offset = c.offset<<1 | c.r.bit()
+	}
+
+	return bin
+}
+
+// bypass decodes an equiprobable bin.
+func (c *cabac) bypass() uint32 {
+	c.offset = c.offset<<1 | c.r.bit()
+	if c.offset >= c.rng {
+		c.offset -= c.rng
+		return 1
+	}
+	return 0
+}
+
+// terminate decodes a bin before termination, ie.
+// end_of_slice_flag or the I_PCM bin of mb_type.
+func (c *cabac) terminate() uint32 {
+	c.rng -= 2
+	if c.offset >= c.rng {
+		return 1
+	}
+	for c.rng < 256 {
+		c.rng <<= 1
+		c.offset = c.offset<<1 | c.r.bit()
+	}
+	return 0
+}
+
+// residual decodes a residual_block_cabac() of the given ctxBlockCat into
+// coeffLevel, in scan order. cbfInc is the ctxIdxInc for coded_block_flag,
+// or -1 if coded_block_flag isn't present (and so inferred to be 1).
+// It returns whether the block has any non-zero coefficients.
+func (c *cabac) residual(cat int, cbfInc int, coeffLevel []int32) bool {
+	if cbfInc >= 0 && c.decision(codedBlockFlagCtx[cat]+cbfInc) == 0 {
+		return false
+	}
+
+	maxNumCoeff := len(coeffLevel)
+	var significant [64]bool
+
+	numCoeff := maxNumCoeff
+	for i := 0; i < numCoeff-1; i++ {
+		sigInc, lastInc := i, i
+		switch cat {
+		case 3:
+			// 4:2:0 chroma DC
+			sigInc = min(i, 2)
+			lastInc = sigInc
+		case 5:
+			sigInc = int(sigCoeffFlagInc8x8[i])
+			lastInc = int(lastCoeffFlagInc8x8[i])
+		}
+
+		if c.decision(sigCoeffFlagCtx[cat]+sigInc) == 1 {
+			significant[i] = true
+			if c.decision(lastCoeffFlagCtx[cat]+lastInc) == 1 {
+				numCoeff = i + 1
+				break
+			}
+		}
+	}
+	significant[numCoeff-1] = true
+
+	maxGt1Inc := 4
+	if cat == 3 {
+		maxGt1Inc = 3
+	}
+
+	numEq1, numGt1 := 0, 0
+	for i := numCoeff - 1; i >= 0; i-- {
+		if !significant[i] {
+			continue
+		}
+
+		// coeff_abs_level_minus1 prefix, TU with cMax = 14
+		ctx := coeffAbsLevelCtx[cat]
+		inc := 0
+		if numGt1 == 0 {
+			inc = min(4, 1+numEq1)
+		}
+
+		var abs int32
+		if c.decision(ctx+inc) == 1 {
+			inc = 5 + min(maxGt1Inc, numGt1)
+			abs = 1
+			for abs < 14 && c.decision(ctx+inc) == 1 {
+				abs++
+			}
+
+			if abs == 14 {
+				// suffix, bypass coded EG0
+				k := 0
+				for c.bypass() == 1 {
+					abs += 1 << k
+					if k++; k > 24 {
+						// not a valid level;
+						// bail out, the slice
+						// will fail to decode
+						c.r.err = errors.New("invalid coeff_abs_level_minus1")
+						return false
+					}
+				}
+				for k--; k >= 0; k-- {
+					abs += int32(c.bypass()) << k
+				}
+			}
+		}
+		abs++
+
+		if abs == 1 {
+			numEq1++
+		} else {
+			numGt1++
+		}
+
+		if c.bypass() == 1 {
+			coeffLevel[i] = -abs
+		} else {
+			coeffLevel[i] = abs
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,149 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+import "errors"
+
+var errInvalidCAVLC = errors.New("invalid cavlc residual")
+
+// cavlcResidual decodes a residual_block_cavlc() into coeffLevel, in scan
+// order, using the given nC (-1 for 4:2:0 chroma DC) to select the table for
+// coeff_token. It returns TotalCoeff(coeff_token).
+func cavlcResidual(r *bitReader, nC int, coeffLevel []int32) (int, error) {
+	maxNumCoeff := len(coeffLevel)
+
+	var (
+		token int
+		ok    bool
+	)
+	switch {
+	case nC == -1:
+		token, ok = chromaDCCoeffTokenVLC.read(r)
+	case nC < 2:
+		token, ok = coeffTokenVLC[0].read(r)
+	case nC < 4:
+		token, ok = coeffTokenVLC[1].read(r)
+	case nC < 8:
+		token, ok = coeffTokenVLC[2].read(r)
+	default:
+		token, ok = coeffTokenVLC[3].read(r)
+	}
+	if !ok {
+		return 0, errInvalidCAVLC
+	}
+
+	totalCoeff, trailingOnes := token>>2, token&3
+	if totalCoeff == 0 {
+		return 0, nil
+	}
+	if totalCoeff > maxNumCoeff {
+		return 0, errInvalidCAVLC
+	}
+
+	var levelVal [16]int32
+	suffixLength := 0
+	if totalCoeff > 10 && trailingOnes < 3 {
+		suffixLength = 1
+	}
+
+	for i := 0; i < totalCoeff; i++ {
+		if i < trailingOnes {
+			levelVal[i] = 1 - 2*int32(r.bit())
+			continue
+		}
+
+		prefix := 0
+		for r.bit() == 0 {
+			if prefix++; prefix > 32 || r.err != nil {
+				return 0, errInvalidCAVLC
+			}
+		}
+
+		levelCode := int32(min(15, prefix) << suffixLength)
+		if suffixLength > 0 || prefix >= 14 {
+			size := suffixLength
+			if prefix == 14 && suffixLength == 0 {
+				size = 4
+			} else if prefix >= 15 {
+				size = prefix - 3
+			}
+			if size > 0 {
+				levelCode += int32(r.u(size))
+			}
+		}
+		if prefix >= 15 && suffixLength == 0 {
+			levelCode += 15
+		}
+		if prefix >= 16 {
+			levelCode += (1 << (prefix - 3)) - 4096
+		}
+		if i == trailingOnes && trailingOnes < 3 {
+			levelCode += 2
+		}
+
+		if levelCode%2 == 0 {
+			levelVal[i] = (levelCode + 2) >> 1
+		} else {
+			levelVal[i] = (-levelCode - 1) >> 1
+		}
+
+		if suffixLength == 0 {
+			suffixLength = 1
+		}
+		if abs32(levelVal[i]) > (3<<(suffixLength-1)) && suffixLength < 6 {
+			suffixLength++
+		}
+	}
+
+	zerosLeft := 0
+	if totalCoeff < maxNumCoeff {
+		if nC == -1 {
+			zerosLeft, ok = chromaDCTotalZerosVLC[totalCoeff-1].read(r)
+		} else {
+			zerosLeft, ok = totalZerosVLC[totalCoeff-1].read(r)
+		}
+		if !ok {
+			return 0, errInvalidCAVLC
+		}
+	}
+
+	var runVal [16]int
+	for i := 0; i < totalCoeff-1; i++ {
+		if zerosLeft > 0 {
+			run, ok := runBeforeVLC[min(zerosLeft, 7)-1].read(r)
+			if !ok || run > zerosLeft {
+				return 0, errInvalidCAVLC
+			}
+			runVal[i] = run
+			zerosLeft -= run
+		}
+	}
+	runVal[totalCoeff-1] = zerosLeft
+
+	coeffNum := -1
+	for i := totalCoeff - 1; i >= 0; i-- {
+		coeffNum += runVal[i] + 1
+		if coeffNum >= maxNumCoeff {
+			return 0, errInvalidCAVLC
+		}
+		coeffLevel[coeffNum] = levelVal[i]
+	}
+
+	return totalCoeff, r.err
+}
@@ -0,0 +1,250 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+// This file contains the variable length code tables used by
+// CAVLC residual coding, as specified in ITU-T H.264 9.2.
+
+// vlc is a variable length code table, decoded
+// by looking up the code read so far at each length.
+type vlc struct {
+	codes [17]map[uint32]int // code length -> code -> value
+	max   int                // max code length
+}
+
+// newVLC creates a vlc from the given code lengths
+// and codes, for values 0 to len(lens)-1. Entries
+// with length 0 are not valid values.
+func newVLC(lens []uint8, codes []uint8) *vlc {
+	v := &vlc{}
+	for i, l := range lens {
+		if l == 0 {
+			continue
+		}
+		if v.codes[l] == nil {
+			v.codes[l] = make(map[uint32]int)
+		}
+		v.codes[l][uint32(codes[i])] = i
+		if int(l) > v.max {
+			v.max = int(l)
+		}
+	}
+	return v
+}
+
+// read reads a single code, returning the value it represents.
+func (v *vlc) read(r *bitReader) (int, bool) {
+	var code uint32
+	for l := 1; l <= v.max; l++ {
+		code = code<<1 | r.bit()
+		if val, ok := v.codes[l][code]; ok {
+			return val, true
+		}
+	}
+	return 0, false
+}
+
+var (
+	// coeff_token tables for 0 <= nC < 2, 2 <= nC < 4, 4 <= nC < 8
+	// and 8 <= nC, indexed by TotalCoeff * 4 + TrailingOnes.
+	coeffTokenVLC [4]*vlc
+
+	// coeff_token table for nC == -1 (4:2:0 chroma DC).
+	chromaDCCoeffTokenVLC *vlc
+
+	// total_zeros tables, indexed by TotalCoeff - 1.
+	totalZerosVLC [15]*vlc
+
+	// total_zeros tables for 4:2:0 chroma DC, indexed by TotalCoeff - 1.
+	chromaDCTotalZerosVLC [3]*vlc
+
+	// run_before tables, indexed by Min(zerosLeft, 7) - 1.
+	runBeforeVLC [7]*vlc
+)
+
+func init() {
+	coeffTokenLens := [4][4 * 17]uint8{
+		{
+			1, 0, 0, 0,
+			6, 2, 0, 0, 8, 6, 3, 0, 9, 8, 7, 5, 10, 9, 8, 6,
+			11, 10, 9, 7, 13, 11, 10, 8, 13, 13, 11, 9, 13, 13, 13, 10,
+			14, 14, 13, 11, 14, 14, 14, 13, 15, 15, 14, 14, 15, 15, 15, 14,
+			16, 15, 15, 15, 16, 16, 16, 15, 16, 16, 16, 16, 16, 16, 16, 16,
+		},
+		{
+			2, 0, 0, 0,
+			6, 2, 0, 0, 6, 5, 3, 0, 7, 6, 6, 4, 8, 6, 6, 4,
+			8, 7, 7, 5, 9, 8, 8, 6, 11, 9, 9, 6, 11, 11, 11, 7,
+			12, 11, 11, 9, 12, 12, 12, 11, 12, 12, 12, 11, 13, 13, 13, 12,
+			13, 13, 13, 13, 13, 14, 13, 13, 14, 14, 14, 13, 14, 14, 14, 14,
+		},
+		{
+			4, 0, 0, 0,
+			6, 4, 0, 0, 6, 5, 4, 0, 6, 5, 5, 4, 7, 5, 5, 4,
+			7, 5, 5, 4, 7, 6, 6, 4, 7, 6, 6, 4, 8, 7, 7, 5,
+			8, 8, 7, 6, 9, 8, 8, 7, 9, 9, 8, 8, 9, 9, 9, 8,
+			10, 9, 9, 9, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10,
+		},
+		{
+			6, 0, 0, 0,
+			6, 6, 0, 0, 6, 6, 6, 0, 6, 6, 6, 6, 6, 6, 6, 6,
+			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
+			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
+			6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
+		},
+	}
+	coeffTokenCodes := [4][4 * 17]uint8{
+		{
+			1, 0, 0, 0,
+			5, 1, 0, 0, 7, 4, 1, 0, 7, 6, 5, 3, 7, 6, 5, 3,
+			7, 6, 5, 4, 15, 6, 5, 4, 11, 14, 5, 4, 8, 10, 13, 4,
+			15, 14, 9, 4, 11, 10, 13, 12, 15, 14, 9, 12, 11, 10, 13, 8,
+			15, 1, 9, 12, 11, 14, 13, 8, 7, 10, 9, 12, 4, 6, 5, 8,
+		},
+		{
+			3, 0, 0, 0,
+			11, 2, 0, 0, 7, 7, 3, 0, 7, 10, 9, 5, 7, 6, 5, 4,
+			4, 6, 5, 6, 7, 6, 5, 8, 15, 6, 5, 4, 11, 14, 13, 4,
+			15, 10, 9, 4, 11, 14, 13, 12, 8, 10, 9, 8, 15, 14, 13, 12,
+			11, 10, 9, 12, 7, 11, 6, 8, 9, 8, 10, 1, 7, 6, 5, 4,
+		},
+		{
+			15, 0, 0, 0,
+			15, 14, 0, 0, 11, 15, 13, 0, 8, 12, 14, 12, 15, 10, 11, 11,
+			11, 8, 9, 10, 9, 14, 13, 9, 8, 10, 9, 8, 15, 14, 13, 13,
+			11, 14, 10, 12, 15, 10, 13, 12, 11, 14, 9, 12, 8, 10, 13, 8,
+			13, 7, 9, 12, 9, 12, 11, 10, 5, 8, 7, 6, 1, 4, 3, 2,
+		},
+		{
+			3, 0, 0, 0,
+			0, 1, 0, 0, 4, 5, 6, 0, 8, 9, 10, 11, 12, 13, 14, 15,
+			16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+			32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47,
+			48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63,
+		},
+	}
+	for i := range coeffTokenVLC {
+		coeffTokenVLC[i] = newVLC(coeffTokenLens[i][:], coeffTokenCodes[i][:])
+	}
+
+	chromaDCCoeffTokenVLC = newVLC(
+		[]uint8{
+			2, 0, 0, 0,
+			6, 1, 0, 0,
+			6, 6, 3, 0,
+			6, 7, 7, 6,
+			6, 8, 8, 7,
+		},
+		[]uint8{
+			1, 0, 0, 0,
+			7, 1, 0, 0,
+			4, 6, 1, 0,
+			3, 3, 2, 5,
+			2, 3, 2, 0,
+		},
+	)
+
+	totalZerosLens := [15][]uint8{
+		{1, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 9},
+		{3, 3, 3, 3, 3, 4, 4, 4, 4, 5, 5, 6, 6, 6, 6},
+		{4, 3, 3, 3, 4, 4, 3, 3, 4, 5, 5, 6, 5, 6},
+		{5, 3, 4, 4, 3, 3, 3, 4, 3, 4, 5, 5, 5},
+		{4, 4, 4, 3, 3, 3, 3, 3, 4, 5, 4, 5},
+		{6, 5, 3, 3, 3, 3, 3, 3, 4, 3, 6},
+		{6, 5, 3, 3, 3, 2, 3, 4, 3, 6},
+		{6, 4, 5, 3, 2, 2, 3, 3, 6},
+		{6, 6, 4, 2, 2, 3, 2, 5},
+		{5, 5, 3, 2, 2, 2, 4},
+		{4, 4, 3, 3, 1, 3},
+		{4, 4, 2, 1, 3},
+		{3, 3, 1, 2},
+		{2, 2, 1},
+		{1, 1},
+	}
+	totalZerosCodes := [15][]uint8{
+		{1, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 1},
+		{7, 6, 5, 4, 3, 5, 4, 3, 2, 3, 2, 3, 2, 1, 0},
+		{5, 7, 6, 5, 4, 3, 4, 3, 2, 3, 2, 1, 1, 0},
+		{3, 7, 5, 4, 6, 5, 4, 3, 3, 2, 2, 1, 0},
+		{5, 4, 3, 7, 6, 5, 4, 3, 2, 1, 1, 0},
+		{1, 1, 7, 6, 5, 4, 3, 2, 1, 1, 0},
+		{1, 1, 5, 4, 3, 3, 2, 1, 1, 0},
+		{1, 1, 1, 3, 3, 2, 2, 1, 0},
+		{1, 0, 1, 3, 2, 1, 1, 1},
+		{1, 0, 1, 3, 2, 1, 1},
+		{0, 1, 1, 2, 1, 3},
+		{0, 1, 1, 1, 1},
+		{0, 1, 1, 1},
+		{0, 1, 1},
+		{0, 1},
+	}
+	for i := range totalZerosVLC {
+		totalZerosVLC[i] = newVLC(totalZerosLens[i], totalZerosCodes[i])
+	}
+
+	chromaDCTotalZerosLens := [3][]uint8{
+		{1, 2, 3, 3},
+		{1, 2, 2},
+		{1, 1},
+	}
+	chromaDCTotalZerosCodes := [3][]uint8{
+		{1, 1, 1, 0},
+		{1, 1, 0},
+		{1, 0},
+	}
+	for i := range chromaDCTotalZerosVLC {
+		chromaDCTotalZerosVLC[i] = newVLC(chromaDCTotalZerosLens[i], chromaDCTotalZerosCodes[i])
+	}
+
+	runBeforeLens := [7][]uint8{
+		{1, 1},
+		{1, 2, 2},
+		{2, 2, 2, 2},
+		{2, 2, 2, 3, 3},
+		{2, 2, 3, 3, 3, 3},
+		{2, 3, 3, 3, 3, 3, 3},
+		{3, 3, 3, 3, 3, 3, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+	}
+	runBeforeCodes := [7][]uint8{
+		{1, 0},
+		{1, 1, 0},
+		{3, 2, 1, 0},
+		{3, 2, 1, 1, 0},
+		{3, 2, 3, 2, 1, 0},
+		{3, 0, 1, 3, 2, 5, 4},
+		{7, 6, 5, 4, 3, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+	}
+	for i := range runBeforeVLC {
+		runBeforeVLC[i] = newVLC(runBeforeLens[i], runBeforeCodes[i])
+	}
+}
+
+// intraCBP maps coded_block_pattern codeNums to
+// intra coded block patterns for 4:2:0 video.
+var intraCBP = [48]uint8{
+	47, 31, 15, 0, 23, 27, 29, 30, 7, 11, 13, 14, 39, 43, 45, 46,
+	16, 3, 5, 10, 12, 19, 21, 26, 28, 35, 37, 42, 44, 1, 2, 4,
+	8, 17, 18, 20, 24, 6, 9, 22, 25, 32, 33, 34, 36, 40, 38, 41,
+}
+
+// intraCBPMono maps coded_block_pattern codeNums
+// to intra coded block patterns for monochrome video.
+var intraCBPMono = [16]uint8{
+	15, 0, 7, 11, 13, 14, 3, 5, 10, 12, 1, 2, 4, 8, 6, 9,
+}
@@ -0,0 +1,251 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+// Tables of alpha (ITU-T H.264 Table 8-16), beta, and tC0 for bS = 3
+// (Table 8-17), indexed by indexA or indexB. Intra pictures only need
+// bS values of 3 and 4, so the rest of Table 8-17 is left out.
+var (
+	alphaTable = [52]int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		4, 4, 5, 6, 7, 8, 9, 10, 12, 13, 15, 17, 20, 22, 25, 28,
+		32, 36, 40, 45, 50, 56, 63, 71, 80, 90, 101, 113, 127, 144, 162, 182,
+		203, 226, 255, 255,
+	}
+	betaTable = [52]int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 6, 6, 7, 7, 8, 8,
+		9, 9, 10, 10, 11, 11, 12, 12, 13, 13, 14, 14, 15, 15, 16, 16,
+		17, 17, 18, 18,
+	}
+	tc0Table = [52]int32{
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 3,
+		3, 3, 4, 4, 4, 5, 6, 6, 7, 8, 9, 10, 11, 13, 14, 16,
+		18, 20, 23, 25,
+	}
+)
+
+// edgeFilter holds the parameters for filtering an edge.
+type edgeFilter struct {
+	bS          int
+	alpha, beta int32
+	tc0         int32
+	chroma      bool
+}
+
+// deblock applies the deblocking filter to the decoded picture,
+// as specified in ITU-T H.264 8.7, for intra macroblocks only.
+func (p *picture) deblock() {
+	lumaStride := p.widthMbs * 16
+	chromaStride := p.widthMbs * 8
+
+	for mbY := 0; mbY < p.heightMbs; mbY++ {
+		for mbX := 0; mbX < p.widthMbs; mbX++ {
+			mbAddr := mbY*p.widthMbs + mbX
+			mb := &p.mbs[mbAddr]
+			sp := &p.slices[mb.slice-1]
+			if sp.disableDeblocking == 1 {
+				continue
+			}
+
+			// the macroblocks across the left and top edges, if they're
+			// to be filtered; a disable_deblocking_filter_idc of 2 means
+			// edges shared with other slices aren't.
+			var mbA, mbB *macroblock
+			if mbX > 0 {
+				mbA = &p.mbs[mbAddr-1]
+				if sp.disableDeblocking == 2 && mbA.slice != mb.slice {
+					mbA = nil
+				}
+			}
+			if mbY > 0 {
+				mbB = &p.mbs[mbAddr-p.widthMbs]
+				if sp.disableDeblocking == 2 && mbB.slice != mb.slice {
+					mbB = nil
+				}
+			}
+
+			// luma: vertical edges, then horizontal
+			off := mbY*16*lumaStride + mbX*16
+			qp := mbQP(mb)
+			for e := 0; e < 4; e++ {
+				if mb.transform8x8 && e%2 == 1 {
+					continue
+				}
+				if e == 0 && mbA == nil {
+					continue
+				}
+				f := p.lumaFilter(sp, mbA, qp, e)
+				f.filter(p.luma, off+4*e, 1, lumaStride, 16)
+			}
+			for e := 0; e < 4; e++ {
+				if mb.transform8x8 && e%2 == 1 {
+					continue
+				}
+				if e == 0 && mbB == nil {
+					continue
+				}
+				f := p.lumaFilter(sp, mbB, qp, e)
+				f.filter(p.luma, off+4*e*lumaStride, lumaStride, 1, 16)
+			}
+
+			if p.cb == nil {
+				continue
+			}
+
+			off = mbY*8*chromaStride + mbX*8
+			for iCbCr, plane := range [][]byte{p.cb, p.cr} {
+				qpOffset := sp.cbQPOffset
+				if iCbCr == 1 {
+					qpOffset = sp.crQPOffset
+				}
+				qp := chromaQP[clip3(0, 51, mbQP(mb)+qpOffset)]
+				for e := 0; e < 2; e++ {
+					if e == 0 && mbA == nil {
+						continue
+					}
+					f := p.chromaFilter(sp, mbA, qp, qpOffset, e)
+					f.filter(plane, off+4*e, 1, chromaStride, 8)
+				}
+				for e := 0; e < 2; e++ {
+					if e == 0 && mbB == nil {
+						continue
+					}
+					f := p.chromaFilter(sp, mbB, qp, qpOffset, e)
+					f.filter(plane, off+4*e*chromaStride, chromaStride, 1, 8)
+				}
+			}
+		}
+	}
+}
+
+// mbQP returns QPY of the macroblock, as used for deblocking.
+func mbQP(mb *macroblock) int {
+	if mb.mbType == mbIPCM {
+		return 0
+	}
+	return mb.qp
+}
+
+// lumaFilter returns the filter for luma edge e (0 being the macroblock
+// edge with macroblock mbP) of a macroblock with the given QPY.
+func (p *picture) lumaFilter(sp *sliceParams, mbP *macroblock, qp int, e int) edgeFilter {
+	bS, qpP := 3, qp
+	if e == 0 {
+		bS, qpP = 4, mbQP(mbP)
+	}
+	return newEdgeFilter(sp, bS, (qpP+qp+1)>>1, false)
+}
+
+// chromaFilter returns the filter for chroma edge e (0 being the macroblock
+// edge with macroblock mbP) of a macroblock with the given QPC.
+func (p *picture) chromaFilter(sp *sliceParams, mbP *macroblock, qp int, qpOffset int, e int) edgeFilter {
+	bS, qpP := 3, qp
+	if e == 0 {
+		bS, qpP = 4, chromaQP[clip3(0, 51, mbQP(mbP)+qpOffset)]
+	}
+	return newEdgeFilter(sp, bS, (qpP+qp+1)>>1, true)
+}
+
+func newEdgeFilter(sp *sliceParams, bS int, qpAv int, chroma bool) edgeFilter {
+	indexA := clip3(0, 51, qpAv+sp.alphaOffset)
+	indexB := clip3(0, 51, qpAv+sp.betaOffset)
+	return edgeFilter{
+		bS:     bS,
+		alpha:  alphaTable[indexA],
+		beta:   betaTable[indexB],
+		tc0:    tc0Table[indexA],
+		chroma: chroma,
+	}
+}
+
+// filter filters n lines of samples across an edge, starting at off in plane,
+// where across is the distance between samples across the edge and along the
+// distance between lines.
+func (f edgeFilter) filter(plane []byte, off int, across int, along int, n int) {
+	if f.alpha == 0 || f.beta == 0 {
+		// nothing would be filtered
+		return
+	}
+
+	for k := 0; k < n; k++ {
+		i := off + k*along
+		p0, q0 := int32(plane[i-across]), int32(plane[i])
+		p1, q1 := int32(plane[i-2*across]), int32(plane[i+across])
+
+		if abs32(p0-q0) >= f.alpha || abs32(p1-p0) >= f.beta || abs32(q1-q0) >= f.beta {
+			continue
+		}
+
+		if f.chroma {
+			if f.bS == 4 {
+				plane[i-across] = byte((2*p1 + p0 + q1 + 2) >> 2)
+				plane[i] = byte((2*q1 + q0 + p1 + 2) >> 2)
+			} else {
+				tc := f.tc0 + 1
+				delta := int32(clip3(int(-tc), int(tc), int((((q0-p0)<<2)+(p1-q1)+4)>>3)))
+				plane[i-across] = clip1(p0 + delta)
+				plane[i] = clip1(q0 - delta)
+			}
+			continue
+		}
+
+		p2, q2 := int32(plane[i-3*across]), int32(plane[i+2*across])
+		ap, aq := abs32(p2-p0), abs32(q2-q0)
+
+		if f.bS == 4 {
+			strong := abs32(p0-q0) < (f.alpha>>2)+2
+			if ap < f.beta && strong {
+				p3 := int32(plane[i-4*across])
+				plane[i-across] = byte((p2 + 2*p1 + 2*p0 + 2*q0 + q1 + 4) >> 3)
+				plane[i-2*across] = byte((p2 + p1 + p0 + q0 + 2) >> 2)
+				plane[i-3*across] = byte((2*p3 + 3*p2 + p1 + p0 + q0 + 4) >> 3)
+			} else {
+				plane[i-across] = byte((2*p1 + p0 + q1 + 2) >> 2)
+			}
+			if aq < f.beta && strong {
+				q3 := int32(plane[i+3*across])
+				plane[i] = byte((p1 + 2*p0 + 2*q0 + 2*q1 + q2 + 4) >> 3)
+				plane[i+across] = byte((p0 + q0 + q1 + q2 + 2) >> 2)
+				plane[i+2*across] = byte((2*q3 + 3*q2 + q1 + q0 + p0 + 4) >> 3)
+			} else {
+				plane[i] = byte((2*q1 + q0 + p1 + 2) >> 2)
+			}
+			continue
+		}
+
+		tc := f.tc0
+		if ap < f.beta {
+			tc++
+		}
+		if aq < f.beta {
+			tc++
+		}
+		delta := int32(clip3(int(-tc), int(tc), int((((q0-p0)<<2)+(p1-q1)+4)>>3)))
+		plane[i-across] = clip1(p0 + delta)
+		plane[i] = clip1(q0 - delta)
+		if ap < f.beta {
+			plane[i-2*across] = byte(p1 + int32(clip3(int(-f.tc0), int(f.tc0), int((p2+((p0+q0+1)>>1)-(p1<<1))>>1))))
+		}
+		if aq < f.beta {
+			plane[i+across] = byte(q1 + int32(clip3(int(-f.tc0), int(f.tc0), int((q2+((p0+q0+1)>>1)-(q1<<1))>>1))))
+		}
+	}
+}
@@ -0,0 +1,348 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package h264 implements a decoder for the intra coded pictures of H.264
+// (MPEG-4 AVC) video, as specified in ITU-T H.264. It's intended to decode
+// key frames for thumbnails, so supports only what's needed for that: frame
+// (progressive) pictures with 8 bit 4:2:0 or monochrome samples, made up of
+// I slices coded with either CAVLC or CABAC. This covers keyframes of the
+// Baseline, Main and High profiles.
+package h264
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// maxFrameMbs is the largest frame size in macroblocks
+// we'll decode, which is MaxFS of the highest level (6.2).
+const maxFrameMbs = 139264
+
+// picture is a frame being decoded.
+type picture struct {
+	sps       *sps
+	widthMbs  int
+	heightMbs int
+
+	// decoded samples; chroma planes are
+	// nil for monochrome pictures
+	luma, cb, cr []byte
+
+	mbs    []macroblock
+	slices []sliceParams
+}
+
+// sliceParams holds the parameters of a slice
+// needed to deblock the macroblocks within it.
+type sliceParams struct {
+	disableDeblocking uint32
+	alphaOffset       int
+	betaOffset        int
+	cbQPOffset        int
+	crQPOffset        int
+}
+
+// sliceDecoder decodes the macroblocks of a single slice.
+type sliceDecoder struct {
+	pic   *picture
+	sps   *sps
+	pps   *pps
+	r     *bitReader
+	cabac *cabac // nil for CAVLC
+	slice int    // index of the slice + 1
+
+	qp          int         // QPY of the previous macroblock
+	prevMb      *macroblock // previous macroblock in decoding order
+	prevQPDelta int         // mb_qp_delta of the previous macroblock
+
+	res residual
+}
+
+// DecodeFrame decodes a picture from the given NAL units, which
+// should include the parameter sets, followed by all the slices
+// of an intra coded picture (ie., the access unit of a keyframe).
+func DecodeFrame(nalus [][]byte) (img image.Image, err error) {
+	defer func() {
+		// the decoder bounds checks what
+		// it parses, but be defensive as
+		// this is run on remote media.
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic decoding frame: %v", r)
+		}
+	}()
+
+	var (
+		spss = make(map[uint32]*sps)
+		ppss = make(map[uint32]*pps)
+		pic  *picture
+	)
+
+	for _, nalu := range nalus {
+		if len(nalu) < 2 {
+			continue
+		}
+
+		if nalu[0]&0x80 != 0 {
+			return nil, errors.New("forbidden_zero_bit set")
+		}
+		nalRefIdc := uint32(nalu[0]>>5) & 3
+		nalType := int(nalu[0] & 31)
+
+		switch nalType {
+		case nalSPS:
+			s, err := parseSPS(unescapeRBSP(nalu[1:]))
+			if err != nil {
+				return nil, fmt.Errorf("error parsing sps: %w", err)
+			}
+			spss[s.id] = s
+
+		case nalPPS:
+			p, err := parsePPS(unescapeRBSP(nalu[1:]), spss)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing pps: %w", err)
+			}
+			ppss[p.id] = p
+
+		case nalSlice, nalSliceIDR:
+			r := &bitReader{buf: unescapeRBSP(nalu[1:])}
+			h, p, s, err := parseSliceHeader(r, nalType, nalRefIdc, spss, ppss)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing slice header: %w", err)
+			}
+
+			if h.redundantPicCnt > 0 {
+				// we decode the primary
+				// coded picture instead
+				continue
+			}
+
+			if pic == nil {
+				pic = newPicture(s)
+			} else if s != pic.sps {
+				return nil, errors.New("slices refer to different sps")
+			}
+
+			if err := pic.decodeSlice(r, h, p); err != nil {
+				return nil, fmt.Errorf("error decoding slice: %w", err)
+			}
+
+		case 2, 3, 4:
+			return nil, errors.New("data partitioning not supported")
+		}
+	}
+
+	if pic == nil {
+		return nil, errors.New("no slices found")
+	}
+
+	for i := range pic.mbs {
+		if pic.mbs[i].slice == 0 {
+			return nil, fmt.Errorf("macroblock %d missing from picture", i)
+		}
+	}
+
+	pic.deblock()
+
+	return pic.image(), nil
+}
+
+// newPicture returns a new picture for the given sps.
+func newPicture(s *sps) *picture {
+	p := &picture{
+		sps:       s,
+		widthMbs:  s.widthMbs,
+		heightMbs: s.heightMbs,
+		mbs:       make([]macroblock, s.widthMbs*s.heightMbs),
+		luma:      make([]byte, s.widthMbs*s.heightMbs*256),
+	}
+	if s.chromaFormatIdc != 0 {
+		p.cb = make([]byte, s.widthMbs*s.heightMbs*64)
+		p.cr = make([]byte, s.widthMbs*s.heightMbs*64)
+	}
+	return p
+}
+
+// decodeSlice decodes the slice data following the given slice header.
+func (p *picture) decodeSlice(r *bitReader, h *sliceHeader, pp *pps) error {
+	p.slices = append(p.slices, sliceParams{
+		disableDeblocking: h.disableDeblocking,
+		alphaOffset:       h.alphaOffset,
+		betaOffset:        h.betaOffset,
+		cbQPOffset:        pp.chromaQPIndexOffset,
+		crQPOffset:        pp.secondChromaQPIndexOffset,
+	})
+
+	d := &sliceDecoder{
+		pic:   p,
+		sps:   p.sps,
+		pps:   pp,
+		r:     r,
+		slice: len(p.slices),
+		qp:    h.qp,
+	}
+
+	if pp.entropyCodingMode {
+		for !r.aligned() {
+			if r.bit() != 1 {
+				return errors.New("invalid cabac_alignment_one_bit")
+			}
+		}
+		d.cabac = &cabac{r: r}
+		d.cabac.initContexts(h.qp)
+		if err := d.cabac.initEngine(); err != nil {
+			return err
+		}
+	}
+
+	for mbAddr := h.firstMb; ; mbAddr++ {
+		if mbAddr >= len(p.mbs) {
+			return errors.New("slice extends past end of picture")
+		}
+
+		if err := d.decodeMacroblock(mbAddr); err != nil {
+			return fmt.Errorf("error decoding macroblock %d: %w", mbAddr, err)
+		}
+
+		if d.cabac != nil {
+			if d.cabac.terminate() == 1 {
+				// end_of_slice_flag
+				return nil
+			}
+		} else if !r.moreRBSPData() {
+			return nil
+		}
+
+		if r.err != nil {
+			return r.err
+		}
+	}
+}
+
+// mbAt returns the macroblock at the given position if
+// it's available, ie. in the picture and the current slice.
+func (d *sliceDecoder) mbAt(mbX, mbY int) *macroblock {
+	if mbX < 0 || mbY < 0 || mbX >= d.pic.widthMbs || mbY >= d.pic.heightMbs {
+		return nil
+	}
+	mb := &d.pic.mbs[mbY*d.pic.widthMbs+mbX]
+	if mb.slice != d.slice {
+		return nil
+	}
+	return mb
+}
+
+// neighbour returns the macroblock containing the given location, relative to
+// the top left of the current macroblock (in luma samples for size 16, chroma
+// samples for size 8), and the location within it; as specified in ITU-T H.264
+// 6.4.12. The macroblock is nil if it's not available.
+func (d *sliceDecoder) neighbour(mbX, mbY, x, y int, size int) (*macroblock, int, int) {
+	if y >= size || (x >= size && y >= 0) {
+		return nil, 0, 0
+	}
+
+	dx, dy := 0, 0
+	switch {
+	case x < 0:
+		dx = -1
+	case x >= size:
+		dx = 1
+	}
+	if y < 0 {
+		dy = -1
+	}
+
+	return d.mbAt(mbX+dx, mbY+dy), (x + size) % size, (y + size) % size
+}
+
+// image converts the decoded picture to
+// RGBA, applying the cropping rectangle.
+func (p *picture) image() image.Image {
+	s := p.sps
+	rect := image.Rect(0, 0,
+		p.widthMbs*16-s.cropLeft-s.cropRight,
+		p.heightMbs*16-s.cropTop-s.cropBottom,
+	)
+	img := image.NewRGBA(rect)
+
+	// Kr and Kb of the colour matrix; BT.601
+	// unless the stream says it's BT.709
+	kr, kb := 0.299, 0.114
+	if s.matrixCoefficients == 1 {
+		kr, kb = 0.2126, 0.0722
+	}
+	kg := 1 - kr - kb
+
+	// scale of luma and chroma to full range
+	yScale, cScale := 255.0/219.0, 255.0/224.0
+	yOffset := int32(16)
+	if s.fullRange {
+		yScale, cScale = 1, 1
+		yOffset = 0
+	}
+
+	// fixed point coefficients, 16 fractional bits
+	fixed := func(f float64) int32 { return int32(f*65536 + 0.5) }
+	var (
+		yc  = fixed(yScale)
+		crR = fixed(2 * (1 - kr) * cScale)
+		cbG = fixed(2 * kb * (1 - kb) / kg * cScale)
+		crG = fixed(2 * kr * (1 - kr) / kg * cScale)
+		cbB = fixed(2 * (1 - kb) * cScale)
+	)
+
+	lumaStride := p.widthMbs * 16
+	chromaStride := p.widthMbs * 8
+	for y := 0; y < rect.Dy(); y++ {
+		ly := y + s.cropTop
+		for x := 0; x < rect.Dx(); x++ {
+			lx := x + s.cropLeft
+
+			yy := (int32(p.luma[ly*lumaStride+lx]) - yOffset) * yc
+			cb, cr := int32(0), int32(0)
+			if p.cb != nil {
+				ci := (ly/2)*chromaStride + lx/2
+				cb, cr = int32(p.cb[ci])-128, int32(p.cr[ci])-128
+			}
+
+			img.SetRGBA(x, y, color.RGBA{
+				R: clipFixed(yy + crR*cr),
+				G: clipFixed(yy - cbG*cb - crG*cr),
+				B: clipFixed(yy + cbB*cb),
+				A: 0xff,
+			})
+		}
+	}
+
+	return img
+}
+
+// clipFixed rounds a fixed point
+// value with 16 fractional bits,
+// clipping it to a sample value.
+func clipFixed(v int32) uint8 {
+	v = (v + 1<<15) >> 16
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return uint8(v)
+}
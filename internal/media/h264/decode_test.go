@@ -0,0 +1,175 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264_test
+
+import (
+	"image"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/abema/go-mp4"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/media/h264"
+)
+
+type DecodeTestSuite struct {
+	suite.Suite
+}
+
+// keyframe returns the NAL units of the first keyframe in the given mp4
+// file, preceded by the parameter sets. It assumes the first track is
+// the video track, with every sample in its own chunk or contiguous.
+func (suite *DecodeTestSuite) keyframe(path string) [][]byte {
+	f, err := os.Open(path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	defer f.Close()
+
+	info, err := mp4.Probe(f)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	boxes, err := mp4.ExtractBoxesWithPayload(f, nil, []mp4.BoxPath{
+		{mp4.BoxTypeMoov(), mp4.BoxTypeTrak(), mp4.BoxTypeMdia(), mp4.BoxTypeMinf(), mp4.BoxTypeStbl(), mp4.BoxTypeStsd(), mp4.BoxTypeAvc1(), mp4.BoxTypeAvcC()},
+		{mp4.BoxTypeMoov(), mp4.BoxTypeTrak(), mp4.BoxTypeMdia(), mp4.BoxTypeMinf(), mp4.BoxTypeStbl(), mp4.BoxTypeStss()},
+	})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	var (
+		avcC   *mp4.AVCDecoderConfiguration
+		sample = 0
+	)
+	for _, box := range boxes {
+		switch payload := box.Payload.(type) {
+		case *mp4.AVCDecoderConfiguration:
+			if avcC == nil {
+				avcC = payload
+			}
+		case *mp4.Stss:
+			if sample == 0 {
+				sample = int(payload.SampleNumber[0]) - 1
+			}
+		}
+	}
+	if avcC == nil {
+		suite.FailNow("no avcC box")
+	}
+
+	var track *mp4.Track
+	for _, tr := range info.Tracks {
+		if tr.AVC != nil {
+			track = tr
+			break
+		}
+	}
+
+	// find the sample
+	offset := int64(track.Chunks[0].DataOffset)
+	first := 0
+	for _, chunk := range track.Chunks {
+		if sample < first+int(chunk.SamplesPerChunk) {
+			offset = int64(chunk.DataOffset)
+			for i := first; i < sample; i++ {
+				offset += int64(track.Samples[i].Size)
+			}
+			break
+		}
+		first += int(chunk.SamplesPerChunk)
+	}
+
+	buf := make([]byte, track.Samples[sample].Size)
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if _, err := io.ReadFull(f, buf); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	nalus := [][]byte{avcC.SequenceParameterSets[0].NALUnit, avcC.PictureParameterSets[0].NALUnit}
+	for len(buf) > 0 {
+		n := 0
+		for _, b := range buf[:avcC.LengthSizeMinusOne+1] {
+			n = n<<8 | int(b)
+		}
+		buf = buf[avcC.LengthSizeMinusOne+1:]
+		nalus = append(nalus, buf[:n])
+		buf = buf[n:]
+	}
+
+	return nalus
+}
+
+func (suite *DecodeTestSuite) TestDecodeKeyframes() {
+	for _, test := range []struct {
+		path   string
+		bounds image.Rectangle
+	}{
+		{path: "../test/birdnest-original.mp4", bounds: image.Rect(0, 0, 404, 720)},               // baseline, cavlc
+		{path: "../test/test-mp4-original.mp4", bounds: image.Rect(0, 0, 338, 240)},               // main, cabac
+		{path: "../test/longer-mp4-original.mp4", bounds: image.Rect(0, 0, 600, 330)},             // high, cabac
+		{path: "../../../testrig/media/cowlick-original.mp4", bounds: image.Rect(0, 0, 720, 404)}, // high, cabac
+	} {
+		img, err := h264.DecodeFrame(suite.keyframe(test.path))
+		if suite.NoError(err, test.path) {
+			suite.Equal(test.bounds, img.Bounds(), test.path)
+		}
+	}
+}
+
+func (suite *DecodeTestSuite) TestDecodeCorrupted() {
+	for _, path := range []string{
+		"../test/birdnest-original.mp4",
+		"../test/test-mp4-original.mp4",
+		"../test/longer-mp4-original.mp4",
+	} {
+		nalus := suite.keyframe(path)
+		slice := len(nalus) - 1
+		orig := nalus[slice]
+
+		// truncated slice data
+		for n := 1; n < len(orig); n += 1 + len(orig)/50 {
+			nalus[slice] = orig[:n]
+			_, err := h264.DecodeFrame(nalus)
+			suite.Error(err, "%s truncated to %d bytes", path, n)
+		}
+
+		// corrupted slice data; may or may not
+		// decode, but mustn't take us down
+		for i := 4; i < len(orig); i += 1 + len(orig)/50 {
+			corrupt := append([]byte{}, orig...)
+			corrupt[i] ^= 0x5a
+			nalus[slice] = corrupt
+			_, _ = h264.DecodeFrame(nalus)
+		}
+	}
+}
+
+func (suite *DecodeTestSuite) TestDecodeNoSlices() {
+	_, err := h264.DecodeFrame(nil)
+	suite.EqualError(err, "no slices found")
+}
+
+func TestDecodeTestSuite(t *testing.T) {
+	suite.Run(t, &DecodeTestSuite{})
+}
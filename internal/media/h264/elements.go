@@ -0,0 +1,238 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+import "fmt"
+
+// This file holds the parsing of macroblock layer syntax elements, other
+// than residuals, for both CAVLC and CABAC entropy coding. For CABAC, the
+// ctxIdxInc derivations use the neighbouring macroblocks A (left) and B
+// (above), which are nil when not available.
+
+// readMbType parses mb_type of a macroblock in an I slice.
+func (d *sliceDecoder) readMbType(mbX, mbY int) (int, error) {
+	if d.cabac == nil {
+		t := int(d.r.ue())
+		if t > mbIPCM {
+			return 0, fmt.Errorf("invalid mb_type %d", t)
+		}
+		return t, d.r.err
+	}
+
+	c := d.cabac
+	inc := 0
+	if mb := d.mbAt(mbX-1, mbY); mb != nil && mb.mbType != mbINxN {
+		inc++
+	}
+	if mb := d.mbAt(mbX, mbY-1); mb != nil && mb.mbType != mbINxN {
+		inc++
+	}
+	if c.decision(3+inc) == 0 {
+		return mbINxN, nil
+	}
+	if c.terminate() == 1 {
+		return mbIPCM, nil
+	}
+
+	t := 1
+	if c.decision(6) == 1 {
+		// coded block pattern luma
+		t += 12
+	}
+	if c.decision(7) == 1 {
+		// coded block pattern chroma
+		t += 4
+		if c.decision(8) == 1 {
+			t += 4
+		}
+	}
+	t += int(c.decision(9)) << 1
+	t += int(c.decision(10))
+
+	return t, d.r.err
+}
+
+// readTransform8x8Flag parses transform_size_8x8_flag.
+func (d *sliceDecoder) readTransform8x8Flag(mbX, mbY int) bool {
+	if d.cabac == nil {
+		return d.r.flag()
+	}
+
+	inc := 0
+	if mb := d.mbAt(mbX-1, mbY); mb != nil && mb.transform8x8 {
+		inc++
+	}
+	if mb := d.mbAt(mbX, mbY-1); mb != nil && mb.transform8x8 {
+		inc++
+	}
+	return d.cabac.decision(399+inc) == 1
+}
+
+// readPrevIntraPredModeFlag parses prev_intra4x4_pred_mode_flag
+// or prev_intra8x8_pred_mode_flag, which are coded the same.
+func (d *sliceDecoder) readPrevIntraPredModeFlag() bool {
+	if d.cabac == nil {
+		return d.r.flag()
+	}
+	return d.cabac.decision(68) == 1
+}
+
+// readRemIntraPredMode parses rem_intra4x4_pred_mode
+// or rem_intra8x8_pred_mode, which are coded the same.
+func (d *sliceDecoder) readRemIntraPredMode() int {
+	if d.cabac == nil {
+		return int(d.r.u(3))
+	}
+
+	// fixed length, least significant bit first
+	mode := 0
+	for i := 0; i < 3; i++ {
+		mode |= int(d.cabac.decision(69)) << i
+	}
+	return mode
+}
+
+// readIntraChromaPredMode parses intra_chroma_pred_mode.
+func (d *sliceDecoder) readIntraChromaPredMode(mbX, mbY int) int {
+	if d.cabac == nil {
+		return int(d.r.ue())
+	}
+
+	inc := 0
+	if mb := d.mbAt(mbX-1, mbY); mb != nil && mb.mbType != mbIPCM && mb.chromaPredMode != 0 {
+		inc++
+	}
+	if mb := d.mbAt(mbX, mbY-1); mb != nil && mb.mbType != mbIPCM && mb.chromaPredMode != 0 {
+		inc++
+	}
+
+	// truncated unary with cMax = 3
+	c := d.cabac
+	if c.decision(64+inc) == 0 {
+		return 0
+	}
+	if c.decision(67) == 0 {
+		return 1
+	}
+	if c.decision(67) == 0 {
+		return 2
+	}
+	return 3
+}
+
+// readCodedBlockPattern parses coded_block_pattern of an intra macroblock.
+func (d *sliceDecoder) readCodedBlockPattern(mb *macroblock, mbX, mbY int) (int, error) {
+	if d.cabac == nil {
+		codeNum := d.r.ue()
+		if d.sps.chromaFormatIdc == 0 {
+			if codeNum >= uint32(len(intraCBPMono)) {
+				return 0, fmt.Errorf("invalid coded_block_pattern %d", codeNum)
+			}
+			return int(intraCBPMono[codeNum]), d.r.err
+		}
+		if codeNum >= uint32(len(intraCBP)) {
+			return 0, fmt.Errorf("invalid coded_block_pattern %d", codeNum)
+		}
+		return int(intraCBP[codeNum]), d.r.err
+	}
+
+	c := d.cabac
+	mbA, mbB := d.mbAt(mbX-1, mbY), d.mbAt(mbX, mbY-1)
+
+	// prefix, one bin for each 8x8 luma block; condTermFlagN is
+	// 0 if the neighbouring 8x8 block is unavailable, I_PCM or
+	// has its bit set in the coded block pattern, and 1 otherwise.
+	cbp := 0
+	for b8 := 0; b8 < 4; b8++ {
+		x, y := 8*(b8%2), 8*(b8/2)
+
+		condA := 0
+		if x == 0 {
+			if mbA != nil && mbA.mbType != mbIPCM && mbA.cbp&(1<<(b8+1)) == 0 {
+				condA = 1
+			}
+		} else if cbp&(1<<(b8-1)) == 0 {
+			condA = 1
+		}
+
+		condB := 0
+		if y == 0 {
+			if mbB != nil && mbB.mbType != mbIPCM && mbB.cbp&(1<<(b8+2)) == 0 {
+				condB = 1
+			}
+		} else if cbp&(1<<(b8-2)) == 0 {
+			condB = 1
+		}
+
+		cbp |= int(c.decision(73+condA+2*condB)) << b8
+	}
+
+	if d.sps.chromaFormatIdc == 0 {
+		return cbp, d.r.err
+	}
+
+	// suffix, truncated unary with cMax = 2
+	chromaCond := func(mb *macroblock, min int) int {
+		if mb != nil && (mb.mbType == mbIPCM || mb.cbp>>4 >= min) {
+			return 1
+		}
+		return 0
+	}
+	if c.decision(77+chromaCond(mbA, 1)+2*chromaCond(mbB, 1)) == 1 {
+		cbp |= 1 << 4
+		if c.decision(81+chromaCond(mbA, 2)+2*chromaCond(mbB, 2)) == 1 {
+			cbp = cbp&15 | 2<<4
+		}
+	}
+
+	return cbp, d.r.err
+}
+
+// readMbQPDelta parses mb_qp_delta.
+func (d *sliceDecoder) readMbQPDelta() int {
+	if d.cabac == nil {
+		return int(d.r.se())
+	}
+
+	c := d.cabac
+	inc := 0
+	if prev := d.prevMb; prev != nil && prev.mbType != mbIPCM &&
+		(prev.isI16x16() || prev.cbp != 0) && d.prevQPDelta != 0 {
+		inc = 1
+	}
+	if c.decision(60+inc) == 0 {
+		return 0
+	}
+
+	// unary, mapped as for se(v)
+	k := 1
+	ctx := 62
+	for c.decision(ctx) == 1 {
+		ctx = 63
+		if k++; k > 52 {
+			// out of range, and will be rejected
+			break
+		}
+	}
+
+	if k%2 == 1 {
+		return (k + 1) / 2
+	}
+	return -(k / 2)
+}
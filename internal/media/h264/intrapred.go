@@ -0,0 +1,599 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+import "errors"
+
+// This file contains the intra prediction
+// processes, as specified in ITU-T H.264 8.3.
+
+var errIntraPredMode = errors.New("intra prediction mode uses unavailable samples")
+
+// Intra4x4PredMode and Intra8x8PredMode values.
+const (
+	predVertical = iota
+	predHorizontal
+	predDC
+	predDiagonalDownLeft
+	predDiagonalDownRight
+	predVerticalRight
+	predHorizontalDown
+	predVerticalLeft
+	predHorizontalUp
+)
+
+// Intra16x16PredMode values; intra_chroma_pred_mode
+// values are the same, but in the order DC, H, V, Plane.
+const (
+	pred16x16Vertical = iota
+	pred16x16Horizontal
+	pred16x16DC
+	pred16x16Plane
+)
+
+// neighbours describes the availability of the
+// samples neighbouring a block for intra prediction.
+type neighbours struct {
+	left, top, topRight, topLeft bool
+}
+
+// needs returns whether the given Intra4x4PredMode
+// or Intra8x8PredMode can be used with neighbours n.
+func (n neighbours) allow(mode int) bool {
+	switch mode {
+	case predVertical, predDiagonalDownLeft, predVerticalLeft:
+		return n.top
+	case predHorizontal, predHorizontalUp:
+		return n.left
+	case predDiagonalDownRight, predVerticalRight, predHorizontalDown:
+		return n.top && n.left && n.topLeft
+	case predDC:
+		return true
+	}
+	return false
+}
+
+// predIntra4x4 predicts the 4x4 luma block at dst[off:], with the given stride.
+func predIntra4x4(dst []byte, off int, stride int, mode int, n neighbours) error {
+	if !n.allow(mode) {
+		return errIntraPredMode
+	}
+
+	var (
+		top    [8]int32
+		left   [4]int32
+		corner int32
+	)
+	if n.top {
+		for x := 0; x < 8; x++ {
+			if x < 4 || n.topRight {
+				top[x] = int32(dst[off-stride+x])
+			} else {
+				top[x] = top[3]
+			}
+		}
+	}
+	if n.left {
+		for y := 0; y < 4; y++ {
+			left[y] = int32(dst[off+y*stride-1])
+		}
+	}
+	if n.topLeft {
+		corner = int32(dst[off-stride-1])
+	}
+
+	// p[x,-1] and p[-1,y], for x, y >= -1
+	t := func(x int) int32 {
+		if x < 0 {
+			return corner
+		}
+		return top[x]
+	}
+	l := func(y int) int32 {
+		if y < 0 {
+			return corner
+		}
+		return left[y]
+	}
+
+	var pred [4][4]int32
+	switch mode {
+	case predVertical:
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				pred[y][x] = top[x]
+			}
+		}
+
+	case predHorizontal:
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				pred[y][x] = left[y]
+			}
+		}
+
+	case predDC:
+		dc := dcValue(top[:4], left[:], n.top, n.left)
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				pred[y][x] = dc
+			}
+		}
+
+	case predDiagonalDownLeft:
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				if x == 3 && y == 3 {
+					pred[y][x] = (top[6] + 3*top[7] + 2) >> 2
+				} else {
+					pred[y][x] = (top[x+y] + 2*top[x+y+1] + top[x+y+2] + 2) >> 2
+				}
+			}
+		}
+
+	case predDiagonalDownRight:
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				switch {
+				case x > y:
+					pred[y][x] = (t(x-y-2) + 2*t(x-y-1) + t(x-y) + 2) >> 2
+				case x < y:
+					pred[y][x] = (l(y-x-2) + 2*l(y-x-1) + l(y-x) + 2) >> 2
+				default:
+					pred[y][x] = (t(0) + 2*corner + l(0) + 2) >> 2
+				}
+			}
+		}
+
+	case predVerticalRight:
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				z := 2*x - y
+				switch {
+				case z >= 0 && z&1 == 0:
+					pred[y][x] = (t(x-(y>>1)-1) + t(x-(y>>1)) + 1) >> 1
+				case z >= 0:
+					pred[y][x] = (t(x-(y>>1)-2) + 2*t(x-(y>>1)-1) + t(x-(y>>1)) + 2) >> 2
+				case z == -1:
+					pred[y][x] = (l(0) + 2*corner + t(0) + 2) >> 2
+				default:
+					pred[y][x] = (l(y-1) + 2*l(y-2) + l(y-3) + 2) >> 2
+				}
+			}
+		}
+
+	case predHorizontalDown:
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				z := 2*y - x
+				switch {
+				case z >= 0 && z&1 == 0:
+					pred[y][x] = (l(y-(x>>1)-1) + l(y-(x>>1)) + 1) >> 1
+				case z >= 0:
+					pred[y][x] = (l(y-(x>>1)-2) + 2*l(y-(x>>1)-1) + l(y-(x>>1)) + 2) >> 2
+				case z == -1:
+					pred[y][x] = (l(0) + 2*corner + t(0) + 2) >> 2
+				default:
+					pred[y][x] = (t(x-1) + 2*t(x-2) + t(x-3) + 2) >> 2
+				}
+			}
+		}
+
+	case predVerticalLeft:
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				i := x + (y >> 1)
+				if y&1 == 0 {
+					pred[y][x] = (top[i] + top[i+1] + 1) >> 1
+				} else {
+					pred[y][x] = (top[i] + 2*top[i+1] + top[i+2] + 2) >> 2
+				}
+			}
+		}
+
+	case predHorizontalUp:
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				z := x + 2*y
+				i := y + (x >> 1)
+				switch {
+				case z > 5:
+					pred[y][x] = left[3]
+				case z == 5:
+					pred[y][x] = (left[2] + 3*left[3] + 2) >> 2
+				case z&1 == 0:
+					pred[y][x] = (left[i] + left[i+1] + 1) >> 1
+				default:
+					pred[y][x] = (left[i] + 2*left[i+1] + left[i+2] + 2) >> 2
+				}
+			}
+		}
+	}
+
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			dst[off+y*stride+x] = byte(pred[y][x])
+		}
+	}
+
+	return nil
+}
+
+// predIntra8x8 predicts the 8x8 luma block at dst[off:], with the given stride.
+func predIntra8x8(dst []byte, off int, stride int, mode int, n neighbours) error {
+	if !n.allow(mode) {
+		return errIntraPredMode
+	}
+
+	var (
+		top    [16]int32
+		left   [8]int32
+		corner int32
+	)
+	if n.top {
+		for x := 0; x < 16; x++ {
+			if x < 8 || n.topRight {
+				top[x] = int32(dst[off-stride+x])
+			} else {
+				top[x] = top[7]
+			}
+		}
+	}
+	if n.left {
+		for y := 0; y < 8; y++ {
+			left[y] = int32(dst[off+y*stride-1])
+		}
+	}
+	if n.topLeft {
+		corner = int32(dst[off-stride-1])
+	}
+
+	// reference sample filtering
+	var (
+		ftop    [16]int32
+		fleft   [8]int32
+		fcorner int32
+	)
+	if n.top {
+		if n.topLeft {
+			ftop[0] = (corner + 2*top[0] + top[1] + 2) >> 2
+		} else {
+			ftop[0] = (3*top[0] + top[1] + 2) >> 2
+		}
+		for x := 1; x < 15; x++ {
+			ftop[x] = (top[x-1] + 2*top[x] + top[x+1] + 2) >> 2
+		}
+		ftop[15] = (top[14] + 3*top[15] + 2) >> 2
+	}
+	if n.topLeft {
+		switch {
+		case n.top && n.left:
+			fcorner = (top[0] + 2*corner + left[0] + 2) >> 2
+		case n.top:
+			fcorner = (3*corner + top[0] + 2) >> 2
+		case n.left:
+			fcorner = (3*corner + left[0] + 2) >> 2
+		default:
+			fcorner = corner
+		}
+	}
+	if n.left {
+		if n.topLeft {
+			fleft[0] = (corner + 2*left[0] + left[1] + 2) >> 2
+		} else {
+			fleft[0] = (3*left[0] + left[1] + 2) >> 2
+		}
+		for y := 1; y < 7; y++ {
+			fleft[y] = (left[y-1] + 2*left[y] + left[y+1] + 2) >> 2
+		}
+		fleft[7] = (left[6] + 3*left[7] + 2) >> 2
+	}
+
+	// p'[x,-1] and p'[-1,y], for x, y >= -1
+	t := func(x int) int32 {
+		if x < 0 {
+			return fcorner
+		}
+		return ftop[x]
+	}
+	l := func(y int) int32 {
+		if y < 0 {
+			return fcorner
+		}
+		return fleft[y]
+	}
+
+	var pred [8][8]int32
+	switch mode {
+	case predVertical:
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				pred[y][x] = ftop[x]
+			}
+		}
+
+	case predHorizontal:
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				pred[y][x] = fleft[y]
+			}
+		}
+
+	case predDC:
+		dc := dcValue(ftop[:8], fleft[:], n.top, n.left)
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				pred[y][x] = dc
+			}
+		}
+
+	case predDiagonalDownLeft:
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				if x == 7 && y == 7 {
+					pred[y][x] = (ftop[14] + 3*ftop[15] + 2) >> 2
+				} else {
+					pred[y][x] = (ftop[x+y] + 2*ftop[x+y+1] + ftop[x+y+2] + 2) >> 2
+				}
+			}
+		}
+
+	case predDiagonalDownRight:
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				switch {
+				case x > y:
+					pred[y][x] = (t(x-y-2) + 2*t(x-y-1) + t(x-y) + 2) >> 2
+				case x < y:
+					pred[y][x] = (l(y-x-2) + 2*l(y-x-1) + l(y-x) + 2) >> 2
+				default:
+					pred[y][x] = (t(0) + 2*fcorner + l(0) + 2) >> 2
+				}
+			}
+		}
+
+	case predVerticalRight:
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				z := 2*x - y
+				switch {
+				case z >= 0 && z&1 == 0:
+					pred[y][x] = (t(x-(y>>1)-1) + t(x-(y>>1)) + 1) >> 1
+				case z >= 0:
+					pred[y][x] = (t(x-(y>>1)-2) + 2*t(x-(y>>1)-1) + t(x-(y>>1)) + 2) >> 2
+				case z == -1:
+					pred[y][x] = (l(0) + 2*fcorner + t(0) + 2) >> 2
+				default:
+					pred[y][x] = (l(y-2*x-1) + 2*l(y-2*x-2) + l(y-2*x-3) + 2) >> 2
+				}
+			}
+		}
+
+	case predHorizontalDown:
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				z := 2*y - x
+				switch {
+				case z >= 0 && z&1 == 0:
+					pred[y][x] = (l(y-(x>>1)-1) + l(y-(x>>1)) + 1) >> 1
+				case z >= 0:
+					pred[y][x] = (l(y-(x>>1)-2) + 2*l(y-(x>>1)-1) + l(y-(x>>1)) + 2) >> 2
+				case z == -1:
+					pred[y][x] = (l(0) + 2*fcorner + t(0) + 2) >> 2
+				default:
+					pred[y][x] = (t(x-2*y-1) + 2*t(x-2*y-2) + t(x-2*y-3) + 2) >> 2
+				}
+			}
+		}
+
+	case predVerticalLeft:
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				i := x + (y >> 1)
+				if y&1 == 0 {
+					pred[y][x] = (ftop[i] + ftop[i+1] + 1) >> 1
+				} else {
+					pred[y][x] = (ftop[i] + 2*ftop[i+1] + ftop[i+2] + 2) >> 2
+				}
+			}
+		}
+
+	case predHorizontalUp:
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				z := x + 2*y
+				i := y + (x >> 1)
+				switch {
+				case z > 13:
+					pred[y][x] = fleft[7]
+				case z == 13:
+					pred[y][x] = (fleft[6] + 3*fleft[7] + 2) >> 2
+				case z&1 == 0:
+					pred[y][x] = (fleft[i] + fleft[i+1] + 1) >> 1
+				default:
+					pred[y][x] = (fleft[i] + 2*fleft[i+1] + fleft[i+2] + 2) >> 2
+				}
+			}
+		}
+	}
+
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			dst[off+y*stride+x] = byte(pred[y][x])
+		}
+	}
+
+	return nil
+}
+
+// predIntraSquare predicts a size x size block at dst[off:], with the given
+// stride, for Intra16x16PredMode (size 16) or 4:2:0 chroma prediction (size
+// 8), using the Intra16x16PredMode numbering of prediction modes.
+func predIntraSquare(dst []byte, off int, stride int, size int, mode int, n neighbours) error {
+	var (
+		top    [16]int32
+		left   [16]int32
+		corner int32
+	)
+	if n.top {
+		for x := 0; x < size; x++ {
+			top[x] = int32(dst[off-stride+x])
+		}
+	}
+	if n.left {
+		for y := 0; y < size; y++ {
+			left[y] = int32(dst[off+y*stride-1])
+		}
+	}
+	if n.topLeft {
+		corner = int32(dst[off-stride-1])
+	}
+
+	set := func(x, y int, v int32) {
+		dst[off+y*stride+x] = clip1(v)
+	}
+
+	switch mode {
+	case pred16x16Vertical:
+		if !n.top {
+			return errIntraPredMode
+		}
+		for y := 0; y < size; y++ {
+			for x := 0; x < size; x++ {
+				set(x, y, top[x])
+			}
+		}
+
+	case pred16x16Horizontal:
+		if !n.left {
+			return errIntraPredMode
+		}
+		for y := 0; y < size; y++ {
+			for x := 0; x < size; x++ {
+				set(x, y, left[y])
+			}
+		}
+
+	case pred16x16DC:
+		if size == 16 {
+			dc := dcValue(top[:], left[:], n.top, n.left)
+			for y := 0; y < size; y++ {
+				for x := 0; x < size; x++ {
+					set(x, y, dc)
+				}
+			}
+			break
+		}
+
+		// chroma DC prediction is done per 4x4 block, preferring
+		// the samples nearest to the block when only one edge is
+		for yO := 0; yO < size; yO += 4 {
+			for xO := 0; xO < size; xO += 4 {
+				t, l := n.top, n.left
+				if xO > 0 && yO == 0 && t {
+					l = false
+				} else if xO == 0 && yO > 0 && l {
+					t = false
+				}
+				dc := dcValue(top[xO:xO+4], left[yO:yO+4], t, l)
+				for y := yO; y < yO+4; y++ {
+					for x := xO; x < xO+4; x++ {
+						set(x, y, dc)
+					}
+				}
+			}
+		}
+
+	case pred16x16Plane:
+		if !n.top || !n.left || !n.topLeft {
+			return errIntraPredMode
+		}
+		half := size / 2
+		t := func(x int) int32 {
+			if x < 0 {
+				return corner
+			}
+			return top[x]
+		}
+		l := func(y int) int32 {
+			if y < 0 {
+				return corner
+			}
+			return left[y]
+		}
+
+		var h, v int32
+		for i := 0; i < half; i++ {
+			h += int32(i+1) * (t(half+i) - t(half-2-i))
+			v += int32(i+1) * (l(half+i) - l(half-2-i))
+		}
+
+		a := 16 * (left[size-1] + top[size-1])
+		var b, c int32
+		if size == 16 {
+			b = (5*h + 32) >> 6
+			c = (5*v + 32) >> 6
+		} else {
+			b = (34*h + 32) >> 6
+			c = (34*v + 32) >> 6
+		}
+
+		for y := 0; y < size; y++ {
+			for x := 0; x < size; x++ {
+				set(x, y, (a+b*int32(x-half+1)+c*int32(y-half+1)+16)>>5)
+			}
+		}
+
+	default:
+		return errIntraPredMode
+	}
+
+	return nil
+}
+
+// dcValue returns the DC prediction value for a block
+// with the given top and left neighbouring samples.
+func dcValue(top []int32, left []int32, useTop, useLeft bool) int32 {
+	n := int32(len(top))
+	shift := uint(0)
+	for 1<<shift < n {
+		shift++
+	}
+
+	var sum int32
+	switch {
+	case useTop && useLeft:
+		for i := range top {
+			sum += top[i] + left[i]
+		}
+		return (sum + n) >> (shift + 1)
+	case useLeft:
+		for _, s := range left {
+			sum += s
+		}
+		return (sum + n/2) >> shift
+	case useTop:
+		for _, s := range top {
+			sum += s
+		}
+		return (sum + n/2) >> shift
+	default:
+		return 128
+	}
+}
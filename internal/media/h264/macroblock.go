@@ -0,0 +1,590 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+import "fmt"
+
+// mb_type values for I slices; values
+// in between are Intra_16x16 types.
+const (
+	mbINxN = 0
+	mbIPCM = 25
+)
+
+// Bits of macroblock.cbf, the coded_block_flags (or for CAVLC,
+// whether there are non-zero coefficients) of each residual
+// block; bits 0-15 are for luma 4x4 blocks by luma4x4BlkIdx.
+const (
+	cbfLumaDC   = 16
+	cbfChromaDC = 17 // + iCbCr
+	cbfChromaAC = 19 // + iCbCr*4 + chroma4x4BlkIdx
+)
+
+// ctxBlockCat values.
+const (
+	catLumaDC = iota
+	catLumaAC
+	catLuma4x4
+	catChromaDC
+	catChromaAC
+	catLuma8x8
+)
+
+// macroblock holds what's needed of a decoded macroblock to decode
+// the macroblocks that come after it, and for deblocking.
+type macroblock struct {
+	slice          int // index of the slice + 1, 0 if not decoded
+	mbType         int
+	transform8x8   bool
+	chromaPredMode int
+	qp             int
+	cbp            int       // CodedBlockPatternLuma | CodedBlockPatternChroma << 4
+	predModes      [16]int8  // Intra4x4PredMode / Intra8x8PredMode by luma4x4BlkIdx
+	totalCoeff     [24]uint8 // luma by luma4x4BlkIdx, then Cb and Cr by chroma4x4BlkIdx
+	cbf            uint32
+}
+
+func (mb *macroblock) isI16x16() bool {
+	return mb.mbType > mbINxN && mb.mbType < mbIPCM
+}
+
+// residual holds the parsed coefficient levels
+// of a macroblock's residual blocks, in scan order.
+type residual struct {
+	lumaDC   [16]int32
+	luma     [16][16]int32
+	luma8x8  [4][64]int32
+	chromaDC [2][4]int32
+	chromaAC [2][4][16]int32
+}
+
+var (
+	// lumaBlkX and lumaBlkY give the position
+	// of each 4x4 luma block by luma4x4BlkIdx.
+	lumaBlkX = [16]int{0, 4, 0, 4, 8, 12, 8, 12, 0, 4, 0, 4, 8, 12, 8, 12}
+	lumaBlkY = [16]int{0, 0, 4, 4, 0, 0, 4, 4, 8, 8, 12, 12, 8, 8, 12, 12}
+
+	// chromaPredModes maps intra_chroma_pred_mode to the
+	// Intra16x16PredMode numbering of prediction modes.
+	chromaPredModes = [4]int{pred16x16DC, pred16x16Horizontal, pred16x16Vertical, pred16x16Plane}
+)
+
+// luma4x4BlkIdx returns the index of the 4x4 luma block
+// containing the given luma location within a macroblock.
+func luma4x4BlkIdx(x, y int) int {
+	return 8*(y/8) + 4*(x/8) + 2*((y%8)/4) + (x%8)/4
+}
+
+// chroma4x4BlkIdx returns the index of the 4x4 chroma block
+// containing the given chroma location within a macroblock.
+func chroma4x4BlkIdx(x, y int) int {
+	return 2*(y/4) + x/4
+}
+
+// decodeMacroblock parses and reconstructs the macroblock at mbAddr.
+func (d *sliceDecoder) decodeMacroblock(mbAddr int) error {
+	mbX, mbY := mbAddr%d.pic.widthMbs, mbAddr/d.pic.widthMbs
+	mb := &d.pic.mbs[mbAddr]
+	*mb = macroblock{slice: d.slice}
+	d.res = residual{}
+
+	mbType, err := d.readMbType(mbX, mbY)
+	if err != nil {
+		return err
+	}
+	mb.mbType = mbType
+
+	if mbType == mbIPCM {
+		return d.decodePCM(mb, mbX, mbY)
+	}
+
+	if mbType == mbINxN {
+		if d.pps.transform8x8Mode {
+			mb.transform8x8 = d.readTransform8x8Flag(mbX, mbY)
+		}
+
+		step := 1
+		if mb.transform8x8 {
+			step = 4
+		}
+		for blk := 0; blk < 16; blk += step {
+			mode := d.predIntraPredMode(mbX, mbY, blk)
+			if !d.readPrevIntraPredModeFlag() {
+				if rem := d.readRemIntraPredMode(); rem < mode {
+					mode = rem
+				} else {
+					mode = rem + 1
+				}
+			}
+			for i := blk; i < blk+step; i++ {
+				mb.predModes[i] = int8(mode)
+			}
+		}
+	} else {
+		// prediction mode and coded
+		// block pattern are implied
+		t := mbType - 1
+		mb.cbp = (t / 4 % 3) << 4
+		if t >= 12 {
+			mb.cbp |= 15
+		}
+	}
+
+	if d.sps.chromaFormatIdc != 0 {
+		mb.chromaPredMode = d.readIntraChromaPredMode(mbX, mbY)
+		if mb.chromaPredMode > 3 {
+			return fmt.Errorf("invalid intra_chroma_pred_mode %d", mb.chromaPredMode)
+		}
+	}
+
+	if mbType == mbINxN {
+		if mb.cbp, err = d.readCodedBlockPattern(mb, mbX, mbY); err != nil {
+			return err
+		}
+	}
+
+	qpDelta := 0
+	if mb.cbp != 0 || mb.isI16x16() {
+		qpDelta = d.readMbQPDelta()
+		if qpDelta < -26 || qpDelta > 25 {
+			return fmt.Errorf("invalid mb_qp_delta %d", qpDelta)
+		}
+		d.qp = (d.qp + qpDelta + 52) % 52
+	}
+	mb.qp = d.qp
+
+	if err := d.readResidual(mb, mbX, mbY); err != nil {
+		return err
+	}
+
+	d.prevMb = mb
+	d.prevQPDelta = qpDelta
+
+	if d.r.err != nil {
+		return d.r.err
+	}
+
+	return d.reconstruct(mb, mbX, mbY)
+}
+
+// decodePCM reads the samples of an I_PCM macroblock.
+func (d *sliceDecoder) decodePCM(mb *macroblock, mbX, mbY int) error {
+	mb.qp = d.qp
+	mb.cbp = 0x2f
+	mb.cbf = ^uint32(0)
+	d.prevMb = mb
+	d.prevQPDelta = 0
+
+	for !d.r.aligned() {
+		d.r.bit() // pcm_alignment_zero_bit
+	}
+
+	stride := d.pic.widthMbs * 16
+	off := mbY*16*stride + mbX*16
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			d.pic.luma[off+y*stride+x] = byte(d.r.u(8))
+		}
+	}
+
+	if d.sps.chromaFormatIdc != 0 {
+		stride = d.pic.widthMbs * 8
+		off = mbY*8*stride + mbX*8
+		for _, plane := range [][]byte{d.pic.cb, d.pic.cr} {
+			for y := 0; y < 8; y++ {
+				for x := 0; x < 8; x++ {
+					plane[off+y*stride+x] = byte(d.r.u(8))
+				}
+			}
+		}
+	}
+
+	if d.r.err != nil {
+		return d.r.err
+	}
+
+	if d.cabac != nil {
+		return d.cabac.initEngine()
+	}
+
+	return nil
+}
+
+// predIntraPredMode returns predIntra4x4PredMode (or predIntra8x8PredMode)
+// for the given 4x4 block (or first 4x4 block of the given 8x8 block).
+func (d *sliceDecoder) predIntraPredMode(mbX, mbY, blk int) int {
+	x, y := lumaBlkX[blk], lumaBlkY[blk]
+	mbA, xA, yA := d.neighbour(mbX, mbY, x-1, y, 16)
+	mbB, xB, yB := d.neighbour(mbX, mbY, x, y-1, 16)
+	if mbA == nil || mbB == nil {
+		return predDC
+	}
+
+	modeA, modeB := predDC, predDC
+	if mbA.mbType == mbINxN {
+		modeA = int(mbA.predModes[luma4x4BlkIdx(xA, yA)])
+	}
+	if mbB.mbType == mbINxN {
+		modeB = int(mbB.predModes[luma4x4BlkIdx(xB, yB)])
+	}
+
+	return min(modeA, modeB)
+}
+
+// readResidual parses the residual blocks of the macroblock.
+func (d *sliceDecoder) readResidual(mb *macroblock, mbX, mbY int) error {
+	res := &d.res
+
+	if mb.isI16x16() {
+		if err := d.readResidualBlock(mb, mbX, mbY, catLumaDC, 0, res.lumaDC[:]); err != nil {
+			return err
+		}
+	}
+
+	for b8 := 0; b8 < 4; b8++ {
+		if mb.cbp&(1<<b8) == 0 {
+			continue
+		}
+
+		if mb.transform8x8 && d.cabac != nil {
+			if err := d.readResidualBlock(mb, mbX, mbY, catLuma8x8, b8*4, res.luma8x8[b8][:]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for b4 := 0; b4 < 4; b4++ {
+			blk := b8*4 + b4
+
+			var err error
+			if mb.isI16x16() {
+				err = d.readResidualBlock(mb, mbX, mbY, catLumaAC, blk, res.luma[blk][1:])
+			} else {
+				err = d.readResidualBlock(mb, mbX, mbY, catLuma4x4, blk, res.luma[blk][:])
+			}
+			if err != nil {
+				return err
+			}
+
+			if mb.transform8x8 {
+				// 8x8 blocks are coded as 4
+				// interleaved 4x4 blocks in CAVLC
+				for k := 0; k < 16; k++ {
+					res.luma8x8[b8][4*k+b4] = res.luma[blk][k]
+				}
+			}
+		}
+	}
+
+	if d.sps.chromaFormatIdc == 0 {
+		return nil
+	}
+
+	if mb.cbp>>4 != 0 {
+		for iCbCr := 0; iCbCr < 2; iCbCr++ {
+			if err := d.readResidualBlock(mb, mbX, mbY, catChromaDC, iCbCr, res.chromaDC[iCbCr][:]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if mb.cbp>>4 == 2 {
+		for iCbCr := 0; iCbCr < 2; iCbCr++ {
+			for b4 := 0; b4 < 4; b4++ {
+				if err := d.readResidualBlock(mb, mbX, mbY, catChromaAC, iCbCr*4+b4, res.chromaAC[iCbCr][b4][1:]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// readResidualBlock parses a single residual block of the given ctxBlockCat
+// into coeffLevel. The block index blk is the luma4x4BlkIdx for luma blocks
+// (or that of the first 4x4 block in an 8x8 block), iCbCr for chroma DC, and
+// iCbCr*4 + chroma4x4BlkIdx for chroma AC blocks.
+func (d *sliceDecoder) readResidualBlock(mb *macroblock, mbX, mbY int, cat int, blk int, coeffLevel []int32) error {
+	var bit int
+	switch cat {
+	case catLumaDC:
+		bit = cbfLumaDC
+	case catLumaAC, catLuma4x4, catLuma8x8:
+		bit = blk
+	case catChromaDC:
+		bit = cbfChromaDC + blk
+	case catChromaAC:
+		bit = cbfChromaAC + blk
+	}
+
+	if d.cabac != nil {
+		cbfInc := -1
+		if cat != catLuma8x8 {
+			mbA, bitA, mbB, bitB := d.residualNeighbours(mbX, mbY, cat, blk)
+			cbfInc = codedBlockFlagCond(mbA, bitA) + 2*codedBlockFlagCond(mbB, bitB)
+		}
+		if d.cabac.residual(cat, cbfInc, coeffLevel) {
+			if cat == catLuma8x8 {
+				// all 4 4x4 blocks share the flag
+				mb.cbf |= 0xf << bit
+			} else {
+				mb.cbf |= 1 << bit
+			}
+		}
+		return d.r.err
+	}
+
+	nC := -1
+	if cat != catChromaDC {
+		mbA, idxA, mbB, idxB := d.residualNeighbours(mbX, mbY, cat, blk)
+		nA, nB := totalCoeff(mbA, idxA), totalCoeff(mbB, idxB)
+		switch {
+		case mbA != nil && mbB != nil:
+			nC = (nA + nB + 1) >> 1
+		case mbA != nil:
+			nC = nA
+		case mbB != nil:
+			nC = nB
+		default:
+			nC = 0
+		}
+	}
+
+	n, err := cavlcResidual(d.r, nC, coeffLevel)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		mb.cbf |= 1 << bit
+	}
+	switch cat {
+	case catLumaAC, catLuma4x4:
+		mb.totalCoeff[blk] = uint8(n)
+	case catChromaAC:
+		mb.totalCoeff[16+blk] = uint8(n)
+	}
+
+	return nil
+}
+
+// residualNeighbours returns the macroblocks containing the blocks to the
+// left of and above the given residual block (or nil if not available),
+// and the index of those blocks in macroblock.cbf and macroblock.totalCoeff.
+// Luma DC blocks use the neighbours of luma4x4BlkIdx 0 for the latter.
+func (d *sliceDecoder) residualNeighbours(mbX, mbY int, cat int, blk int) (mbA *macroblock, idxA int, mbB *macroblock, idxB int) {
+	switch cat {
+	case catLumaDC:
+		if d.cabac != nil {
+			return d.mbAt(mbX-1, mbY), cbfLumaDC, d.mbAt(mbX, mbY-1), cbfLumaDC
+		}
+		blk = 0
+		fallthrough
+
+	case catLumaAC, catLuma4x4:
+		x, y := lumaBlkX[blk], lumaBlkY[blk]
+		mbA, xA, yA := d.neighbour(mbX, mbY, x-1, y, 16)
+		mbB, xB, yB := d.neighbour(mbX, mbY, x, y-1, 16)
+		return mbA, luma4x4BlkIdx(xA, yA), mbB, luma4x4BlkIdx(xB, yB)
+
+	case catChromaDC:
+		return d.mbAt(mbX-1, mbY), cbfChromaDC + blk, d.mbAt(mbX, mbY-1), cbfChromaDC + blk
+
+	case catChromaAC:
+		iCbCr, b4 := blk/4, blk%4
+		x, y := 4*(b4%2), 4*(b4/2)
+		mbA, xA, yA := d.neighbour(mbX, mbY, x-1, y, 8)
+		mbB, xB, yB := d.neighbour(mbX, mbY, x, y-1, 8)
+		offset := 16 + iCbCr*4
+		if d.cabac != nil {
+			offset = cbfChromaAC + iCbCr*4
+		}
+		return mbA, offset + chroma4x4BlkIdx(xA, yA), mbB, offset + chroma4x4BlkIdx(xB, yB)
+	}
+
+	return nil, 0, nil, 0
+}
+
+// codedBlockFlagCond returns condTermFlagN for coded_block_flag,
+// for the block with the given cbf bit in (intra) macroblock mb.
+func codedBlockFlagCond(mb *macroblock, bit int) int {
+	if mb == nil {
+		return 1
+	}
+	return int(mb.cbf>>bit) & 1
+}
+
+// totalCoeff returns TotalCoeff(coeff_token) of the block with the given index in mb.
+func totalCoeff(mb *macroblock, idx int) int {
+	if mb == nil {
+		return 0
+	}
+	if mb.mbType == mbIPCM {
+		return 16
+	}
+	return int(mb.totalCoeff[idx])
+}
+
+// reconstruct predicts the samples of the macroblock,
+// and adds the residual to give the decoded samples.
+func (d *sliceDecoder) reconstruct(mb *macroblock, mbX, mbY int) error {
+	var (
+		res    = &d.res
+		stride = d.pic.widthMbs * 16
+		off    = mbY*16*stride + mbX*16
+		qp     = mb.qp
+		c4     [16]int32
+		c8     [64]int32
+	)
+
+	mbN := neighbours{
+		left:    d.mbAt(mbX-1, mbY) != nil,
+		top:     d.mbAt(mbX, mbY-1) != nil,
+		topLeft: d.mbAt(mbX-1, mbY-1) != nil,
+	}
+
+	switch {
+	case mb.mbType == mbINxN && mb.transform8x8:
+		for b8 := 0; b8 < 4; b8++ {
+			blk := b8 * 4
+			x, y := lumaBlkX[blk], lumaBlkY[blk]
+			n := neighbours{
+				left:     d.lumaAvailable(mbX, mbY, x-1, y, blk),
+				top:      d.lumaAvailable(mbX, mbY, x, y-1, blk),
+				topRight: d.lumaAvailable(mbX, mbY, x+8, y-1, blk),
+				topLeft:  d.lumaAvailable(mbX, mbY, x-1, y-1, blk),
+			}
+			boff := off + y*stride + x
+			if err := predIntra8x8(d.pic.luma, boff, stride, int(mb.predModes[blk]), n); err != nil {
+				return err
+			}
+			if mb.cbf&(0xf<<blk) == 0 {
+				continue
+			}
+			for k, pos := range zigzag8x8 {
+				c8[pos] = res.luma8x8[b8][k]
+			}
+			scale8x8(&c8, d.pps.levelScale8x8, qp)
+			idct8x8(&c8, d.pic.luma[boff:], stride)
+		}
+
+	case mb.mbType == mbINxN:
+		for blk := 0; blk < 16; blk++ {
+			x, y := lumaBlkX[blk], lumaBlkY[blk]
+			n := neighbours{
+				left:     d.lumaAvailable(mbX, mbY, x-1, y, blk),
+				top:      d.lumaAvailable(mbX, mbY, x, y-1, blk),
+				topRight: d.lumaAvailable(mbX, mbY, x+4, y-1, blk),
+				topLeft:  d.lumaAvailable(mbX, mbY, x-1, y-1, blk),
+			}
+			boff := off + y*stride + x
+			if err := predIntra4x4(d.pic.luma, boff, stride, int(mb.predModes[blk]), n); err != nil {
+				return err
+			}
+			if mb.cbf&(1<<blk) == 0 {
+				continue
+			}
+			for k, pos := range zigzag4x4 {
+				c4[pos] = res.luma[blk][k]
+			}
+			scale4x4(&c4, d.pps.levelScale4x4[0], qp, false)
+			idct4x4(&c4, d.pic.luma[boff:], stride)
+		}
+
+	default:
+		if err := predIntraSquare(d.pic.luma, off, stride, 16, (mb.mbType-1)%4, mbN); err != nil {
+			return err
+		}
+
+		var dc [16]int32
+		if mb.cbf&(1<<cbfLumaDC) != 0 {
+			for k, pos := range zigzag4x4 {
+				dc[pos] = res.lumaDC[k]
+			}
+			lumaDC(&dc, d.pps.levelScale4x4[0], qp)
+		}
+
+		for blk := 0; blk < 16; blk++ {
+			x, y := lumaBlkX[blk], lumaBlkY[blk]
+			c4[0] = dc[(y/4)*4+x/4]
+			if c4[0] == 0 && mb.cbf&(1<<blk) == 0 {
+				continue
+			}
+			for k := 1; k < 16; k++ {
+				c4[zigzag4x4[k]] = res.luma[blk][k]
+			}
+			scale4x4(&c4, d.pps.levelScale4x4[0], qp, true)
+			idct4x4(&c4, d.pic.luma[off+y*stride+x:], stride)
+		}
+	}
+
+	if d.sps.chromaFormatIdc == 0 {
+		return nil
+	}
+
+	stride = d.pic.widthMbs * 8
+	off = mbY*8*stride + mbX*8
+	for iCbCr, plane := range [][]byte{d.pic.cb, d.pic.cr} {
+		if err := predIntraSquare(plane, off, stride, 8, chromaPredModes[mb.chromaPredMode], mbN); err != nil {
+			return err
+		}
+
+		qpOffset := d.pps.chromaQPIndexOffset
+		if iCbCr == 1 {
+			qpOffset = d.pps.secondChromaQPIndexOffset
+		}
+		qpc := chromaQP[clip3(0, 51, qp+qpOffset)]
+		ls := d.pps.levelScale4x4[1+iCbCr]
+
+		dc := res.chromaDC[iCbCr]
+		if mb.cbf&(1<<(cbfChromaDC+iCbCr)) != 0 {
+			chromaDC(&dc, ls, qpc)
+		}
+
+		for b4 := 0; b4 < 4; b4++ {
+			c4[0] = dc[b4]
+			if c4[0] == 0 && mb.cbf&(1<<(cbfChromaAC+iCbCr*4+b4)) == 0 {
+				continue
+			}
+			for k := 1; k < 16; k++ {
+				c4[zigzag4x4[k]] = res.chromaAC[iCbCr][b4][k]
+			}
+			scale4x4(&c4, ls, qpc, true)
+			idct4x4(&c4, plane[off+4*(b4/2)*stride+4*(b4%2):], stride)
+		}
+	}
+
+	return nil
+}
+
+// lumaAvailable returns whether the luma sample at the given location
+// relative to the macroblock is available for intra prediction of the
+// block with the given luma4x4BlkIdx (or the first luma4x4BlkIdx of the
+// 8x8 block), ie. in the same slice and already decoded.
+func (d *sliceDecoder) lumaAvailable(mbX, mbY, x, y int, blk int) bool {
+	mb, xW, yW := d.neighbour(mbX, mbY, x, y, 16)
+	if mb == nil {
+		return false
+	}
+	if x >= 0 && x < 16 && y >= 0 {
+		// within the current macroblock
+		return luma4x4BlkIdx(xW, yW) < blk
+	}
+	return true
+}
@@ -0,0 +1,389 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+import (
+	"errors"
+	"fmt"
+)
+
+// sps is a parsed sequence parameter set,
+// containing only what's needed to decode
+// intra coded pictures.
+type sps struct {
+	id                    uint32
+	profileIdc            uint32
+	chromaFormatIdc       uint32
+	log2MaxFrameNum       uint32
+	picOrderCntType       uint32
+	log2MaxPicOrderCntLsb uint32
+	deltaPicOrderZero     bool
+	frameMbsOnly          bool
+	scalingMatrixPresent  bool
+	widthMbs              int
+	heightMbs             int
+
+	// scaling lists in zig-zag order, flat if not present
+	scaling4x4 [6][]int32
+	scaling8x8 [6][]int32
+
+	// frame cropping rectangle in luma samples
+	cropLeft, cropRight, cropTop, cropBottom int
+
+	// colour description from the vui
+	fullRange          bool
+	matrixCoefficients uint32
+}
+
+// pps is a parsed picture parameter set.
+type pps struct {
+	id                        uint32
+	spsID                     uint32
+	entropyCodingMode         bool
+	bottomFieldPicOrder       bool
+	redundantPicCntPresent    bool
+	picInitQP                 int
+	chromaQPIndexOffset       int
+	secondChromaQPIndexOffset int
+	deblockingFilterControl   bool
+	transform8x8Mode          bool
+
+	// scaling lists in zig-zag order, derived
+	// from the sps if not present in the pps
+	scaling4x4 [6][]int32
+	scaling8x8 [6][]int32
+
+	// LevelScale4x4 for Y, Cb and Cr, and LevelScale8x8 for
+	// Y, of intra macroblocks, derived from the scaling lists
+	levelScale4x4 [3]*levelScale4x4
+	levelScale8x8 *levelScale8x8
+}
+
+var (
+	flat4x4 = []int32{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16}
+	flat8x8 = []int32{
+		16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+		16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+		16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+		16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+	}
+
+	default4x4Intra = []int32{6, 13, 13, 20, 20, 20, 28, 28, 28, 28, 32, 32, 32, 37, 37, 42}
+	default4x4Inter = []int32{10, 14, 14, 20, 20, 20, 24, 24, 24, 24, 27, 27, 27, 30, 30, 34}
+	default8x8Intra = []int32{
+		6, 10, 10, 13, 11, 13, 16, 16, 16, 16, 18, 18, 18, 18, 18, 23,
+		23, 23, 23, 23, 23, 25, 25, 25, 25, 25, 25, 25, 27, 27, 27, 27,
+		27, 27, 27, 27, 29, 29, 29, 29, 29, 29, 29, 31, 31, 31, 31, 31,
+		31, 33, 33, 33, 33, 33, 36, 36, 36, 36, 38, 38, 38, 40, 40, 42,
+	}
+	default8x8Inter = []int32{
+		9, 13, 13, 15, 13, 15, 17, 17, 17, 17, 19, 19, 19, 19, 19, 21,
+		21, 21, 21, 21, 21, 22, 22, 22, 22, 22, 22, 22, 24, 24, 24, 24,
+		24, 24, 24, 24, 25, 25, 25, 25, 25, 25, 25, 27, 27, 27, 27, 27,
+		27, 28, 28, 28, 28, 28, 30, 30, 30, 30, 32, 32, 32, 33, 33, 35,
+	}
+)
+
+// scalingList parses a scaling_list() of the given size,
+// returning nil if the default scaling list should be used.
+func scalingList(r *bitReader, size int) []int32 {
+	list := make([]int32, size)
+	last, next := int32(8), int32(8)
+	for j := 0; j < size; j++ {
+		if next != 0 {
+			delta := r.se()
+			next = (last + delta + 256) % 256
+			if j == 0 && next == 0 {
+				// useDefaultScalingMatrixFlag
+				return nil
+			}
+		}
+		if next != 0 {
+			last = next
+		}
+		list[j] = last
+	}
+	return list
+}
+
+// scalingMatrix parses the scaling lists of a seq_scaling_matrix or pic_scaling_matrix,
+// using the given fallback lists for the first list of each type when not present.
+func scalingMatrix(r *bitReader, n int, fallback4x4 [2][]int32, fallback8x8 [2][]int32) (l4x4 [6][]int32, l8x8 [6][]int32) {
+	for i := 0; i < n; i++ {
+		present := r.flag()
+
+		if i < 6 {
+			var list []int32
+			if present {
+				if list = scalingList(r, 16); list == nil {
+					list = default4x4Intra
+					if i >= 3 {
+						list = default4x4Inter
+					}
+				}
+			} else if i == 0 || i == 3 {
+				list = fallback4x4[i/3]
+			} else {
+				list = l4x4[i-1]
+			}
+			l4x4[i] = list
+			continue
+		}
+
+		var list []int32
+		j := i - 6
+		if present {
+			if list = scalingList(r, 64); list == nil {
+				list = default8x8Intra
+				if j&1 == 1 {
+					list = default8x8Inter
+				}
+			}
+		} else if j < 2 {
+			list = fallback8x8[j]
+		} else {
+			list = l8x8[j-2]
+		}
+		l8x8[j] = list
+	}
+
+	// lists which weren't signalled at all
+	// (for 8x8 lists, when n < 12) fall back
+	// in the same way as those not present.
+	for j := n - 6; j < 6; j++ {
+		if j < 0 {
+			continue
+		}
+		if j < 2 {
+			l8x8[j] = fallback8x8[j]
+		} else {
+			l8x8[j] = l8x8[j-2]
+		}
+	}
+
+	return
+}
+
+// parseSPS parses a sequence parameter set RBSP.
+func parseSPS(b []byte) (*sps, error) {
+	r := &bitReader{buf: b}
+	s := &sps{chromaFormatIdc: 1}
+
+	s.profileIdc = r.u(8)
+	r.skip(16) // constraint flags, reserved bits and level_idc
+	s.id = r.ue()
+	if s.id > 31 {
+		return nil, fmt.Errorf("invalid sps id %d", s.id)
+	}
+
+	for i := range s.scaling4x4 {
+		s.scaling4x4[i] = flat4x4
+		s.scaling8x8[i] = flat8x8
+	}
+
+	switch s.profileIdc {
+	case 100, 110, 122, 244, 44, 83, 86, 118, 128, 138, 139, 134, 135:
+		s.chromaFormatIdc = r.ue()
+		if s.chromaFormatIdc == 3 && r.flag() {
+			return nil, errors.New("separate colour planes not supported")
+		}
+		if r.ue() != 0 || r.ue() != 0 {
+			return nil, errors.New("bit depths other than 8 not supported")
+		}
+		if r.flag() {
+			return nil, errors.New("transform bypass not supported")
+		}
+		if r.flag() {
+			// seq_scaling_matrix_present_flag
+			s.scalingMatrixPresent = true
+			n := 8
+			if s.chromaFormatIdc == 3 {
+				n = 12
+			}
+			s.scaling4x4, s.scaling8x8 = scalingMatrix(r, n,
+				[2][]int32{default4x4Intra, default4x4Inter},
+				[2][]int32{default8x8Intra, default8x8Inter},
+			)
+		}
+	}
+
+	if s.chromaFormatIdc > 1 {
+		return nil, fmt.Errorf("chroma format %d not supported", s.chromaFormatIdc)
+	}
+
+	s.log2MaxFrameNum = r.ue() + 4
+	s.picOrderCntType = r.ue()
+	switch s.picOrderCntType {
+	case 0:
+		s.log2MaxPicOrderCntLsb = r.ue() + 4
+	case 1:
+		s.deltaPicOrderZero = r.flag()
+		r.se() // offset_for_non_ref_pic
+		r.se() // offset_for_top_to_bottom_field
+		n := r.ue()
+		if n > 255 {
+			return nil, errors.New("invalid num_ref_frames_in_pic_order_cnt_cycle")
+		}
+		for i := uint32(0); i < n; i++ {
+			r.se() // offset_for_ref_frame
+		}
+	}
+
+	r.ue()   // max_num_ref_frames
+	r.flag() // gaps_in_frame_num_value_allowed_flag
+
+	s.widthMbs = int(r.ue()) + 1
+	s.heightMbs = int(r.ue()) + 1
+	s.frameMbsOnly = r.flag()
+	if !s.frameMbsOnly {
+		return nil, errors.New("interlaced video not supported")
+	}
+	r.flag() // direct_8x8_inference_flag
+
+	if r.flag() {
+		// frame_cropping_flag; crop units
+		// are 2 luma samples for 4:2:0,
+		// and 1 for monochrome.
+		unit := 2
+		if s.chromaFormatIdc == 0 {
+			unit = 1
+		}
+		s.cropLeft = int(r.ue()) * unit
+		s.cropRight = int(r.ue()) * unit
+		s.cropTop = int(r.ue()) * unit
+		s.cropBottom = int(r.ue()) * unit
+	}
+
+	s.matrixCoefficients = 2 // unspecified
+	if r.flag() {
+		// vui_parameters_present_flag; we
+		// only need the colour description
+		if r.flag() {
+			// aspect_ratio_info_present_flag
+			if r.u(8) == 255 {
+				r.skip(32) // sar_width, sar_height
+			}
+		}
+		if r.flag() {
+			r.flag() // overscan_appropriate_flag
+		}
+		if r.flag() {
+			// video_signal_type_present_flag
+			r.skip(3) // video_format
+			s.fullRange = r.flag()
+			if r.flag() {
+				// colour_description_present_flag
+				r.skip(16) // colour_primaries, transfer_characteristics
+				s.matrixCoefficients = r.u(8)
+			}
+		}
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if s.widthMbs*s.heightMbs > maxFrameMbs {
+		return nil, fmt.Errorf("frame size %dx%d macroblocks too large", s.widthMbs, s.heightMbs)
+	}
+
+	if s.cropLeft+s.cropRight >= s.widthMbs*16 ||
+		s.cropTop+s.cropBottom >= s.heightMbs*16 {
+		return nil, errors.New("invalid frame cropping")
+	}
+
+	return s, nil
+}
+
+// parsePPS parses a picture parameter set RBSP, using
+// the given sequence parameter sets for derivations.
+func parsePPS(b []byte, spss map[uint32]*sps) (*pps, error) {
+	r := &bitReader{buf: b}
+	p := &pps{}
+
+	p.id = r.ue()
+	if p.id > 255 {
+		return nil, fmt.Errorf("invalid pps id %d", p.id)
+	}
+	p.spsID = r.ue()
+	s, ok := spss[p.spsID]
+	if !ok {
+		return nil, fmt.Errorf("pps refers to missing sps %d", p.spsID)
+	}
+
+	p.entropyCodingMode = r.flag()
+	p.bottomFieldPicOrder = r.flag()
+	if r.ue() != 0 {
+		return nil, errors.New("slice groups not supported")
+	}
+	r.ue()   // num_ref_idx_l0_default_active_minus1
+	r.ue()   // num_ref_idx_l1_default_active_minus1
+	r.flag() // weighted_pred_flag
+	r.u(2)   // weighted_bipred_idc
+	p.picInitQP = 26 + int(r.se())
+	r.se() // pic_init_qs_minus26
+	p.chromaQPIndexOffset = int(r.se())
+	p.deblockingFilterControl = r.flag()
+	r.flag() // constrained_intra_pred_flag
+	p.redundantPicCntPresent = r.flag()
+
+	p.secondChromaQPIndexOffset = p.chromaQPIndexOffset
+	p.scaling4x4 = s.scaling4x4
+	p.scaling8x8 = s.scaling8x8
+
+	if r.moreRBSPData() {
+		p.transform8x8Mode = r.flag()
+		if r.flag() {
+			// pic_scaling_matrix_present_flag
+			n := 6
+			if p.transform8x8Mode {
+				n += 2
+				if s.chromaFormatIdc == 3 {
+					n += 4
+				}
+			}
+
+			// fall-back rule B if the sps has a
+			// scaling matrix, otherwise rule A
+			fallback4x4 := [2][]int32{default4x4Intra, default4x4Inter}
+			fallback8x8 := [2][]int32{default8x8Intra, default8x8Inter}
+			if s.scalingMatrixPresent {
+				fallback4x4 = [2][]int32{s.scaling4x4[0], s.scaling4x4[3]}
+				fallback8x8 = [2][]int32{s.scaling8x8[0], s.scaling8x8[1]}
+			}
+			p.scaling4x4, p.scaling8x8 = scalingMatrix(r, n, fallback4x4, fallback8x8)
+		}
+		p.secondChromaQPIndexOffset = int(r.se())
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if p.picInitQP < 0 || p.picInitQP > 51 {
+		return nil, fmt.Errorf("invalid pic_init_qp %d", p.picInitQP)
+	}
+
+	for i := range p.levelScale4x4 {
+		p.levelScale4x4[i] = newLevelScale4x4(p.scaling4x4[i])
+	}
+	p.levelScale8x8 = newLevelScale8x8(p.scaling8x8[0])
+
+	return p, nil
+}
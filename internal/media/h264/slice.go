@@ -0,0 +1,154 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NAL unit types we care about.
+const (
+	nalSlice    = 1
+	nalSliceIDR = 5
+	nalSPS      = 7
+	nalPPS      = 8
+)
+
+// sliceHeader is a parsed slice header, containing
+// only what's needed to decode intra coded slices.
+type sliceHeader struct {
+	firstMb           int
+	ppsID             uint32
+	redundantPicCnt   uint32
+	qp                int
+	disableDeblocking uint32
+	alphaOffset       int
+	betaOffset        int
+}
+
+// parseSliceHeader parses the header of an I slice, leaving the reader
+// positioned at the start of the slice data. It also returns the pps and
+// sps referred to by the slice.
+func parseSliceHeader(r *bitReader, nalType int, nalRefIdc uint32, spss map[uint32]*sps, ppss map[uint32]*pps) (*sliceHeader, *pps, *sps, error) {
+	h := &sliceHeader{}
+
+	h.firstMb = int(r.ue())
+	sliceType := r.ue()
+	if sliceType%5 != 2 {
+		// only I slices can be decoded
+		// without reference pictures.
+		return nil, nil, nil, fmt.Errorf("slice type %d not supported", sliceType)
+	}
+
+	h.ppsID = r.ue()
+	p, ok := ppss[h.ppsID]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("slice refers to missing pps %d", h.ppsID)
+	}
+	s, ok := spss[p.spsID]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("pps refers to missing sps %d", p.spsID)
+	}
+
+	r.skip(int(s.log2MaxFrameNum)) // frame_num
+	if nalType == nalSliceIDR {
+		r.ue() // idr_pic_id
+	}
+
+	switch s.picOrderCntType {
+	case 0:
+		r.skip(int(s.log2MaxPicOrderCntLsb)) // pic_order_cnt_lsb
+		if p.bottomFieldPicOrder {
+			r.se() // delta_pic_order_cnt_bottom
+		}
+	case 1:
+		if !s.deltaPicOrderZero {
+			r.se() // delta_pic_order_cnt[0]
+			if p.bottomFieldPicOrder {
+				r.se() // delta_pic_order_cnt[1]
+			}
+		}
+	}
+
+	if p.redundantPicCntPresent {
+		h.redundantPicCnt = r.ue()
+	}
+
+	if nalRefIdc != 0 {
+		// dec_ref_pic_marking()
+		if nalType == nalSliceIDR {
+			r.flag() // no_output_of_prior_pics_flag
+			r.flag() // long_term_reference_flag
+		} else if r.flag() {
+			// adaptive_ref_pic_marking_mode_flag
+			for i := 0; ; i++ {
+				op := r.ue()
+				if op == 0 {
+					break
+				}
+				if op > 6 || i > 64 || r.err != nil {
+					return nil, nil, nil, errors.New("invalid memory_management_control_operation")
+				}
+				if op == 1 || op == 3 {
+					r.ue() // difference_of_pic_nums_minus1
+				}
+				if op == 2 {
+					r.ue() // long_term_pic_num
+				}
+				if op == 3 || op == 6 {
+					r.ue() // long_term_frame_idx
+				}
+				if op == 4 {
+					r.ue() // max_long_term_frame_idx_plus1
+				}
+			}
+		}
+	}
+
+	h.qp = p.picInitQP + int(r.se())
+	if h.qp < 0 || h.qp > 51 {
+		return nil, nil, nil, fmt.Errorf("invalid slice qp %d", h.qp)
+	}
+
+	if p.deblockingFilterControl {
+		h.disableDeblocking = r.ue()
+		if h.disableDeblocking > 2 {
+			return nil, nil, nil, errors.New("invalid disable_deblocking_filter_idc")
+		}
+		if h.disableDeblocking != 1 {
+			h.alphaOffset = int(r.se()) * 2
+			h.betaOffset = int(r.se()) * 2
+			if h.alphaOffset < -12 || h.alphaOffset > 12 ||
+				h.betaOffset < -12 || h.betaOffset > 12 {
+				return nil, nil, nil, errors.New("invalid deblocking filter offsets")
+			}
+		}
+	}
+
+	if r.err != nil {
+		return nil, nil, nil, r.err
+	}
+
+	if h.firstMb >= s.widthMbs*s.heightMbs {
+		return nil, nil, nil, fmt.Errorf("invalid first_mb_in_slice %d", h.firstMb)
+	}
+
+	return h, p, s, nil
+}
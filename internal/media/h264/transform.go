@@ -0,0 +1,316 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package h264
+
+// This file contains the scaling and transform
+// processes for residual blocks, as specified in
+// ITU-T H.264 8.5.
+
+var (
+	// zigzag4x4 maps 4x4 zig-zag scan positions to raster positions.
+	zigzag4x4 = [16]int{0, 1, 4, 8, 5, 2, 3, 6, 9, 12, 13, 10, 7, 11, 14, 15}
+
+	// zigzag8x8 maps 8x8 zig-zag scan positions to raster positions.
+	zigzag8x8 = [64]int{
+		0, 1, 8, 16, 9, 2, 3, 10, 17, 24, 32, 25, 18, 11, 4, 5,
+		12, 19, 26, 33, 40, 48, 41, 34, 27, 20, 13, 6, 7, 14, 21, 28,
+		35, 42, 49, 56, 57, 50, 43, 36, 29, 22, 15, 23, 30, 37, 44, 51,
+		58, 59, 52, 45, 38, 31, 39, 46, 53, 60, 61, 54, 47, 55, 62, 63,
+	}
+
+	// normAdjust4x4 values v, indexed by qP % 6.
+	normAdjust4x4 = [6][3]int32{
+		{10, 16, 13}, {11, 18, 14}, {13, 20, 16},
+		{14, 23, 18}, {16, 25, 20}, {18, 29, 23},
+	}
+
+	// normAdjust8x8 values v, indexed by qP % 6.
+	normAdjust8x8 = [6][6]int32{
+		{20, 18, 32, 19, 25, 24}, {22, 19, 35, 21, 28, 26},
+		{26, 23, 42, 24, 33, 31}, {28, 25, 45, 26, 35, 33},
+		{32, 28, 51, 30, 40, 38}, {36, 32, 58, 34, 46, 43},
+	}
+
+	// chromaQP maps qPI to QPC.
+	chromaQP = [52]int{
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
+		29, 30, 31, 32, 32, 33, 34, 34, 35, 35, 36, 36, 37, 37,
+		37, 38, 38, 38, 39, 39, 39, 39,
+	}
+)
+
+// levelScale4x4 holds LevelScale4x4 in raster order, for each qP % 6.
+type levelScale4x4 [6][16]int32
+
+// levelScale8x8 holds LevelScale8x8 in raster order, for each qP % 6.
+type levelScale8x8 [6][64]int32
+
+// newLevelScale4x4 calculates LevelScale4x4 for the given scaling list (in zig-zag order).
+func newLevelScale4x4(list []int32) *levelScale4x4 {
+	ls := &levelScale4x4{}
+	for m := 0; m < 6; m++ {
+		for k := 0; k < 16; k++ {
+			pos := zigzag4x4[k]
+			i, j := pos/4, pos%4
+			v := normAdjust4x4[m][2]
+			if i%2 == 0 && j%2 == 0 {
+				v = normAdjust4x4[m][0]
+			} else if i%2 == 1 && j%2 == 1 {
+				v = normAdjust4x4[m][1]
+			}
+			ls[m][pos] = list[k] * v
+		}
+	}
+	return ls
+}
+
+// newLevelScale8x8 calculates LevelScale8x8 for the given scaling list (in zig-zag order).
+func newLevelScale8x8(list []int32) *levelScale8x8 {
+	ls := &levelScale8x8{}
+	for m := 0; m < 6; m++ {
+		for k := 0; k < 64; k++ {
+			pos := zigzag8x8[k]
+			i, j := pos/8, pos%8
+			var v int32
+			switch {
+			case i%4 == 0 && j%4 == 0:
+				v = normAdjust8x8[m][0]
+			case i%2 == 1 && j%2 == 1:
+				v = normAdjust8x8[m][1]
+			case i%4 == 2 && j%4 == 2:
+				v = normAdjust8x8[m][2]
+			case (i%4 == 0 && j%2 == 1) || (i%2 == 1 && j%4 == 0):
+				v = normAdjust8x8[m][3]
+			case (i%4 == 0 && j%4 == 2) || (i%4 == 2 && j%4 == 0):
+				v = normAdjust8x8[m][4]
+			default:
+				v = normAdjust8x8[m][5]
+			}
+			ls[m][pos] = list[k] * v
+		}
+	}
+	return ls
+}
+
+// scale4x4 scales the coefficients of a 4x4 block (in raster order) in place.
+// If dc is true, the DC coefficient is left as is, having already been scaled.
+func scale4x4(c *[16]int32, ls *levelScale4x4, qP int, dc bool) {
+	start := 0
+	if dc {
+		start = 1
+	}
+	scale := &ls[qP%6]
+	if qP >= 24 {
+		shift := uint(qP/6 - 4)
+		for i := start; i < 16; i++ {
+			c[i] = (c[i] * scale[i]) << shift
+		}
+	} else {
+		shift := uint(4 - qP/6)
+		round := int32(1) << (shift - 1)
+		for i := start; i < 16; i++ {
+			c[i] = (c[i]*scale[i] + round) >> shift
+		}
+	}
+}
+
+// scale8x8 scales the coefficients of an 8x8 block (in raster order) in place.
+func scale8x8(c *[64]int32, ls *levelScale8x8, qP int) {
+	scale := &ls[qP%6]
+	if qP >= 36 {
+		shift := uint(qP/6 - 6)
+		for i := range c {
+			c[i] = (c[i] * scale[i]) << shift
+		}
+	} else {
+		shift := uint(6 - qP/6)
+		round := int32(1) << (shift - 1)
+		for i := range c {
+			c[i] = (c[i]*scale[i] + round) >> shift
+		}
+	}
+}
+
+// lumaDC transforms and scales the Intra16x16 luma DC coefficients (in raster order) in place.
+func lumaDC(c *[16]int32, ls *levelScale4x4, qP int) {
+	var f [16]int32
+
+	// f = H * c * H, with H the 4x4 hadamard matrix
+	for i := 0; i < 4; i++ {
+		c0, c1, c2, c3 := c[i*4], c[i*4+1], c[i*4+2], c[i*4+3]
+		f[i*4] = c0 + c1 + c2 + c3
+		f[i*4+1] = c0 + c1 - c2 - c3
+		f[i*4+2] = c0 - c1 - c2 + c3
+		f[i*4+3] = c0 - c1 + c2 - c3
+	}
+	for j := 0; j < 4; j++ {
+		f0, f1, f2, f3 := f[j], f[4+j], f[8+j], f[12+j]
+		c[j] = f0 + f1 + f2 + f3
+		c[4+j] = f0 + f1 - f2 - f3
+		c[8+j] = f0 - f1 - f2 + f3
+		c[12+j] = f0 - f1 + f2 - f3
+	}
+
+	scale := ls[qP%6][0]
+	if qP >= 36 {
+		shift := uint(qP/6 - 6)
+		for i := range c {
+			c[i] = (c[i] * scale) << shift
+		}
+	} else {
+		shift := uint(6 - qP/6)
+		round := int32(1) << (shift - 1)
+		for i := range c {
+			c[i] = (c[i]*scale + round) >> shift
+		}
+	}
+}
+
+// chromaDC transforms and scales 4:2:0 chroma DC coefficients (in raster order) in place.
+func chromaDC(c *[4]int32, ls *levelScale4x4, qP int) {
+	c0, c1, c2, c3 := c[0], c[1], c[2], c[3]
+	f := [4]int32{
+		c0 + c1 + c2 + c3,
+		c0 - c1 + c2 - c3,
+		c0 + c1 - c2 - c3,
+		c0 - c1 - c2 + c3,
+	}
+	scale := ls[qP%6][0]
+	for i := range c {
+		c[i] = ((f[i] * scale) << uint(qP/6)) >> 5
+	}
+}
+
+// idct4x4 applies the inverse 4x4 transform to the given scaled
+// coefficients, adding the residual to the 4x4 block of predicted
+// samples at the start of dst (with the given stride).
+func idct4x4(c *[16]int32, dst []byte, stride int) {
+	var f [16]int32
+	for i := 0; i < 4; i++ {
+		d := c[i*4 : i*4+4]
+		e0 := d[0] + d[2]
+		e1 := d[0] - d[2]
+		e2 := (d[1] >> 1) - d[3]
+		e3 := d[1] + (d[3] >> 1)
+		f[i*4] = e0 + e3
+		f[i*4+1] = e1 + e2
+		f[i*4+2] = e1 - e2
+		f[i*4+3] = e0 - e3
+	}
+	for j := 0; j < 4; j++ {
+		g0 := f[j] + f[8+j]
+		g1 := f[j] - f[8+j]
+		g2 := (f[4+j] >> 1) - f[12+j]
+		g3 := f[4+j] + (f[12+j] >> 1)
+		dst[j] = clip1(int32(dst[j]) + (g0+g3+32)>>6)
+		dst[stride+j] = clip1(int32(dst[stride+j]) + (g1+g2+32)>>6)
+		dst[2*stride+j] = clip1(int32(dst[2*stride+j]) + (g1-g2+32)>>6)
+		dst[3*stride+j] = clip1(int32(dst[3*stride+j]) + (g0-g3+32)>>6)
+	}
+}
+
+// idct8x8 applies the inverse 8x8 transform to the given scaled
+// coefficients, adding the residual to the 8x8 block of predicted
+// samples at the start of dst (with the given stride).
+func idct8x8(c *[64]int32, dst []byte, stride int) {
+	var f [64]int32
+	for i := 0; i < 8; i++ {
+		idct8(c[i*8:i*8+8], f[i*8:i*8+8])
+	}
+	var col, out [8]int32
+	for j := 0; j < 8; j++ {
+		for i := 0; i < 8; i++ {
+			col[i] = f[i*8+j]
+		}
+		idct8(col[:], out[:])
+		for i := 0; i < 8; i++ {
+			p := i*stride + j
+			dst[p] = clip1(int32(dst[p]) + (out[i]+32)>>6)
+		}
+	}
+}
+
+// idct8 applies the 1-D inverse 8x8 transform to d, writing to out.
+func idct8(d []int32, out []int32) {
+	a0 := d[0] + d[4]
+	a4 := d[0] - d[4]
+	a2 := (d[2] >> 1) - d[6]
+	a6 := d[2] + (d[6] >> 1)
+
+	b0 := a0 + a6
+	b2 := a4 + a2
+	b4 := a4 - a2
+	b6 := a0 - a6
+
+	a1 := -d[3] + d[5] - d[7] - (d[7] >> 1)
+	a3 := d[1] + d[7] - d[3] - (d[3] >> 1)
+	a5 := -d[1] + d[7] + d[5] + (d[5] >> 1)
+	a7 := d[3] + d[5] + d[1] + (d[1] >> 1)
+
+	b1 := a1 + (a7 >> 2)
+	b7 := a7 - (a1 >> 2)
+	b3 := a3 + (a5 >> 2)
+	b5 := (a3 >> 2) - a5
+
+	out[0] = b0 + b7
+	out[1] = b2 + b5
+	out[2] = b4 + b3
+	out[3] = b6 + b1
+	out[4] = b6 - b1
+	out[5] = b4 - b3
+	out[6] = b2 - b5
+	out[7] = b0 - b7
+}
+
+// clip1 clips x to the range of an 8-bit sample.
+func clip1(x int32) byte {
+	if x < 0 {
+		return 0
+	}
+	if x > 255 {
+		return 255
+	}
+	return byte(x)
+}
+
+// clip3 clips x to the range [lo, hi].
+func clip3(lo, hi, x int) int {
+	if x < lo {
+		return lo
+	}
+	if x > hi {
+		return hi
+	}
+	return x
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func abs32(x int32) int32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
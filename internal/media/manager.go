@@ -33,6 +33,9 @@ var SupportedMIMETypes = []string{
 	mimeImagePng,
 	mimeImageWebp,
 	mimeVideoMp4,
+	mimeAudioMpeg,
+	mimeAudioOgg,
+	mimeAudioFlac,
 }
 
 var SupportedEmojiMIMETypes = []string{
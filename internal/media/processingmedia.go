@@ -154,10 +154,23 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 	// Recombine header bytes with remaining stream
 	r := io.MultiReader(bytes.NewReader(hdrBuf), rc)
 
+	// Content type as detected; we
+	// normalise a few of these below.
+	contentType := info.MIME.Value
+
 	switch info.Extension {
 	case "mp4":
 		p.media.Type = gtsmodel.FileTypeVideo
 
+	case "mp3", "ogg":
+		p.media.Type = gtsmodel.FileTypeAudio
+
+	case "flac":
+		// use the registered
+		// rather than x- type
+		p.media.Type = gtsmodel.FileTypeAudio
+		contentType = mimeAudioFlac
+
 	case "gif":
 		p.media.Type = gtsmodel.FileTypeImage
 
@@ -212,7 +225,7 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		p.media.ID,
 		info.Extension,
 	)
-	p.media.File.ContentType = info.MIME.Value
+	p.media.File.ContentType = contentType
 	cached := true
 	p.media.Cached = &cached
 
@@ -260,6 +273,21 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 		p.media.FileMeta.Original.Duration = &video.duration
 		p.media.FileMeta.Original.Framerate = &video.framerate
 		p.media.FileMeta.Original.Bitrate = &video.bitrate
+
+	// .mp3, .ogg, .flac audio types
+	case mimeAudioMpeg, mimeAudioOgg, mimeAudioFlac:
+		audio, err := decodeAudio(rc, p.media.File.ContentType, int64(p.media.File.FileSize))
+		if err != nil {
+			return fmt.Errorf("error decoding audio: %w", err)
+		}
+
+		// Set audio metadata in attachment info.
+		p.media.FileMeta.Original.Duration = &audio.duration
+		p.media.FileMeta.Original.Bitrate = &audio.bitrate
+
+		// There's nothing to see, so use a placeholder
+		// image for the thumbnail (and blurhash).
+		fullImg = blankImage(audioThumbnailSize, audioThumbnailSize)
 	}
 
 	// The image should be in-memory by now.
@@ -267,11 +295,13 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 		return fmt.Errorf("error closing file: %w", err)
 	}
 
-	// Set full-size dimensions in attachment info.
-	p.media.FileMeta.Original.Width = int(fullImg.Width())
-	p.media.FileMeta.Original.Height = int(fullImg.Height())
-	p.media.FileMeta.Original.Size = int(fullImg.Size())
-	p.media.FileMeta.Original.Aspect = fullImg.AspectRatio()
+	if p.media.Type != gtsmodel.FileTypeAudio {
+		// Set full-size dimensions in attachment info.
+		p.media.FileMeta.Original.Width = int(fullImg.Width())
+		p.media.FileMeta.Original.Height = int(fullImg.Height())
+		p.media.FileMeta.Original.Size = int(fullImg.Size())
+		p.media.FileMeta.Original.Aspect = fullImg.AspectRatio()
+	}
 
 	// Calculate attachment thumbnail file path
 	p.media.Thumbnail.Path = fmt.Sprintf(
@@ -28,6 +28,7 @@ import (
 const (
 	mimeImage = "image"
 	mimeVideo = "video"
+	mimeAudio = "audio"
 
 	mimeJpeg      = "jpeg"
 	mimeImageJpeg = mimeImage + "/" + mimeJpeg
@@ -43,6 +44,15 @@ const (
 
 	mimeMp4      = "mp4"
 	mimeVideoMp4 = mimeVideo + "/" + mimeMp4
+
+	mimeMpeg      = "mpeg"
+	mimeAudioMpeg = mimeAudio + "/" + mimeMpeg
+
+	mimeOgg      = "ogg"
+	mimeAudioOgg = mimeAudio + "/" + mimeOgg
+
+	mimeFlac      = "flac"
+	mimeAudioFlac = mimeAudio + "/" + mimeFlac
 )
 
 // EmojiMaxBytes is the maximum permitted bytes of an emoji upload (50kb)
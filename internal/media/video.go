@@ -19,14 +19,21 @@
 package media
 
 import (
+	"errors"
 	"fmt"
+	"image"
 	"io"
 
 	"github.com/abema/go-mp4"
 	"github.com/superseriousbusiness/gotosocial/internal/iotools"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media/h264"
 )
 
+// maxKeyframeSize is the largest keyframe
+// sample we'll read in order to decode it.
+const maxKeyframeSize = 16 * 1024 * 1024
+
 type gtsVideo struct {
 	frame     *gtsImage
 	duration  float32 // in seconds
@@ -35,7 +42,8 @@ type gtsVideo struct {
 }
 
 // decodeVideoFrame decodes and returns an image from a single frame in the given video stream.
-// (note: currently this only returns a blank image resized to fit video dimensions).
+// This is the first keyframe of the H.264 video track; if it can't be decoded, a blank image
+// resized to fit the video dimensions is returned instead.
 func decodeVideoFrame(r io.Reader) (*gtsVideo, error) {
 	// we need a readseeker to decode the video...
 	tfs, err := iotools.TempFileSeeker(r)
@@ -124,9 +132,147 @@ func decodeVideoFrame(r io.Reader) (*gtsVideo, error) {
 		return nil, fmt.Errorf("error determining video metadata: %v", empty)
 	}
 
-	// Create new empty "frame" image.
-	// TODO: decode frame from video file.
-	video.frame = blankImage(width, height)
+	// Decode the first keyframe to use as the
+	// "frame" image, falling back to an empty
+	// image if the video can't be decoded.
+	frame, err := decodeKeyframe(tfs, info)
+	if err != nil {
+		log.Warnf("error decoding video keyframe, using blank frame: %s", err)
+		video.frame = blankImage(width, height)
+	} else {
+		video.frame = &gtsImage{image: frame}
+	}
 
 	return &video, nil
 }
+
+// decodeKeyframe decodes the first keyframe of the first AVC (H.264) video track in the mp4.
+func decodeKeyframe(rs io.ReadSeeker, info *mp4.ProbeInfo) (image.Image, error) {
+	traks, err := mp4.ExtractBox(rs, nil, mp4.BoxPath{mp4.BoxTypeMoov(), mp4.BoxTypeTrak()})
+	if err != nil {
+		return nil, fmt.Errorf("error extracting tracks: %w", err)
+	}
+
+	// probed tracks are in the same order as the trak boxes
+	if len(traks) != len(info.Tracks) {
+		return nil, errors.New("mismatched number of tracks")
+	}
+
+	for i, tr := range info.Tracks {
+		if tr.AVC == nil || tr.Encrypted {
+			continue
+		}
+
+		nalus, err := readKeyframe(rs, traks[i], tr)
+		if err != nil {
+			return nil, err
+		}
+
+		img, err := h264.DecodeFrame(nalus)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding h264 frame: %w", err)
+		}
+
+		return img, nil
+	}
+
+	return nil, errors.New("no avc video track found")
+}
+
+// readKeyframe reads the NAL units of the first keyframe of the given AVC video track,
+// preceded by the sequence and picture parameter sets from the track's sample entry.
+func readKeyframe(rs io.ReadSeeker, trak *mp4.BoxInfo, tr *mp4.Track) ([][]byte, error) {
+	stbl := mp4.BoxPath{mp4.BoxTypeMdia(), mp4.BoxTypeMinf(), mp4.BoxTypeStbl()}
+	boxes, err := mp4.ExtractBoxesWithPayload(rs, trak, []mp4.BoxPath{
+		append(stbl, mp4.BoxTypeStsd(), mp4.BoxTypeAvc1(), mp4.BoxTypeAvcC()),
+		append(stbl, mp4.BoxTypeStss()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error extracting sample tables: %w", err)
+	}
+
+	var (
+		avcC *mp4.AVCDecoderConfiguration
+		stss *mp4.Stss
+	)
+	for _, box := range boxes {
+		switch payload := box.Payload.(type) {
+		case *mp4.AVCDecoderConfiguration:
+			avcC = payload
+		case *mp4.Stss:
+			stss = payload
+		}
+	}
+
+	if avcC == nil {
+		return nil, errors.New("avcC box not found")
+	}
+
+	// without a sync sample table, every sample is a keyframe
+	sample := 0
+	if stss != nil && len(stss.SampleNumber) > 0 {
+		sample = int(stss.SampleNumber[0]) - 1
+	}
+	if sample < 0 || sample >= len(tr.Samples) {
+		return nil, fmt.Errorf("invalid keyframe sample %d", sample)
+	}
+
+	// find the offset of the sample within its chunk
+	offset := int64(-1)
+	first := 0
+	for _, chunk := range tr.Chunks {
+		if sample < first+int(chunk.SamplesPerChunk) {
+			offset = int64(chunk.DataOffset)
+			for i := first; i < sample; i++ {
+				offset += int64(tr.Samples[i].Size)
+			}
+			break
+		}
+		first += int(chunk.SamplesPerChunk)
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("keyframe sample %d not in any chunk", sample)
+	}
+
+	size := tr.Samples[sample].Size
+	if size > maxKeyframeSize {
+		return nil, fmt.Errorf("keyframe sample size %d too large", size)
+	}
+
+	buf := make([]byte, size)
+	if _, err := rs.Seek(offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("error seeking to keyframe: %w", err)
+	}
+	if _, err := io.ReadFull(rs, buf); err != nil {
+		return nil, fmt.Errorf("error reading keyframe: %w", err)
+	}
+
+	nalus := make([][]byte, 0, len(avcC.SequenceParameterSets)+len(avcC.PictureParameterSets)+1)
+	for _, ps := range avcC.SequenceParameterSets {
+		nalus = append(nalus, ps.NALUnit)
+	}
+	for _, ps := range avcC.PictureParameterSets {
+		nalus = append(nalus, ps.NALUnit)
+	}
+
+	// the sample is made up of
+	// length prefixed NAL units
+	lengthSize := int(avcC.LengthSizeMinusOne) + 1
+	for len(buf) > 0 {
+		if len(buf) < lengthSize {
+			return nil, errors.New("truncated nal unit length")
+		}
+		n := 0
+		for _, b := range buf[:lengthSize] {
+			n = n<<8 | int(b)
+		}
+		buf = buf[lengthSize:]
+		if n > len(buf) {
+			return nil, errors.New("truncated nal unit")
+		}
+		nalus = append(nalus, buf[:n])
+		buf = buf[n:]
+	}
+
+	return nalus, nil
+}
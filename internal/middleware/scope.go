@@ -0,0 +1,54 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/oauth2/v4"
+)
+
+// RequireScope returns a new gin middleware which aborts requests made
+// with an oauth token that wasn't granted the given scope, returning code
+// 403 - Forbidden. It must be used after the TokenCheck middleware.
+//
+// Requests made without a token are let through, since some routes may be
+// accessed without authorization; handlers still check for a token if one
+// is required.
+func RequireScope(scope oauth.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		i, ok := c.Get(oauth.SessionAuthorizedToken)
+		if !ok {
+			return
+		}
+
+		ti, ok := i.(oauth2.TokenInfo)
+		if !ok {
+			return
+		}
+
+		if !oauth.ScopesGrant(ti.GetScope(), scope) {
+			log.Debugf("token with scope %q was used for a request requiring scope %q", ti.GetScope(), scope)
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden: this action is outside the authorized scopes"})
+		}
+	}
+}
@@ -0,0 +1,67 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type ScopeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScopeTestSuite) requireScope(tokenScope *string, required oauth.Scope) int {
+	recorder := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(recorder)
+
+	engine.GET("/", func(c *gin.Context) {
+		if tokenScope != nil {
+			c.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(&gtsmodel.Token{Scope: *tokenScope}))
+		}
+	}, middleware.RequireScope(required), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	return recorder.Code
+}
+
+func (suite *ScopeTestSuite) TestRequireScope() {
+	scope := func(s string) *string { return &s }
+
+	suite.Equal(http.StatusOK, suite.requireScope(scope("read write"), oauth.ScopeWriteStatuses))
+	suite.Equal(http.StatusOK, suite.requireScope(scope("write:statuses"), oauth.ScopeWriteStatuses))
+	suite.Equal(http.StatusForbidden, suite.requireScope(scope("read"), oauth.ScopeWriteStatuses))
+	suite.Equal(http.StatusForbidden, suite.requireScope(scope("write:media"), oauth.ScopeWriteStatuses))
+
+	// Requests without a token are left for the handler to deal with.
+	suite.Equal(http.StatusOK, suite.requireScope(nil, oauth.ScopeWriteStatuses))
+}
+
+func TestScopeTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeTestSuite))
+}
@@ -0,0 +1,217 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package oauth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scope is an oauth scope which can be granted to a token. Scopes follow
+// the Mastodon hierarchy: a token granted a scope such as `read` is also
+// granted every scope nested beneath it, such as `read:statuses`.
+type Scope string
+
+const (
+	/*
+		Top-level scopes
+	*/
+
+	ScopeRead       Scope = "read"
+	ScopeWrite      Scope = "write"
+	ScopeFollow     Scope = "follow"
+	ScopePush       Scope = "push"
+	ScopeAdminRead  Scope = "admin:read"
+	ScopeAdminWrite Scope = "admin:write"
+
+	/*
+		Legacy scopes, as requested by older versions of the settings panel.
+	*/
+
+	// ScopeUser grants the same as read, write, follow, and push combined.
+	ScopeUser Scope = "user"
+	// ScopeAdmin grants the same as admin:read and admin:write combined.
+	ScopeAdmin Scope = "admin"
+
+	/*
+		Granular read scopes
+	*/
+
+	ScopeReadAccounts      Scope = "read:accounts"
+	ScopeReadBlocks        Scope = "read:blocks"
+	ScopeReadBookmarks     Scope = "read:bookmarks"
+	ScopeReadFavourites    Scope = "read:favourites"
+	ScopeReadFilters       Scope = "read:filters"
+	ScopeReadFollows       Scope = "read:follows"
+	ScopeReadLists         Scope = "read:lists"
+	ScopeReadMutes         Scope = "read:mutes"
+	ScopeReadNotifications Scope = "read:notifications"
+	ScopeReadReports       Scope = "read:reports"
+	ScopeReadSearch        Scope = "read:search"
+	ScopeReadStatuses      Scope = "read:statuses"
+
+	/*
+		Granular write scopes
+	*/
+
+	ScopeWriteAccounts      Scope = "write:accounts"
+	ScopeWriteBlocks        Scope = "write:blocks"
+	ScopeWriteBookmarks     Scope = "write:bookmarks"
+	ScopeWriteConversations Scope = "write:conversations"
+	ScopeWriteFavourites    Scope = "write:favourites"
+	ScopeWriteFilters       Scope = "write:filters"
+	ScopeWriteFollows       Scope = "write:follows"
+	ScopeWriteLists         Scope = "write:lists"
+	ScopeWriteMedia         Scope = "write:media"
+	ScopeWriteMutes         Scope = "write:mutes"
+	ScopeWriteNotifications Scope = "write:notifications"
+	ScopeWriteReports       Scope = "write:reports"
+	ScopeWriteStatuses      Scope = "write:statuses"
+
+	/*
+		Granular admin scopes
+	*/
+
+	ScopeAdminReadAccounts           Scope = "admin:read:accounts"
+	ScopeAdminReadReports            Scope = "admin:read:reports"
+	ScopeAdminReadDomainBlocks       Scope = "admin:read:domain_blocks"
+	ScopeAdminReadEmailDomainBlocks  Scope = "admin:read:email_domain_blocks"
+	ScopeAdminWriteAccounts          Scope = "admin:write:accounts"
+	ScopeAdminWriteReports           Scope = "admin:write:reports"
+	ScopeAdminWriteDomainBlocks      Scope = "admin:write:domain_blocks"
+	ScopeAdminWriteEmailDomainBlocks Scope = "admin:write:email_domain_blocks"
+)
+
+// knownScopes contains every scope that may be granted to a token.
+var knownScopes = map[Scope]struct{}{
+	ScopeRead:                        {},
+	ScopeWrite:                       {},
+	ScopeFollow:                      {},
+	ScopePush:                        {},
+	ScopeAdminRead:                   {},
+	ScopeAdminWrite:                  {},
+	ScopeUser:                        {},
+	ScopeAdmin:                       {},
+	ScopeReadAccounts:                {},
+	ScopeReadBlocks:                  {},
+	ScopeReadBookmarks:               {},
+	ScopeReadFavourites:              {},
+	ScopeReadFilters:                 {},
+	ScopeReadFollows:                 {},
+	ScopeReadLists:                   {},
+	ScopeReadMutes:                   {},
+	ScopeReadNotifications:           {},
+	ScopeReadReports:                 {},
+	ScopeReadSearch:                  {},
+	ScopeReadStatuses:                {},
+	ScopeWriteAccounts:               {},
+	ScopeWriteBlocks:                 {},
+	ScopeWriteBookmarks:              {},
+	ScopeWriteConversations:          {},
+	ScopeWriteFavourites:             {},
+	ScopeWriteFilters:                {},
+	ScopeWriteFollows:                {},
+	ScopeWriteLists:                  {},
+	ScopeWriteMedia:                  {},
+	ScopeWriteMutes:                  {},
+	ScopeWriteNotifications:          {},
+	ScopeWriteReports:                {},
+	ScopeWriteStatuses:               {},
+	ScopeAdminReadAccounts:           {},
+	ScopeAdminReadReports:            {},
+	ScopeAdminReadDomainBlocks:       {},
+	ScopeAdminReadEmailDomainBlocks:  {},
+	ScopeAdminWriteAccounts:          {},
+	ScopeAdminWriteReports:           {},
+	ScopeAdminWriteDomainBlocks:      {},
+	ScopeAdminWriteEmailDomainBlocks: {},
+}
+
+// followScopes are the granular scopes granted by the deprecated follow scope.
+var followScopes = map[Scope]struct{}{
+	ScopeReadBlocks:   {},
+	ScopeWriteBlocks:  {},
+	ScopeReadFollows:  {},
+	ScopeWriteFollows: {},
+	ScopeReadMutes:    {},
+	ScopeWriteMutes:   {},
+}
+
+// Grants returns true if a token granted this
+// scope is also granted the required scope.
+func (s Scope) Grants(required Scope) bool {
+	if s == required || strings.HasPrefix(string(required), string(s)+":") {
+		// eg., read grants read:statuses,
+		// admin grants admin:read:reports
+		return true
+	}
+
+	switch s {
+	case ScopeFollow:
+		_, ok := followScopes[required]
+		return ok
+	case ScopeUser:
+		return ScopeRead.Grants(required) ||
+			ScopeWrite.Grants(required) ||
+			ScopeFollow.Grants(required) ||
+			ScopePush.Grants(required)
+	}
+
+	return false
+}
+
+// ParseScopes parses the given space-separated string of scopes, as
+// stored on a token or provided in an oauth request, returning an
+// error if any of them are unknown. As in Mastodon, an empty string
+// is parsed as the read scope.
+func ParseScopes(scopes string) ([]Scope, error) {
+	fields := strings.Fields(scopes)
+	if len(fields) == 0 {
+		return []Scope{ScopeRead}, nil
+	}
+
+	parsed := make([]Scope, 0, len(fields))
+	for _, field := range fields {
+		scope := Scope(field)
+		if _, ok := knownScopes[scope]; !ok {
+			return nil, fmt.Errorf("unknown scope %q", field)
+		}
+		parsed = append(parsed, scope)
+	}
+
+	return parsed, nil
+}
+
+// ScopesGrant returns true if any of the given space-separated
+// scopes, as stored on a token, grants the required scope.
+// Unknown scopes are ignored.
+func ScopesGrant(scopes string, required Scope) bool {
+	fields := strings.Fields(scopes)
+	if len(fields) == 0 {
+		return ScopeRead.Grants(required)
+	}
+
+	for _, field := range fields {
+		if Scope(field).Grants(required) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,86 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package oauth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type ScopeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScopeTestSuite) TestGrants() {
+	for _, test := range []struct {
+		granted  oauth.Scope
+		required oauth.Scope
+		expected bool
+	}{
+		{oauth.ScopeRead, oauth.ScopeRead, true},
+		{oauth.ScopeRead, oauth.ScopeReadStatuses, true},
+		{oauth.ScopeRead, oauth.ScopeWriteStatuses, false},
+		{oauth.ScopeReadStatuses, oauth.ScopeRead, false},
+		{oauth.ScopeReadStatuses, oauth.ScopeReadAccounts, false},
+		{oauth.ScopeWrite, oauth.ScopeWriteMedia, true},
+		{oauth.ScopeWrite, oauth.ScopeAdminWriteAccounts, false},
+		{oauth.ScopeFollow, oauth.ScopeWriteFollows, true},
+		{oauth.ScopeFollow, oauth.ScopeReadMutes, true},
+		{oauth.ScopeFollow, oauth.ScopeWriteStatuses, false},
+		{oauth.ScopePush, oauth.ScopePush, true},
+		{oauth.ScopeAdminRead, oauth.ScopeAdminReadReports, true},
+		{oauth.ScopeAdminRead, oauth.ScopeAdminWriteReports, false},
+		{oauth.ScopeAdminRead, oauth.ScopeReadReports, false},
+		{oauth.ScopeUser, oauth.ScopeWriteStatuses, true},
+		{oauth.ScopeUser, oauth.ScopePush, true},
+		{oauth.ScopeUser, oauth.ScopeAdminRead, false},
+		{oauth.ScopeAdmin, oauth.ScopeAdminWriteDomainBlocks, true},
+		{oauth.ScopeAdmin, oauth.ScopeRead, false},
+	} {
+		suite.Equal(test.expected, test.granted.Grants(test.required), "%s grants %s", test.granted, test.required)
+	}
+}
+
+func (suite *ScopeTestSuite) TestParseScopes() {
+	scopes, err := oauth.ParseScopes("read write:statuses  admin:read")
+	suite.NoError(err)
+	suite.Equal([]oauth.Scope{oauth.ScopeRead, oauth.ScopeWriteStatuses, oauth.ScopeAdminRead}, scopes)
+
+	scopes, err = oauth.ParseScopes("")
+	suite.NoError(err)
+	suite.Equal([]oauth.Scope{oauth.ScopeRead}, scopes)
+
+	scopes, err = oauth.ParseScopes("read write:everything")
+	suite.EqualError(err, `unknown scope "write:everything"`)
+	suite.Nil(scopes)
+}
+
+func (suite *ScopeTestSuite) TestScopesGrant() {
+	suite.True(oauth.ScopesGrant("read write follow push", oauth.ScopeWriteBlocks))
+	suite.True(oauth.ScopesGrant("read:statuses write:statuses", oauth.ScopeWriteStatuses))
+	suite.False(oauth.ScopesGrant("read:statuses write:statuses", oauth.ScopeWriteFavourites))
+	suite.True(oauth.ScopesGrant("", oauth.ScopeReadStatuses))
+	suite.False(oauth.ScopesGrant("", oauth.ScopeWriteStatuses))
+}
+
+func TestScopeTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeTestSuite))
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/oauth2/v4"
 	"github.com/superseriousbusiness/oauth2/v4/errors"
@@ -119,11 +120,50 @@ func New(ctx context.Context, database db.Basic) Server {
 		return userID, nil
 	})
 	srv.SetClientInfoHandler(server.ClientFormHandler)
+	srv.SetClientScopeHandler(func(tgr *oauth2.TokenGenerateRequest) (bool, error) {
+		return clientScopeAllowed(ctx, database, tgr)
+	})
 	return &s{
 		server: srv,
 	}
 }
 
+// clientScopeAllowed checks that the scope requested for a new token only
+// contains known scopes, which were all registered by the application that
+// the token is being generated for. An empty scope is treated as read.
+func clientScopeAllowed(ctx context.Context, database db.Basic, tgr *oauth2.TokenGenerateRequest) (bool, error) {
+	if tgr.Request != nil {
+		ctx = tgr.Request.Context()
+	}
+
+	requested, err := ParseScopes(tgr.Scope)
+	if err != nil {
+		log.Debugf("client %s requested invalid scope: %s", tgr.ClientID, err)
+		return false, nil
+	}
+
+	app := &gtsmodel.Application{}
+	if err := database.GetWhere(ctx, []db.Where{{Key: "client_id", Value: tgr.ClientID}}, app); err != nil {
+		if err == db.ErrNoEntries {
+			return false, errors.ErrInvalidClient
+		}
+		return false, err
+	}
+
+	for _, scope := range requested {
+		if !ScopesGrant(app.Scopes, scope) {
+			log.Debugf("client %s requested scope %s which was not registered by its application", tgr.ClientID, scope)
+			return false, nil
+		}
+	}
+
+	if tgr.Scope == "" {
+		tgr.Scope = string(ScopeRead)
+	}
+
+	return true, nil
+}
+
 // HandleTokenRequest wraps the oauth2 library's HandleTokenRequest function
 func (s *s) HandleTokenRequest(r *http.Request) (map[string]interface{}, gtserror.WithCode) {
 	ctx := r.Context()
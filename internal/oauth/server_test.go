@@ -0,0 +1,105 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package oauth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ServerTestSuite struct {
+	suite.Suite
+	db               db.DB
+	server           oauth.Server
+	testApplications map[string]*gtsmodel.Application
+}
+
+func (suite *ServerTestSuite) SetupSuite() {
+	suite.testApplications = testrig.NewTestApplications()
+}
+
+func (suite *ServerTestSuite) SetupTest() {
+	testrig.InitTestLog()
+	testrig.InitTestConfig()
+	suite.db = testrig.NewTestDB()
+	suite.server = oauth.New(context.Background(), suite.db)
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *ServerTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+func (suite *ServerTestSuite) tokenRequest(scope string) *http.Request {
+	app := suite.testApplications["application_1"]
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {app.ClientID},
+		"client_secret": {app.ClientSecret},
+		"redirect_uri":  {app.RedirectURI},
+	}
+	if scope != "" {
+		form.Set("scope", scope)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+func (suite *ServerTestSuite) TestTokenRequestScope() {
+	data, errWithCode := suite.server.HandleTokenRequest(suite.tokenRequest("read:statuses write"))
+	suite.NoError(errWithCode)
+	suite.Equal("read:statuses write", data["scope"])
+}
+
+func (suite *ServerTestSuite) TestTokenRequestDefaultScope() {
+	data, errWithCode := suite.server.HandleTokenRequest(suite.tokenRequest(""))
+	suite.NoError(errWithCode)
+	suite.Equal("read", data["scope"])
+}
+
+func (suite *ServerTestSuite) TestTokenRequestUnknownScope() {
+	data, errWithCode := suite.server.HandleTokenRequest(suite.tokenRequest("read write:everything"))
+	suite.Nil(data)
+	suite.EqualError(errWithCode, "invalid_scope")
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *ServerTestSuite) TestTokenRequestUnregisteredScope() {
+	// The application didn't register any admin scopes.
+	data, errWithCode := suite.server.HandleTokenRequest(suite.tokenRequest("read admin:read"))
+	suite.Nil(data)
+	suite.EqualError(errWithCode, "invalid_scope")
+}
+
+func TestServerTestSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
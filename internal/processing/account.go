@@ -55,6 +55,14 @@ func (p *processor) AccountUpdate(ctx context.Context, authed *oauth.Auth, form
 	return p.accountProcessor.Update(ctx, authed.Account, form)
 }
 
+func (p *processor) AccountAlias(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountAliasRequest) (*apimodel.Account, gtserror.WithCode) {
+	return p.accountProcessor.Alias(ctx, authed.Account, form)
+}
+
+func (p *processor) AccountMove(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountMoveRequest) (*apimodel.Account, gtserror.WithCode) {
+	return p.accountProcessor.Move(ctx, authed.Account, form)
+}
+
 func (p *processor) AccountStatusesGet(ctx context.Context, authed *oauth.Auth, targetAccountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, pinnedOnly bool, mediaOnly bool, publicOnly bool) (*apimodel.PageableResponse, gtserror.WithCode) {
 	return p.accountProcessor.StatusesGet(ctx, authed.Account, targetAccountID, limit, excludeReplies, excludeReblogs, maxID, minID, pinnedOnly, mediaOnly, publicOnly)
 }
@@ -26,6 +26,7 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/emaildomain"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -81,6 +82,16 @@ type Processor interface {
 	BlockCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
 	// BlockRemove handles the removal of a block from requestingAccount to targetAccountID, either remote or local.
 	BlockRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// MuteCreate handles the creation or updating of a mute from requestingAccount to the account in the form.
+	MuteCreate(ctx context.Context, requestingAccount *gtsmodel.Account, form *apimodel.AccountMuteRequest) (*apimodel.Relationship, gtserror.WithCode)
+	// MuteRemove handles the removal of a mute from requestingAccount to targetAccountID.
+	MuteRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// Alias sets the URIs of other accounts that the given account is also known as,
+	// which allows those accounts to move to the given account.
+	Alias(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountAliasRequest) (*apimodel.Account, gtserror.WithCode)
+	// Move moves the given account to the account in the form, which must list the given account as an alias.
+	// The move is federated out to followers, and local followers will follow the target account instead.
+	Move(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountMoveRequest) (*apimodel.Account, gtserror.WithCode)
 	// UpdateAvatar does the dirty work of checking the avatar part of an account update form,
 	// parsing and checking the image, and doing the necessary updates in the database for this to become
 	// the account's new avatar image.
@@ -101,10 +112,11 @@ type processor struct {
 	db           db.DB
 	federator    federation.Federator
 	parseMention gtsmodel.ParseMentionFunc
+	emailDomains emaildomain.Checker
 }
 
 // New returns a new account processor.
-func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, oauthServer oauth.Server, clientWorker *concurrency.WorkerPool[messages.FromClientAPI], federator federation.Federator, parseMention gtsmodel.ParseMentionFunc) Processor {
+func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, oauthServer oauth.Server, clientWorker *concurrency.WorkerPool[messages.FromClientAPI], federator federation.Federator, parseMention gtsmodel.ParseMentionFunc, emailDomains emaildomain.Checker) Processor {
 	return &processor{
 		tc:           tc,
 		mediaManager: mediaManager,
@@ -115,5 +127,6 @@ func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, oauth
 		db:           db,
 		federator:    federator,
 		parseMention: parseMention,
+		emailDomains: emailDomains,
 	}
 }
@@ -0,0 +1,78 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+func (p *processor) Alias(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountAliasRequest) (*apimodel.Account, gtserror.WithCode) {
+	alsoKnownAsURIs := make([]string, 0, len(form.AlsoKnownAsURIs))
+	seen := make(map[string]struct{}, len(form.AlsoKnownAsURIs))
+
+	for _, rawURI := range form.AlsoKnownAsURIs {
+		uri, err := url.Parse(rawURI)
+		if err != nil || (uri.Scheme != "http" && uri.Scheme != "https") || uri.Host == "" {
+			err := fmt.Errorf("invalid alias uri %s", rawURI)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if uri.String() == account.URI {
+			err := errors.New("account can't be an alias of itself")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if _, ok := seen[uri.String()]; ok {
+			// skip duplicates
+			continue
+		}
+		seen[uri.String()] = struct{}{}
+
+		alsoKnownAsURIs = append(alsoKnownAsURIs, uri.String())
+	}
+
+	account.AlsoKnownAsURIs = alsoKnownAsURIs
+	if err := p.db.UpdateAccount(ctx, account); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("could not update account %s: %s", account.ID, err))
+	}
+
+	// federate the new aliases, so that
+	// other instances can verify a move
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       account,
+		OriginAccount:  account,
+	})
+
+	acctSensitive, err := p.tc.AccountToAPIAccountSensitive(ctx, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("could not convert account into apisensitive account: %s", err))
+	}
+	return acctSensitive, nil
+}
@@ -20,11 +20,14 @@ package account
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -34,6 +37,15 @@ import (
 )
 
 func (p *processor) Create(ctx context.Context, applicationToken oauth2.TokenInfo, application *gtsmodel.Application, form *apimodel.AccountCreateRequest) (*apimodel.Token, gtserror.WithCode) {
+	emailBlocked, err := p.emailDomains.Blocked(ctx, form.Email)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err)
+	}
+	if emailBlocked {
+		err := fmt.Errorf("email address %s is on a blocked domain", form.Email)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, "email addresses from this domain are not accepted on this instance")
+	}
+
 	emailAvailable, err := p.db.IsEmailAvailable(ctx, form.Email)
 	if err != nil {
 		return nil, gtserror.NewErrorBadRequest(err)
@@ -53,6 +65,20 @@ func (p *processor) Create(ctx context.Context, applicationToken oauth2.TokenInf
 	reasonRequired := config.GetAccountsReasonRequired()
 	approvalRequired := config.GetAccountsApprovalRequired()
 
+	var invite *gtsmodel.Invite
+	if form.InviteCode != "" {
+		var errWithCode gtserror.WithCode
+		invite, errWithCode = p.getUsableInvite(ctx, form.InviteCode)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		// invited users don't need to explain themselves,
+		// and may skip approval if the invite says so
+		reasonRequired = false
+		approvalRequired = approvalRequired && !*invite.SkipApproval
+	}
+
 	// don't store a reason if we don't require one
 	reason := form.Reason
 	if !reasonRequired {
@@ -65,6 +91,15 @@ func (p *processor) Create(ctx context.Context, applicationToken oauth2.TokenInf
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error creating new signup in the database: %s", err))
 	}
 
+	if invite != nil {
+		// Only use up the invite now the signup has
+		// actually been created, so a failed signup
+		// doesn't cost the invite one of its uses.
+		if errWithCode := p.useInvite(ctx, invite, user); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
 	log.Tracef("generating a token for user %s with account %s and application %s", user.ID, user.AccountID, application.ID)
 	accessToken, err := p.oauthServer.GenerateUserAccessToken(ctx, applicationToken, application.ClientSecret, user.ID)
 	if err != nil {
@@ -95,3 +130,55 @@ func (p *processor) Create(ctx context.Context, applicationToken oauth2.TokenInf
 		CreatedAt:   accessToken.GetAccessCreateAt().Unix(),
 	}, nil
 }
+
+// getUsableInvite returns the invite with the given
+// code, if it can still be used to sign up.
+func (p *processor) getUsableInvite(ctx context.Context, code string) (*gtsmodel.Invite, gtserror.WithCode) {
+	invite, err := p.db.GetInviteByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("no invite with code %s", code)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, "invite code is not valid")
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting invite: %s", err))
+	}
+
+	if !invite.Usable(time.Now()) {
+		err := fmt.Errorf("invite %s has expired or has no uses left", invite.ID)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, "invite code has expired or has no uses left")
+	}
+
+	return invite, nil
+}
+
+// useInvite uses up one use of the given invite for the
+// newly signed up user, and records the invite on the user.
+func (p *processor) useInvite(ctx context.Context, invite *gtsmodel.Invite, user *gtsmodel.User) gtserror.WithCode {
+	// Another sign-up may have used the
+	// last use in the meantime, so the db
+	// has the final say on this.
+	if err := p.db.UseInvite(ctx, invite.ID); err != nil {
+		// The signup can't go ahead
+		// without the invite, so undo it.
+		if err := p.db.DeleteUserByID(ctx, user.ID); err != nil {
+			log.Errorf("useInvite: error deleting user %s: %s", user.ID, err)
+		}
+		if err := p.db.DeleteAccount(ctx, user.AccountID); err != nil {
+			log.Errorf("useInvite: error deleting account %s: %s", user.AccountID, err)
+		}
+
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("invite %s has expired or has no uses left", invite.ID)
+			return gtserror.NewErrorUnprocessableEntity(err, "invite code has expired or has no uses left")
+		}
+		return gtserror.NewErrorInternalError(fmt.Errorf("error using invite: %s", err))
+	}
+	invite.Uses++
+
+	user.InviteID = invite.ID
+	if err := p.db.UpdateUser(ctx, user, "invite_id"); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("error setting invite of user %s: %s", user.ID, err))
+	}
+
+	return nil
+}
@@ -66,6 +66,17 @@ func (p *processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error creating block in db: %s", err))
 	}
 
+	// clear any list entries pertaining to a follow from the blocked account to the target account
+	blockedFollow := &gtsmodel.Follow{}
+	if err := p.db.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: targetAccountID},
+		{Key: "target_account_id", Value: requestingAccount.ID},
+	}, blockedFollow); err == nil {
+		if err := p.db.DeleteListEntriesForFollowID(ctx, blockedFollow.ID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing list entries for follow from db: %s", err))
+		}
+	}
+
 	// clear any follows or follow requests from the blocked account to the target account -- this is a simple delete
 	if err := p.db.DeleteWhere(ctx, []db.Where{
 		{Key: "account_id", Value: targetAccountID},
@@ -107,6 +118,9 @@ func (p *processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 		{Key: "target_account_id", Value: targetAccountID},
 	}, f); err == nil {
 		fURI = f.URI
+		if err := p.db.DeleteListEntriesForFollowID(ctx, f.ID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing list entries for follow from db: %s", err))
+		}
 		if err := p.db.DeleteByID(ctx, f.ID, f); err != nil {
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing follow from db: %s", err))
 		}
@@ -41,7 +41,7 @@ import (
 // 2. Delete account's blocks
 // 3. Delete account's emoji
 // 4. Delete account's follow requests
-// 5. Delete account's follows
+// 5. Delete account's follows + lists
 // 6. Delete account's statuses
 // 7. Delete account's media attachments
 // 8. Delete account's mentions
@@ -49,7 +49,7 @@ import (
 // 10. Delete account's notifications
 // 11. Delete account's bookmarks
 // 12. Delete account's faves
-// 13. Delete account's mutes
+// 13. Delete account's mutes + filters
 // 14. Delete account's streams
 // 15. Delete account's tags
 // 16. Delete account's user
@@ -124,9 +124,36 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 		l.Errorf("error deleting follow requests targeting account: %s", err)
 	}
 
-	// 5. Delete account's follows
+	// 5. Delete account's follows, and any list entries pertaining to them
 	// TODO: federate these if necessary
 	l.Trace("deleting account follows")
+	// first clean up list entries for follows that this account created
+	if follows, err := p.db.GetAccountFollows(ctx, account.ID); err == nil {
+		for _, f := range follows {
+			if err := p.db.DeleteListEntriesForFollowID(ctx, f.ID); err != nil {
+				l.Errorf("error deleting list entries for follow %s: %s", f.ID, err)
+			}
+		}
+	}
+
+	// now clean up list entries for follows that target this account
+	if followedBy, err := p.db.GetAccountFollowedBy(ctx, account.ID, false); err == nil {
+		for _, f := range followedBy {
+			if err := p.db.DeleteListEntriesForFollowID(ctx, f.ID); err != nil {
+				l.Errorf("error deleting list entries for follow %s: %s", f.ID, err)
+			}
+		}
+	}
+
+	// and delete any lists that this account created
+	if lists, err := p.db.GetListsForAccountID(ctx, account.ID); err == nil {
+		for _, list := range lists {
+			if err := p.db.DeleteListByID(ctx, list.ID); err != nil {
+				l.Errorf("error deleting list %s: %s", list.ID, err)
+			}
+		}
+	}
+
 	// first delete any follows that this account created
 	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.Follow{}); err != nil {
 		l.Errorf("error deleting follows created by account: %s", err)
@@ -213,6 +240,17 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 		maxID = statuses[len(statuses)-1].ID
 	}
 
+	// statuses that haven't been published yet can just go; their
+	// media attachments are released so they'll be pruned as unattached
+	l.Trace("deleting account scheduled statuses")
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.ScheduledStatus{}); err != nil {
+		l.Errorf("error deleting scheduled statuses of account: %s", err)
+	}
+
+	if err := p.db.UpdateWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, "scheduled_status_id", nil, &[]*gtsmodel.MediaAttachment{}); err != nil {
+		l.Errorf("error releasing media attachments of scheduled statuses of account: %s", err)
+	}
+
 	// 10. Delete account's notifications
 	l.Trace("deleting account notifications")
 	// first notifications created by account
@@ -238,17 +276,67 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 		l.Errorf("error deleting faves created by account: %s", err)
 	}
 
-	// 13. Delete account's mutes
-	l.Trace("deleting account mutes")
+	// 13. Delete account's mutes + filters
+	l.Trace("deleting account mutes + filters")
 	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.StatusMute{}); err != nil {
 		l.Errorf("error deleting status mutes created by account: %s", err)
 	}
 
+	// delete any account mutes that this account created
+	if err := p.db.DeleteMutesByOriginAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting account mutes created by account: %s", err)
+	}
+
+	// now delete any account mutes that target this account
+	if err := p.db.DeleteMutesByTargetAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting account mutes targeting account: %s", err)
+	}
+
+	// and delete any filters that this account created
+	if filters, err := p.db.GetFiltersForAccountID(ctx, account.ID); err == nil {
+		for _, filter := range filters {
+			if err := p.db.DeleteFilterByID(ctx, filter.ID); err != nil {
+				l.Errorf("error deleting filter %s: %s", filter.ID, err)
+			}
+		}
+	}
+
 	// 14. Delete account's streams
 	// TODO
 
-	// 15. Delete account's tags
-	// TODO
+	// 15. Delete account's followed + featured tags + push subscriptions + conversations + markers + announcement reactions/dismissals + invites
+	l.Trace("deleting account followed + featured tags + push subscriptions + conversations + markers + announcement reactions/dismissals + invites")
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.FollowedTag{}); err != nil {
+		l.Errorf("error deleting tags followed by account: %s", err)
+	}
+
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.FeaturedTag{}); err != nil {
+		l.Errorf("error deleting tags featured by account: %s", err)
+	}
+
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.WebPushSubscription{}); err != nil {
+		l.Errorf("error deleting push subscriptions of account: %s", err)
+	}
+
+	if err := p.db.DeleteConversationsByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting conversations of account: %s", err)
+	}
+
+	if err := p.db.DeleteMarkersByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting markers of account: %s", err)
+	}
+
+	if err := p.db.DeleteAnnouncementReactionsByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting announcement reactions of account: %s", err)
+	}
+
+	if err := p.db.DeleteAnnouncementDismissalsByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting announcement dismissals of account: %s", err)
+	}
+
+	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.Invite{}); err != nil {
+		l.Errorf("error deleting invites of account: %s", err)
+	}
 
 	// 16. Delete account's user
 	if user != nil {
@@ -20,12 +20,14 @@ package account
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -60,6 +62,14 @@ func (p *processor) StatusesGet(ctx context.Context, requestingAccount *gtsmodel
 		return util.EmptyPageableResponse(), nil
 	}
 
+	var filters []*gtsmodel.Filter
+	if requestingAccount != nil {
+		filters, err = p.db.GetFiltersForAccountID(ctx, requestingAccount.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting filters: %w", err))
+		}
+	}
+
 	items := []interface{}{}
 	nextMaxIDValue := ""
 	prevMinIDValue := ""
@@ -77,6 +87,14 @@ func (p *processor) StatusesGet(ctx context.Context, requestingAccount *gtsmodel
 			prevMinIDValue = item.GetID()
 		}
 
+		item, err = p.tc.ApplyFilters(ctx, item, filters, gtsmodel.FilterContextAccount)
+		if err != nil {
+			if errors.Is(err, typeutils.ErrHideStatus) {
+				continue
+			}
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error applying filters: %w", err))
+		}
+
 		items = append(items, item)
 	}
 
@@ -0,0 +1,116 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (p *processor) Move(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountMoveRequest) (*apimodel.Account, gtserror.WithCode) {
+	user, err := p.db.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// make sure a password is actually set and bail if not
+	if user.EncryptedPassword == "" {
+		return nil, gtserror.NewErrorForbidden(errors.New("user password was not set"))
+	}
+
+	// compare the provided password with the encrypted one from the db, bail if they don't match
+	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(form.Password)); err != nil {
+		return nil, gtserror.NewErrorForbidden(errors.New("invalid password"))
+	}
+
+	if account.MovedToAccountID != "" {
+		err := errors.New("account has already moved")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	targetURI, err := url.Parse(form.MovedToURI)
+	if err != nil || (targetURI.Scheme != "http" && targetURI.Scheme != "https") || targetURI.Host == "" {
+		err := fmt.Errorf("invalid moved_to_uri %s", form.MovedToURI)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if targetURI.String() == account.URI {
+		err := errors.New("account can't move to itself")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// get the latest version of the target
+	// account, since it must list this one
+	// as an alias for the move to be valid
+	target, err := p.db.GetAccountByURI(ctx, targetURI.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting account to move to %s: %s", targetURI, err))
+	}
+
+	if target != nil {
+		target, err = p.federator.UpdateAccount(ctx, account.Username, target, true)
+	} else {
+		target, err = p.federator.GetAccountByURI(ctx, account.Username, targetURI, true)
+	}
+	if err != nil {
+		err := fmt.Errorf("couldn't get account to move to %s: %s", targetURI, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if !target.HasAlias(account.URI) {
+		err := errors.New("account to move to doesn't list this account as an alias")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if target.MovedToAccountID != "" {
+		err := errors.New("account to move to has itself moved")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	account.MovedToAccountID = target.ID
+	if err := p.db.UpdateAccount(ctx, account); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("could not update account %s: %s", account.ID, err))
+	}
+
+	// federate the move and move local
+	// followers over to the target account
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityMove,
+		GTSModel:       account,
+		OriginAccount:  account,
+		TargetAccount:  target,
+	})
+
+	acctSensitive, err := p.tc.AccountToAPIAccountSensitive(ctx, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("could not convert account into apisensitive account: %s", err))
+	}
+	return acctSensitive, nil
+}
@@ -0,0 +1,95 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) MuteCreate(ctx context.Context, requestingAccount *gtsmodel.Account, form *apimodel.AccountMuteRequest) (*apimodel.Relationship, gtserror.WithCode) {
+	// make sure the target account actually exists in our db
+	if _, err := p.db.GetAccountByID(ctx, form.ID); err != nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("MuteCreate: error getting account %s from the db: %s", form.ID, err))
+	}
+
+	// don't mute yourself, silly
+	if requestingAccount.ID == form.ID {
+		return nil, gtserror.NewErrorNotAcceptable(fmt.Errorf("MuteCreate: account %s cannot mute itself", requestingAccount.ID))
+	}
+
+	if form.Duration < 0 {
+		err := errors.New("duration must not be negative")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// notifications are muted too unless specified otherwise
+	notifications := true
+	if form.Notifications != nil {
+		notifications = *form.Notifications
+	}
+
+	now := time.Now()
+	mute := &gtsmodel.UserMute{
+		ID:              id.NewULID(),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		AccountID:       requestingAccount.ID,
+		TargetAccountID: form.ID,
+		Notifications:   &notifications,
+	}
+	if form.Duration != 0 {
+		mute.ExpiresAt = now.Add(time.Duration(form.Duration) * time.Second)
+	}
+
+	// whack it in the database, replacing any existing mute of the target account
+	if err := p.db.PutMute(ctx, mute); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteCreate: error creating mute in db: %s", err))
+	}
+
+	return p.RelationshipGet(ctx, requestingAccount, form.ID)
+}
+
+func (p *processor) MuteRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode) {
+	// make sure the target account actually exists in our db
+	if _, err := p.db.GetAccountByID(ctx, targetAccountID); err != nil {
+		return nil, gtserror.NewErrorNotFound(fmt.Errorf("MuteRemove: error getting account %s from the db: %s", targetAccountID, err))
+	}
+
+	// check if a mute exists, and remove it if it does
+	mute, err := p.db.GetMute(ctx, requestingAccount.ID, targetAccountID)
+	if err == nil {
+		if err := p.db.DeleteMuteByID(ctx, mute.ID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteRemove: error removing mute from db: %s", err))
+		}
+	} else if !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("MuteRemove: error getting possible mute from db: %s", err))
+	}
+
+	// return whatever relationship results from all this
+	return p.RelationshipGet(ctx, requestingAccount, targetAccountID)
+}
@@ -30,6 +30,18 @@ func (p *processor) AdminAccountAction(ctx context.Context, authed *oauth.Auth,
 	return p.adminProcessor.AccountAction(ctx, authed.Account, form)
 }
 
+func (p *processor) AdminAccountsGet(ctx context.Context, authed *oauth.Auth, status string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.adminProcessor.AccountsGet(ctx, authed.Account, status, maxID, sinceID, minID, limit)
+}
+
+func (p *processor) AdminAccountApprove(ctx context.Context, authed *oauth.Auth, accountID string) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	return p.adminProcessor.AccountApprove(ctx, authed.Account, accountID)
+}
+
+func (p *processor) AdminAccountReject(ctx context.Context, authed *oauth.Auth, accountID string) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	return p.adminProcessor.AccountReject(ctx, authed.Account, accountID)
+}
+
 func (p *processor) AdminEmojiCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.EmojiCreateRequest) (*apimodel.Emoji, gtserror.WithCode) {
 	return p.adminProcessor.EmojiCreate(ctx, authed.Account, authed.User, form)
 }
@@ -74,6 +86,22 @@ func (p *processor) AdminDomainBlockDelete(ctx context.Context, authed *oauth.Au
 	return p.adminProcessor.DomainBlockDelete(ctx, authed.Account, id)
 }
 
+func (p *processor) AdminEmailDomainBlockCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.EmailDomainBlockCreateRequest) (*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	return p.adminProcessor.EmailDomainBlockCreate(ctx, authed.Account, form.Domain)
+}
+
+func (p *processor) AdminEmailDomainBlocksGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	return p.adminProcessor.EmailDomainBlocksGet(ctx, authed.Account)
+}
+
+func (p *processor) AdminEmailDomainBlockGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	return p.adminProcessor.EmailDomainBlockGet(ctx, authed.Account, id)
+}
+
+func (p *processor) AdminEmailDomainBlockDelete(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	return p.adminProcessor.EmailDomainBlockDelete(ctx, authed.Account, id)
+}
+
 func (p *processor) AdminMediaPrune(ctx context.Context, mediaRemoteCacheDays int) gtserror.WithCode {
 	return p.adminProcessor.MediaPrune(ctx, mediaRemoteCacheDays)
 }
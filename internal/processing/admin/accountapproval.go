@@ -0,0 +1,147 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+func (p *processor) AccountApprove(ctx context.Context, account *gtsmodel.Account, targetAccountID string) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	user, errWithCode := p.getPendingUser(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	approved := true
+	user.Approved = &approved
+	if err := p.db.UpdateUser(ctx, user, "approved"); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error updating user %s: %w", user.ID, err))
+	}
+
+	apiAccount, err := p.tc.AccountToAdminAPIAccount(ctx, user.Account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting account to api: %w", err))
+	}
+
+	// the user is approved regardless of whether
+	// we manage to let them know, so just log
+	// any errors sending the email
+	instanceURL, instanceName := p.instanceURLAndName(ctx)
+	approveData := email.ApproveData{
+		Username:     user.Account.Username,
+		InstanceURL:  instanceURL,
+		InstanceName: instanceName,
+	}
+	if err := p.emailSender.SendApproveEmail(emailAddress(user), approveData); err != nil {
+		log.Errorf("AccountApprove: error sending approval email to user %s: %s", user.ID, err)
+	}
+
+	return apiAccount, nil
+}
+
+func (p *processor) AccountReject(ctx context.Context, account *gtsmodel.Account, targetAccountID string) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	user, errWithCode := p.getPendingUser(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// convert the account before it's deleted, so
+	// the caller can see what was rejected
+	apiAccount, err := p.tc.AccountToAdminAPIAccount(ctx, user.Account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting account to api: %w", err))
+	}
+
+	instanceURL, instanceName := p.instanceURLAndName(ctx)
+	rejectData := email.RejectData{
+		Username:     user.Account.Username,
+		InstanceURL:  instanceURL,
+		InstanceName: instanceName,
+	}
+	if err := p.emailSender.SendRejectEmail(emailAddress(user), rejectData); err != nil {
+		log.Errorf("AccountReject: error sending rejection email to user %s: %s", user.ID, err)
+	}
+
+	// pass the account delete through the client api channel for processing;
+	// this removes the user and leaves just a stub of the account behind
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ActorPerson,
+		APActivityType: ap.ActivityDelete,
+		OriginAccount:  account,
+		TargetAccount:  user.Account,
+	})
+
+	return apiAccount, nil
+}
+
+// getPendingUser returns the user belonging to the given local
+// account id, or an error if the user has already been approved.
+func (p *processor) getPendingUser(ctx context.Context, accountID string) (*gtsmodel.User, gtserror.WithCode) {
+	user, err := p.db.GetUserByAccountID(ctx, accountID)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			err = fmt.Errorf("no local account with id %s", accountID)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting user for account %s: %w", accountID, err))
+	}
+
+	if *user.Approved {
+		err := errors.New("account is not pending approval")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return user, nil
+}
+
+// instanceURLAndName returns the URL and title of
+// this instance, to greet users nicely in emails.
+func (p *processor) instanceURLAndName(ctx context.Context) (string, string) {
+	host := config.GetHost()
+	instanceURL := config.GetProtocol() + "://" + host
+
+	instance := &gtsmodel.Instance{}
+	if err := p.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: host}}, instance); err != nil {
+		log.Errorf("instanceURLAndName: error getting instance: %s", err)
+		return instanceURL, host
+	}
+
+	return instance.URI, instance.Title
+}
+
+// emailAddress returns the address to contact the given
+// user at; pending users may not have confirmed theirs yet.
+func emailAddress(user *gtsmodel.User) string {
+	if user.Email != "" {
+		return user.Email
+	}
+	return user.UnconfirmedEmail
+}
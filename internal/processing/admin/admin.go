@@ -25,6 +25,7 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
@@ -41,7 +42,14 @@ type Processor interface {
 	DomainBlocksGet(ctx context.Context, account *gtsmodel.Account, export bool) ([]*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlockGet(ctx context.Context, account *gtsmodel.Account, id string, export bool) (*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlockDelete(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.DomainBlock, gtserror.WithCode)
+	EmailDomainBlockCreate(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.EmailDomainBlock, gtserror.WithCode)
+	EmailDomainBlocksGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.EmailDomainBlock, gtserror.WithCode)
+	EmailDomainBlockGet(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.EmailDomainBlock, gtserror.WithCode)
+	EmailDomainBlockDelete(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.EmailDomainBlock, gtserror.WithCode)
 	AccountAction(ctx context.Context, account *gtsmodel.Account, form *apimodel.AdminAccountActionRequest) gtserror.WithCode
+	AccountsGet(ctx context.Context, account *gtsmodel.Account, status string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	AccountApprove(ctx context.Context, account *gtsmodel.Account, targetAccountID string) (*apimodel.AdminAccountInfo, gtserror.WithCode)
+	AccountReject(ctx context.Context, account *gtsmodel.Account, targetAccountID string) (*apimodel.AdminAccountInfo, gtserror.WithCode)
 	EmojiCreate(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, form *apimodel.EmojiCreateRequest) (*apimodel.Emoji, gtserror.WithCode)
 	EmojisGet(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, domain string, includeDisabled bool, includeEnabled bool, shortcode string, maxShortcodeDomain string, minShortcodeDomain string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 	EmojiGet(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, id string) (*apimodel.AdminEmoji, gtserror.WithCode)
@@ -62,10 +70,11 @@ type processor struct {
 	storage             *storage.Driver
 	clientWorker        *concurrency.WorkerPool[messages.FromClientAPI]
 	db                  db.DB
+	emailSender         email.Sender
 }
 
 // New returns a new admin processor.
-func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, transportController transport.Controller, storage *storage.Driver, clientWorker *concurrency.WorkerPool[messages.FromClientAPI]) Processor {
+func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, transportController transport.Controller, storage *storage.Driver, clientWorker *concurrency.WorkerPool[messages.FromClientAPI], emailSender email.Sender) Processor {
 	return &processor{
 		tc:                  tc,
 		mediaManager:        mediaManager,
@@ -73,5 +82,6 @@ func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, trans
 		storage:             storage,
 		clientWorker:        clientWorker,
 		db:                  db,
+		emailSender:         emailSender,
 	}
 }
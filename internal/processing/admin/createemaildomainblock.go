@@ -0,0 +1,78 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	"golang.org/x/net/idna"
+)
+
+func (p *processor) EmailDomainBlockCreate(ctx context.Context, account *gtsmodel.Account, domain string) (*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	// email domain blocks are always stored as lowercase punycode
+	normalized, err := idna.ToASCII(strings.ToLower(strings.TrimSpace(domain)))
+	if err != nil {
+		err = fmt.Errorf("invalid email domain %s: %w", domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	domain = normalized
+
+	// first check if we already have a block -- if err == nil we already had a block so we can just return it
+	block, err := p.db.GetEmailDomainBlock(ctx, domain)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// something went wrong in the DB
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error checking for existence of email domain block %s: %w", domain, err))
+		}
+
+		// there's no block for this domain yet so create one
+		newBlock := &gtsmodel.EmailDomainBlock{
+			ID:                 id.NewULID(),
+			Domain:             domain,
+			CreatedByAccountID: account.ID,
+		}
+
+		if err := validate.Struct(newBlock); err != nil {
+			err = fmt.Errorf("invalid email domain %s: %w", domain, err)
+			return nil, gtserror.NewErrorBadRequest(err, fmt.Sprintf("invalid email domain %s", domain))
+		}
+
+		if err := p.db.CreateEmailDomainBlock(ctx, newBlock); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting new email domain block %s: %w", domain, err))
+		}
+
+		block = newBlock
+	}
+
+	apiBlock, err := p.tc.EmailDomainBlockToAPIEmailDomainBlock(ctx, block)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting email domain block to frontend/api representation %s: %w", domain, err))
+	}
+
+	return apiBlock, nil
+}
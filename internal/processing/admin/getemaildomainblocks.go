@@ -0,0 +1,48 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) EmailDomainBlocksGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.EmailDomainBlock, gtserror.WithCode) {
+	blocks, err := p.db.GetEmailDomainBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		// something has gone really wrong
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiBlocks := []*apimodel.EmailDomainBlock{}
+	for _, b := range blocks {
+		apiBlock, err := p.tc.EmailDomainBlockToAPIEmailDomainBlock(ctx, b)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiBlocks = append(apiBlocks, apiBlock)
+	}
+
+	return apiBlocks, nil
+}
@@ -0,0 +1,63 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) AdminAnnouncementCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AnnouncementCreateRequest) (*apimodel.Announcement, gtserror.WithCode) {
+	return p.announcementsProcessor.Create(ctx, authed.Account, form)
+}
+
+func (p *processor) AdminAnnouncementsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.Announcement, gtserror.WithCode) {
+	return p.announcementsProcessor.GetAll(ctx)
+}
+
+func (p *processor) AdminAnnouncementGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Announcement, gtserror.WithCode) {
+	return p.announcementsProcessor.Get(ctx, id)
+}
+
+func (p *processor) AdminAnnouncementUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.AnnouncementUpdateRequest) (*apimodel.Announcement, gtserror.WithCode) {
+	return p.announcementsProcessor.Update(ctx, authed.Account, id, form)
+}
+
+func (p *processor) AdminAnnouncementDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.announcementsProcessor.Delete(ctx, id)
+}
+
+func (p *processor) AnnouncementsGet(ctx context.Context, authed *oauth.Auth, withDismissed bool) ([]*apimodel.Announcement, gtserror.WithCode) {
+	return p.announcementsProcessor.GetActive(ctx, authed.Account, withDismissed)
+}
+
+func (p *processor) AnnouncementDismiss(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.announcementsProcessor.Dismiss(ctx, authed.Account, id)
+}
+
+func (p *processor) AnnouncementReactionAdd(ctx context.Context, authed *oauth.Auth, id string, name string) gtserror.WithCode {
+	return p.announcementsProcessor.AddReaction(ctx, authed.Account, id, name)
+}
+
+func (p *processor) AnnouncementReactionRemove(ctx context.Context, authed *oauth.Auth, id string, name string) gtserror.WithCode {
+	return p.announcementsProcessor.RemoveReaction(ctx, authed.Account, id, name)
+}
@@ -0,0 +1,321 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+)
+
+type AnnouncementTestSuite struct {
+	AnnouncementsStandardTestSuite
+}
+
+func (suite *AnnouncementTestSuite) TestCreatePublished() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+
+	openStream, errWithCode := suite.streamingProcessor.OpenStreamForAccount(ctx, suite.testAccounts["local_account_1"], stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	announcement, errWithCode := suite.announcements.Create(ctx, admin, &apimodel.AnnouncementCreateRequest{
+		Text: "Hello @the_mighty_zork, check out #welcome :rainbow:",
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.True(announcement.Published)
+	suite.NotEmpty(announcement.PublishedAt)
+	suite.Equal(`<p>Hello <span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention" rel="nofollow noreferrer noopener" target="_blank">@<span>the_mighty_zork</span></a></span>, check out <a href="http://localhost:8080/tags/welcome" class="mention hashtag" rel="tag nofollow noreferrer noopener" target="_blank">#<span>welcome</span></a> :rainbow:</p>`, announcement.Content)
+	suite.Len(announcement.Mentions, 1)
+	suite.Equal(suite.testAccounts["local_account_1"].ID, announcement.Mentions[0].ID)
+	suite.Len(announcement.Tags, 1)
+	suite.Equal("welcome", announcement.Tags[0].Name)
+	suite.Len(announcement.Emojis, 1)
+	suite.Equal("rainbow", announcement.Emojis[0].Shortcode)
+
+	msg := <-openStream.Messages
+	suite.Equal(stream.EventTypeAnnouncement, msg.Event)
+
+	streamed := &apimodel.Announcement{}
+	suite.NoError(json.Unmarshal([]byte(msg.Payload), streamed))
+	suite.Equal(announcement.ID, streamed.ID)
+}
+
+func (suite *AnnouncementTestSuite) TestCreateScheduled() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+	scheduledAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+
+	announcement, errWithCode := suite.announcements.Create(ctx, admin, &apimodel.AnnouncementCreateRequest{
+		Text:        "Coming soon",
+		ScheduledAt: scheduledAt,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.False(announcement.Published)
+
+	// not visible to users yet
+	active, errWithCode := suite.announcements.GetActive(ctx, suite.testAccounts["local_account_1"], true)
+	suite.NoError(errWithCode)
+	for _, a := range active {
+		suite.NotEqual(announcement.ID, a.ID)
+	}
+}
+
+func (suite *AnnouncementTestSuite) TestCreateInvalid() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+
+	for _, form := range []*apimodel.AnnouncementCreateRequest{
+		{Text: ""},
+		{Text: "hi", StartsAt: "not a date"},
+		{Text: "hi", StartsAt: "2023-04-02T10:00:00Z", EndsAt: "2023-04-01T10:00:00Z"},
+		{Text: "hi", AllDay: true},
+		{Text: "hi", ScheduledAt: "2020-04-01T10:00:00Z"},
+	} {
+		_, errWithCode := suite.announcements.Create(ctx, admin, form)
+		if suite.Error(errWithCode) {
+			suite.Contains([]int{http.StatusBadRequest, http.StatusUnprocessableEntity}, errWithCode.Code())
+		}
+	}
+}
+
+func (suite *AnnouncementTestSuite) TestUpdateUnpublish() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+
+	openStream, errWithCode := suite.streamingProcessor.OpenStreamForAccount(ctx, suite.testAccounts["local_account_1"], stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	published := false
+	announcement, errWithCode := suite.announcements.Update(ctx, admin, testAnnouncement.ID, &apimodel.AnnouncementUpdateRequest{
+		Published: &published,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(announcement.Published)
+
+	msg := <-openStream.Messages
+	suite.Equal(stream.EventTypeAnnouncementDelete, msg.Event)
+	suite.Equal(testAnnouncement.ID, msg.Payload)
+
+	// unpublished announcements can't be dismissed
+	errWithCode = suite.announcements.Dismiss(ctx, suite.testAccounts["local_account_1"], testAnnouncement.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *AnnouncementTestSuite) TestPublishDue() {
+	ctx := context.Background()
+	testAnnouncement := suite.testAnnouncements["announcement_2_scheduled"]
+
+	// bring the schedule forward
+	testAnnouncement.ScheduledAt = time.Now().Add(-1 * time.Minute)
+	suite.NoError(suite.db.UpdateAnnouncement(ctx, testAnnouncement, "scheduled_at"))
+
+	suite.NoError(suite.announcements.PublishDue(ctx))
+
+	announcement, errWithCode := suite.announcements.Get(ctx, testAnnouncement.ID)
+	suite.NoError(errWithCode)
+	suite.True(announcement.Published)
+
+	dbAnnouncement, err := suite.db.GetAnnouncementByID(ctx, testAnnouncement.ID)
+	suite.NoError(err)
+	suite.Zero(dbAnnouncement.ScheduledAt)
+}
+
+func (suite *AnnouncementTestSuite) TestPublishDueAlreadyEnded() {
+	ctx := context.Background()
+	testAnnouncement := suite.testAnnouncements["announcement_2_scheduled"]
+
+	// the schedule and the end have both passed
+	testAnnouncement.StartsAt = time.Now().Add(-2 * time.Hour)
+	testAnnouncement.EndsAt = time.Now().Add(-1 * time.Hour)
+	testAnnouncement.ScheduledAt = time.Now().Add(-3 * time.Hour)
+	suite.NoError(suite.db.UpdateAnnouncement(ctx, testAnnouncement, "starts_at", "ends_at", "scheduled_at"))
+
+	// several ticks of the job shouldn't publish it
+	for i := 0; i < 2; i++ {
+		suite.NoError(suite.announcements.UnpublishExpired(ctx))
+		suite.NoError(suite.announcements.PublishDue(ctx))
+
+		announcement, errWithCode := suite.announcements.Get(ctx, testAnnouncement.ID)
+		suite.NoError(errWithCode)
+		suite.False(announcement.Published)
+	}
+}
+
+func (suite *AnnouncementTestSuite) TestUnpublishExpiredAfterPublishDue() {
+	ctx := context.Background()
+	testAnnouncement := suite.testAnnouncements["announcement_2_scheduled"]
+
+	testAnnouncement.StartsAt = time.Time{}
+	testAnnouncement.EndsAt = time.Now().Add(time.Minute)
+	testAnnouncement.ScheduledAt = time.Now().Add(-1 * time.Minute)
+	suite.NoError(suite.db.UpdateAnnouncement(ctx, testAnnouncement, "starts_at", "ends_at", "scheduled_at"))
+
+	suite.NoError(suite.announcements.PublishDue(ctx))
+
+	// the announcement ends after it was published from its schedule
+	testAnnouncement.EndsAt = time.Now().Add(-1 * time.Second)
+	suite.NoError(suite.db.UpdateAnnouncement(ctx, testAnnouncement, "ends_at"))
+
+	suite.NoError(suite.announcements.UnpublishExpired(ctx))
+	suite.NoError(suite.announcements.PublishDue(ctx))
+
+	announcement, errWithCode := suite.announcements.Get(ctx, testAnnouncement.ID)
+	suite.NoError(errWithCode)
+	suite.False(announcement.Published)
+}
+
+func (suite *AnnouncementTestSuite) TestPublishDueAfterUnpublish() {
+	ctx := context.Background()
+	admin := suite.testAccounts["admin_account"]
+	testAnnouncement := suite.testAnnouncements["announcement_2_scheduled"]
+
+	testAnnouncement.ScheduledAt = time.Now().Add(-1 * time.Minute)
+	suite.NoError(suite.db.UpdateAnnouncement(ctx, testAnnouncement, "scheduled_at"))
+
+	suite.NoError(suite.announcements.PublishDue(ctx))
+
+	// an admin takes the announcement down again
+	published := false
+	announcement, errWithCode := suite.announcements.Update(ctx, admin, testAnnouncement.ID, &apimodel.AnnouncementUpdateRequest{
+		Published: &published,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.False(announcement.Published)
+
+	// and it stays down
+	suite.NoError(suite.announcements.PublishDue(ctx))
+
+	announcement, errWithCode = suite.announcements.Get(ctx, testAnnouncement.ID)
+	suite.NoError(errWithCode)
+	suite.False(announcement.Published)
+}
+
+func (suite *AnnouncementTestSuite) TestGetActiveDismissed() {
+	ctx := context.Background()
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+
+	// local_account_2 has dismissed the announcement already
+	active, errWithCode := suite.announcements.GetActive(ctx, suite.testAccounts["local_account_2"], false)
+	suite.NoError(errWithCode)
+	suite.Empty(active)
+
+	active, errWithCode = suite.announcements.GetActive(ctx, suite.testAccounts["local_account_2"], true)
+	suite.NoError(errWithCode)
+	suite.Len(active, 1)
+	suite.True(active[0].Read)
+
+	// local_account_1 hasn't, until now
+	account := suite.testAccounts["local_account_1"]
+	active, errWithCode = suite.announcements.GetActive(ctx, account, false)
+	suite.NoError(errWithCode)
+	suite.Len(active, 1)
+	suite.False(active[0].Read)
+
+	suite.NoError(suite.announcements.Dismiss(ctx, account, testAnnouncement.ID))
+
+	active, errWithCode = suite.announcements.GetActive(ctx, account, false)
+	suite.NoError(errWithCode)
+	suite.Empty(active)
+}
+
+func (suite *AnnouncementTestSuite) TestReactions() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+
+	openStream, errWithCode := suite.streamingProcessor.OpenStreamForAccount(ctx, account, stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	suite.NoError(suite.announcements.AddReaction(ctx, account, testAnnouncement.ID, "👍"))
+
+	msg := <-openStream.Messages
+	suite.Equal(stream.EventTypeAnnouncementReaction, msg.Event)
+	suite.Equal(`{"name":"👍","count":2,"me":false,"announcement_id":"`+testAnnouncement.ID+`"}`, msg.Payload)
+
+	suite.NoError(suite.announcements.AddReaction(ctx, account, testAnnouncement.ID, "rainbow"))
+
+	msg = <-openStream.Messages
+	suite.Equal(`{"name":"rainbow","count":1,"me":false,"url":"http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png","static_url":"http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/static/01F8MH9H8E4VG3KDYJR9EGPXCQ.png","announcement_id":"`+testAnnouncement.ID+`"}`, msg.Payload)
+
+	active, errWithCode := suite.announcements.GetActive(ctx, account, true)
+	suite.NoError(errWithCode)
+	suite.Equal([]apimodel.AnnouncementReaction{
+		{Name: "👍", Count: 2, Me: true},
+		{
+			Name:      "rainbow",
+			Count:     1,
+			Me:        true,
+			URL:       "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png",
+			StaticURL: "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/static/01F8MH9H8E4VG3KDYJR9EGPXCQ.png",
+		},
+	}, active[0].Reactions)
+
+	suite.NoError(suite.announcements.RemoveReaction(ctx, account, testAnnouncement.ID, "👍"))
+
+	msg = <-openStream.Messages
+	suite.Equal(`{"name":"👍","count":1,"me":false,"announcement_id":"`+testAnnouncement.ID+`"}`, msg.Payload)
+
+	errWithCode = suite.announcements.RemoveReaction(ctx, account, testAnnouncement.ID, "👍")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *AnnouncementTestSuite) TestReactionInvalid() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	testAnnouncement := suite.testAnnouncements["announcement_1"]
+
+	for _, name := range []string{"not an emoji", "nonexistent_emoji"} {
+		errWithCode := suite.announcements.AddReaction(ctx, account, testAnnouncement.ID, name)
+		if suite.Error(errWithCode) {
+			suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+		}
+	}
+
+	// reactions are limited to 8 different emojis
+	for _, name := range []string{"😀", "😁", "😂", "🤣", "😃", "😄", "😅"} {
+		suite.NoError(suite.announcements.AddReaction(ctx, account, testAnnouncement.ID, name))
+	}
+	errWithCode := suite.announcements.AddReaction(ctx, account, testAnnouncement.ID, "😆")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// but existing ones can still be added
+	suite.NoError(suite.announcements.AddReaction(ctx, suite.testAccounts["admin_account"], testAnnouncement.ID, "😀"))
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, &AnnouncementTestSuite{})
+}
@@ -0,0 +1,79 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/streaming"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor interface {
+	// Create creates a new announcement from the given form, on behalf of the given admin account.
+	// The announcement is published right away, unless the form schedules it for later.
+	Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.AnnouncementCreateRequest) (*apimodel.Announcement, gtserror.WithCode)
+	// Update changes the announcement with the given id using the given form, on behalf of the given admin account.
+	Update(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.AnnouncementUpdateRequest) (*apimodel.Announcement, gtserror.WithCode)
+	// Delete deletes the announcement with the given id.
+	Delete(ctx context.Context, id string) gtserror.WithCode
+	// Get returns the announcement with the given id, whether it's published or not.
+	Get(ctx context.Context, id string) (*apimodel.Announcement, gtserror.WithCode)
+	// GetAll returns all announcements, whether they're published or not, newest first.
+	GetAll(ctx context.Context) ([]*apimodel.Announcement, gtserror.WithCode)
+	// GetActive returns the currently published announcements, as seen by the given account.
+	// Announcements dismissed by the account are left out, unless withDismissed is true.
+	GetActive(ctx context.Context, account *gtsmodel.Account, withDismissed bool) ([]*apimodel.Announcement, gtserror.WithCode)
+	// Dismiss marks the published announcement with the given id as read by the given account.
+	Dismiss(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+	// AddReaction adds a reaction with the given unicode emoji or custom emoji shortcode
+	// to the published announcement with the given id, on behalf of the given account.
+	AddReaction(ctx context.Context, account *gtsmodel.Account, id string, name string) gtserror.WithCode
+	// RemoveReaction removes the given account's reaction with the given name from the announcement with the given id.
+	RemoveReaction(ctx context.Context, account *gtsmodel.Account, id string, name string) gtserror.WithCode
+	// PublishDue publishes all scheduled announcements whose scheduled time has passed.
+	PublishDue(ctx context.Context) error
+	// UnpublishExpired unpublishes all published announcements whose end time has passed.
+	UnpublishExpired(ctx context.Context) error
+}
+
+type processor struct {
+	db                 db.DB
+	tc                 typeutils.TypeConverter
+	formatter          text.Formatter
+	parseMention       gtsmodel.ParseMentionFunc
+	streamingProcessor streaming.Processor
+}
+
+// New returns a new announcements processor, which uses
+// the given streaming processor to push changes to users.
+func New(db db.DB, tc typeutils.TypeConverter, streamingProcessor streaming.Processor, parseMention gtsmodel.ParseMentionFunc) Processor {
+	return &processor{
+		db:                 db,
+		tc:                 tc,
+		formatter:          text.NewFormatter(db),
+		parseMention:       parseMention,
+		streamingProcessor: streamingProcessor,
+	}
+}
@@ -0,0 +1,83 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.AnnouncementCreateRequest) (*apimodel.Announcement, gtserror.WithCode) {
+	startsAt, errWithCode := parseTime("starts_at", form.StartsAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	endsAt, errWithCode := parseTime("ends_at", form.EndsAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	scheduledAt, errWithCode := parseTime("scheduled_at", form.ScheduledAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	allDay := form.AllDay
+	published := scheduledAt.IsZero()
+	announcement := &gtsmodel.Announcement{
+		ID:          id.NewULID(),
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+		AllDay:      &allDay,
+		ScheduledAt: scheduledAt,
+		Published:   &published,
+	}
+
+	if errWithCode := checkTimes(announcement); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if published {
+		announcement.PublishedAt = time.Now()
+	} else if scheduledAt.Before(time.Now()) {
+		err := errors.New("scheduled_at must be in the future")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if errWithCode := p.setText(ctx, account, announcement, form.Text); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.db.PutAnnouncement(ctx, announcement); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if published {
+		p.streamAnnouncement(ctx, announcement)
+	}
+
+	return p.apiAnnouncement(ctx, announcement, account)
+}
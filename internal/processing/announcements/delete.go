@@ -0,0 +1,42 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+func (p *processor) Delete(ctx context.Context, id string) gtserror.WithCode {
+	announcement, errWithCode := p.getAnnouncement(ctx, id, false)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteAnnouncementByID(ctx, announcement.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if *announcement.Published {
+		p.streamAnnouncementDelete(announcement.ID)
+	}
+
+	return nil
+}
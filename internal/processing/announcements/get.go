@@ -0,0 +1,87 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (p *processor) Get(ctx context.Context, id string) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id, false)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiAnnouncement(ctx, announcement, nil)
+}
+
+func (p *processor) GetAll(ctx context.Context) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.db.GetAnnouncements(ctx)
+	if err != nil {
+		err = fmt.Errorf("GetAll: error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiAnnouncements(ctx, announcements, nil), nil
+}
+
+func (p *processor) GetActive(ctx context.Context, account *gtsmodel.Account, withDismissed bool) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.db.GetActiveAnnouncements(ctx, time.Now())
+	if err != nil {
+		err = fmt.Errorf("GetActive: error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncements := p.apiAnnouncements(ctx, announcements, account)
+	if withDismissed {
+		return apiAnnouncements, nil
+	}
+
+	undismissed := make([]*apimodel.Announcement, 0, len(apiAnnouncements))
+	for _, apiAnnouncement := range apiAnnouncements {
+		if !apiAnnouncement.Read {
+			undismissed = append(undismissed, apiAnnouncement)
+		}
+	}
+
+	return undismissed, nil
+}
+
+// apiAnnouncements converts the given announcements, as seen by the
+// given account (if any), skipping those that can't be converted.
+func (p *processor) apiAnnouncements(ctx context.Context, announcements []*gtsmodel.Announcement, account *gtsmodel.Account) []*apimodel.Announcement {
+	apiAnnouncements := make([]*apimodel.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		apiAnnouncement, err := p.tc.AnnouncementToAPIAnnouncement(ctx, announcement, account)
+		if err != nil {
+			log.Errorf("apiAnnouncements: error converting announcement %s: %v", announcement.ID, err)
+			continue
+		}
+		apiAnnouncements = append(apiAnnouncements, apiAnnouncement)
+	}
+
+	return apiAnnouncements
+}
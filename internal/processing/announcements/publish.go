@@ -0,0 +1,70 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (p *processor) PublishDue(ctx context.Context) error {
+	announcements, err := p.db.GetDueAnnouncements(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("PublishDue: error getting due announcements: %w", err)
+	}
+
+	for _, announcement := range announcements {
+		published := true
+		announcement.Published = &published
+		announcement.PublishedAt = time.Now()
+		announcement.ScheduledAt = time.Time{}
+		if err := p.db.UpdateAnnouncement(ctx, announcement, "published", "published_at", "scheduled_at"); err != nil {
+			log.WithField("announcementID", announcement.ID).Errorf("error publishing announcement: %v", err)
+			continue
+		}
+
+		p.streamAnnouncement(ctx, announcement)
+	}
+
+	return nil
+}
+
+func (p *processor) UnpublishExpired(ctx context.Context) error {
+	announcements, err := p.db.GetExpiredAnnouncements(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("UnpublishExpired: error getting expired announcements: %w", err)
+	}
+
+	for _, announcement := range announcements {
+		published := false
+		announcement.Published = &published
+		announcement.ScheduledAt = time.Time{}
+		if err := p.db.UpdateAnnouncement(ctx, announcement, "published", "scheduled_at"); err != nil {
+			log.WithField("announcementID", announcement.ID).Errorf("error unpublishing announcement: %v", err)
+			continue
+		}
+
+		p.streamAnnouncementDelete(announcement.ID)
+	}
+
+	return nil
+}
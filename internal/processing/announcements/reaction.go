@@ -0,0 +1,161 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// maxReactions is the maximum number of different
+// reactions that one announcement can have.
+const maxReactions = 8
+
+func (p *processor) AddReaction(ctx context.Context, account *gtsmodel.Account, id string, name string) gtserror.WithCode {
+	announcement, errWithCode := p.getAnnouncement(ctx, id, true)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	reaction, errWithCode := p.newReaction(ctx, account, announcement, name)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	reactions, err := p.db.GetAnnouncementReactions(ctx, announcement.ID)
+	if err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	names := make(map[string]struct{}, maxReactions)
+	for _, r := range reactions {
+		names[r.Name] = struct{}{}
+	}
+
+	if _, ok := names[reaction.Name]; !ok && len(names) >= maxReactions {
+		err := fmt.Errorf("announcement already has the maximum of %d different reactions", maxReactions)
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if err := p.db.PutAnnouncementReaction(ctx, reaction); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// already reacted with this,
+			// so nothing has changed
+			return nil
+		}
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.streamReaction(ctx, announcement.ID, reaction.Name)
+	return nil
+}
+
+func (p *processor) RemoveReaction(ctx context.Context, account *gtsmodel.Account, id string, name string) gtserror.WithCode {
+	announcement, errWithCode := p.getAnnouncement(ctx, id, true)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteAnnouncementReaction(ctx, announcement.ID, account.ID, name); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("account %s has no reaction %s to announcement %s", account.ID, name, announcement.ID)
+			return gtserror.NewErrorNotFound(err)
+		}
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.streamReaction(ctx, announcement.ID, name)
+	return nil
+}
+
+// newReaction checks that the given name is either a unicode emoji or the
+// shortcode of a usable local custom emoji, and returns a new reaction
+// with that name by the given account to the given announcement.
+func (p *processor) newReaction(ctx context.Context, account *gtsmodel.Account, announcement *gtsmodel.Announcement, name string) (*gtsmodel.AnnouncementReaction, gtserror.WithCode) {
+	reaction := &gtsmodel.AnnouncementReaction{
+		ID:             id.NewULID(),
+		AnnouncementID: announcement.ID,
+		AccountID:      account.ID,
+		Name:           name,
+	}
+
+	if validate.EmojiShortcode(name) != nil {
+		// not a shortcode, so it has to be a unicode emoji
+		if err := validate.UnicodeEmoji(name); err != nil {
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		return reaction, nil
+	}
+
+	emoji, err := p.db.GetEmojiByShortcodeDomain(ctx, name, "")
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if emoji == nil || *emoji.Disabled {
+		err := fmt.Errorf("no custom emoji with shortcode %s", name)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	reaction.EmojiID = emoji.ID
+	reaction.Emoji = emoji
+	return reaction, nil
+}
+
+// streamReaction streams the current state of the reaction with the given
+// name to the announcement with the given id to all users. Errors are only
+// logged, since the change has already been stored at this point.
+func (p *processor) streamReaction(ctx context.Context, announcementID string, name string) {
+	reactions, err := p.db.GetAnnouncementReactions(ctx, announcementID)
+	if err != nil {
+		log.Errorf("streamReaction: error getting reactions to announcement %s: %v", announcementID, err)
+		return
+	}
+
+	named := []*gtsmodel.AnnouncementReaction{}
+	for _, reaction := range reactions {
+		if reaction.Name == name {
+			named = append(named, reaction)
+		}
+	}
+
+	apiReaction := &apimodel.AnnouncementReaction{Name: name}
+	if len(named) != 0 {
+		apiReactions, err := p.tc.AnnouncementReactionsToAPIAnnouncementReactions(ctx, named, nil)
+		if err != nil {
+			log.Errorf("streamReaction: error converting reactions to announcement %s: %v", announcementID, err)
+			return
+		}
+		apiReaction = &apiReactions[0]
+	}
+	apiReaction.AnnouncementID = announcementID
+
+	if err := p.streamingProcessor.StreamAnnouncementReaction(apiReaction); err != nil {
+		log.Errorf("streamReaction: error streaming reaction to announcement %s: %v", announcementID, err)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.AnnouncementUpdateRequest) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, errWithCode := p.getAnnouncement(ctx, id, false)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	wasPublished := *announcement.Published
+	columns := []string{}
+
+	if form.Text != nil {
+		if errWithCode := p.setText(ctx, account, announcement, *form.Text); errWithCode != nil {
+			return nil, errWithCode
+		}
+		columns = append(columns, "text", "content", "mentioned_accounts", "tags", "emojis")
+	}
+
+	if form.StartsAt != nil {
+		announcement.StartsAt, errWithCode = parseTime("starts_at", *form.StartsAt)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		columns = append(columns, "starts_at")
+	}
+
+	if form.EndsAt != nil {
+		announcement.EndsAt, errWithCode = parseTime("ends_at", *form.EndsAt)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		columns = append(columns, "ends_at")
+	}
+
+	if form.AllDay != nil {
+		allDay := *form.AllDay
+		announcement.AllDay = &allDay
+		columns = append(columns, "all_day")
+	}
+
+	if errWithCode := checkTimes(announcement); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.Published != nil && *form.Published != wasPublished {
+		published := *form.Published
+		announcement.Published = &published
+
+		// publishing or unpublishing by hand replaces
+		// any schedule, otherwise PublishDue would
+		// publish an unpublished announcement again
+		announcement.ScheduledAt = time.Time{}
+		columns = append(columns, "published", "scheduled_at")
+
+		if published {
+			announcement.PublishedAt = time.Now()
+			columns = append(columns, "published_at")
+		}
+	}
+
+	if form.ScheduledAt != nil {
+		if *announcement.Published {
+			err := errors.New("scheduled_at can only be set on unpublished announcements")
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		announcement.ScheduledAt, errWithCode = parseTime("scheduled_at", *form.ScheduledAt)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		if !announcement.ScheduledAt.IsZero() && announcement.ScheduledAt.Before(time.Now()) {
+			err := errors.New("scheduled_at must be in the future")
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		if form.Published == nil || *form.Published == wasPublished {
+			// not already in columns from above
+			columns = append(columns, "scheduled_at")
+		}
+	}
+
+	if len(columns) == 0 {
+		// nothing to do
+		return p.apiAnnouncement(ctx, announcement, account)
+	}
+
+	if err := p.db.UpdateAnnouncement(ctx, announcement, columns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	switch {
+	case *announcement.Published:
+		// either newly published or changed
+		// while published; both are streamed
+		// as an announcement event
+		p.streamAnnouncement(ctx, announcement)
+	case wasPublished:
+		// unpublished, so make clients drop it
+		p.streamAnnouncementDelete(announcement.ID)
+	}
+
+	return p.apiAnnouncement(ctx, announcement, account)
+}
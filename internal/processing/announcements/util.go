@@ -0,0 +1,154 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package announcements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// parseTime parses the given optional ISO 8601 datetime
+// from the form field with the given name. An empty
+// string results in a zero time, meaning "not set".
+func parseTime(field string, value string) (time.Time, gtserror.WithCode) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		err := fmt.Errorf("%s %s could not be parsed as an ISO 8601 datetime", field, value)
+		return time.Time{}, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return t, nil
+}
+
+// checkTimes checks that the start, end and all day values of the given announcement go together.
+func checkTimes(announcement *gtsmodel.Announcement) gtserror.WithCode {
+	if !announcement.StartsAt.IsZero() && !announcement.EndsAt.IsZero() && announcement.EndsAt.Before(announcement.StartsAt) {
+		err := errors.New("ends_at must not be before starts_at")
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if *announcement.AllDay && (announcement.StartsAt.IsZero() || announcement.EndsAt.IsZero()) {
+		err := errors.New("all_day announcements must have both starts_at and ends_at")
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return nil
+}
+
+// setText validates the given text, and sets it as the text of the
+// given announcement, along with the formatted content, mentions,
+// tags and emojis parsed from it.
+func (p *processor) setText(ctx context.Context, account *gtsmodel.Account, announcement *gtsmodel.Announcement, text string) gtserror.WithCode {
+	if err := validate.AnnouncementText(text); err != nil {
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Announcements are never stored as statuses,
+	// so no status ID is given, and no mentions
+	// end up in the database; we just keep track
+	// of who was mentioned.
+	formatted := p.formatter.FromMarkdown(ctx, p.parseMention, account.ID, "", text)
+
+	announcement.Text = text
+	announcement.Content = formatted.HTML
+
+	announcement.MentionedAccountIDs = make([]string, 0, len(formatted.Mentions))
+	for _, mention := range formatted.Mentions {
+		announcement.MentionedAccountIDs = append(announcement.MentionedAccountIDs, mention.TargetAccountID)
+	}
+
+	announcement.TagIDs = make([]string, 0, len(formatted.Tags))
+	for _, tag := range formatted.Tags {
+		announcement.TagIDs = append(announcement.TagIDs, tag.ID)
+	}
+
+	announcement.EmojiIDs = make([]string, 0, len(formatted.Emojis))
+	for _, emoji := range formatted.Emojis {
+		announcement.EmojiIDs = append(announcement.EmojiIDs, emoji.ID)
+	}
+
+	return nil
+}
+
+// getAnnouncement is a shortcut to get one announcement from the database.
+// If publishedOnly is true, unpublished announcements are treated as not
+// found. Will return appropriate errors so caller doesn't need to bother.
+func (p *processor) getAnnouncement(ctx context.Context, id string, publishedOnly bool) (*gtsmodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.db.GetAnnouncementByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if publishedOnly && !*announcement.Published {
+		err = fmt.Errorf("announcement with id %s is not published", announcement.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return announcement, nil
+}
+
+// apiAnnouncement is a shortcut to return the API version of the given
+// announcement, or return an appropriate error if conversion fails.
+func (p *processor) apiAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement, account *gtsmodel.Account) (*apimodel.Announcement, gtserror.WithCode) {
+	apiAnnouncement, err := p.tc.AnnouncementToAPIAnnouncement(ctx, announcement, account)
+	if err != nil {
+		err = fmt.Errorf("error converting announcement %s to frontend representation: %w", announcement.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAnnouncement, nil
+}
+
+// streamAnnouncement streams the given announcement to all users. Errors are
+// only logged, since the change has already been stored at this point.
+func (p *processor) streamAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) {
+	apiAnnouncement, err := p.tc.AnnouncementToAPIAnnouncement(ctx, announcement, nil)
+	if err != nil {
+		log.Errorf("streamAnnouncement: error converting announcement %s: %v", announcement.ID, err)
+		return
+	}
+
+	if err := p.streamingProcessor.StreamAnnouncement(apiAnnouncement); err != nil {
+		log.Errorf("streamAnnouncement: error streaming announcement %s: %v", announcement.ID, err)
+	}
+}
+
+// streamAnnouncementDelete streams the deletion of the announcement with the
+// given id to all users. Errors are only logged, like for streamAnnouncement.
+func (p *processor) streamAnnouncementDelete(id string) {
+	if err := p.streamingProcessor.StreamAnnouncementDelete(id); err != nil {
+		log.Errorf("streamAnnouncementDelete: error streaming delete of announcement %s: %v", id, err)
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/uuid"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -85,5 +86,13 @@ func (p *processor) AppCreate(ctx context.Context, authed *oauth.Auth, form *api
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// include the vapid key so the app can subscribe to web push
+	if config.GetWebPushEnabled() {
+		apiApp.VapidKey, err = p.pushProcessor.VAPIDKey(ctx)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
 	return apiApp, nil
 }
@@ -0,0 +1,59 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package conversations
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/streaming"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor interface {
+	// GetAll returns a pageable response of direct message conversations of the given account, most recently active first.
+	GetAll(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// Read marks the conversation with the given id as read by the given account.
+	Read(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Conversation, gtserror.WithCode)
+	// Delete removes the conversation with the given id from the given account's conversations.
+	// The statuses in it are left alone; a new status in the thread will start the conversation again.
+	Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+	// UpdateForStatus adds the given status, if it's a direct message, to the conversations of each
+	// local account taking part in it, and streams the updated conversations to those accounts.
+	UpdateForStatus(ctx context.Context, status *gtsmodel.Status) error
+}
+
+type processor struct {
+	db                 db.DB
+	tc                 typeutils.TypeConverter
+	streamingProcessor streaming.Processor
+}
+
+// New returns a new conversations processor, which uses
+// the given streaming processor to stream updated conversations.
+func New(db db.DB, tc typeutils.TypeConverter, streamingProcessor streaming.Processor) Processor {
+	return &processor{
+		db:                 db,
+		tc:                 tc,
+		streamingProcessor: streamingProcessor,
+	}
+}
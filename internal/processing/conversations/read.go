@@ -0,0 +1,46 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package conversations
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Read(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Conversation, gtserror.WithCode) {
+	conversation, errWithCode := p.getConversation(ctx, account, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !*conversation.Read {
+		read := true
+		conversation.Read = &read
+		if err := p.db.UpdateConversation(ctx, conversation, "read"); err != nil {
+			err = fmt.Errorf("Read: error updating conversation %s: %w", id, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.apiConversation(ctx, conversation, account)
+}
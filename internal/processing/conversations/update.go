@@ -0,0 +1,122 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package conversations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) UpdateForStatus(ctx context.Context, status *gtsmodel.Status) error {
+	if status.Visibility != gtsmodel.VisibilityDirect {
+		// only direct messages form conversations
+		return nil
+	}
+
+	participants, err := p.participants(ctx, status)
+	if err != nil {
+		return fmt.Errorf("UpdateForStatus: %w", err)
+	}
+
+	threadRoot, err := p.db.GetStatusThreadRoot(ctx, status)
+	if err != nil {
+		return fmt.Errorf("UpdateForStatus: error getting thread of status %s: %w", status.ID, err)
+	}
+	threadID := threadRoot.ID
+
+	for _, participant := range participants {
+		if participant.Domain != "" {
+			// conversations are only kept for local accounts
+			continue
+		}
+
+		if participant.ID != status.AccountID {
+			// don't bother someone with direct messages from an account they've blocked
+			blocked, err := p.db.IsBlocked(ctx, participant.ID, status.AccountID, false)
+			if err != nil {
+				return fmt.Errorf("UpdateForStatus: error checking block: %w", err)
+			}
+
+			if blocked {
+				continue
+			}
+		}
+
+		otherAccountIDs := make([]string, 0, len(participants)-1)
+		for _, other := range participants {
+			if other.ID != participant.ID {
+				otherAccountIDs = append(otherAccountIDs, other.ID)
+			}
+		}
+
+		// the author has obviously read their own status
+		read := participant.ID == status.AccountID
+
+		conversation, err := p.db.GetConversationByThreadAndAccountIDs(ctx, participant.ID, threadID, otherAccountIDs)
+		switch {
+		case err == nil:
+			// only move the conversation along if this status is newer;
+			// statuses from other instances can arrive out of order
+			if status.ID > conversation.LastStatusID {
+				conversation.LastStatusID = status.ID
+				conversation.LastStatus = status
+				conversation.Read = &read
+				if err := p.db.UpdateConversation(ctx, conversation, "last_status_id", "read"); err != nil {
+					return fmt.Errorf("UpdateForStatus: error updating conversation %s: %w", conversation.ID, err)
+				}
+			}
+		case errors.Is(err, db.ErrNoEntries):
+			conversation = &gtsmodel.Conversation{
+				ID:              id.NewULID(),
+				AccountID:       participant.ID,
+				Account:         participant,
+				OtherAccountIDs: otherAccountIDs,
+				ThreadID:        threadID,
+				LastStatusID:    status.ID,
+				LastStatus:      status,
+				Read:            &read,
+			}
+			if err := p.db.PutConversation(ctx, conversation); err != nil {
+				return fmt.Errorf("UpdateForStatus: error putting conversation: %w", err)
+			}
+		default:
+			return fmt.Errorf("UpdateForStatus: error getting conversation: %w", err)
+		}
+
+		if err := p.db.AddStatusToConversation(ctx, conversation.ID, status.ID); err != nil {
+			return fmt.Errorf("UpdateForStatus: error adding status %s to conversation %s: %w", status.ID, conversation.ID, err)
+		}
+
+		apiConversation, errWithCode := p.apiConversation(ctx, conversation, participant)
+		if errWithCode != nil {
+			return fmt.Errorf("UpdateForStatus: %w", errWithCode)
+		}
+
+		if err := p.streamingProcessor.StreamConversationToAccount(apiConversation, participant); err != nil {
+			return fmt.Errorf("UpdateForStatus: error streaming conversation to account: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package conversations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// getConversation is a shortcut to get one conversation
+// from the database and check that it's owned by the given
+// account. Will return appropriate errors so caller doesn't
+// need to bother.
+func (p *processor) getConversation(ctx context.Context, account *gtsmodel.Account, id string) (*gtsmodel.Conversation, gtserror.WithCode) {
+	conversation, err := p.db.GetConversationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if conversation.AccountID != account.ID {
+		err = fmt.Errorf("conversation with id %s does not belong to account %s", conversation.ID, account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return conversation, nil
+}
+
+// apiConversation is a shortcut to return the API version of the given
+// conversation, or return an appropriate error if conversion fails.
+func (p *processor) apiConversation(ctx context.Context, conversation *gtsmodel.Conversation, account *gtsmodel.Account) (*apimodel.Conversation, gtserror.WithCode) {
+	apiConversation, err := p.tc.ConversationToAPIConversation(ctx, conversation, account)
+	if err != nil {
+		err = fmt.Errorf("error converting conversation %s to frontend representation: %w", conversation.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiConversation, nil
+}
+
+// participants returns the accounts taking part in the given
+// status: its author, and every account mentioned in it.
+func (p *processor) participants(ctx context.Context, status *gtsmodel.Status) ([]*gtsmodel.Account, error) {
+	if status.Account == nil {
+		account, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting status author %s: %w", status.AccountID, err)
+		}
+		status.Account = account
+	}
+
+	if status.Mentions == nil && len(status.MentionIDs) != 0 {
+		mentions, err := p.db.GetMentions(ctx, status.MentionIDs)
+		if err != nil {
+			return nil, fmt.Errorf("error getting mentions of status %s: %w", status.ID, err)
+		}
+		status.Mentions = mentions
+	}
+
+	participants := []*gtsmodel.Account{status.Account}
+	seen := map[string]bool{status.AccountID: true}
+
+	for _, mention := range status.Mentions {
+		if seen[mention.TargetAccountID] {
+			continue
+		}
+		seen[mention.TargetAccountID] = true
+
+		if mention.TargetAccount == nil {
+			account, err := p.db.GetAccountByID(ctx, mention.TargetAccountID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting mentioned account %s: %w", mention.TargetAccountID, err)
+			}
+			mention.TargetAccount = account
+		}
+
+		participants = append(participants, mention.TargetAccount)
+	}
+
+	return participants, nil
+}
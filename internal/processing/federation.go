@@ -39,6 +39,14 @@ func (p *processor) GetFediFollowing(ctx context.Context, requestedUsername stri
 	return p.federationProcessor.GetFollowing(ctx, requestedUsername, requestURL)
 }
 
+func (p *processor) GetFediFeatured(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode) {
+	return p.federationProcessor.GetFeatured(ctx, requestedUsername, requestURL)
+}
+
+func (p *processor) GetFediFeaturedTags(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode) {
+	return p.federationProcessor.GetFeaturedTags(ctx, requestedUsername, requestURL)
+}
+
 func (p *processor) GetFediStatus(ctx context.Context, requestedUsername string, requestedStatusID string, requestURL *url.URL) (interface{}, gtserror.WithCode) {
 	return p.federationProcessor.GetStatus(ctx, requestedUsername, requestedStatusID, requestURL)
 }
@@ -45,6 +45,14 @@ type Processor interface {
 	// authentication before returning a JSON serializable interface to the caller.
 	GetFollowing(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
 
+	// GetFeatured handles the getting of a fedi/activitypub representation of a user/account's featured (pinned) statuses,
+	// performing appropriate authentication before returning a JSON serializable interface to the caller.
+	GetFeatured(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
+
+	// GetFeaturedTags handles the getting of a fedi/activitypub representation of a user/account's featured hashtags,
+	// performing appropriate authentication before returning a JSON serializable interface to the caller.
+	GetFeaturedTags(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
+
 	// GetStatus handles the getting of a fedi/activitypub representation of a particular status, performing appropriate
 	// authentication before returning a JSON serializable interface to the caller.
 	GetStatus(ctx context.Context, requestedUsername string, requestedStatusID string, requestURL *url.URL) (interface{}, gtserror.WithCode)
@@ -0,0 +1,87 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) FiltersGetV1(ctx context.Context, authed *oauth.Auth) ([]*apimodel.Filter, gtserror.WithCode) {
+	return p.filtersProcessor.GetAllV1(ctx, authed.Account)
+}
+
+func (p *processor) FilterGetV1(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Filter, gtserror.WithCode) {
+	return p.filtersProcessor.GetV1(ctx, authed.Account, id)
+}
+
+func (p *processor) FilterCreateV1(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode) {
+	return p.filtersProcessor.CreateV1(ctx, authed.Account, form)
+}
+
+func (p *processor) FilterUpdateV1(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode) {
+	return p.filtersProcessor.UpdateV1(ctx, authed.Account, id, form)
+}
+
+func (p *processor) FilterDeleteV1(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.filtersProcessor.DeleteV1(ctx, authed.Account, id)
+}
+
+func (p *processor) FiltersGetV2(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FilterV2, gtserror.WithCode) {
+	return p.filtersProcessor.GetAllV2(ctx, authed.Account)
+}
+
+func (p *processor) FilterGetV2(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.FilterV2, gtserror.WithCode) {
+	return p.filtersProcessor.GetV2(ctx, authed.Account, id)
+}
+
+func (p *processor) FilterCreateV2(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	return p.filtersProcessor.CreateV2(ctx, authed.Account, form)
+}
+
+func (p *processor) FilterUpdateV2(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	return p.filtersProcessor.UpdateV2(ctx, authed.Account, id, form)
+}
+
+func (p *processor) FilterDeleteV2(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.filtersProcessor.DeleteV2(ctx, authed.Account, id)
+}
+
+func (p *processor) FilterKeywordsGet(ctx context.Context, authed *oauth.Auth, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode) {
+	return p.filtersProcessor.KeywordsGet(ctx, authed.Account, filterID)
+}
+
+func (p *processor) FilterKeywordGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	return p.filtersProcessor.KeywordGet(ctx, authed.Account, id)
+}
+
+func (p *processor) FilterKeywordCreate(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	return p.filtersProcessor.KeywordCreate(ctx, authed.Account, filterID, form)
+}
+
+func (p *processor) FilterKeywordUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	return p.filtersProcessor.KeywordUpdate(ctx, authed.Account, id, form)
+}
+
+func (p *processor) FilterKeywordDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.filtersProcessor.KeywordDelete(ctx, authed.Account, id)
+}
@@ -0,0 +1,107 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) CreateV1(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode) {
+	filter := &gtsmodel.Filter{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Title:     form.Phrase,
+		Action:    gtsmodel.FilterActionWarn,
+	}
+
+	if form.Irreversible != nil && *form.Irreversible {
+		filter.Action = gtsmodel.FilterActionHide
+	}
+
+	if form.ExpiresIn != nil {
+		setExpiresAt(filter, *form.ExpiresIn)
+	}
+
+	setContexts(filter, form.Context)
+
+	filterKeyword := &gtsmodel.FilterKeyword{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		FilterID:  filter.ID,
+		Filter:    filter,
+		Keyword:   form.Phrase,
+		WholeWord: form.WholeWord,
+	}
+	filter.Keywords = []*gtsmodel.FilterKeyword{filterKeyword}
+
+	if err := p.db.PutFilter(ctx, filter); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiFilterV1(ctx, filterKeyword)
+}
+
+func (p *processor) CreateV2(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	filter := &gtsmodel.Filter{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Title:     form.Title,
+		Action:    gtsmodel.FilterAction(form.FilterAction),
+	}
+
+	if filter.Action == "" {
+		// use default if nothing given
+		filter.Action = gtsmodel.FilterActionWarn
+	}
+
+	if form.ExpiresIn != nil {
+		setExpiresAt(filter, *form.ExpiresIn)
+	}
+
+	setContexts(filter, form.Context)
+
+	filter.Keywords = make([]*gtsmodel.FilterKeyword, 0, len(form.KeywordsAttributes))
+	for _, attributes := range form.KeywordsAttributes {
+		filter.Keywords = append(filter.Keywords, &gtsmodel.FilterKeyword{
+			ID:        id.NewULID(),
+			AccountID: account.ID,
+			FilterID:  filter.ID,
+			Keyword:   attributes.Keyword,
+			WholeWord: attributes.WholeWord,
+		})
+	}
+
+	if err := p.db.PutFilter(ctx, filter); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = fmt.Errorf("duplicate keyword: %w", err)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiFilterV2(ctx, filter)
+}
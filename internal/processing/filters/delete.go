@@ -0,0 +1,64 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) DeleteV1(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if len(filterKeyword.Filter.Keywords) <= 1 {
+		// This is the only keyword in the filter,
+		// so just remove the whole filter.
+		if err := p.db.DeleteFilterByID(ctx, filterKeyword.FilterID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.NewErrorInternalError(err)
+		}
+		return nil
+	}
+
+	if err := p.db.DeleteFilterKeywordByID(ctx, filterKeyword.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+func (p *processor) DeleteV2(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	// Ensure filter exists + is owned by account.
+	filter, errWithCode := p.getFilter(ctx, account.ID, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteFilterByID(ctx, filter.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
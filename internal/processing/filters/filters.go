@@ -0,0 +1,78 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filters
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor interface {
+	// GetAllV1 returns all v1 filters (ie., keywords) owned by the given account.
+	GetAllV1(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Filter, gtserror.WithCode)
+	// GetV1 returns the v1 filter (ie., keyword) with the given ID, owned by the given account.
+	GetV1(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Filter, gtserror.WithCode)
+	// CreateV1 creates a new filter containing a single keyword, owned by the given account.
+	CreateV1(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode)
+	// UpdateV1 updates the v1 filter (ie., keyword) with the given ID, and its parent filter.
+	UpdateV1(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode)
+	// DeleteV1 deletes the v1 filter (ie., keyword) with the given ID. If it was
+	// the only keyword in its parent filter, then the parent filter is deleted too.
+	DeleteV1(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+
+	// GetAllV2 returns all filters owned by the given account.
+	GetAllV2(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FilterV2, gtserror.WithCode)
+	// GetV2 returns the filter with the given ID, owned by the given account.
+	GetV2(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterV2, gtserror.WithCode)
+	// CreateV2 creates a new filter owned by the given account, with any given keywords.
+	CreateV2(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// UpdateV2 updates the filter with the given ID, adding, updating, or removing keywords as requested.
+	UpdateV2(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// DeleteV2 deletes the filter with the given ID, and all of its keywords.
+	DeleteV2(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+
+	// KeywordsGet returns all keywords of the filter with the given ID.
+	KeywordsGet(ctx context.Context, account *gtsmodel.Account, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode)
+	// KeywordGet returns the filter keyword with the given ID.
+	KeywordGet(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// KeywordCreate adds a new keyword to the filter with the given ID.
+	KeywordCreate(ctx context.Context, account *gtsmodel.Account, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// KeywordUpdate updates the filter keyword with the given ID.
+	KeywordUpdate(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// KeywordDelete deletes the filter keyword with the given ID.
+	KeywordDelete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+}
+
+type processor struct {
+	db db.DB
+	tc typeutils.TypeConverter
+}
+
+// New returns a new filters processor.
+func New(db db.DB, tc typeutils.TypeConverter) Processor {
+	return &processor{
+		db: db,
+		tc: tc,
+	}
+}
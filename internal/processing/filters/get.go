@@ -0,0 +1,91 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filters
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) GetAllV1(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Filter, gtserror.WithCode) {
+	filters, err := p.db.GetFiltersForAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Each v1 filter corresponds to
+	// one keyword of a v2 filter.
+	apiFilters := []*apimodel.Filter{}
+	for _, filter := range filters {
+		for _, filterKeyword := range filter.Keywords {
+			filterKeyword.Filter = filter
+
+			apiFilter, errWithCode := p.apiFilterV1(ctx, filterKeyword)
+			if errWithCode != nil {
+				return nil, errWithCode
+			}
+
+			apiFilters = append(apiFilters, apiFilter)
+		}
+	}
+
+	return apiFilters, nil
+}
+
+func (p *processor) GetV1(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Filter, gtserror.WithCode) {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterV1(ctx, filterKeyword)
+}
+
+func (p *processor) GetAllV2(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FilterV2, gtserror.WithCode) {
+	filters, err := p.db.GetFiltersForAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiFilters := make([]*apimodel.FilterV2, 0, len(filters))
+	for _, filter := range filters {
+		apiFilter, errWithCode := p.apiFilterV2(ctx, filter)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiFilters = append(apiFilters, apiFilter)
+	}
+
+	return apiFilters, nil
+}
+
+func (p *processor) GetV2(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterV2, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterV2(ctx, filter)
+}
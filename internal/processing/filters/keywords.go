@@ -0,0 +1,112 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) KeywordsGet(ctx context.Context, account *gtsmodel.Account, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiFilterKeywords := make([]*apimodel.FilterKeyword, 0, len(filter.Keywords))
+	for _, filterKeyword := range filter.Keywords {
+		apiFilterKeyword, errWithCode := p.apiFilterKeyword(ctx, filterKeyword)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiFilterKeywords = append(apiFilterKeywords, apiFilterKeyword)
+	}
+
+	return apiFilterKeywords, nil
+}
+
+func (p *processor) KeywordGet(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterKeyword(ctx, filterKeyword)
+}
+
+func (p *processor) KeywordCreate(ctx context.Context, account *gtsmodel.Account, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	// Ensure filter exists + is owned by account.
+	filter, errWithCode := p.getFilter(ctx, account.ID, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	filterKeyword := &gtsmodel.FilterKeyword{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		FilterID:  filter.ID,
+		Keyword:   form.Keyword,
+		WholeWord: form.WholeWord,
+	}
+
+	if err := p.db.PutFilterKeyword(ctx, filterKeyword); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = fmt.Errorf("duplicate keyword: %w", err)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiFilterKeyword(ctx, filterKeyword)
+}
+
+func (p *processor) KeywordUpdate(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := p.updateKeyword(ctx, filterKeyword, form.Keyword, form.WholeWord); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterKeyword(ctx, filterKeyword)
+}
+
+func (p *processor) KeywordDelete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	// Ensure keyword exists + is owned by account.
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteFilterKeywordByID(ctx, filterKeyword.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
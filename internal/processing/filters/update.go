@@ -0,0 +1,219 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) UpdateV1(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode) {
+	filterKeyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	filter := filterKeyword.Filter
+
+	// Update the keyword itself.
+	filterKeyword.Keyword = form.Phrase
+	keywordColumns := []string{"keyword"}
+
+	if form.WholeWord != nil {
+		filterKeyword.WholeWord = form.WholeWord
+		keywordColumns = append(keywordColumns, "whole_word")
+	}
+
+	if err := p.db.UpdateFilterKeyword(ctx, filterKeyword, keywordColumns...); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = fmt.Errorf("duplicate keyword: %w", err)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Update the parent filter. v1 filters
+	// always provide the full set of contexts.
+	setContexts(filter, form.Context)
+	filterColumns := append([]string{}, contextColumns...)
+
+	if len(filter.Keywords) <= 1 {
+		// If this is the only keyword of the
+		// filter, keep the title in sync with it.
+		filter.Title = form.Phrase
+		filterColumns = append(filterColumns, "title")
+	}
+
+	if form.Irreversible != nil {
+		filter.Action = gtsmodel.FilterActionWarn
+		if *form.Irreversible {
+			filter.Action = gtsmodel.FilterActionHide
+		}
+		filterColumns = append(filterColumns, "action")
+	}
+
+	if form.ExpiresIn != nil {
+		setExpiresAt(filter, *form.ExpiresIn)
+		filterColumns = append(filterColumns, "expires_at")
+	}
+
+	if err := p.db.UpdateFilter(ctx, filter, filterColumns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiFilterV1(ctx, filterKeyword)
+}
+
+func (p *processor) UpdateV2(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Only update columns we're told to update.
+	columns := make([]string, 0, len(contextColumns)+3)
+
+	if form.Title != nil {
+		filter.Title = *form.Title
+		columns = append(columns, "title")
+	}
+
+	if len(form.Context) != 0 {
+		setContexts(filter, form.Context)
+		columns = append(columns, contextColumns...)
+	}
+
+	if form.FilterAction != nil {
+		filter.Action = gtsmodel.FilterAction(*form.FilterAction)
+		columns = append(columns, "action")
+	}
+
+	if form.ExpiresIn != nil {
+		setExpiresAt(filter, *form.ExpiresIn)
+		columns = append(columns, "expires_at")
+	}
+
+	if len(columns) != 0 {
+		if err := p.db.UpdateFilter(ctx, filter, columns...); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Add, update, or remove keywords.
+	for _, attributes := range form.KeywordsAttributes {
+		if errWithCode := p.updateKeywordAttributes(ctx, filter, attributes); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	// Refetch the filter to
+	// ensure keywords are current.
+	filter, errWithCode = p.getFilter(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterV2(ctx, filter)
+}
+
+// updateKeywordAttributes adds, updates, or removes one keyword of
+// the given filter, as described by the given keyword attributes.
+func (p *processor) updateKeywordAttributes(ctx context.Context, filter *gtsmodel.Filter, attributes apimodel.FilterKeywordAttributes) gtserror.WithCode {
+	if attributes.ID == "" {
+		// No ID given, so this is a new keyword.
+		filterKeyword := &gtsmodel.FilterKeyword{
+			ID:        id.NewULID(),
+			AccountID: filter.AccountID,
+			FilterID:  filter.ID,
+			Keyword:   attributes.Keyword,
+			WholeWord: attributes.WholeWord,
+		}
+
+		if err := p.db.PutFilterKeyword(ctx, filterKeyword); err != nil {
+			if errors.Is(err, db.ErrAlreadyExists) {
+				err = fmt.Errorf("duplicate keyword: %w", err)
+				return gtserror.NewErrorConflict(err, err.Error())
+			}
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		return nil
+	}
+
+	// Find the existing keyword on this filter.
+	var filterKeyword *gtsmodel.FilterKeyword
+	for _, fk := range filter.Keywords {
+		if fk.ID == attributes.ID {
+			filterKeyword = fk
+			break
+		}
+	}
+
+	if filterKeyword == nil {
+		err := fmt.Errorf("filter keyword with id %s does not belong to filter %s", attributes.ID, filter.ID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if attributes.Destroy {
+		if err := p.db.DeleteFilterKeywordByID(ctx, filterKeyword.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.NewErrorInternalError(err)
+		}
+		return nil
+	}
+
+	return p.updateKeyword(ctx, filterKeyword, attributes.Keyword, attributes.WholeWord)
+}
+
+// updateKeyword updates the keyword text and/or whole word
+// setting of the given filter keyword, if either is set.
+func (p *processor) updateKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, keyword string, wholeWord *bool) gtserror.WithCode {
+	// Only update columns we're told to update.
+	columns := make([]string, 0, 2)
+
+	if keyword != "" {
+		filterKeyword.Keyword = keyword
+		columns = append(columns, "keyword")
+	}
+
+	if wholeWord != nil {
+		filterKeyword.WholeWord = wholeWord
+		columns = append(columns, "whole_word")
+	}
+
+	if len(columns) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	if err := p.db.UpdateFilterKeyword(ctx, filterKeyword, columns...); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = fmt.Errorf("duplicate keyword: %w", err)
+			return gtserror.NewErrorConflict(err, err.Error())
+		}
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
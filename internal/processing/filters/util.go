@@ -0,0 +1,163 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// getFilter is a shortcut to get one filter from the database and
+// check that it's owned by the given accountID. Will return
+// appropriate errors so caller doesn't need to bother.
+func (p *processor) getFilter(ctx context.Context, accountID string, filterID string) (*gtsmodel.Filter, gtserror.WithCode) {
+	filter, err := p.db.GetFilterByID(ctx, filterID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Filter doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if filter.AccountID != accountID {
+		err = fmt.Errorf("filter with id %s does not belong to account %s", filter.ID, accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return filter, nil
+}
+
+// getFilterKeyword is a shortcut to get one filter keyword from the
+// database and check that it's owned by the given accountID. The parent
+// filter of the keyword will be populated. Will return appropriate
+// errors so caller doesn't need to bother.
+func (p *processor) getFilterKeyword(ctx context.Context, accountID string, filterKeywordID string) (*gtsmodel.FilterKeyword, gtserror.WithCode) {
+	filterKeyword, err := p.db.GetFilterKeywordByID(ctx, filterKeywordID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Keyword doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if filterKeyword.AccountID != accountID {
+		err = fmt.Errorf("filter keyword with id %s does not belong to account %s", filterKeyword.ID, accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	filter, errWithCode := p.getFilter(ctx, accountID, filterKeyword.FilterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+	filterKeyword.Filter = filter
+
+	return filterKeyword, nil
+}
+
+// apiFilterV1 is a shortcut to return the API v1 version of the given
+// filter keyword, or return an appropriate error if conversion fails.
+func (p *processor) apiFilterV1(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) (*apimodel.Filter, gtserror.WithCode) {
+	apiFilter, err := p.tc.FilterKeywordToAPIFilterV1(ctx, filterKeyword)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter keyword to api v1 filter: %w", err))
+	}
+
+	return apiFilter, nil
+}
+
+// apiFilterV2 is a shortcut to return the API v2 version of the given
+// filter, or return an appropriate error if conversion fails.
+func (p *processor) apiFilterV2(ctx context.Context, filter *gtsmodel.Filter) (*apimodel.FilterV2, gtserror.WithCode) {
+	apiFilter, err := p.tc.FilterToAPIFilterV2(ctx, filter)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter to api v2 filter: %w", err))
+	}
+
+	return apiFilter, nil
+}
+
+// apiFilterKeyword is a shortcut to return the API version of the given
+// filter keyword, or return an appropriate error if conversion fails.
+func (p *processor) apiFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	apiFilterKeyword, err := p.tc.FilterKeywordToAPIFilterKeyword(ctx, filterKeyword)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter keyword to api: %w", err))
+	}
+
+	return apiFilterKeyword, nil
+}
+
+// setContexts sets the context fields of the given
+// filter according to the given slice of contexts.
+// Contexts are expected to already be validated.
+func setContexts(filter *gtsmodel.Filter, contexts []string) {
+	f, t := false, true
+	filter.ContextHome = &f
+	filter.ContextNotifications = &f
+	filter.ContextPublic = &f
+	filter.ContextThread = &f
+	filter.ContextAccount = &f
+
+	for _, context := range contexts {
+		switch gtsmodel.FilterContext(context) {
+		case gtsmodel.FilterContextHome:
+			filter.ContextHome = &t
+		case gtsmodel.FilterContextNotifications:
+			filter.ContextNotifications = &t
+		case gtsmodel.FilterContextPublic:
+			filter.ContextPublic = &t
+		case gtsmodel.FilterContextThread:
+			filter.ContextThread = &t
+		case gtsmodel.FilterContextAccount:
+			filter.ContextAccount = &t
+		}
+	}
+}
+
+// contextColumns is the list of db columns
+// set on a filter by a call to setContexts.
+var contextColumns = []string{
+	"context_home",
+	"context_notifications",
+	"context_public",
+	"context_thread",
+	"context_account",
+}
+
+// setExpiresAt sets the expiry time of the given filter to expiresIn
+// seconds from now, or clears it if expiresIn is 0.
+func setExpiresAt(filter *gtsmodel.Filter, expiresIn int) {
+	if expiresIn == 0 {
+		filter.ExpiresAt = time.Time{}
+		return
+	}
+
+	filter.ExpiresAt = time.Now().Add(time.Duration(expiresIn) * time.Second)
+}
@@ -75,6 +75,9 @@ func (p *processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ActivityBlock:
 			// CREATE BLOCK
 			return p.processCreateBlockFromClientAPI(ctx, clientMsg)
+		case ap.ActivityQuestion:
+			// CREATE POLL VOTE
+			return p.processCreatePollVoteFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityUpdate:
 		// UPDATE
@@ -82,6 +85,18 @@ func (p *processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ObjectProfile, ap.ActorPerson:
 			// UPDATE ACCOUNT/PROFILE
 			return p.processUpdateAccountFromClientAPI(ctx, clientMsg)
+		case ap.ObjectNote:
+			// UPDATE (EDIT) STATUS
+			return p.processUpdateStatusFromClientAPI(ctx, clientMsg)
+		case ap.ActivityQuestion:
+			// UPDATE (CLOSE) POLL
+			return p.processClosePollFromClientAPI(ctx, clientMsg)
+		}
+	case ap.ActivityMove:
+		// MOVE
+		if clientMsg.APObjectType == ap.ObjectProfile {
+			// MOVE ACCOUNT/PROFILE
+			return p.processMoveAccountFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityAccept:
 		// ACCEPT
@@ -148,6 +163,12 @@ func (p *processor) processCreateAccountFromClientAPI(ctx context.Context, clien
 		return err
 	}
 
+	// let the admins know someone new has signed up; don't
+	// let a failure here stop the user getting their email
+	if err := p.notifySignup(ctx, account); err != nil {
+		log.Errorf("processCreateAccountFromClientAPI: %s", err)
+	}
+
 	// email a confirmation to this user
 	return p.userProcessor.SendConfirmEmail(ctx, user, account.Username)
 }
@@ -166,6 +187,10 @@ func (p *processor) processCreateStatusFromClientAPI(ctx context.Context, client
 		return err
 	}
 
+	if err := p.conversationsProcessor.UpdateForStatus(ctx, status); err != nil {
+		return err
+	}
+
 	return p.federateStatus(ctx, status)
 }
 
@@ -241,6 +266,68 @@ func (p *processor) processUpdateAccountFromClientAPI(ctx context.Context, clien
 	return p.federateAccountUpdate(ctx, account, clientMsg.OriginAccount)
 }
 
+func (p *processor) processMoveAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	account, ok := clientMsg.GTSModel.(*gtsmodel.Account)
+	if !ok {
+		return errors.New("account was not parseable as *gtsmodel.Account")
+	}
+
+	if clientMsg.TargetAccount == nil {
+		return errors.New("move had no target account")
+	}
+
+	// make sure remote instances see the new movedTo
+	// value before they receive the move itself
+	if err := p.federateAccountUpdate(ctx, account, account); err != nil {
+		return err
+	}
+
+	if err := p.federateMove(ctx, account, clientMsg.TargetAccount); err != nil {
+		return err
+	}
+
+	return p.moveFollowers(ctx, account, clientMsg.TargetAccount)
+}
+
+func (p *processor) processCreatePollVoteFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	vote, ok := clientMsg.GTSModel.(*gtsmodel.PollVote)
+	if !ok {
+		return errors.New("vote was not parseable as *gtsmodel.PollVote")
+	}
+
+	return p.federatePollVote(ctx, vote)
+}
+
+func (p *processor) processUpdateStatusFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("note was not parseable as *gtsmodel.Status")
+	}
+
+	if err := p.timelineStatusUpdate(ctx, status); err != nil {
+		return err
+	}
+
+	if err := p.notifyStatus(ctx, status); err != nil {
+		return err
+	}
+
+	return p.federateStatusUpdate(ctx, status)
+}
+
+func (p *processor) processClosePollFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("poll was not parseable as *gtsmodel.Status")
+	}
+
+	if err := p.notifyPollClosed(ctx, status); err != nil {
+		return err
+	}
+
+	return p.federateStatusUpdate(ctx, status)
+}
+
 func (p *processor) processAcceptFollowFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	follow, ok := clientMsg.GTSModel.(*gtsmodel.Follow)
 	if !ok {
@@ -492,7 +579,9 @@ func (p *processor) federateStatusDelete(ctx context.Context, status *gtsmodel.S
 
 	// Set the status as the 'object' property.
 	deleteObject := streams.NewActivityStreamsObjectProperty()
-	deleteObject.AppendActivityStreamsNote(asStatus)
+	if err := deleteObject.AppendType(asStatus); err != nil {
+		return fmt.Errorf("federateStatusDelete: error setting object: %s", err)
+	}
 	delete.SetActivityStreamsObject(deleteObject)
 
 	// set the to and cc as the original to/cc of the original status
@@ -503,6 +592,94 @@ func (p *processor) federateStatusDelete(ctx context.Context, status *gtsmodel.S
 	return err
 }
 
+func (p *processor) federateStatusUpdate(ctx context.Context, status *gtsmodel.Status) error {
+	// do nothing if the status shouldn't be federated
+	if !*status.Federated {
+		return nil
+	}
+
+	if status.Account == nil {
+		statusAccount, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("federateStatusUpdate: error fetching status author account: %s", err)
+		}
+		status.Account = statusAccount
+	}
+
+	// do nothing if this isn't our status
+	if status.Account.Domain != "" {
+		return nil
+	}
+
+	asStatus, err := p.tc.StatusToAS(ctx, status)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error converting status to as format: %s", err)
+	}
+
+	update, err := p.tc.WrapNoteInUpdate(asStatus, status.Account)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error wrapping status in update: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(status.Account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error parsing outboxURI %s: %s", status.Account.OutboxURI, err)
+	}
+
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, update)
+	return err
+}
+
+func (p *processor) federatePollVote(ctx context.Context, vote *gtsmodel.PollVote) error {
+	if vote.Account == nil {
+		voteAccount, err := p.db.GetAccountByID(ctx, vote.AccountID)
+		if err != nil {
+			return fmt.Errorf("federatePollVote: error fetching voter account: %s", err)
+		}
+		vote.Account = voteAccount
+	}
+
+	if vote.Poll == nil {
+		poll, err := p.db.GetPollByID(ctx, vote.PollID)
+		if err != nil {
+			return fmt.Errorf("federatePollVote: error fetching poll: %s", err)
+		}
+		vote.Poll = poll
+	}
+
+	if vote.Poll.Status == nil {
+		status, err := p.db.GetStatusByID(ctx, vote.Poll.StatusID)
+		if err != nil {
+			return fmt.Errorf("federatePollVote: error fetching poll status: %s", err)
+		}
+		vote.Poll.Status = status
+	}
+
+	// do nothing if this is our poll,
+	// its counts are already up to date
+	if *vote.Poll.Status.Local {
+		return nil
+	}
+
+	creates, err := p.tc.PollVoteToASCreates(ctx, vote)
+	if err != nil {
+		return fmt.Errorf("federatePollVote: error converting vote to as format: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(vote.Account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federatePollVote: error parsing outboxURI %s: %s", vote.Account.OutboxURI, err)
+	}
+
+	for _, create := range creates {
+		if _, err := p.federator.FederatingActor().Send(ctx, outboxIRI, create); err != nil {
+			return fmt.Errorf("federatePollVote: error sending vote: %s", err)
+		}
+	}
+
+	return nil
+}
+
 func (p *processor) federateFollow(ctx context.Context, followRequest *gtsmodel.FollowRequest, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
 	// if both accounts are local there's nothing to do here
 	if originAccount.Domain == "" && targetAccount.Domain == "" {
@@ -842,6 +1019,21 @@ func (p *processor) federateAccountUpdate(ctx context.Context, updatedAccount *g
 	return err
 }
 
+func (p *processor) federateMove(ctx context.Context, account *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
+	move, err := p.tc.AccountToASMove(ctx, account, targetAccount)
+	if err != nil {
+		return fmt.Errorf("federateMove: error converting account to move: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateMove: error parsing outboxURI %s: %s", account.OutboxURI, err)
+	}
+
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, move)
+	return err
+}
+
 func (p *processor) federateBlock(ctx context.Context, block *gtsmodel.Block) error {
 	if block.Account == nil {
 		blockAccount, err := p.db.GetAccountByID(ctx, block.AccountID)
@@ -21,6 +21,7 @@ package processing_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -30,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -115,6 +117,83 @@ func (suite *FromClientAPITestSuite) TestProcessStreamNewStatus() {
 	suite.Empty(irrelevantStream.Messages)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessStreamNewStatusToList() {
+	ctx := context.Background()
+
+	// admin account is in zork's list, so a new
+	// status from admin should be streamed into
+	// a stream opened for that list
+	postingAccount := suite.testAccounts["admin_account"]
+	receivingAccount := suite.testAccounts["local_account_1"]
+	testList := suite.testLists["local_account_1_list_1"]
+
+	// open a list stream for zork
+	listStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, receivingAccount, stream.TimelineList+":"+testList.ID)
+	suite.NoError(errWithCode)
+
+	// make a new status from admin account
+	newStatus := &gtsmodel.Status{
+		ID:                       "01FN4B2F88TF9676DYNXWE1WST",
+		URI:                      "http://localhost:8080/users/admin/statuses/01FN4B2F88TF9676DYNXWE1WST",
+		URL:                      "http://localhost:8080/@admin/statuses/01FN4B2F88TF9676DYNXWE1WST",
+		Content:                  "this status should stream to the list :)",
+		AttachmentIDs:            []string{},
+		TagIDs:                   []string{},
+		MentionIDs:               []string{},
+		EmojiIDs:                 []string{},
+		CreatedAt:                testrig.TimeMustParse("2021-10-20T11:36:45Z"),
+		UpdatedAt:                testrig.TimeMustParse("2021-10-20T11:36:45Z"),
+		Local:                    testrig.TrueBool(),
+		AccountURI:               "http://localhost:8080/users/admin",
+		AccountID:                "01F8MH17FWEB39HZJ76B6VXSKF",
+		InReplyToID:              "",
+		BoostOfID:                "",
+		ContentWarning:           "",
+		Visibility:               gtsmodel.VisibilityFollowersOnly,
+		Sensitive:                testrig.FalseBool(),
+		Language:                 "en",
+		CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
+		Pinned:                   testrig.FalseBool(),
+		Federated:                testrig.FalseBool(),
+		Boostable:                testrig.TrueBool(),
+		Replyable:                testrig.TrueBool(),
+		Likeable:                 testrig.TrueBool(),
+		ActivityStreamsType:      ap.ObjectNote,
+	}
+
+	// put the status in the db first, to mimic what would have already happened earlier up the flow
+	err := suite.db.PutStatus(ctx, newStatus)
+	suite.NoError(err)
+
+	// process the new status
+	err = suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newStatus,
+		OriginAccount:  postingAccount,
+	})
+	suite.NoError(err)
+
+	// zork's list stream should have the newly created status in it now
+	msg := <-listStream.Messages
+	suite.Equal(stream.EventTypeUpdate, msg.Event)
+	suite.EqualValues([]string{stream.TimelineList, testList.ID}, msg.Stream)
+	statusStreamed := &apimodel.Status{}
+	err = json.Unmarshal([]byte(msg.Payload), statusStreamed)
+	suite.NoError(err)
+	suite.Equal("01FN4B2F88TF9676DYNXWE1WST", statusStreamed.ID)
+
+	// and stream should now be empty
+	suite.Empty(listStream.Messages)
+
+	// the status should also be in the list timeline
+	resp, errWithCode := suite.processor.ListTimelineGet(ctx, suite.testAutheds["local_account_1"], testList.ID, "", "", "", 20)
+	suite.NoError(errWithCode)
+	if suite.NotEmpty(resp.Items) {
+		suite.Equal("01FN4B2F88TF9676DYNXWE1WST", resp.Items[0].(*apimodel.Status).ID)
+	}
+}
+
 func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	ctx := context.Background()
 
@@ -161,6 +240,471 @@ func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessPollClosed() {
+	ctx := context.Background()
+
+	authorAccount := suite.testAccounts["local_account_2"]
+	voterAccount := suite.testAccounts["local_account_1"]
+
+	// close the poll, to mimic what would have already happened earlier up the flow
+	poll := testrig.NewTestPolls()["local_account_2_status_7_poll"]
+	poll.ClosedAt = testrig.TimeMustParse("2022-01-01T12:00:00+02:00")
+	suite.NoError(suite.db.UpdatePoll(ctx, poll, "closed_at"))
+
+	status, err := suite.db.GetStatusByID(ctx, poll.StatusID)
+	suite.NoError(err)
+
+	// open a notifications stream for zork, who voted in the poll
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, voterAccount, stream.TimelineNotifications)
+	suite.NoError(errWithCode)
+
+	err = suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityQuestion,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       status,
+		OriginAccount:  authorAccount,
+	})
+	suite.NoError(err)
+
+	// zork should have been streamed a poll notification
+	msg := <-wssStream.Messages
+	suite.Equal(stream.EventTypeNotification, msg.Event)
+
+	notif := &apimodel.Notification{}
+	suite.NoError(json.Unmarshal([]byte(msg.Payload), notif))
+	suite.Equal("poll", notif.Type)
+	suite.Equal(status.ID, notif.Status.ID)
+	suite.True(notif.Status.Poll.Expired)
+
+	// the author should have been notified too
+	notifs, err := suite.db.GetNotifications(ctx, authorAccount.ID, nil, 10, "", "")
+	suite.NoError(err)
+
+	var authorNotified bool
+	for _, n := range notifs {
+		if n.NotificationType == gtsmodel.NotificationPoll && n.StatusID == status.ID {
+			authorNotified = true
+		}
+	}
+	suite.True(authorNotified)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreateAccountNotifiesAdmins() {
+	ctx := context.Background()
+
+	newAccount := suite.testAccounts["unconfirmed_account"]
+	adminAccount := suite.testAccounts["admin_account"]
+
+	// open a notifications stream for the admin
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, adminAccount, stream.TimelineNotifications)
+	suite.NoError(errWithCode)
+
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newAccount,
+		OriginAccount:  newAccount,
+	})
+	suite.NoError(err)
+
+	// the admin should have been streamed a sign-up notification
+	msg := <-wssStream.Messages
+	suite.Equal(stream.EventTypeNotification, msg.Event)
+
+	notif := &apimodel.Notification{}
+	suite.NoError(json.Unmarshal([]byte(msg.Payload), notif))
+	suite.Equal("admin.sign_up", notif.Type)
+	suite.Equal(newAccount.ID, notif.Account.ID)
+	suite.Nil(notif.Status)
+
+	// zork isn't an admin, so shouldn't have been notified
+	notifs, err := suite.db.GetNotifications(ctx, suite.testAccounts["local_account_1"].ID, nil, 10, "", "")
+	suite.NoError(err)
+	for _, n := range notifs {
+		suite.NotEqual(gtsmodel.NotificationSignup, n.NotificationType)
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessStreamNewStatusWithFollowedTag() {
+	ctx := context.Background()
+
+	// admin posts a public status using a hashtag that
+	// local_account_2 follows; local_account_2 doesn't
+	// follow admin, but should still get the status
+	postingAccount := suite.testAccounts["admin_account"]
+	receivingAccount := suite.testAccounts["local_account_2"]
+	testTag := suite.testTags["Hashtag"]
+
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, receivingAccount, stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	newStatus := suite.newStatusWithTag(postingAccount, testTag, gtsmodel.VisibilityPublic)
+	suite.NoError(suite.db.PutStatus(ctx, newStatus))
+
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newStatus,
+		OriginAccount:  postingAccount,
+	})
+	suite.NoError(err)
+
+	msg := <-wssStream.Messages
+	suite.Equal(stream.EventTypeUpdate, msg.Event)
+	suite.EqualValues([]string{stream.TimelineHome}, msg.Stream)
+	statusStreamed := &apimodel.Status{}
+	suite.NoError(json.Unmarshal([]byte(msg.Payload), statusStreamed))
+	suite.Equal(newStatus.ID, statusStreamed.ID)
+	suite.Empty(wssStream.Messages)
+
+	// the status should also be in the db home timeline
+	statuses, err := suite.db.GetHomeTimeline(ctx, receivingAccount.ID, "", "", "", 20, false)
+	suite.NoError(err)
+	suite.Equal(newStatus.ID, statuses[0].ID)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessNewUnlistedStatusWithFollowedTag() {
+	ctx := context.Background()
+
+	// only public statuses go to tag followers
+	postingAccount := suite.testAccounts["admin_account"]
+	receivingAccount := suite.testAccounts["local_account_2"]
+	testTag := suite.testTags["Hashtag"]
+
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, receivingAccount, stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	newStatus := suite.newStatusWithTag(postingAccount, testTag, gtsmodel.VisibilityUnlocked)
+	suite.NoError(suite.db.PutStatus(ctx, newStatus))
+
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newStatus,
+		OriginAccount:  postingAccount,
+	})
+	suite.NoError(err)
+	suite.Empty(wssStream.Messages)
+
+	statuses, err := suite.db.GetHomeTimeline(ctx, receivingAccount.ID, "", "", "", 20, false)
+	suite.NoError(err)
+	for _, status := range statuses {
+		suite.NotEqual(newStatus.ID, status.ID)
+	}
+}
+
+func (suite *FromClientAPITestSuite) newStatusWithTag(account *gtsmodel.Account, tag *gtsmodel.Tag, visibility gtsmodel.Visibility) *gtsmodel.Status {
+	statusID := "01H1Z3GXN5XHJ6AR8GMB6KRX3S"
+	return &gtsmodel.Status{
+		ID:                       statusID,
+		URI:                      account.URI + "/statuses/" + statusID,
+		URL:                      account.URL + "/statuses/" + statusID,
+		Content:                  "this status uses #" + tag.Name,
+		AttachmentIDs:            []string{},
+		TagIDs:                   []string{tag.ID},
+		MentionIDs:               []string{},
+		EmojiIDs:                 []string{},
+		CreatedAt:                testrig.TimeMustParse("2023-06-01T11:36:45Z"),
+		UpdatedAt:                testrig.TimeMustParse("2023-06-01T11:36:45Z"),
+		Local:                    testrig.TrueBool(),
+		AccountURI:               account.URI,
+		AccountID:                account.ID,
+		Visibility:               visibility,
+		Sensitive:                testrig.FalseBool(),
+		Language:                 "en",
+		CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
+		Pinned:                   testrig.FalseBool(),
+		Federated:                testrig.FalseBool(),
+		Boostable:                testrig.TrueBool(),
+		Replyable:                testrig.TrueBool(),
+		Likeable:                 testrig.TrueBool(),
+		ActivityStreamsType:      ap.ObjectNote,
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessDirectStatusConversation() {
+	ctx := context.Background()
+
+	// zork replies to turtle's direct message
+	postingAccount := suite.testAccounts["local_account_1"]
+	receivingAccount := suite.testAccounts["local_account_2"]
+	repliedStatus := suite.testStatuses["local_account_2_status_6"]
+	postingConversation := suite.testConversations["local_account_1_conversation_1"]
+	receivingConversation := suite.testConversations["local_account_2_conversation_1"]
+
+	// open a direct stream for turtle
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, receivingAccount, stream.TimelineDirect)
+	suite.NoError(errWithCode)
+
+	mention := &gtsmodel.Mention{
+		ID:               "01H2H2QEZP8D6V47G1Y2F8ZB3Q",
+		StatusID:         "01H2H2QF0FQ6M8RB1Y5QAXN1Z4",
+		OriginAccountID:  postingAccount.ID,
+		OriginAccountURI: postingAccount.URI,
+		TargetAccountID:  receivingAccount.ID,
+		NameString:       "@1happyturtle",
+		TargetAccountURI: receivingAccount.URI,
+		TargetAccountURL: receivingAccount.URL,
+	}
+	suite.NoError(suite.db.Put(ctx, mention))
+
+	newStatus := &gtsmodel.Status{
+		ID:                       "01H2H2QF0FQ6M8RB1Y5QAXN1Z4",
+		URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01H2H2QF0FQ6M8RB1Y5QAXN1Z4",
+		URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01H2H2QF0FQ6M8RB1Y5QAXN1Z4",
+		Content:                  "@1happyturtle hi turtle, shhhhhh yourself!",
+		AttachmentIDs:            []string{},
+		TagIDs:                   []string{},
+		MentionIDs:               []string{mention.ID},
+		EmojiIDs:                 []string{},
+		CreatedAt:                testrig.TimeMustParse("2021-10-20T11:36:45Z"),
+		UpdatedAt:                testrig.TimeMustParse("2021-10-20T11:36:45Z"),
+		Local:                    testrig.TrueBool(),
+		AccountURI:               postingAccount.URI,
+		AccountID:                postingAccount.ID,
+		InReplyToID:              repliedStatus.ID,
+		InReplyToURI:             repliedStatus.URI,
+		InReplyToAccountID:       receivingAccount.ID,
+		Visibility:               gtsmodel.VisibilityDirect,
+		Sensitive:                testrig.FalseBool(),
+		Language:                 "en",
+		CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+		Pinned:                   testrig.FalseBool(),
+		Federated:                testrig.TrueBool(),
+		Boostable:                testrig.FalseBool(),
+		Replyable:                testrig.TrueBool(),
+		Likeable:                 testrig.TrueBool(),
+		ActivityStreamsType:      ap.ObjectNote,
+	}
+	suite.NoError(suite.db.PutStatus(ctx, newStatus))
+
+	// process the new status
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newStatus,
+		OriginAccount:  postingAccount,
+	})
+	suite.NoError(err)
+
+	// turtle's stream should have the updated conversation in it now
+	msg := <-wssStream.Messages
+	suite.Equal(stream.EventTypeConversation, msg.Event)
+	suite.EqualValues([]string{stream.TimelineDirect}, msg.Stream)
+	conversationStreamed := &apimodel.Conversation{}
+	err = json.Unmarshal([]byte(msg.Payload), conversationStreamed)
+	suite.NoError(err)
+	suite.Equal(receivingConversation.ID, conversationStreamed.ID)
+	suite.True(conversationStreamed.Unread)
+	suite.Equal(newStatus.ID, conversationStreamed.LastStatus.ID)
+	suite.Len(conversationStreamed.Accounts, 1)
+	suite.Equal(postingAccount.ID, conversationStreamed.Accounts[0].ID)
+	suite.Empty(wssStream.Messages)
+
+	// zork's conversation in the same thread should be moved along too, but still read
+	conversation, err := suite.db.GetConversationByID(ctx, postingConversation.ID)
+	suite.NoError(err)
+	suite.Equal(newStatus.ID, conversation.LastStatusID)
+	suite.True(*conversation.Read)
+
+	// now zork deletes the reply again
+	suite.NoError(suite.db.DeleteStatusByID(ctx, newStatus.ID))
+	err = suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityDelete,
+		GTSModel:       newStatus,
+		OriginAccount:  postingAccount,
+	})
+	suite.NoError(err)
+
+	// both conversations should be back to the original direct message
+	conversation, err = suite.db.GetConversationByID(ctx, postingConversation.ID)
+	suite.NoError(err)
+	suite.Equal(repliedStatus.ID, conversation.LastStatusID)
+
+	conversation, err = suite.db.GetConversationByID(ctx, receivingConversation.ID)
+	suite.NoError(err)
+	suite.Equal(repliedStatus.ID, conversation.LastStatusID)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessDirectStatusNewConversation() {
+	ctx := context.Background()
+
+	// admin sends zork a new direct message, not in reply to anything
+	postingAccount := suite.testAccounts["admin_account"]
+	receivingAccount := suite.testAccounts["local_account_1"]
+
+	mention := &gtsmodel.Mention{
+		ID:               "01H2H3B1ZJ3F0R0W9QZ8D7ZK6N",
+		StatusID:         "01H2H3B20XG3YQ1FQ8C5E6W9Y2",
+		OriginAccountID:  postingAccount.ID,
+		OriginAccountURI: postingAccount.URI,
+		TargetAccountID:  receivingAccount.ID,
+		NameString:       "@the_mighty_zork",
+		TargetAccountURI: receivingAccount.URI,
+		TargetAccountURL: receivingAccount.URL,
+	}
+	suite.NoError(suite.db.Put(ctx, mention))
+
+	newStatus := &gtsmodel.Status{
+		ID:                       "01H2H3B20XG3YQ1FQ8C5E6W9Y2",
+		URI:                      "http://localhost:8080/users/admin/statuses/01H2H3B20XG3YQ1FQ8C5E6W9Y2",
+		URL:                      "http://localhost:8080/@admin/statuses/01H2H3B20XG3YQ1FQ8C5E6W9Y2",
+		Content:                  "@the_mighty_zork please stop posting",
+		AttachmentIDs:            []string{},
+		TagIDs:                   []string{},
+		MentionIDs:               []string{mention.ID},
+		EmojiIDs:                 []string{},
+		CreatedAt:                testrig.TimeMustParse("2021-10-20T11:36:45Z"),
+		UpdatedAt:                testrig.TimeMustParse("2021-10-20T11:36:45Z"),
+		Local:                    testrig.TrueBool(),
+		AccountURI:               postingAccount.URI,
+		AccountID:                postingAccount.ID,
+		Visibility:               gtsmodel.VisibilityDirect,
+		Sensitive:                testrig.FalseBool(),
+		Language:                 "en",
+		CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
+		Pinned:                   testrig.FalseBool(),
+		Federated:                testrig.TrueBool(),
+		Boostable:                testrig.FalseBool(),
+		Replyable:                testrig.TrueBool(),
+		Likeable:                 testrig.TrueBool(),
+		ActivityStreamsType:      ap.ObjectNote,
+	}
+	suite.NoError(suite.db.PutStatus(ctx, newStatus))
+
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newStatus,
+		OriginAccount:  postingAccount,
+	})
+	suite.NoError(err)
+
+	// zork should have a new, unread conversation with admin
+	conversation, err := suite.db.GetConversationByThreadAndAccountIDs(ctx, receivingAccount.ID, newStatus.ID, []string{postingAccount.ID})
+	suite.NoError(err)
+	suite.Equal(newStatus.ID, conversation.LastStatusID)
+	suite.False(*conversation.Read)
+
+	// and admin should have a read one with zork
+	conversation, err = suite.db.GetConversationByThreadAndAccountIDs(ctx, postingAccount.ID, newStatus.ID, []string{receivingAccount.ID})
+	suite.NoError(err)
+	suite.Equal(newStatus.ID, conversation.LastStatusID)
+	suite.True(*conversation.Read)
+
+	// zork's existing conversation with turtle is untouched
+	conversation, err = suite.db.GetConversationByID(ctx, suite.testConversations["local_account_1_conversation_1"].ID)
+	suite.NoError(err)
+	suite.Equal(suite.testStatuses["local_account_2_status_6"].ID, conversation.LastStatusID)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessFavePushed() {
+	ctx := context.Background()
+
+	// local_account_2 faves a status of local_account_1,
+	// who has a push subscription for faves
+	favingAccount := suite.testAccounts["local_account_2"]
+	receivingAccount := suite.testAccounts["local_account_1"]
+	testStatus := suite.testStatuses["local_account_1_status_1"]
+	testToken := suite.testTokens["local_account_1"]
+
+	subscription := suite.newPushSubscription(receivingAccount, testToken.ID)
+	fave := suite.newFave(favingAccount, testStatus)
+
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       fave,
+		OriginAccount:  favingAccount,
+		TargetAccount:  receivingAccount,
+	})
+	suite.NoError(err)
+
+	// pushes are sent asynchronously, so wait for it
+	var payload *webpush.Payload
+	if !testrig.WaitFor(func() bool {
+		p, ok := suite.sentPushes.Load(subscription.ID)
+		if ok {
+			payload = p.(*webpush.Payload)
+		}
+		return ok
+	}) {
+		suite.FailNow("expected push for subscription")
+	}
+	suite.Equal(testToken.Access, payload.AccessToken)
+	suite.Equal("favourite", payload.NotificationType)
+	suite.NotEmpty(payload.NotificationID)
+	suite.Equal("happy little turtle :3 favourited your post", payload.Title)
+	suite.Equal("introduction post", payload.Body)
+	suite.Equal(receivingAccount.Language, payload.PreferredLocale)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessFavePushRevokedToken() {
+	ctx := context.Background()
+
+	// the token of this subscription doesn't exist
+	// anymore, so it should be removed, not pushed to
+	favingAccount := suite.testAccounts["local_account_2"]
+	receivingAccount := suite.testAccounts["local_account_1"]
+	testStatus := suite.testStatuses["local_account_1_status_1"]
+
+	subscription := suite.newPushSubscription(receivingAccount, "01H2FWA4D7TMPJ4CC7ZBH3EKNZ")
+	fave := suite.newFave(favingAccount, testStatus)
+
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       fave,
+		OriginAccount:  favingAccount,
+		TargetAccount:  receivingAccount,
+	})
+	suite.NoError(err)
+
+	// pushes are sent asynchronously, so wait for the removal
+	if !testrig.WaitFor(func() bool {
+		_, err := suite.db.GetWebPushSubscriptionByTokenID(ctx, subscription.TokenID)
+		return errors.Is(err, db.ErrNoEntries)
+	}) {
+		suite.FailNow("expected push subscription to be removed")
+	}
+
+	_, pushed := suite.sentPushes.Load(subscription.ID)
+	suite.False(pushed)
+}
+
+func (suite *FromClientAPITestSuite) newPushSubscription(account *gtsmodel.Account, tokenID string) *gtsmodel.WebPushSubscription {
+	subscription := &gtsmodel.WebPushSubscription{
+		ID:              "01H2FW7Q7DR6H1JQX4WMJ5CPN2",
+		AccountID:       account.ID,
+		TokenID:         tokenID,
+		Endpoint:        "https://push.example.org/push/some-id",
+		Auth:            "BTBZMqHH6r4Tts7J_aSIgg",
+		P256dh:          "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		NotifyFavourite: testrig.TrueBool(),
+	}
+	if err := suite.db.PutWebPushSubscription(context.Background(), subscription); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return subscription
+}
+
+func (suite *FromClientAPITestSuite) newFave(account *gtsmodel.Account, status *gtsmodel.Status) *gtsmodel.StatusFave {
+	fave := &gtsmodel.StatusFave{
+		ID:              "01H2FWDQ2T5CG3DP6VQ0CJYCJN",
+		AccountID:       account.ID,
+		Account:         account,
+		TargetAccountID: status.AccountID,
+		StatusID:        status.ID,
+		Status:          status,
+		URI:             account.URI + "/liked/01H2FWDQ2T5CG3DP6VQ0CJYCJN",
+	}
+	if err := suite.db.Put(context.Background(), fave); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return fave
+}
+
 func TestFromClientAPITestSuite(t *testing.T) {
 	suite.Run(t, &FromClientAPITestSuite{})
 }
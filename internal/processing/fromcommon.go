@@ -20,14 +20,18 @@ package processing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
 
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
 func (p *processor) notifyStatus(ctx context.Context, status *gtsmodel.Status) error {
@@ -75,6 +79,20 @@ func (p *processor) notifyStatus(ctx context.Context, status *gtsmodel.Status) e
 			return fmt.Errorf("notifyStatus: error checking existence of notification for mention with id %s : %s", m.ID, err)
 		}
 
+		// don't notify if the mentioned account has muted notifications from the status author
+		if muted, err := p.notificationsMuted(ctx, m.TargetAccountID, status.AccountID); err != nil {
+			return fmt.Errorf("notifyStatus: %s", err)
+		} else if muted {
+			continue
+		}
+
+		// don't notify if the mentioned account has muted the thread this status is part of
+		if muted, err := p.db.IsStatusMutedBy(ctx, status, m.TargetAccountID); err != nil {
+			return fmt.Errorf("notifyStatus: error checking if thread of status %s is muted: %s", status.ID, err)
+		} else if muted {
+			continue
+		}
+
 		// if we've reached this point we know the mention is for a local account, and the notification doesn't exist, so create it
 		notif := &gtsmodel.Notification{
 			ID:               id.NewULID(),
@@ -97,9 +115,20 @@ func (p *processor) notifyStatus(ctx context.Context, status *gtsmodel.Status) e
 			return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 		}
 
+		apiNotif, err = p.filterNotificationForAccount(ctx, apiNotif, m.TargetAccountID)
+		if err != nil {
+			if errors.Is(err, typeutils.ErrHideStatus) {
+				// Filtered; don't stream it.
+				continue
+			}
+			return fmt.Errorf("notifyStatus: error applying filters to notification: %s", err)
+		}
+
 		if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, m.TargetAccount); err != nil {
 			return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 		}
+
+		p.pushProcessor.Notify(ctx, apiNotif, m.TargetAccount)
 	}
 
 	return nil
@@ -122,6 +151,12 @@ func (p *processor) notifyFollowRequest(ctx context.Context, followRequest *gtsm
 		return nil
 	}
 
+	if muted, err := p.notificationsMuted(ctx, followRequest.TargetAccountID, followRequest.AccountID); err != nil {
+		return fmt.Errorf("notifyFollowRequest: %s", err)
+	} else if muted {
+		return nil
+	}
+
 	notif := &gtsmodel.Notification{
 		ID:               id.NewULID(),
 		NotificationType: gtsmodel.NotificationFollowRequest,
@@ -143,6 +178,8 @@ func (p *processor) notifyFollowRequest(ctx context.Context, followRequest *gtsm
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
+	p.pushProcessor.Notify(ctx, apiNotif, targetAccount)
+
 	return nil
 }
 
@@ -161,6 +198,12 @@ func (p *processor) notifyFollow(ctx context.Context, follow *gtsmodel.Follow, t
 		return fmt.Errorf("notifyFollow: error removing old follow request notification from database: %s", err)
 	}
 
+	if muted, err := p.notificationsMuted(ctx, follow.TargetAccountID, follow.AccountID); err != nil {
+		return fmt.Errorf("notifyFollow: %s", err)
+	} else if muted {
+		return nil
+	}
+
 	// now create the new follow notification
 	notif := &gtsmodel.Notification{
 		ID:               id.NewULID(),
@@ -184,6 +227,8 @@ func (p *processor) notifyFollow(ctx context.Context, follow *gtsmodel.Follow, t
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
+	p.pushProcessor.Notify(ctx, apiNotif, targetAccount)
+
 	return nil
 }
 
@@ -207,6 +252,27 @@ func (p *processor) notifyFave(ctx context.Context, fave *gtsmodel.StatusFave) e
 		return nil
 	}
 
+	if muted, err := p.notificationsMuted(ctx, fave.TargetAccountID, fave.AccountID); err != nil {
+		return fmt.Errorf("notifyFave: %s", err)
+	} else if muted {
+		return nil
+	}
+
+	if fave.Status == nil {
+		s, err := p.db.GetStatusByID(ctx, fave.StatusID)
+		if err != nil {
+			return fmt.Errorf("notifyFave: error getting status with id %s: %s", fave.StatusID, err)
+		}
+		fave.Status = s
+	}
+
+	// don't notify if the faved account has muted the thread the faved status is part of
+	if muted, err := p.db.IsStatusMutedBy(ctx, fave.Status, fave.TargetAccountID); err != nil {
+		return fmt.Errorf("notifyFave: error checking if thread of status %s is muted: %s", fave.StatusID, err)
+	} else if muted {
+		return nil
+	}
+
 	notif := &gtsmodel.Notification{
 		ID:               id.NewULID(),
 		NotificationType: gtsmodel.NotificationFave,
@@ -228,10 +294,166 @@ func (p *processor) notifyFave(ctx context.Context, fave *gtsmodel.StatusFave) e
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
+	apiNotif, err = p.filterNotificationForAccount(ctx, apiNotif, targetAccount.ID)
+	if err != nil {
+		if errors.Is(err, typeutils.ErrHideStatus) {
+			// Filtered; don't stream it.
+			return nil
+		}
+		return fmt.Errorf("notifyFave: error applying filters to notification: %s", err)
+	}
+
 	if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, targetAccount); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
+	p.pushProcessor.Notify(ctx, apiNotif, targetAccount)
+
+	return nil
+}
+
+// notifyPollClosed notifies all local accounts that voted in the poll
+// attached to the given status, as well as its author if they're
+// local, that the poll has ended and its results are available.
+func (p *processor) notifyPollClosed(ctx context.Context, status *gtsmodel.Status) error {
+	if status.Poll == nil {
+		poll, err := p.db.GetPollByID(ctx, status.PollID)
+		if err != nil {
+			return fmt.Errorf("notifyPollClosed: error getting poll: %s", err)
+		}
+		status.Poll = poll
+	}
+
+	if status.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("notifyPollClosed: error getting status author: %s", err)
+		}
+		status.Account = a
+	}
+
+	votes, err := p.db.GetPollVotes(ctx, status.PollID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("notifyPollClosed: error getting poll votes: %s", err)
+	}
+
+	targetAccounts := make([]*gtsmodel.Account, 0, len(votes)+1)
+	targetAccounts = append(targetAccounts, status.Account)
+	for _, vote := range votes {
+		if vote.Account == nil {
+			a, err := p.db.GetAccountByID(ctx, vote.AccountID)
+			if err != nil {
+				log.Errorf("notifyPollClosed: error getting voter account %s: %s", vote.AccountID, err)
+				continue
+			}
+			vote.Account = a
+		}
+		targetAccounts = append(targetAccounts, vote.Account)
+	}
+
+	for _, targetAccount := range targetAccounts {
+		// only notify local accounts
+		if targetAccount.Domain != "" {
+			continue
+		}
+
+		if targetAccount.ID != status.AccountID {
+			if muted, err := p.notificationsMuted(ctx, targetAccount.ID, status.AccountID); err != nil {
+				return fmt.Errorf("notifyPollClosed: %s", err)
+			} else if muted {
+				continue
+			}
+		}
+
+		notif := &gtsmodel.Notification{
+			ID:               id.NewULID(),
+			NotificationType: gtsmodel.NotificationPoll,
+			TargetAccountID:  targetAccount.ID,
+			TargetAccount:    targetAccount,
+			OriginAccountID:  status.AccountID,
+			OriginAccount:    status.Account,
+			StatusID:         status.ID,
+			Status:           status,
+		}
+
+		if err := p.db.Put(ctx, notif); err != nil {
+			return fmt.Errorf("notifyPollClosed: error putting notification in database: %s", err)
+		}
+
+		// now stream the notification to the user
+		apiNotif, err := p.tc.NotificationToAPINotification(ctx, notif)
+		if err != nil {
+			return fmt.Errorf("notifyPollClosed: error converting notification to api representation: %s", err)
+		}
+
+		apiNotif, err = p.filterNotificationForAccount(ctx, apiNotif, targetAccount.ID)
+		if err != nil {
+			if errors.Is(err, typeutils.ErrHideStatus) {
+				// Filtered; don't stream it.
+				continue
+			}
+			return fmt.Errorf("notifyPollClosed: error applying filters to notification: %s", err)
+		}
+
+		if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, targetAccount); err != nil {
+			return fmt.Errorf("notifyPollClosed: error streaming notification to account: %s", err)
+		}
+
+		p.pushProcessor.Notify(ctx, apiNotif, targetAccount)
+	}
+
+	return nil
+}
+
+// notifySignup notifies all admins of this instance
+// that the given local account has just signed up.
+func (p *processor) notifySignup(ctx context.Context, account *gtsmodel.Account) error {
+	admins, err := p.db.GetAdminUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("notifySignup: error getting admin users: %s", err)
+	}
+
+	for _, admin := range admins {
+		// no point notifying an admin about themself
+		if admin.AccountID == account.ID {
+			continue
+		}
+
+		targetAccount := admin.Account
+		if targetAccount == nil {
+			targetAccount, err = p.db.GetAccountByID(ctx, admin.AccountID)
+			if err != nil {
+				log.Errorf("notifySignup: error getting admin account %s: %s", admin.AccountID, err)
+				continue
+			}
+		}
+
+		notif := &gtsmodel.Notification{
+			ID:               id.NewULID(),
+			NotificationType: gtsmodel.NotificationSignup,
+			TargetAccountID:  targetAccount.ID,
+			TargetAccount:    targetAccount,
+			OriginAccountID:  account.ID,
+			OriginAccount:    account,
+		}
+
+		if err := p.db.Put(ctx, notif); err != nil {
+			return fmt.Errorf("notifySignup: error putting notification in database: %s", err)
+		}
+
+		// now stream the notification to the admin
+		apiNotif, err := p.tc.NotificationToAPINotification(ctx, notif)
+		if err != nil {
+			return fmt.Errorf("notifySignup: error converting notification to api representation: %s", err)
+		}
+
+		if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, targetAccount); err != nil {
+			return fmt.Errorf("notifySignup: error streaming notification to account: %s", err)
+		}
+
+		p.pushProcessor.Notify(ctx, apiNotif, targetAccount)
+	}
+
 	return nil
 }
 
@@ -280,6 +502,19 @@ func (p *processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status)
 		return nil
 	}
 
+	if muted, err := p.notificationsMuted(ctx, status.BoostOfAccountID, status.AccountID); err != nil {
+		return fmt.Errorf("notifyAnnounce: %s", err)
+	} else if muted {
+		return nil
+	}
+
+	// don't notify if the boosted account has muted the thread the boosted status is part of
+	if muted, err := p.db.IsStatusMutedBy(ctx, status.BoostOf, status.BoostOfAccountID); err != nil {
+		return fmt.Errorf("notifyAnnounce: error checking if thread of status %s is muted: %s", status.BoostOfID, err)
+	} else if muted {
+		return nil
+	}
+
 	// now create the new reblog notification
 	notif := &gtsmodel.Notification{
 		ID:               id.NewULID(),
@@ -302,10 +537,21 @@ func (p *processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 	}
 
+	apiNotif, err = p.filterNotificationForAccount(ctx, apiNotif, status.BoostOfAccountID)
+	if err != nil {
+		if errors.Is(err, typeutils.ErrHideStatus) {
+			// Filtered; don't stream it.
+			return nil
+		}
+		return fmt.Errorf("notifyAnnounce: error applying filters to notification: %s", err)
+	}
+
 	if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, status.BoostOfAccount); err != nil {
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
+	p.pushProcessor.Notify(ctx, apiNotif, status.BoostOfAccount)
+
 	return nil
 }
 
@@ -335,14 +581,47 @@ func (p *processor) timelineStatus(ctx context.Context, status *gtsmodel.Status)
 		})
 	}
 
+	// get the IDs of any lists that the poster has been added to by local followers
+	listIDs := []string{}
+	for _, f := range follows {
+		if f.ID == "" {
+			// fake entry for the poster
+			continue
+		}
+
+		listEntries, err := p.db.GetListEntriesForFollowID(ctx, f.ID)
+		if err != nil && err != db.ErrNoEntries {
+			return fmt.Errorf("timelineStatus: error getting list entries for follow id %s: %s", f.ID, err)
+		}
+
+		for _, listEntry := range listEntries {
+			listIDs = append(listIDs, listEntry.ListID)
+		}
+	}
+
+	// get any local accounts following tags used in the status,
+	// who don't already get the status by following the poster
+	tagFollowerIDs, err := p.tagFollowerIDs(ctx, status, follows)
+	if err != nil {
+		return fmt.Errorf("timelineStatus: error getting tag followers for status id %s: %s", status.ID, err)
+	}
+
 	wg := sync.WaitGroup{}
-	wg.Add(len(follows))
-	errors := make(chan error, len(follows))
+	wg.Add(len(follows) + len(tagFollowerIDs) + len(listIDs))
+	errors := make(chan error, len(follows)+len(tagFollowerIDs)+len(listIDs))
 
 	for _, f := range follows {
 		go p.timelineStatusForAccount(ctx, status, f.AccountID, errors, &wg)
 	}
 
+	for _, accountID := range tagFollowerIDs {
+		go p.timelineStatusForAccount(ctx, status, accountID, errors, &wg)
+	}
+
+	for _, listID := range listIDs {
+		go p.timelineStatusForList(ctx, status, listID, errors, &wg)
+	}
+
 	// read any errors that come in from the async functions
 	errs := []string{}
 	go func(errs []string) {
@@ -365,6 +644,37 @@ func (p *processor) timelineStatus(ctx context.Context, status *gtsmodel.Status)
 	return nil
 }
 
+// tagFollowerIDs returns the IDs of accounts following any hashtag
+// used in the given status, excluding accounts in the given follows
+// (who will get the status in their home timeline anyway). Only
+// public statuses are timelined for tag followers, so for other
+// statuses the returned slice will be empty.
+func (p *processor) tagFollowerIDs(ctx context.Context, status *gtsmodel.Status, follows []*gtsmodel.Follow) ([]string, error) {
+	if status.Visibility != gtsmodel.VisibilityPublic || len(status.TagIDs) == 0 {
+		return nil, nil
+	}
+
+	followerIDs, err := p.db.GetTagFollowerIDs(ctx, status.TagIDs)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	alreadyTimelined := make(map[string]struct{}, len(follows))
+	for _, f := range follows {
+		alreadyTimelined[f.AccountID] = struct{}{}
+	}
+
+	accountIDs := make([]string, 0, len(followerIDs))
+	for _, accountID := range followerIDs {
+		if _, ok := alreadyTimelined[accountID]; ok {
+			continue
+		}
+		accountIDs = append(accountIDs, accountID)
+	}
+
+	return accountIDs, nil
+}
+
 // timelineStatusForAccount puts the given status in the HOME timeline
 // of the account with given accountID, if it's hometimelineable.
 //
@@ -406,12 +716,203 @@ func (p *processor) timelineStatusForAccount(ctx context.Context, status *gtsmod
 			return
 		}
 
+		apiStatus, visible, err := p.filterStatusForAccount(ctx, apiStatus, timelineAccount.ID, gtsmodel.FilterContextHome)
+		if err != nil {
+			errors <- fmt.Errorf("timelineStatusForAccount: error filtering status %s: %s", status.ID, err)
+			return
+		}
+
+		if !visible {
+			// Hidden by a filter; don't stream it.
+			return
+		}
+
 		if err := p.streamingProcessor.StreamUpdateToAccount(apiStatus, timelineAccount, stream.TimelineHome); err != nil {
 			errors <- fmt.Errorf("timelineStatusForAccount: error streaming status %s: %s", status.ID, err)
 		}
 	}
 }
 
+// timelineStatusForList puts the given status in the list timeline
+// with the given listID, if it's timelineable for that list.
+//
+// If the status was inserted into the list timeline, it will also
+// be streamed via websockets to the list owner.
+func (p *processor) timelineStatusForList(ctx context.Context, status *gtsmodel.Status, listID string, errors chan error, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	// get the list (the owner account is pinned onto it)
+	list, err := p.db.GetListByID(ctx, listID)
+	if err != nil {
+		errors <- fmt.Errorf("timelineStatusForList: error getting list with id %s: %s", listID, err)
+		return
+	}
+
+	// make sure the status is timelineable for this list
+	timelineable, err := listTimelineable(ctx, p.db, p.filter, list, status)
+	if err != nil {
+		errors <- fmt.Errorf("timelineStatusForList: error getting timelineability for status for list with id %s: %s", listID, err)
+		return
+	}
+
+	if !timelineable {
+		return
+	}
+
+	// stick the status in the list timeline and then immediately prepare it so the owner can see it right away
+	inserted, err := p.listTimelines.IngestAndPrepare(ctx, status, listID)
+	if err != nil {
+		errors <- fmt.Errorf("timelineStatusForList: error ingesting status %s: %s", status.ID, err)
+		return
+	}
+
+	// the status was inserted so stream it to the list owner
+	if inserted {
+		apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, list.Account)
+		if err != nil {
+			errors <- fmt.Errorf("timelineStatusForList: error converting status %s to frontend representation: %s", status.ID, err)
+			return
+		}
+
+		apiStatus, visible, err := p.filterStatusForAccount(ctx, apiStatus, list.AccountID, gtsmodel.FilterContextHome)
+		if err != nil {
+			errors <- fmt.Errorf("timelineStatusForList: error filtering status %s: %s", status.ID, err)
+			return
+		}
+
+		if !visible {
+			// Hidden by a filter; don't stream it.
+			return
+		}
+
+		if err := p.streamingProcessor.StreamUpdateToAccount(apiStatus, list.Account, stream.TimelineList+":"+listID); err != nil {
+			errors <- fmt.Errorf("timelineStatusForList: error streaming status %s: %s", status.ID, err)
+		}
+	}
+}
+
+// timelineStatusUpdate refreshes the given edited status wherever it's
+// already been prepared in HOME and list timelines, and streams the edit
+// to local followers of the status author (and to the author themself),
+// so that their clients can update the status in place.
+func (p *processor) timelineStatusUpdate(ctx context.Context, status *gtsmodel.Status) error {
+	if err := p.statusTimelines.ReprepareItemInAllTimelines(ctx, status.ID); err != nil {
+		return fmt.Errorf("timelineStatusUpdate: error repreparing status %s in home timelines: %s", status.ID, err)
+	}
+
+	if err := p.listTimelines.ReprepareItemInAllTimelines(ctx, status.ID); err != nil {
+		return fmt.Errorf("timelineStatusUpdate: error repreparing status %s in list timelines: %s", status.ID, err)
+	}
+
+	// make sure the author account is pinned onto the status
+	if status.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("timelineStatusUpdate: error getting author account with id %s: %s", status.AccountID, err)
+		}
+		status.Account = a
+	}
+
+	// get local followers of the account that posted the status
+	follows, err := p.db.GetAccountFollowedBy(ctx, status.AccountID, true)
+	if err != nil {
+		return fmt.Errorf("timelineStatusUpdate: error getting followers for account id %s: %s", status.AccountID, err)
+	}
+
+	// if the poster is local, add a fake entry for them to the followers list so they see their own edit
+	if status.Account.Domain == "" {
+		follows = append(follows, &gtsmodel.Follow{
+			AccountID: status.AccountID,
+			Account:   status.Account,
+		})
+	}
+
+	errs := []string{}
+	for _, f := range follows {
+		if err := p.streamStatusUpdateForFollow(ctx, status, f); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("timelineStatusUpdate: one or more errors streaming status update: %s", strings.Join(errs, ";"))
+	}
+
+	return nil
+}
+
+// streamStatusUpdateForFollow streams the given edited status to the HOME
+// timeline of the following account of the given follow, and to any of their
+// lists that the followed account has been added to, where appropriate.
+func (p *processor) streamStatusUpdateForFollow(ctx context.Context, status *gtsmodel.Status, follow *gtsmodel.Follow) error {
+	// get the timeline owner account
+	timelineAccount, err := p.db.GetAccountByID(ctx, follow.AccountID)
+	if err != nil {
+		return fmt.Errorf("error getting account for timeline with id %s: %s", follow.AccountID, err)
+	}
+
+	// make sure the status is timelineable
+	timelineable, err := p.filter.StatusHometimelineable(ctx, status, timelineAccount)
+	if err != nil {
+		return fmt.Errorf("error getting timelineability for status for timeline with id %s: %s", timelineAccount.ID, err)
+	}
+
+	if !timelineable {
+		return nil
+	}
+
+	apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, timelineAccount)
+	if err != nil {
+		return fmt.Errorf("error converting status %s to frontend representation: %s", status.ID, err)
+	}
+
+	apiStatus, visible, err := p.filterStatusForAccount(ctx, apiStatus, timelineAccount.ID, gtsmodel.FilterContextHome)
+	if err != nil {
+		return fmt.Errorf("error filtering status %s: %s", status.ID, err)
+	}
+
+	if !visible {
+		// Hidden by a filter; don't stream it.
+		return nil
+	}
+
+	if err := p.streamingProcessor.StreamStatusUpdateToAccount(apiStatus, timelineAccount, stream.TimelineHome); err != nil {
+		return fmt.Errorf("error streaming status %s: %s", status.ID, err)
+	}
+
+	if follow.ID == "" {
+		// fake entry for the poster
+		return nil
+	}
+
+	listEntries, err := p.db.GetListEntriesForFollowID(ctx, follow.ID)
+	if err != nil && err != db.ErrNoEntries {
+		return fmt.Errorf("error getting list entries for follow id %s: %s", follow.ID, err)
+	}
+
+	for _, listEntry := range listEntries {
+		list, err := p.db.GetListByID(ctx, listEntry.ListID)
+		if err != nil {
+			return fmt.Errorf("error getting list with id %s: %s", listEntry.ListID, err)
+		}
+
+		timelineable, err := listTimelineable(ctx, p.db, p.filter, list, status)
+		if err != nil {
+			return fmt.Errorf("error getting timelineability for status for list with id %s: %s", list.ID, err)
+		}
+
+		if !timelineable {
+			continue
+		}
+
+		if err := p.streamingProcessor.StreamStatusUpdateToAccount(apiStatus, timelineAccount, stream.TimelineList+":"+list.ID); err != nil {
+			return fmt.Errorf("error streaming status %s to list %s: %s", status.ID, list.ID, err)
+		}
+	}
+
+	return nil
+}
+
 // deleteStatusFromTimelines completely removes the given status from all timelines.
 // It will also stream deletion of the status to all open streams.
 func (p *processor) deleteStatusFromTimelines(ctx context.Context, status *gtsmodel.Status) error {
@@ -419,6 +920,10 @@ func (p *processor) deleteStatusFromTimelines(ctx context.Context, status *gtsmo
 		return err
 	}
 
+	if err := p.listTimelines.WipeItemFromAllTimelines(ctx, status.ID); err != nil {
+		return err
+	}
+
 	return p.streamingProcessor.StreamDelete(status.ID)
 }
 
@@ -451,6 +956,18 @@ func (p *processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Sta
 		}
 	}
 
+	// delete the poll attached to this status, and all votes cast in it
+	if statusToDelete.PollID != "" {
+		if err := p.db.DeletePollByID(ctx, statusToDelete.PollID); err != nil {
+			return err
+		}
+	}
+
+	// delete the edit history of this status
+	if err := p.db.DeleteStatusEdits(ctx, statusToDelete.ID); err != nil {
+		return err
+	}
+
 	// delete all notification entries generated by this status
 	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "status_id", Value: statusToDelete.ID}}, &[]*gtsmodel.Notification{}); err != nil {
 		return err
@@ -473,6 +990,11 @@ func (p *processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Sta
 		return err
 	}
 
+	// remove this status from any direct message conversations
+	if err := p.db.RemoveStatusFromConversations(ctx, statusToDelete.ID); err != nil {
+		return err
+	}
+
 	// delete the status itself
 	if err := p.db.DeleteStatusByID(ctx, statusToDelete.ID); err != nil {
 		return err
@@ -480,3 +1002,49 @@ func (p *processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Sta
 
 	return nil
 }
+
+// moveFollowers migrates the local followers of originAccount over to targetAccount,
+// by following targetAccount on their behalf and then unfollowing originAccount.
+// Followers who can't follow the target (because of a block, for example) are left as-is.
+func (p *processor) moveFollowers(ctx context.Context, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
+	follows, err := p.db.GetAccountFollowedBy(ctx, originAccount.ID, true)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("moveFollowers: error getting followers of account %s: %w", originAccount.ID, err)
+	}
+
+	errs := []string{}
+	for _, follow := range follows {
+		if follow.Account == nil {
+			a, err := p.db.GetAccountByID(ctx, follow.AccountID)
+			if err != nil {
+				errs = append(errs, err.Error())
+				continue
+			}
+			follow.Account = a
+		}
+
+		if follow.AccountID == targetAccount.ID {
+			// the target can't follow itself
+			continue
+		}
+
+		if _, errWithCode := p.accountProcessor.FollowCreate(ctx, follow.Account, &apimodel.AccountFollowRequest{
+			ID:      targetAccount.ID,
+			Reblogs: follow.ShowReblogs,
+			Notify:  follow.Notify,
+		}); errWithCode != nil {
+			errs = append(errs, errWithCode.Error())
+			continue
+		}
+
+		if _, errWithCode := p.accountProcessor.FollowRemove(ctx, follow.Account, originAccount.ID); errWithCode != nil {
+			errs = append(errs, errWithCode.Error())
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("moveFollowers: one or more errors while moving followers: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
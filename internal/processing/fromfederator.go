@@ -23,10 +23,12 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -87,9 +89,19 @@ func (p *processor) ProcessFromFederator(ctx context.Context, federatorMsg messa
 		}
 	case ap.ActivityUpdate:
 		// UPDATE SOMETHING
-		if federatorMsg.APObjectType == ap.ObjectProfile {
+		switch federatorMsg.APObjectType {
+		case ap.ObjectProfile:
 			// UPDATE AN ACCOUNT
 			return p.processUpdateAccountFromFederator(ctx, federatorMsg)
+		case ap.ObjectNote:
+			// UPDATE A STATUS
+			return p.processUpdateStatusFromFederator(ctx, federatorMsg)
+		}
+	case ap.ActivityMove:
+		// MOVE SOMETHING
+		if federatorMsg.APObjectType == ap.ObjectProfile {
+			// MOVE AN ACCOUNT
+			return p.processMoveAccountFromFederator(ctx, federatorMsg)
 		}
 	case ap.ActivityDelete:
 		// DELETE SOMETHING
@@ -173,6 +185,10 @@ func (p *processor) processCreateStatusFromFederator(ctx context.Context, federa
 		return err
 	}
 
+	if err := p.conversationsProcessor.UpdateForStatus(ctx, status); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -390,6 +406,136 @@ func (p *processor) processUpdateAccountFromFederator(ctx context.Context, feder
 	return nil
 }
 
+// processMoveAccountFromFederator handles Activity Move and Object Profile
+func (p *processor) processMoveAccountFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
+	account, ok := federatorMsg.GTSModel.(*gtsmodel.Account)
+	if !ok {
+		return errors.New("profile was not parseable as *gtsmodel.Account")
+	}
+
+	if federatorMsg.APIri == nil {
+		return errors.New("move had no target IRI")
+	}
+	targetURI := federatorMsg.APIri
+
+	// always get the latest version of the target account,
+	// since it must list the moving account as an alias
+	target, err := p.db.GetAccountByURI(ctx, targetURI.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("error getting move target %s: %s", targetURI, err)
+	}
+
+	if target != nil {
+		target, err = p.federator.UpdateAccount(ctx, federatorMsg.ReceivingAccount.Username, target, true)
+	} else {
+		target, err = p.federator.GetAccountByURI(ctx, federatorMsg.ReceivingAccount.Username, targetURI, true)
+	}
+	if err != nil {
+		return fmt.Errorf("error dereferencing move target %s: %s", targetURI, err)
+	}
+
+	if !target.HasAlias(account.URI) {
+		return fmt.Errorf("move target %s doesn't list %s as an alias", target.URI, account.URI)
+	}
+
+	if account.MovedToAccountID != target.ID {
+		account.MovedToAccountID = target.ID
+		if err := p.db.UpdateAccount(ctx, account); err != nil {
+			return fmt.Errorf("error updating moved account %s: %s", account.ID, err)
+		}
+	}
+
+	return p.moveFollowers(ctx, account, target)
+}
+
+// processUpdateStatusFromFederator handles Activity Update and Object Note
+func (p *processor) processUpdateStatusFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
+	editedStatus, ok := federatorMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("note was not parseable as *gtsmodel.Status")
+	}
+
+	status, err := p.db.GetStatusByID(ctx, editedStatus.ID)
+	if err != nil {
+		return fmt.Errorf("error getting status %s: %s", editedStatus.ID, err)
+	}
+
+	// snapshot the status as it was before this edit
+	edit := p.tc.StatusToStatusEdit(ctx, status)
+
+	// reuse attachments we already have, new ones will be dereferenced
+	attachments := make([]*gtsmodel.MediaAttachment, 0, len(editedStatus.Attachments))
+	for _, a := range editedStatus.Attachments {
+		for _, existing := range status.Attachments {
+			if existing.RemoteURL == a.RemoteURL {
+				a = existing
+				break
+			}
+		}
+		attachments = append(attachments, a)
+	}
+
+	// likewise for mentions
+	mentions := make([]*gtsmodel.Mention, 0, len(editedStatus.Mentions))
+	for _, m := range editedStatus.Mentions {
+		for _, existing := range status.Mentions {
+			if existing.TargetAccountURI == m.TargetAccountURI {
+				m = existing
+				break
+			}
+		}
+		mentions = append(mentions, m)
+	}
+	oldMentionIDs := status.MentionIDs
+
+	now := time.Now()
+	status.Content = editedStatus.Content
+	status.ContentWarning = editedStatus.ContentWarning
+	status.Sensitive = editedStatus.Sensitive
+	if editedStatus.Language != "" {
+		status.Language = editedStatus.Language
+	}
+	status.Attachments = attachments
+	status.Mentions = mentions
+	status.Tags = editedStatus.Tags
+	status.Emojis = editedStatus.Emojis
+	status.UpdatedAt = now
+	status.EditedAt = editedStatus.EditedAt
+	if status.EditedAt.IsZero() {
+		status.EditedAt = now
+	}
+
+	// further database updates occur inside enrichremotestatus
+	status, err = p.federator.EnrichRemoteStatus(ctx, federatorMsg.ReceivingAccount.Username, status, false)
+	if err != nil {
+		return fmt.Errorf("error enriching edited status from federator: %s", err)
+	}
+
+	if err := p.db.PutStatusEdit(ctx, edit); err != nil {
+		return fmt.Errorf("error putting status edit: %s", err)
+	}
+
+	// remove mentions that were dropped in this edit
+	keptMentionIDs := make(map[string]struct{}, len(status.MentionIDs))
+	for _, id := range status.MentionIDs {
+		keptMentionIDs[id] = struct{}{}
+	}
+	for _, id := range oldMentionIDs {
+		if _, kept := keptMentionIDs[id]; kept {
+			continue
+		}
+		if err := p.db.DeleteByID(ctx, id, &gtsmodel.Mention{}); err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("error deleting mention %s: %s", id, err)
+		}
+	}
+
+	if err := p.timelineStatusUpdate(ctx, status); err != nil {
+		return err
+	}
+
+	return p.notifyStatus(ctx, status)
+}
+
 // processDeleteStatusFromFederator handles Activity Delete and Object Note
 func (p *processor) processDeleteStatusFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
 	statusToDelete, ok := federatorMsg.GTSModel.(*gtsmodel.Status)
@@ -178,6 +178,76 @@ func (suite *FromFederatorTestSuite) TestProcessReplyMention() {
 	suite.Equal(replyingAccount.ID, notifStreamed.Account.ID)
 }
 
+func (suite *FromFederatorTestSuite) TestProcessCreateStatusWithHashtags() {
+	ctx := context.Background()
+	remoteAccount := suite.testAccounts["remote_account_1"]
+
+	status := &gtsmodel.Status{
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		URI:       "http://fossbros-anonymous.io/users/foss_satan/statuses/106221634728637553",
+		URL:       "http://fossbros-anonymous.io/@foss_satan/106221634728637553",
+		Content:   `<p>hello <a href="http://fossbros-anonymous.io/tags/Welcome" class="mention hashtag" rel="tag">#<span>Welcome</span></a> <a href="http://fossbros-anonymous.io/tags/fossbros" class="mention hashtag" rel="tag">#<span>fossbros</span></a></p>`,
+		Tags: []*gtsmodel.Tag{
+			{
+				URL:  "http://fossbros-anonymous.io/tags/Welcome",
+				Name: "Welcome",
+			},
+			{
+				URL:  "http://fossbros-anonymous.io/tags/fossbros",
+				Name: "fossbros",
+			},
+			{
+				URL:  "http://fossbros-anonymous.io/tags/not-a-tag",
+				Name: "not-a-tag",
+			},
+		},
+		AccountID:           remoteAccount.ID,
+		AccountURI:          remoteAccount.URI,
+		Visibility:          gtsmodel.VisibilityPublic,
+		ActivityStreamsType: ap.ObjectNote,
+		Federated:           testrig.TrueBool(),
+		Boostable:           testrig.TrueBool(),
+		Replyable:           testrig.TrueBool(),
+		Likeable:            testrig.TrueBool(),
+	}
+
+	statusID, err := id.NewULIDFromTime(status.CreatedAt)
+	suite.NoError(err)
+	status.ID = statusID
+
+	err = suite.db.PutStatus(ctx, status)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ObjectNote,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         status,
+		ReceivingAccount: suite.testAccounts["local_account_1"],
+	})
+	suite.NoError(err)
+
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+	suite.Len(dbStatus.TagIDs, 2)
+
+	// the existing tag should have been reused
+	suite.Equal(suite.testTags["welcome"].ID, dbStatus.TagIDs[0])
+
+	// the new tag should have been created, served from our instance
+	newTag, err := suite.db.GetTagByName(ctx, "fossbros")
+	suite.NoError(err)
+	suite.Equal(newTag.ID, dbStatus.TagIDs[1])
+	suite.Equal("http://localhost:8080/tags/fossbros", newTag.URL)
+	suite.Equal(remoteAccount.ID, newTag.FirstSeenFromAccountID)
+
+	// the status should be on the tag timeline
+	statuses, err := suite.db.GetTagTimeline(ctx, newTag.ID, "", "", "", 20, false)
+	suite.NoError(err)
+	suite.Len(statuses, 1)
+	suite.Equal(status.ID, statuses[0].ID)
+}
+
 func (suite *FromFederatorTestSuite) TestProcessFave() {
 	favedAccount := suite.testAccounts["local_account_1"]
 	favedStatus := suite.testStatuses["local_account_1_status_1"]
@@ -245,6 +315,118 @@ func (suite *FromFederatorTestSuite) TestProcessFave() {
 	suite.EqualValues([]string{stream.TimelineNotifications}, msg.Stream)
 }
 
+// TestProcessFaveFromMutedAccount ensures that no notification is created or
+// streamed for a fave from an account whose notifications have been muted.
+func (suite *FromFederatorTestSuite) TestProcessFaveFromMutedAccount() {
+	favedAccount := suite.testAccounts["local_account_1"]
+	favedStatus := suite.testStatuses["local_account_1_status_1"]
+	favingAccount := suite.testAccounts["remote_account_3"] // muted by local_account_1, including notifications
+
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(context.Background(), favedAccount, stream.TimelineNotifications)
+	suite.NoError(errWithCode)
+
+	fave := &gtsmodel.StatusFave{
+		ID:              "01H3E2G4W0YCX3T1KJ2D5B6N7M",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		AccountID:       favingAccount.ID,
+		Account:         favingAccount,
+		TargetAccountID: favedAccount.ID,
+		TargetAccount:   favedAccount,
+		StatusID:        favedStatus.ID,
+		Status:          favedStatus,
+		URI:             favingAccount.URI + "/faves/bbbbbbbbbbbb",
+	}
+
+	err := suite.db.Put(context.Background(), fave)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(context.Background(), messages.FromFederator{
+		APObjectType:     ap.ActivityLike,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         fave,
+		ReceivingAccount: favedAccount,
+	})
+	suite.NoError(err)
+
+	// no notification should exist for the fave
+	notif := &gtsmodel.Notification{}
+	err = suite.db.GetWhere(context.Background(), []db.Where{
+		{Key: "status_id", Value: favedStatus.ID},
+		{Key: "origin_account_id", Value: favingAccount.ID},
+	}, notif)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// and nothing should be streamed
+	select {
+	case msg := <-wssStream.Messages:
+		suite.FailNow("unexpected message from wssStream", msg.Event)
+	case <-time.After(1 * time.Second):
+		// fine
+	}
+}
+
+// TestProcessFaveInMutedThread ensures that no notification is created or
+// streamed for a fave of a status in a thread that the faved account has muted.
+func (suite *FromFederatorTestSuite) TestProcessFaveInMutedThread() {
+	favedAccount := suite.testAccounts["local_account_2"]
+	favedStatus := suite.testStatuses["local_account_2_status_5"] // reply to local_account_1_status_1
+	favingAccount := suite.testAccounts["remote_account_1"]
+	threadRoot := suite.testStatuses["local_account_1_status_1"]
+
+	// mute the thread from the root
+	err := suite.db.Put(context.Background(), &gtsmodel.StatusMute{
+		ID:              "01H3F7C2D8QZ1M5W9V0JX3KT6B",
+		AccountID:       favedAccount.ID,
+		TargetAccountID: threadRoot.AccountID,
+		StatusID:        threadRoot.ID,
+	})
+	suite.NoError(err)
+
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(context.Background(), favedAccount, stream.TimelineNotifications)
+	suite.NoError(errWithCode)
+
+	fave := &gtsmodel.StatusFave{
+		ID:              "01H3F7C9SXH2V4N8Q6ZB0W1E3R",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		AccountID:       favingAccount.ID,
+		Account:         favingAccount,
+		TargetAccountID: favedAccount.ID,
+		TargetAccount:   favedAccount,
+		StatusID:        favedStatus.ID,
+		Status:          favedStatus,
+		URI:             favingAccount.URI + "/faves/cccccccccccc",
+	}
+
+	err = suite.db.Put(context.Background(), fave)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(context.Background(), messages.FromFederator{
+		APObjectType:     ap.ActivityLike,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         fave,
+		ReceivingAccount: favedAccount,
+	})
+	suite.NoError(err)
+
+	// no notification should exist for the fave
+	notif := &gtsmodel.Notification{}
+	err = suite.db.GetWhere(context.Background(), []db.Where{
+		{Key: "status_id", Value: favedStatus.ID},
+		{Key: "origin_account_id", Value: favingAccount.ID},
+	}, notif)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// and nothing should be streamed
+	select {
+	case msg := <-wssStream.Messages:
+		suite.FailNow("unexpected message from wssStream", msg.Event)
+	case <-time.After(1 * time.Second):
+		// fine
+	}
+}
+
 // TestProcessFaveWithDifferentReceivingAccount ensures that when an account receives a fave that's for
 // another account in their AP inbox, a notification isn't streamed to the receiving account.
 //
@@ -392,6 +574,49 @@ func (suite *FromFederatorTestSuite) TestProcessAccountDelete() {
 	suite.Equal(dbAccount.ID, dbAccount.SuspensionOrigin)
 }
 
+func (suite *FromFederatorTestSuite) TestProcessUpdateStatus() {
+	ctx := context.Background()
+
+	receivingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["remote_account_1_status_1"]
+	editedAt := testrig.TimeMustParse("2022-11-05T12:00:00+01:00")
+
+	// the edit drops the attachment and changes the content
+	err := suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel: &gtsmodel.Status{
+			ID:             targetStatus.ID,
+			URI:            targetStatus.URI,
+			Content:        "dark souls status bot: \"thoughts of cat\"",
+			ContentWarning: "cat thoughts",
+			Sensitive:      testrig.TrueBool(),
+			EditedAt:       editedAt,
+		},
+		ReceivingAccount: receivingAccount,
+	})
+	suite.NoError(err)
+
+	// the status should be updated in place
+	dbStatus, err := suite.db.GetStatusByID(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Equal("dark souls status bot: \"thoughts of cat\"", dbStatus.Content)
+	suite.Equal("cat thoughts", dbStatus.ContentWarning)
+	suite.True(*dbStatus.Sensitive)
+	suite.Empty(dbStatus.AttachmentIDs)
+	suite.Empty(dbStatus.Attachments)
+	suite.WithinDuration(editedAt, dbStatus.EditedAt, time.Second)
+
+	// the previous version should be in the edit history
+	edits, err := suite.db.GetStatusEdits(ctx, targetStatus.ID)
+	suite.NoError(err)
+	if !suite.Len(edits, 1) {
+		suite.FailNow("")
+	}
+	suite.Equal(targetStatus.Content, edits[0].Content)
+	suite.Equal(targetStatus.AttachmentIDs, edits[0].AttachmentIDs)
+}
+
 func (suite *FromFederatorTestSuite) TestProcessFollowRequestLocked() {
 	ctx := context.Background()
 
@@ -0,0 +1,43 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) InviteCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.InviteCreateRequest) (*apimodel.Invite, gtserror.WithCode) {
+	return p.inviteProcessor.Create(ctx, authed.Account, authed.User, form)
+}
+
+func (p *processor) InviteGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Invite, gtserror.WithCode) {
+	return p.inviteProcessor.Get(ctx, authed.Account, id)
+}
+
+func (p *processor) InvitesGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.inviteProcessor.GetAll(ctx, authed.Account, maxID, sinceID, minID, limit)
+}
+
+func (p *processor) InviteRevoke(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Invite, gtserror.WithCode) {
+	return p.inviteProcessor.Revoke(ctx, authed.Account, authed.User, id)
+}
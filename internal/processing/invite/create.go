@@ -0,0 +1,77 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, form *apimodel.InviteCreateRequest) (*apimodel.Invite, gtserror.WithCode) {
+	if form.MaxUses < 0 {
+		err := fmt.Errorf("max_uses must be 0 or greater, got %d", form.MaxUses)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if form.ExpiresIn < 0 {
+		err := fmt.Errorf("expires_in must be 0 or greater, got %d", form.ExpiresIn)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if form.SkipApproval && !*user.Admin {
+		err := fmt.Errorf("user %s is not an admin", user.ID)
+		return nil, gtserror.NewErrorForbidden(err, "only admins can create invites that skip approval")
+	}
+
+	inviteID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	invite := &gtsmodel.Invite{
+		ID:           inviteID,
+		Code:         code,
+		AccountID:    account.ID,
+		Account:      account,
+		MaxUses:      form.MaxUses,
+		SkipApproval: &form.SkipApproval,
+	}
+
+	if form.ExpiresIn != 0 {
+		invite.ExpiresAt = time.Now().Add(time.Duration(form.ExpiresIn) * time.Second)
+	}
+
+	if err := p.db.PutInvite(ctx, invite); err != nil {
+		err = fmt.Errorf("Create: error putting invite: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiInvite(ctx, invite)
+}
@@ -0,0 +1,109 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type CreateTestSuite struct {
+	InviteStandardTestSuite
+}
+
+func (suite *CreateTestSuite) TestCreate() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+
+	apiInvite, errWithCode := suite.invite.Create(ctx, testAccount, testUser, &apimodel.InviteCreateRequest{
+		MaxUses:   3,
+		ExpiresIn: 86400,
+	})
+	suite.NoError(errWithCode)
+	suite.Len(apiInvite.Code, 8)
+	suite.Equal(3, *apiInvite.MaxUses)
+	suite.Equal(0, apiInvite.Uses)
+	suite.False(apiInvite.SkipApproval)
+	suite.False(apiInvite.Expired)
+
+	expiresAt, err := time.Parse(time.RFC3339, *apiInvite.ExpiresAt)
+	suite.NoError(err)
+	suite.WithinDuration(time.Now().Add(24*time.Hour), expiresAt, time.Minute)
+
+	dbInvite, err := suite.db.GetInviteByCode(ctx, apiInvite.Code)
+	suite.NoError(err)
+	suite.Equal(apiInvite.ID, dbInvite.ID)
+	suite.Equal(testAccount.ID, dbInvite.AccountID)
+}
+
+func (suite *CreateTestSuite) TestCreateUnlimited() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+
+	apiInvite, errWithCode := suite.invite.Create(context.Background(), testAccount, testUser, &apimodel.InviteCreateRequest{})
+	suite.NoError(errWithCode)
+	suite.Nil(apiInvite.MaxUses)
+	suite.Nil(apiInvite.ExpiresAt)
+	suite.False(apiInvite.Expired)
+}
+
+func (suite *CreateTestSuite) TestCreateSkipApprovalNotAdmin() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+
+	apiInvite, errWithCode := suite.invite.Create(context.Background(), testAccount, testUser, &apimodel.InviteCreateRequest{
+		SkipApproval: true,
+	})
+	suite.Nil(apiInvite)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+	suite.Equal("Forbidden: only admins can create invites that skip approval", errWithCode.Safe())
+}
+
+func (suite *CreateTestSuite) TestCreateSkipApprovalAdmin() {
+	testAccount := suite.testAccounts["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+
+	apiInvite, errWithCode := suite.invite.Create(context.Background(), testAccount, testUser, &apimodel.InviteCreateRequest{
+		MaxUses:      1,
+		SkipApproval: true,
+	})
+	suite.NoError(errWithCode)
+	suite.True(apiInvite.SkipApproval)
+}
+
+func (suite *CreateTestSuite) TestCreateNegativeMaxUses() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+
+	apiInvite, errWithCode := suite.invite.Create(context.Background(), testAccount, testUser, &apimodel.InviteCreateRequest{
+		MaxUses: -1,
+	})
+	suite.Nil(apiInvite)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateTestSuite))
+}
@@ -0,0 +1,86 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Invite, gtserror.WithCode) {
+	invite, errWithCode := p.getInvite(ctx, account, nil, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiInvite(ctx, invite)
+}
+
+func (p *processor) GetAll(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	invites, err := p.db.GetInvites(ctx, account.ID, maxID, sinceID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetAll: error getting invites: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(invites)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+
+	for i, invite := range invites {
+		// Set next + prev values before API converting,
+		// so caller can still page properly.
+		if i == count-1 {
+			nextMaxIDValue = invite.ID
+		}
+
+		if i == 0 {
+			prevMinIDValue = invite.ID
+		}
+
+		apiInvite, errWithCode := p.apiInvite(ctx, invite)
+		if errWithCode != nil {
+			log.Errorf("GetAll: error converting invite %s: %s", invite.ID, errWithCode)
+			continue
+		}
+
+		items = append(items, apiInvite)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/invites",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
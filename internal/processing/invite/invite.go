@@ -0,0 +1,54 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor interface {
+	// Create creates a new invite code owned by the given account, using the given form.
+	Create(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, form *apimodel.InviteCreateRequest) (*apimodel.Invite, gtserror.WithCode)
+	// Get returns one invite of the given account, with the given id.
+	Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Invite, gtserror.WithCode)
+	// GetAll returns a pageable response of invites created by the given account.
+	GetAll(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// Revoke expires the invite with the given id, so it can't be used to sign up anymore.
+	// Admins may revoke invites created by other accounts.
+	Revoke(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, id string) (*apimodel.Invite, gtserror.WithCode)
+}
+
+type processor struct {
+	db db.DB
+	tc typeutils.TypeConverter
+}
+
+// New returns a new invite processor.
+func New(db db.DB, tc typeutils.TypeConverter) Processor {
+	return &processor{
+		db: db,
+		tc: tc,
+	}
+}
@@ -0,0 +1,63 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite_test
+
+import (
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/invite"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type InviteStandardTestSuite struct {
+	suite.Suite
+	db            db.DB
+	typeConverter typeutils.TypeConverter
+
+	// standard suite models
+	testAccounts map[string]*gtsmodel.Account
+	testUsers    map[string]*gtsmodel.User
+	testInvites  map[string]*gtsmodel.Invite
+
+	// module being tested
+	invite invite.Processor
+}
+
+func (suite *InviteStandardTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testUsers = testrig.NewTestUsers()
+	suite.testInvites = testrig.NewTestInvites()
+}
+
+func (suite *InviteStandardTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB()
+	suite.typeConverter = testrig.NewTestTypeConverter(suite.db)
+	suite.invite = invite.New(suite.db, suite.typeConverter)
+
+	testrig.StandardDBSetup(suite.db, suite.testAccounts)
+}
+
+func (suite *InviteStandardTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
@@ -0,0 +1,49 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Revoke(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, id string) (*apimodel.Invite, gtserror.WithCode) {
+	invite, errWithCode := p.getInvite(ctx, account, user, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	now := time.Now()
+	if invite.ExpiresAt.IsZero() || invite.ExpiresAt.After(now) {
+		// Invite hasn't expired yet,
+		// so expire it right now.
+		invite.ExpiresAt = now
+		if err := p.db.UpdateInvite(ctx, invite, "expires_at"); err != nil {
+			err = fmt.Errorf("Revoke: error updating invite: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.apiInvite(ctx, invite)
+}
@@ -0,0 +1,86 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RevokeTestSuite struct {
+	InviteStandardTestSuite
+}
+
+func (suite *RevokeTestSuite) TestRevoke() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+	testUser := suite.testUsers["local_account_1"]
+	testInvite := suite.testInvites["local_account_1_invite"]
+
+	apiInvite, errWithCode := suite.invite.Revoke(ctx, testAccount, testUser, testInvite.ID)
+	suite.NoError(errWithCode)
+	suite.True(apiInvite.Expired)
+
+	dbInvite, err := suite.db.GetInviteByID(ctx, testInvite.ID)
+	suite.NoError(err)
+	suite.True(dbInvite.ExpiresAt.Before(testInvite.ExpiresAt))
+}
+
+func (suite *RevokeTestSuite) TestRevokeOtherAccountNotAdmin() {
+	testAccount := suite.testAccounts["local_account_1"]
+	testUser := suite.testUsers["local_account_1"]
+	testInvite := suite.testInvites["admin_account_invite"]
+
+	apiInvite, errWithCode := suite.invite.Revoke(context.Background(), testAccount, testUser, testInvite.ID)
+	suite.Nil(apiInvite)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *RevokeTestSuite) TestRevokeOtherAccountAdmin() {
+	testAccount := suite.testAccounts["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+	testInvite := suite.testInvites["local_account_1_invite"]
+
+	apiInvite, errWithCode := suite.invite.Revoke(context.Background(), testAccount, testUser, testInvite.ID)
+	suite.NoError(errWithCode)
+	suite.True(apiInvite.Expired)
+}
+
+func (suite *RevokeTestSuite) TestRevokeAlreadyExpired() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["admin_account"]
+	testUser := suite.testUsers["admin_account"]
+	testInvite := suite.testInvites["admin_account_invite_expired"]
+
+	apiInvite, errWithCode := suite.invite.Revoke(ctx, testAccount, testUser, testInvite.ID)
+	suite.NoError(errWithCode)
+	suite.True(apiInvite.Expired)
+
+	// expiry time should be left alone
+	dbInvite, err := suite.db.GetInviteByID(ctx, testInvite.ID)
+	suite.NoError(err)
+	suite.True(dbInvite.ExpiresAt.Equal(testInvite.ExpiresAt))
+}
+
+func TestRevokeTestSuite(t *testing.T) {
+	suite.Run(t, new(RevokeTestSuite))
+}
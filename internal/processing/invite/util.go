@@ -0,0 +1,88 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+const (
+	inviteCodeLength   = 8
+	inviteCodeAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// newInviteCode returns a new random invite
+// code, which is short enough to type by hand.
+func newInviteCode() (string, error) {
+	code := make([]byte, inviteCodeLength)
+	max := big.NewInt(int64(len(inviteCodeAlphabet)))
+
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("newInviteCode: error reading random number: %w", err)
+		}
+		code[i] = inviteCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
+
+// getInvite is a shortcut to get one invite from the database
+// and check that it's owned by the given account. If user is
+// set and is an admin, invites of other accounts are returned
+// too. Will return appropriate errors so caller doesn't need
+// to bother.
+func (p *processor) getInvite(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, id string) (*gtsmodel.Invite, gtserror.WithCode) {
+	invite, err := p.db.GetInviteByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite.AccountID != account.ID && (user == nil || !*user.Admin) {
+		err = fmt.Errorf("invite with id %s does not belong to account %s", invite.ID, account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return invite, nil
+}
+
+// apiInvite is a shortcut to return the API version of the given
+// invite, or return an appropriate error if conversion fails.
+func (p *processor) apiInvite(ctx context.Context, invite *gtsmodel.Invite) (*apimodel.Invite, gtserror.WithCode) {
+	apiInvite, err := p.tc.InviteToAPIInvite(ctx, invite)
+	if err != nil {
+		err = fmt.Errorf("error converting invite %s to frontend representation: %w", invite.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiInvite, nil
+}
@@ -0,0 +1,161 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.List, gtserror.WithCode) {
+	list, errWithCode := p.getList(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiList(ctx, list)
+}
+
+func (p *processor) GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.List, gtserror.WithCode) {
+	lists, err := p.db.GetListsForAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiLists := make([]*apimodel.List, 0, len(lists))
+	for _, list := range lists {
+		apiList, errWithCode := p.apiList(ctx, list)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiLists = append(apiLists, apiList)
+	}
+
+	return apiLists, nil
+}
+
+func (p *processor) GetListAccounts(ctx context.Context, account *gtsmodel.Account, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	// Ensure list exists + is owned by requesting account.
+	if _, errWithCode := p.getList(ctx, account.ID, listID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// To know which accounts are in the list,
+	// we need to first get requested list entries.
+	listEntries, err := p.db.GetListEntries(ctx, listID, maxID, sinceID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetListAccounts: error getting list entries: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(listEntries)
+	if count == 0 {
+		// No list entries means no accounts.
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+
+	// For each list entry, we want the account it points to.
+	// To get this, we need to first get the follow that the
+	// list entry pertains to, then extract the target account
+	// from that follow.
+	for i, listEntry := range listEntries {
+		// Set next + prev values before filtering and API
+		// converting, so caller can still page properly.
+		if i == count-1 {
+			nextMaxIDValue = listEntry.ID
+		}
+
+		if i == 0 {
+			prevMinIDValue = listEntry.ID
+		}
+
+		if listEntry.Follow == nil || listEntry.Follow.TargetAccount == nil {
+			log.Debugf("GetListAccounts: skipping list entry %s with no follow or target account", listEntry.ID)
+			continue
+		}
+
+		apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, listEntry.Follow.TargetAccount)
+		if err != nil {
+			log.Errorf("GetListAccounts: error converting to public api account: %s", err)
+			continue
+		}
+
+		items = append(items, apiAccount)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/lists/" + listID + "/accounts",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
+func (p *processor) GetListsContainingAccount(ctx context.Context, account *gtsmodel.Account, targetAccountID string) ([]*apimodel.List, gtserror.WithCode) {
+	// Lists can only contain accounts that the requesting
+	// account follows, so get the follow first (if any).
+	follow := &gtsmodel.Follow{}
+	if err := p.db.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: account.ID},
+		{Key: "target_account_id", Value: targetAccountID},
+	}, follow); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Not following, so can't be in any lists.
+			return []*apimodel.List{}, nil
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	listEntries, err := p.db.GetListEntriesForFollowID(ctx, follow.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetListsContainingAccount: error getting list entries: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiLists := make([]*apimodel.List, 0, len(listEntries))
+	for _, listEntry := range listEntries {
+		list, errWithCode := p.getList(ctx, account.ID, listEntry.ListID)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiList, errWithCode := p.apiList(ctx, list)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiLists = append(apiLists, apiList)
+	}
+
+	return apiLists, nil
+}
@@ -0,0 +1,66 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package list
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor interface {
+	// Create creates a new list with the given title and replies policy, owned by the given account.
+	Create(ctx context.Context, account *gtsmodel.Account, title string, repliesPolicy gtsmodel.RepliesPolicy) (*apimodel.List, gtserror.WithCode)
+	// Get returns the api model of one list with the given ID, owned by the given account.
+	Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.List, gtserror.WithCode)
+	// GetAll returns all lists owned by the given account.
+	GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.List, gtserror.WithCode)
+	// GetListAccounts returns a pageable response of accounts that are entries in the given list.
+	GetListAccounts(ctx context.Context, account *gtsmodel.Account, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// GetListsContainingAccount returns all lists owned by the given account which contain the target account.
+	GetListsContainingAccount(ctx context.Context, account *gtsmodel.Account, targetAccountID string) ([]*apimodel.List, gtserror.WithCode)
+	// Update updates the title and/or replies policy of the given list.
+	Update(ctx context.Context, account *gtsmodel.Account, id string, title *string, repliesPolicy *gtsmodel.RepliesPolicy) (*apimodel.List, gtserror.WithCode)
+	// Delete deletes the given list, and all of its entries.
+	Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+	// AddToList adds the given target account IDs to the given list. The account must follow each target.
+	AddToList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode
+	// RemoveFromList removes the given target account IDs from the given list.
+	RemoveFromList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode
+}
+
+type processor struct {
+	db            db.DB
+	tc            typeutils.TypeConverter
+	listTimelines timeline.Manager
+}
+
+// New returns a new list processor.
+func New(db db.DB, tc typeutils.TypeConverter, listTimelines timeline.Manager) Processor {
+	return &processor{
+		db:            db,
+		tc:            tc,
+		listTimelines: listTimelines,
+	}
+}
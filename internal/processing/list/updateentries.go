@@ -0,0 +1,171 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) AddToList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode {
+	// Ensure this list exists + account owns it.
+	if _, errWithCode := p.getList(ctx, account.ID, listID); errWithCode != nil {
+		return errWithCode
+	}
+
+	// Pre-assemble list of entries to add. We *could* add these
+	// one by one as we iterate through accountIDs, but according
+	// to the Mastodon API we should only add them all once we know
+	// they're all valid, no partial updates.
+	listEntries := make([]*gtsmodel.ListEntry, 0, len(targetAccountIDs))
+
+	// Check each targetAccountID is valid.
+	//   - Follow must exist.
+	//   - Follow must not already be in the given list.
+	for _, targetAccountID := range targetAccountIDs {
+		follow, errWithCode := p.getFollow(ctx, account.ID, targetAccountID)
+		if errWithCode != nil {
+			return errWithCode
+		}
+
+		inList, errWithCode := p.followInList(ctx, follow.ID, listID)
+		if errWithCode != nil {
+			return errWithCode
+		}
+
+		if inList {
+			// Nothing to do for this one.
+			continue
+		}
+
+		listEntries = append(listEntries, &gtsmodel.ListEntry{
+			ID:       id.NewULID(),
+			ListID:   listID,
+			FollowID: follow.ID,
+		})
+	}
+
+	// If we get to here we can assume all
+	// entries are valid, so try to add them.
+	if err := p.db.PutListEntries(ctx, listEntries); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = fmt.Errorf("AddToList: one or more errors inserting list entries: %w", err)
+			return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		err = fmt.Errorf("AddToList: db error inserting list entries: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+func (p *processor) RemoveFromList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode {
+	// Ensure this list exists + account owns it.
+	if _, errWithCode := p.getList(ctx, account.ID, listID); errWithCode != nil {
+		return errWithCode
+	}
+
+	// For each targetAccountID, we want to check if
+	// a follow with that targetAccountID is in the
+	// given list. If it is in there, we want to remove
+	// it from the list.
+	for _, targetAccountID := range targetAccountIDs {
+		follow := &gtsmodel.Follow{}
+		if err := p.db.GetWhere(ctx, []db.Where{
+			{Key: "account_id", Value: account.ID},
+			{Key: "target_account_id", Value: targetAccountID},
+		}, follow); err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// Not following, so can't be in the list; skip.
+				continue
+			}
+			err = fmt.Errorf("RemoveFromList: db error getting follow: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		listEntries, err := p.db.GetListEntriesForFollowID(ctx, follow.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("RemoveFromList: db error getting list entries: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		for _, listEntry := range listEntries {
+			if listEntry.ListID != listID {
+				continue
+			}
+
+			if err := p.db.DeleteListEntry(ctx, listEntry.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+				err = fmt.Errorf("RemoveFromList: db error removing list entry: %w", err)
+				return gtserror.NewErrorInternalError(err)
+			}
+		}
+
+		// Clear any statuses from this
+		// account out of the list timeline.
+		if err := p.listTimelines.WipeItemsFromAccountID(ctx, listID, targetAccountID); err != nil {
+			err = fmt.Errorf("RemoveFromList: error wiping statuses from list timeline: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return nil
+}
+
+// getFollow is a shortcut to get the follow from accountID
+// to targetAccountID, returning appropriate errors if the
+// follow doesn't exist, so caller doesn't need to bother.
+func (p *processor) getFollow(ctx context.Context, accountID string, targetAccountID string) (*gtsmodel.Follow, gtserror.WithCode) {
+	follow := &gtsmodel.Follow{}
+	if err := p.db.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: accountID},
+		{Key: "target_account_id", Value: targetAccountID},
+	}, follow); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("you do not follow account %s", targetAccountID)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return follow, nil
+}
+
+// followInList returns true if the given followID
+// is already an entry in the list with the given listID.
+func (p *processor) followInList(ctx context.Context, followID string, listID string) (bool, gtserror.WithCode) {
+	listEntries, err := p.db.GetListEntriesForFollowID(ctx, followID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("followInList: db error getting list entries: %w", err)
+		return false, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, listEntry := range listEntries {
+		if listEntry.ListID == listID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,73 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package markers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Get(ctx context.Context, account *gtsmodel.Account, names []apimodel.MarkerName) (*apimodel.Marker, gtserror.WithCode) {
+	if len(names) == 0 {
+		names = []apimodel.MarkerName{
+			apimodel.MarkerNameHome,
+			apimodel.MarkerNameNotifications,
+		}
+	}
+
+	markers := make([]*gtsmodel.Marker, 0, len(names))
+	for _, name := range names {
+		if name != apimodel.MarkerNameHome && name != apimodel.MarkerNameNotifications {
+			err := fmt.Errorf("timeline %s is not one of %s or %s", name, apimodel.MarkerNameHome, apimodel.MarkerNameNotifications)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		marker, err := p.db.GetMarker(ctx, account.ID, gtsmodel.MarkerName(name))
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// no marker set for this timeline yet
+				continue
+			}
+			err = fmt.Errorf("Get: error getting %s marker: %w", name, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		markers = append(markers, marker)
+	}
+
+	return p.apiMarker(ctx, markers)
+}
+
+// apiMarker is a shortcut to return the API version of the given
+// markers, or return an appropriate error if conversion fails.
+func (p *processor) apiMarker(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, gtserror.WithCode) {
+	apiMarker, err := p.tc.MarkersToAPIMarker(ctx, markers)
+	if err != nil {
+		err = fmt.Errorf("error converting markers to frontend representation: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiMarker, nil
+}
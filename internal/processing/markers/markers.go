@@ -0,0 +1,50 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package markers
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor interface {
+	// Get returns the given account's markers for the timelines with the given names,
+	// or for all timelines if no names are given. Timelines without a marker are left out.
+	Get(ctx context.Context, account *gtsmodel.Account, names []apimodel.MarkerName) (*apimodel.Marker, gtserror.WithCode)
+	// Update sets the given account's markers from the given form, returning all updated markers.
+	Update(ctx context.Context, account *gtsmodel.Account, form *apimodel.MarkerPostRequest) (*apimodel.Marker, gtserror.WithCode)
+}
+
+type processor struct {
+	db db.DB
+	tc typeutils.TypeConverter
+}
+
+// New returns a new markers processor.
+func New(db db.DB, tc typeutils.TypeConverter) Processor {
+	return &processor{
+		db: db,
+		tc: tc,
+	}
+}
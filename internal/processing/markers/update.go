@@ -0,0 +1,68 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package markers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, form *apimodel.MarkerPostRequest) (*apimodel.Marker, gtserror.WithCode) {
+	markers := make([]*gtsmodel.Marker, 0, 2)
+
+	if homeLastReadID := form.HomeLastReadID(); homeLastReadID != "" {
+		markers = append(markers, &gtsmodel.Marker{
+			AccountID:  account.ID,
+			Name:       gtsmodel.MarkerNameHome,
+			LastReadID: homeLastReadID,
+		})
+	}
+
+	if notificationsLastReadID := form.NotificationsLastReadID(); notificationsLastReadID != "" {
+		markers = append(markers, &gtsmodel.Marker{
+			AccountID:  account.ID,
+			Name:       gtsmodel.MarkerNameNotifications,
+			LastReadID: notificationsLastReadID,
+		})
+	}
+
+	if len(markers) == 0 {
+		err := errors.New("no markers given: set home[last_read_id] and/or notifications[last_read_id]")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	for _, marker := range markers {
+		if err := p.db.UpdateMarker(ctx, marker); err != nil {
+			if errors.Is(err, db.ErrAlreadyExists) {
+				err = fmt.Errorf("%s marker was updated by another client in the meantime, try again", marker.Name)
+				return nil, gtserror.NewErrorConflict(err, err.Error())
+			}
+			err = fmt.Errorf("Update: error updating %s marker: %w", marker.Name, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return p.apiMarker(ctx, markers)
+}
@@ -0,0 +1,107 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) AccountMuteCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountMuteRequest) (*apimodel.Relationship, gtserror.WithCode) {
+	relationship, errWithCode := p.accountProcessor.MuteCreate(ctx, authed.Account, form)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// statuses from the muted account shouldn't show up in home or list timelines anymore
+	if err := p.wipeMutedFromTimelines(ctx, authed.Account.ID, form.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return relationship, nil
+}
+
+func (p *processor) AccountMuteRemove(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode) {
+	return p.accountProcessor.MuteRemove(ctx, authed.Account, targetAccountID)
+}
+
+func (p *processor) MutesGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	mutes, err := p.db.GetAccountMutes(ctx, authed.Account.ID, maxID, sinceID, limit)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// there are just no entries
+			return util.EmptyPageableResponse(), nil
+		}
+		// there's an actual error
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := make([]interface{}, 0, len(mutes))
+	for _, mute := range mutes {
+		apiAccount, err := p.tc.AccountToAPIAccountPublic(ctx, mute.TargetAccount)
+		if err != nil {
+			log.Debugf("MutesGet: error converting account %s to api, will skip it: %s", mute.TargetAccountID, err)
+			continue
+		}
+
+		if !mute.ExpiresAt.IsZero() {
+			apiAccount.MuteExpiresAt = util.FormatISO8601(mute.ExpiresAt)
+		}
+
+		items = append(items, apiAccount)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/mutes",
+		NextMaxIDValue: mutes[len(mutes)-1].ID,
+		PrevMinIDKey:   "since_id",
+		PrevMinIDValue: mutes[0].ID,
+		Limit:          limit,
+	})
+}
+
+// wipeMutedFromTimelines removes statuses and boosts by the muted account
+// from the home timeline of the muting account, and from all of its lists.
+func (p *processor) wipeMutedFromTimelines(ctx context.Context, accountID string, mutedAccountID string) error {
+	if err := p.statusTimelines.WipeItemsFromAccountID(ctx, accountID, mutedAccountID); err != nil {
+		return fmt.Errorf("wipeMutedFromTimelines: error wiping home timeline: %w", err)
+	}
+
+	lists, err := p.db.GetListsForAccountID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("wipeMutedFromTimelines: error getting lists: %w", err)
+	}
+
+	for _, list := range lists {
+		if err := p.listTimelines.WipeItemsFromAccountID(ctx, list.ID, mutedAccountID); err != nil {
+			return fmt.Errorf("wipeMutedFromTimelines: error wiping list timeline %s: %w", list.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -20,11 +20,16 @@ package processing
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -40,6 +45,11 @@ func (p *processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, ex
 		return util.EmptyPageableResponse(), nil
 	}
 
+	filters, err := p.db.GetFiltersForAccountID(ctx, authed.Account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	items := []interface{}{}
 	nextMaxIDValue := ""
 	prevMinIDValue := ""
@@ -58,6 +68,14 @@ func (p *processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, ex
 			prevMinIDValue = item.GetID()
 		}
 
+		item, err = p.filterNotification(ctx, item, filters)
+		if err != nil {
+			if !errors.Is(err, typeutils.ErrHideStatus) {
+				log.Debugf("got an error applying filters to a notification, will skip it: %s", err)
+			}
+			continue
+		}
+
 		items = append(items, item)
 	}
 
@@ -79,3 +97,57 @@ func (p *processor) NotificationsClear(ctx context.Context, authed *oauth.Auth)
 
 	return nil
 }
+
+// filterNotification applies the given filters to the status attached to
+// the given notification, if there is one. If the status should be hidden,
+// typeutils.ErrHideStatus will be returned, and the notification should
+// not be shown either.
+func (p *processor) filterNotification(ctx context.Context, apiNotif *apimodel.Notification, filters []*gtsmodel.Filter) (*apimodel.Notification, error) {
+	if apiNotif.Status == nil {
+		// Nothing to filter.
+		return apiNotif, nil
+	}
+
+	apiStatus, err := p.tc.ApplyFilters(ctx, apiNotif.Status, filters, gtsmodel.FilterContextNotifications)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiStatus != apiNotif.Status {
+		// Status was changed by filters, so copy
+		// the notification to avoid modifying it.
+		filtered := new(apimodel.Notification)
+		*filtered = *apiNotif
+		filtered.Status = apiStatus
+		apiNotif = filtered
+	}
+
+	return apiNotif, nil
+}
+
+// filterNotificationForAccount is like filterNotification, but
+// it uses the filters owned by the account with the given ID.
+func (p *processor) filterNotificationForAccount(ctx context.Context, apiNotif *apimodel.Notification, accountID string) (*apimodel.Notification, error) {
+	filters, err := p.db.GetFiltersForAccountID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("filterNotificationForAccount: error getting filters for account %s: %w", accountID, err)
+	}
+
+	return p.filterNotification(ctx, apiNotif, filters)
+}
+
+// notificationsMuted returns true if the account with the given targetAccountID
+// has muted the account with the given originAccountID, and chose to hide
+// notifications from it too. No notification from the origin account
+// should be created for the target account if this is the case.
+func (p *processor) notificationsMuted(ctx context.Context, targetAccountID string, originAccountID string) (bool, error) {
+	mute, err := p.db.GetMute(ctx, targetAccountID, originAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return false, nil
+		}
+		return false, fmt.Errorf("notificationsMuted: error getting mute of %s by %s: %w", originAccountID, targetAccountID, err)
+	}
+
+	return *mute.Notifications, nil
+}
@@ -0,0 +1,70 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"codeberg.org/gruf/go-kv"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+func (p *processor) CloseExpired(ctx context.Context) error {
+	now := time.Now()
+
+	polls, err := p.db.GetExpiredOpenPolls(ctx, now)
+	if err != nil {
+		return fmt.Errorf("CloseExpired: error getting expired polls: %w", err)
+	}
+
+	for _, poll := range polls {
+		status, err := p.db.GetStatusByID(ctx, poll.StatusID)
+		if err != nil {
+			log.WithFields(kv.Fields{
+				{"pollID", poll.ID},
+				{"statusID", poll.StatusID},
+			}...).Errorf("error getting poll status: %v", err)
+			continue
+		}
+
+		poll.ClosedAt = now
+		if err := p.db.UpdatePoll(ctx, poll, "closed_at"); err != nil {
+			log.WithField("pollID", poll.ID).Errorf("error closing poll: %v", err)
+			continue
+		}
+
+		poll.Status = status
+		status.Poll = poll
+
+		// notify voters and federate the final
+		// results (if it's our poll) asynchronously
+		p.clientWorker.Queue(messages.FromClientAPI{
+			APObjectType:   ap.ActivityQuestion,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       status,
+			OriginAccount:  status.Account,
+		})
+	}
+
+	return nil
+}
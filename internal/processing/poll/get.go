@@ -0,0 +1,81 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Get(ctx context.Context, account *gtsmodel.Account, pollID string) (*apimodel.Poll, gtserror.WithCode) {
+	poll, errWithCode := p.getVisiblePoll(ctx, account, pollID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiPoll(ctx, poll, account)
+}
+
+// getVisiblePoll fetches the poll with the given ID, along with
+// the status it's attached to, and checks the status is visible
+// to the given account. If the poll doesn't exist or isn't visible,
+// then a 404 will be returned, to avoid leaking its existence.
+func (p *processor) getVisiblePoll(ctx context.Context, account *gtsmodel.Account, pollID string) (*gtsmodel.Poll, gtserror.WithCode) {
+	poll, err := p.db.GetPollByID(ctx, pollID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("poll %s not found", pollID)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("getVisiblePoll: error getting poll %s: %w", pollID, err))
+	}
+
+	status, err := p.db.GetStatusByID(ctx, poll.StatusID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("getVisiblePoll: error getting status %s: %w", poll.StatusID, err))
+	}
+	poll.Status = status
+
+	visible, err := p.filter.StatusVisible(ctx, status, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("getVisiblePoll: error checking visibility of status %s: %w", status.ID, err))
+	}
+
+	if !visible {
+		err = fmt.Errorf("poll %s not found", pollID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return poll, nil
+}
+
+func (p *processor) apiPoll(ctx context.Context, poll *gtsmodel.Poll, account *gtsmodel.Account) (*apimodel.Poll, gtserror.WithCode) {
+	apiPoll, err := p.tc.PollToAPIPoll(ctx, poll, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("apiPoll: error converting poll %s to api poll: %w", poll.ID, err))
+	}
+
+	return apiPoll, nil
+}
@@ -0,0 +1,59 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+)
+
+type Processor interface {
+	// Get returns the api model of the poll with the given ID, if it's visible to the given account.
+	Get(ctx context.Context, account *gtsmodel.Account, pollID string) (*apimodel.Poll, gtserror.WithCode)
+	// Vote casts a vote by the given account for the given choices in the poll with the given ID.
+	Vote(ctx context.Context, account *gtsmodel.Account, pollID string, choices []int) (*apimodel.Poll, gtserror.WithCode)
+	// CloseExpired closes all open polls whose expiry time has passed,
+	// and queues them for notifying voters and federating the result.
+	CloseExpired(ctx context.Context) error
+}
+
+type processor struct {
+	db           db.DB
+	tc           typeutils.TypeConverter
+	filter       visibility.Filter
+	clientWorker *concurrency.WorkerPool[messages.FromClientAPI]
+}
+
+// New returns a new poll processor.
+func New(db db.DB, tc typeutils.TypeConverter, clientWorker *concurrency.WorkerPool[messages.FromClientAPI]) Processor {
+	return &processor{
+		db:           db,
+		tc:           tc,
+		filter:       visibility.NewFilter(db),
+		clientWorker: clientWorker,
+	}
+}
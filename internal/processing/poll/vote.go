@@ -0,0 +1,145 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+func (p *processor) Vote(ctx context.Context, account *gtsmodel.Account, pollID string, choices []int) (*apimodel.Poll, gtserror.WithCode) {
+	poll, errWithCode := p.getVisiblePoll(ctx, account, pollID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if poll.Closed() || poll.Expired(time.Now()) {
+		err := errors.New("poll has already ended")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if poll.Status.AccountID == account.ID {
+		err := errors.New("you can't vote in your own poll")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if _, err := p.db.GetPollVoteBy(ctx, poll.ID, account.ID); err == nil {
+		err := errors.New("you have already voted in this poll")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	} else if !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("Vote: error checking for existing vote: %w", err))
+	}
+
+	if errWithCode := validateChoices(poll, choices); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	vote := &gtsmodel.PollVote{
+		ID:        id.NewULID(),
+		PollID:    poll.ID,
+		Poll:      poll,
+		AccountID: account.ID,
+		Account:   account,
+		Choices:   choices,
+	}
+
+	if err := p.db.PutPollVote(ctx, vote); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// another request voted since we checked above
+			err := errors.New("you have already voted in this poll")
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("Vote: error putting vote: %w", err))
+	}
+
+	if *poll.Status.Local {
+		// we have every vote for local
+		// polls, so just recount them
+		votes, err := p.db.GetPollVotes(ctx, poll.ID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("Vote: error getting votes: %w", err))
+		}
+		poll.Tally(votes)
+	} else {
+		// we only know the counts of remote polls
+		// as of the last update we received, so
+		// add this vote on top until the next one
+		counts := make([]int, len(poll.Options))
+		copy(counts, poll.Votes)
+		for _, choice := range choices {
+			counts[choice]++
+		}
+		poll.Votes = counts
+		poll.Voters++
+	}
+
+	if err := p.db.UpdatePoll(ctx, poll, "votes", "voters"); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("Vote: error updating poll counts: %w", err))
+	}
+
+	// process side effects (federating the vote) asynchronously
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ActivityQuestion,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       vote,
+		OriginAccount:  account,
+	})
+
+	return p.apiPoll(ctx, poll, account)
+}
+
+// validateChoices checks that the given choices
+// are valid, unique option indices for the poll.
+func validateChoices(poll *gtsmodel.Poll, choices []int) gtserror.WithCode {
+	if len(choices) == 0 {
+		err := errors.New("no choices provided")
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if len(choices) > 1 && !*poll.Multiple {
+		err := errors.New("poll only allows a single choice")
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	seen := make(map[int]struct{}, len(choices))
+	for _, choice := range choices {
+		if choice < 0 || choice >= len(poll.Options) {
+			err := fmt.Errorf("choice %d is not a valid option", choice)
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if _, ok := seen[choice]; ok {
+			err := fmt.Errorf("choice %d was provided more than once", choice)
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		seen[choice] = struct{}{}
+	}
+
+	return nil
+}
@@ -28,25 +28,38 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/emaildomain"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/announcements"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/conversations"
 	federationProcessor "github.com/superseriousbusiness/gotosocial/internal/processing/federation"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/filters"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/invite"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/list"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/markers"
 	mediaProcessor "github.com/superseriousbusiness/gotosocial/internal/processing/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/poll"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/push"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/report"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/scheduledstatus"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/streaming"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/tags"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/user"
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
@@ -89,6 +102,10 @@ type Processor interface {
 	AccountGetRSSFeedForUsername(ctx context.Context, username string) (func() (string, gtserror.WithCode), time.Time, gtserror.WithCode)
 	// AccountUpdate processes the update of an account with the given form
 	AccountUpdate(ctx context.Context, authed *oauth.Auth, form *apimodel.UpdateCredentialsRequest) (*apimodel.Account, gtserror.WithCode)
+	// AccountAlias sets the alsoKnownAs aliases of the authed account to the URIs given in the form.
+	AccountAlias(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountAliasRequest) (*apimodel.Account, gtserror.WithCode)
+	// AccountMove moves the authed account to the target account given in the form, and migrates its followers.
+	AccountMove(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountMoveRequest) (*apimodel.Account, gtserror.WithCode)
 	// AccountStatusesGet fetches a number of statuses (in time descending order) from the given account, filtered by visibility for
 	// the account given in authed.
 	AccountStatusesGet(ctx context.Context, authed *oauth.Auth, targetAccountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, pinned bool, mediaOnly bool, publicOnly bool) (*apimodel.PageableResponse, gtserror.WithCode)
@@ -109,9 +126,19 @@ type Processor interface {
 	AccountBlockCreate(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
 	// AccountBlockRemove handles the removal of a block from authed account to target account, either remote or local.
 	AccountBlockRemove(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// AccountMuteCreate handles the creation or updating of a mute from authed account to the account in the form.
+	AccountMuteCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountMuteRequest) (*apimodel.Relationship, gtserror.WithCode)
+	// AccountMuteRemove handles the removal of a mute from authed account to target account.
+	AccountMuteRemove(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
 
 	// AdminAccountAction handles the creation/execution of an action on an account.
 	AdminAccountAction(ctx context.Context, authed *oauth.Auth, form *apimodel.AdminAccountActionRequest) gtserror.WithCode
+	// AdminAccountsGet returns a list of local accounts, optionally filtered by status (eg., pending approval).
+	AdminAccountsGet(ctx context.Context, authed *oauth.Auth, status string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// AdminAccountApprove approves the pending sign-up of the given local account, and emails the user to let them know.
+	AdminAccountApprove(ctx context.Context, authed *oauth.Auth, accountID string) (*apimodel.AdminAccountInfo, gtserror.WithCode)
+	// AdminAccountReject rejects the pending sign-up of the given local account, emails the user to let them know, and deletes the account.
+	AdminAccountReject(ctx context.Context, authed *oauth.Auth, accountID string) (*apimodel.AdminAccountInfo, gtserror.WithCode)
 	// AdminEmojiCreate handles the creation of a new instance emoji by an admin, using the given form.
 	AdminEmojiCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.EmojiCreateRequest) (*apimodel.Emoji, gtserror.WithCode)
 	// AdminEmojisGet allows admins to view emojis based on various filters.
@@ -136,6 +163,14 @@ type Processor interface {
 	AdminDomainBlockGet(ctx context.Context, authed *oauth.Auth, id string, export bool) (*apimodel.DomainBlock, gtserror.WithCode)
 	// AdminDomainBlockDelete deletes one domain block, specified by ID, returning the deleted domain block.
 	AdminDomainBlockDelete(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.DomainBlock, gtserror.WithCode)
+	// AdminEmailDomainBlockCreate handles the creation of a new email domain block by an admin, using the given form.
+	AdminEmailDomainBlockCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.EmailDomainBlockCreateRequest) (*apimodel.EmailDomainBlock, gtserror.WithCode)
+	// AdminEmailDomainBlocksGet returns a list of currently blocked email domains.
+	AdminEmailDomainBlocksGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.EmailDomainBlock, gtserror.WithCode)
+	// AdminEmailDomainBlockGet returns one email domain block, specified by ID.
+	AdminEmailDomainBlockGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.EmailDomainBlock, gtserror.WithCode)
+	// AdminEmailDomainBlockDelete deletes one email domain block, specified by ID, returning the deleted email domain block.
+	AdminEmailDomainBlockDelete(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.EmailDomainBlock, gtserror.WithCode)
 	// AdminMediaRemotePrune triggers a prune of remote media according to the given number of mediaRemoteCacheDays
 	AdminMediaPrune(ctx context.Context, mediaRemoteCacheDays int) gtserror.WithCode
 	// AdminMediaRefetch triggers a refetch of remote media for the given domain (or all if domain is empty).
@@ -153,6 +188,8 @@ type Processor interface {
 
 	// BlocksGet returns a list of accounts blocked by the requesting account.
 	BlocksGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, limit int) (*apimodel.BlocksResponse, gtserror.WithCode)
+	// MutesGet returns a list of accounts muted by the requesting account.
+	MutesGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 
 	// CustomEmojisGet returns an array of info about the custom emojis on this server
 	CustomEmojisGet(ctx context.Context) ([]*apimodel.Emoji, gtserror.WithCode)
@@ -160,9 +197,71 @@ type Processor interface {
 	// BookmarksGet returns a pageable response of statuses that have been bookmarked
 	BookmarksGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 
+	// ConversationsGet returns a pageable response of the direct message conversations of the authed account.
+	ConversationsGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ConversationRead marks the conversation with the given id as read.
+	ConversationRead(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Conversation, gtserror.WithCode)
+	// ConversationDelete removes the conversation with the given id from the authed account's conversations.
+	ConversationDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+
+	// AdminAnnouncementCreate creates a new instance announcement from the given form.
+	AdminAnnouncementCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AnnouncementCreateRequest) (*apimodel.Announcement, gtserror.WithCode)
+	// AdminAnnouncementsGet returns all instance announcements, whether they're published or not.
+	AdminAnnouncementsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.Announcement, gtserror.WithCode)
+	// AdminAnnouncementGet returns one instance announcement with the given id, whether it's published or not.
+	AdminAnnouncementGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Announcement, gtserror.WithCode)
+	// AdminAnnouncementUpdate updates the instance announcement with the given id, using the given form.
+	AdminAnnouncementUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.AnnouncementUpdateRequest) (*apimodel.Announcement, gtserror.WithCode)
+	// AdminAnnouncementDelete deletes the instance announcement with the given id.
+	AdminAnnouncementDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// AnnouncementsGet returns the currently published instance announcements, leaving out those dismissed by the authed account unless withDismissed is true.
+	AnnouncementsGet(ctx context.Context, authed *oauth.Auth, withDismissed bool) ([]*apimodel.Announcement, gtserror.WithCode)
+	// AnnouncementDismiss marks the announcement with the given id as read by the authed account.
+	AnnouncementDismiss(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// AnnouncementReactionAdd adds a reaction with the given name to the announcement with the given id, on behalf of the authed account.
+	AnnouncementReactionAdd(ctx context.Context, authed *oauth.Auth, id string, name string) gtserror.WithCode
+	// AnnouncementReactionRemove removes the authed account's reaction with the given name from the announcement with the given id.
+	AnnouncementReactionRemove(ctx context.Context, authed *oauth.Auth, id string, name string) gtserror.WithCode
+
+	// MarkersGet returns the authed account's read markers for the timelines with the given names.
+	MarkersGet(ctx context.Context, authed *oauth.Auth, names []apimodel.MarkerName) (*apimodel.Marker, gtserror.WithCode)
+	// MarkersSet updates the authed account's read markers from the given form.
+	MarkersSet(ctx context.Context, authed *oauth.Auth, form *apimodel.MarkerPostRequest) (*apimodel.Marker, gtserror.WithCode)
+
 	// FileGet handles the fetching of a media attachment file via the fileserver.
 	FileGet(ctx context.Context, authed *oauth.Auth, form *apimodel.GetContentRequestForm) (*apimodel.Content, gtserror.WithCode)
 
+	// FiltersGetV1 returns all v1 filters owned by the authed account.
+	FiltersGetV1(ctx context.Context, authed *oauth.Auth) ([]*apimodel.Filter, gtserror.WithCode)
+	// FilterGetV1 returns the v1 filter with the given id, owned by the authed account.
+	FilterGetV1(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Filter, gtserror.WithCode)
+	// FilterCreateV1 creates a new v1 filter for the authed account, using the given form.
+	FilterCreateV1(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode)
+	// FilterUpdateV1 updates the v1 filter with the given id, using the given form.
+	FilterUpdateV1(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode)
+	// FilterDeleteV1 deletes the v1 filter with the given id.
+	FilterDeleteV1(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// FiltersGetV2 returns all v2 filters owned by the authed account.
+	FiltersGetV2(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FilterV2, gtserror.WithCode)
+	// FilterGetV2 returns the v2 filter with the given id, owned by the authed account.
+	FilterGetV2(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.FilterV2, gtserror.WithCode)
+	// FilterCreateV2 creates a new v2 filter for the authed account, using the given form.
+	FilterCreateV2(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// FilterUpdateV2 updates the v2 filter with the given id, using the given form.
+	FilterUpdateV2(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// FilterDeleteV2 deletes the v2 filter with the given id, along with all its keywords.
+	FilterDeleteV2(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// FilterKeywordsGet returns all keywords of the v2 filter with the given id.
+	FilterKeywordsGet(ctx context.Context, authed *oauth.Auth, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordGet returns the filter keyword with the given id.
+	FilterKeywordGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordCreate adds a keyword to the v2 filter with the given id, using the given form.
+	FilterKeywordCreate(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordUpdate updates the filter keyword with the given id, using the given form.
+	FilterKeywordUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordDelete deletes the filter keyword with the given id.
+	FilterKeywordDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+
 	// FollowRequestsGet handles the getting of the authed account's incoming follow requests
 	FollowRequestsGet(ctx context.Context, auth *oauth.Auth) ([]apimodel.Account, gtserror.WithCode)
 	// FollowRequestAccept handles the acceptance of a follow request from the given account ID.
@@ -180,6 +279,25 @@ type Processor interface {
 	// It should already be ascertained that the requesting account is authenticated and an admin.
 	InstancePatch(ctx context.Context, form *apimodel.InstanceSettingsUpdateRequest) (*apimodel.InstanceV1, gtserror.WithCode)
 
+	// ListCreate creates a new list for the authed account, using the given form.
+	ListCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.ListCreateRequest) (*apimodel.List, gtserror.WithCode)
+	// ListGet returns one list owned by the authed account, with the given id.
+	ListGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.List, gtserror.WithCode)
+	// ListsGet returns all lists owned by the authed account.
+	ListsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.List, gtserror.WithCode)
+	// ListUpdate updates the title and/or replies policy of the list with the given id, using the given form.
+	ListUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.ListUpdateRequest) (*apimodel.List, gtserror.WithCode)
+	// ListDelete deletes the list with the given id, along with all its entries.
+	ListDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// ListAccountsGet returns a pageable response of accounts in the list with the given id.
+	ListAccountsGet(ctx context.Context, authed *oauth.Auth, id string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ListAccountsAdd adds the accounts specified in the given form to the list with the given id.
+	ListAccountsAdd(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.ListAccountsChangeRequest) gtserror.WithCode
+	// ListAccountsRemove removes the accounts specified in the given form from the list with the given id.
+	ListAccountsRemove(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.ListAccountsChangeRequest) gtserror.WithCode
+	// AccountListsGet returns all lists owned by the authed account which contain the given target account.
+	AccountListsGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]*apimodel.List, gtserror.WithCode)
+
 	// MediaCreate handles the creation of a media attachment, using the given form.
 	MediaCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AttachmentRequest) (*apimodel.Attachment, gtserror.WithCode)
 	// MediaGet handles the GET of a media attachment with the given ID
@@ -196,13 +314,42 @@ type Processor interface {
 	OAuthHandleAuthorizeRequest(w http.ResponseWriter, r *http.Request) gtserror.WithCode
 	OAuthValidateBearerToken(r *http.Request) (oauth2.TokenInfo, error)
 
+	// PollGet returns the poll with the given id, if it's visible to the authed account.
+	PollGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Poll, gtserror.WithCode)
+	// PollVote casts a vote in the poll with the given id by the authed account, using the given form.
+	PollVote(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.PollVoteRequest) (*apimodel.Poll, gtserror.WithCode)
+
 	// SearchGet performs a search with the given params, resolving/dereferencing remotely as desired
 	SearchGet(ctx context.Context, authed *oauth.Auth, searchQuery *apimodel.SearchQuery) (*apimodel.SearchResult, gtserror.WithCode)
 
 	// StatusCreate processes the given form to create a new status, returning the api model representation of that status if it's OK.
 	StatusCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AdvancedStatusCreateForm) (*apimodel.Status, gtserror.WithCode)
+	// ScheduledStatusCreate schedules a new status to be created from the given form, at the form's scheduled_at time.
+	ScheduledStatusCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AdvancedStatusCreateForm) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// ScheduledStatusGet returns one scheduled status of the authed account, with the given id.
+	ScheduledStatusGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// ScheduledStatusesGet returns a pageable response of scheduled statuses of the authed account.
+	ScheduledStatusesGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ScheduledStatusUpdate changes when the scheduled status with the given id will be published, using the given form.
+	ScheduledStatusUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.ScheduledStatusUpdateRequest) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// ScheduledStatusDelete cancels the scheduled status with the given id.
+	ScheduledStatusDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// InviteCreate creates a new invite code owned by the authed account, using the given form.
+	InviteCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.InviteCreateRequest) (*apimodel.Invite, gtserror.WithCode)
+	// InviteGet returns one invite of the authed account, with the given id.
+	InviteGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Invite, gtserror.WithCode)
+	// InvitesGet returns a pageable response of invites created by the authed account.
+	InvitesGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// InviteRevoke revokes the invite with the given id, so it can't be used to sign up anymore.
+	InviteRevoke(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Invite, gtserror.WithCode)
 	// StatusDelete processes the delete of a given status, returning the deleted status if the delete goes through.
 	StatusDelete(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusEdit processes the edit of a given status, returning the edited status if the edit goes through.
+	StatusEdit(ctx context.Context, authed *oauth.Auth, targetStatusID string, form *apimodel.StatusEditRequest) (*apimodel.Status, gtserror.WithCode)
+	// StatusHistoryGet returns the edit history of the given status, from the original revision to the current one.
+	StatusHistoryGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) ([]*apimodel.StatusEdit, gtserror.WithCode)
+	// StatusSourceGet returns the plain-text source of the given status, for editing it.
+	StatusSourceGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.StatusSource, gtserror.WithCode)
 	// StatusFave processes the faving of a given status, returning the updated status if the fave goes through.
 	StatusFave(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// StatusBoost processes the boost/reblog of a given status, returning the newly-created boost if all is well.
@@ -223,11 +370,50 @@ type Processor interface {
 	StatusBookmark(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// StatusUnbookmark removes a bookmark for a status
 	StatusUnbookmark(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusMute mutes the thread that the given status is part of, so that no more notifications are created for it.
+	StatusMute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusUnmute removes a mute of the thread that the given status is part of.
+	StatusUnmute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusPin pins the given status to the requesting account's profile.
+	StatusPin(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusUnpin removes the given status from the requesting account's profile.
+	StatusUnpin(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 
 	// HomeTimelineGet returns statuses from the home timeline, with the given filters/parameters.
 	HomeTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode)
 	// PublicTimelineGet returns statuses from the public/local timeline, with the given filters/parameters.
 	PublicTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode)
+	// TagTimelineGet returns public statuses that use the hashtag with the given name, with the given filters/parameters.
+	TagTimelineGet(ctx context.Context, authed *oauth.Auth, tagName string, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ListTimelineGet returns statuses from the list timeline with the given id, with the given filters/parameters.
+	ListTimelineGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// TagGet returns the hashtag with the given name, including whether the authed account follows it.
+	TagGet(ctx context.Context, authed *oauth.Auth, tagName string) (*apimodel.Tag, gtserror.WithCode)
+	// TagFollow makes the authed account follow the hashtag with the given name.
+	TagFollow(ctx context.Context, authed *oauth.Auth, tagName string) (*apimodel.Tag, gtserror.WithCode)
+	// TagUnfollow makes the authed account stop following the hashtag with the given name.
+	TagUnfollow(ctx context.Context, authed *oauth.Auth, tagName string) (*apimodel.Tag, gtserror.WithCode)
+	// FollowedTagsGet returns a pageable response of hashtags followed by the authed account.
+	FollowedTagsGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// FeaturedTagsGet returns all hashtags featured on the profile of the authed account.
+	FeaturedTagsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FeaturedTag, gtserror.WithCode)
+	// FeaturedTagCreate features the hashtag with the given name on the profile of the authed account.
+	FeaturedTagCreate(ctx context.Context, authed *oauth.Auth, tagName string) (*apimodel.FeaturedTag, gtserror.WithCode)
+	// FeaturedTagDelete stops featuring the featured tag with the given id on the profile of the authed account.
+	FeaturedTagDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// AccountFeaturedTagsGet returns all hashtags featured on the profile of the given target account.
+	// The authed account may be nil, for unauthenticated (eg., web) requests.
+	AccountFeaturedTagsGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode)
+
+	// PushSubscriptionCreate subscribes the authed access token to Web Push notifications, replacing any existing subscription.
+	PushSubscriptionCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.PushSubscriptionCreateRequest) (*apimodel.PushSubscription, gtserror.WithCode)
+	// PushSubscriptionGet returns the Web Push subscription of the authed access token.
+	PushSubscriptionGet(ctx context.Context, authed *oauth.Auth) (*apimodel.PushSubscription, gtserror.WithCode)
+	// PushSubscriptionUpdate changes which alerts the Web Push subscription of the authed access token receives.
+	PushSubscriptionUpdate(ctx context.Context, authed *oauth.Auth, form *apimodel.PushSubscriptionUpdateRequest) (*apimodel.PushSubscription, gtserror.WithCode)
+	// PushSubscriptionDelete removes the Web Push subscription of the authed access token.
+	PushSubscriptionDelete(ctx context.Context, authed *oauth.Auth) gtserror.WithCode
+
 	// FavedTimelineGet returns faved statuses, with the given filters/parameters.
 	FavedTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 
@@ -238,9 +424,23 @@ type Processor interface {
 
 	// UserChangePassword changes the password for the given user, with the given form.
 	UserChangePassword(ctx context.Context, authed *oauth.Auth, form *apimodel.PasswordChangeRequest) gtserror.WithCode
+	// UserChangeEmail requests a change of email address for the given user, with the given form.
+	// The new address only replaces the old one once it has been confirmed.
+	UserChangeEmail(ctx context.Context, authed *oauth.Auth, form *apimodel.EmailChangeRequest) gtserror.WithCode
 	// UserConfirmEmail confirms an email address using the given token.
 	// The user belonging to the confirmed email is also returned.
 	UserConfirmEmail(ctx context.Context, token string) (*gtsmodel.User, gtserror.WithCode)
+	// UserTwoFactorGet returns the two-factor authentication status of the given user.
+	UserTwoFactorGet(ctx context.Context, authed *oauth.Auth) (*apimodel.TwoFactorStatus, gtserror.WithCode)
+	// UserTwoFactorSetup generates a new, not yet enabled, two-factor authentication secret for the given user.
+	UserTwoFactorSetup(ctx context.Context, authed *oauth.Auth) (*apimodel.TwoFactorSetup, gtserror.WithCode)
+	// UserTwoFactorEnable enables two-factor authentication for the given user, with the given form.
+	// The returned recovery codes are only ever shown this once.
+	UserTwoFactorEnable(ctx context.Context, authed *oauth.Auth, form *apimodel.TwoFactorEnableRequest) (*apimodel.TwoFactorRecoveryCodes, gtserror.WithCode)
+	// UserTwoFactorDisable disables two-factor authentication for the given user, with the given form.
+	UserTwoFactorDisable(ctx context.Context, authed *oauth.Auth, form *apimodel.TwoFactorDisableRequest) gtserror.WithCode
+	// UserTwoFactorCheck checks the TOTP or recovery code given by a user in the second step of password sign-in.
+	UserTwoFactorCheck(ctx context.Context, user *gtsmodel.User, code string) gtserror.WithCode
 
 	// ReportsGet returns reports created by the given user.
 	ReportsGet(ctx context.Context, authed *oauth.Auth, resolved *bool, targetAccountID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
@@ -266,6 +466,12 @@ type Processor interface {
 	// GetFediFollowing handles the getting of a fedi/activitypub representation of a user/account's following, performing appropriate
 	// authentication before returning a JSON serializable interface to the caller.
 	GetFediFollowing(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
+	// GetFediFeatured handles the getting of a fedi/activitypub representation of a user/account's featured (pinned) statuses,
+	// performing appropriate authentication before returning a JSON serializable interface to the caller.
+	GetFediFeatured(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
+	// GetFediFeaturedTags handles the getting of a fedi/activitypub representation of a user/account's featured hashtags,
+	// performing appropriate authentication before returning a JSON serializable interface to the caller.
+	GetFediFeaturedTags(ctx context.Context, requestedUsername string, requestURL *url.URL) (interface{}, gtserror.WithCode)
 	// GetFediStatus handles the getting of a fedi/activitypub representation of a particular status, performing appropriate
 	// authentication before returning a JSON serializable interface to the caller.
 	GetFediStatus(ctx context.Context, requestedUsername string, requestedStatusID string, requestURL *url.URL) (interface{}, gtserror.WithCode)
@@ -306,9 +512,15 @@ type processor struct {
 	mediaManager    media.Manager
 	storage         *storage.Driver
 	statusTimelines timeline.Manager
+	listTimelines   timeline.Manager
 	db              db.DB
 	filter          visibility.Filter
 
+	// jobsCtx is cancelled by Stop, to end
+	// the periodic jobs started by Start
+	jobsCtx    context.Context
+	cancelJobs context.CancelFunc
+
 	/*
 		SUB-PROCESSORS
 	*/
@@ -321,6 +533,17 @@ type processor struct {
 	userProcessor       user.Processor
 	federationProcessor federationProcessor.Processor
 	reportProcessor     report.Processor
+	listProcessor       list.Processor
+	filtersProcessor    filters.Processor
+	pollProcessor       poll.Processor
+	tagsProcessor       tags.Processor
+	pushProcessor       push.Processor
+
+	scheduledStatusProcessor scheduledstatus.Processor
+	inviteProcessor          invite.Processor
+	conversationsProcessor   conversations.Processor
+	markersProcessor         markers.Processor
+	announcementsProcessor   announcements.Processor
 }
 
 // NewProcessor returns a new Processor.
@@ -332,20 +555,25 @@ func NewProcessor(
 	storage *storage.Driver,
 	db db.DB,
 	emailSender email.Sender,
+	webPushSender webpush.Sender,
+	mxResolver emaildomain.Resolver,
 	clientWorker *concurrency.WorkerPool[messages.FromClientAPI],
 	fedWorker *concurrency.WorkerPool[messages.FromFederator],
 ) Processor {
 	parseMentionFunc := GetParseMentionFunc(db, federator)
+	emailDomains := emaildomain.NewChecker(db, mxResolver)
 
 	statusProcessor := status.New(db, tc, clientWorker, parseMentionFunc)
 	streamingProcessor := streaming.New(db, oauthServer)
-	accountProcessor := account.New(db, tc, mediaManager, oauthServer, clientWorker, federator, parseMentionFunc)
-	adminProcessor := admin.New(db, tc, mediaManager, federator.TransportController(), storage, clientWorker)
+	accountProcessor := account.New(db, tc, mediaManager, oauthServer, clientWorker, federator, parseMentionFunc, emailDomains)
+	adminProcessor := admin.New(db, tc, mediaManager, federator.TransportController(), storage, clientWorker, emailSender)
 	mediaProcessor := mediaProcessor.New(db, tc, mediaManager, federator.TransportController(), storage)
-	userProcessor := user.New(db, emailSender)
+	userProcessor := user.New(db, emailSender, emailDomains)
 	federationProcessor := federationProcessor.New(db, tc, federator)
 	reportProcessor := report.New(db, tc, clientWorker)
 	filter := visibility.NewFilter(db)
+	listTimelines := timeline.NewManager(ListGrabFunction(db), ListFilterFunction(db, filter), ListPrepareFunction(db, tc), StatusSkipInsertFunction())
+	listProcessor := list.New(db, tc, listTimelines)
 
 	return &processor{
 		clientWorker: clientWorker,
@@ -357,6 +585,7 @@ func NewProcessor(
 		mediaManager:    mediaManager,
 		storage:         storage,
 		statusTimelines: timeline.NewManager(StatusGrabFunction(db), StatusFilterFunction(db, filter), StatusPrepareFunction(db, tc), StatusSkipInsertFunction()),
+		listTimelines:   listTimelines,
 		db:              db,
 		filter:          visibility.NewFilter(db),
 
@@ -368,6 +597,17 @@ func NewProcessor(
 		userProcessor:       userProcessor,
 		federationProcessor: federationProcessor,
 		reportProcessor:     reportProcessor,
+		listProcessor:       listProcessor,
+		filtersProcessor:    filters.New(db, tc),
+		pollProcessor:       poll.New(db, tc, clientWorker),
+		tagsProcessor:       tags.New(db, tc),
+		pushProcessor:       push.New(db, webPushSender),
+
+		scheduledStatusProcessor: scheduledstatus.New(db, tc, statusProcessor),
+		inviteProcessor:          invite.New(db, tc),
+		conversationsProcessor:   conversations.New(db, tc, streamingProcessor),
+		markersProcessor:         markers.New(db, tc),
+		announcementsProcessor:   announcements.New(db, tc, streamingProcessor, parseMentionFunc),
 	}
 }
 
@@ -385,16 +625,63 @@ func (p *processor) Start() error {
 		return err
 	}
 
+	// Start the push notification worker pool
+	if err := p.pushProcessor.Start(); err != nil {
+		return err
+	}
+
 	// Start status timelines
 	if err := p.statusTimelines.Start(); err != nil {
 		return err
 	}
 
+	// Start list timelines
+	if err := p.listTimelines.Start(); err != nil {
+		return err
+	}
+
+	p.jobsCtx, p.cancelJobs = context.WithCancel(context.Background())
+
+	// Close expired polls once per minute
+	p.runPeriodically(1*time.Minute, func(ctx context.Context) {
+		if err := p.pollProcessor.CloseExpired(ctx); err != nil {
+			log.Errorf("error closing expired polls: %v", err)
+		}
+	})
+
+	// Publish due scheduled statuses once per minute
+	p.runPeriodically(1*time.Minute, func(ctx context.Context) {
+		if err := p.scheduledStatusProcessor.PublishDue(ctx); err != nil {
+			log.Errorf("error publishing scheduled statuses: %v", err)
+		}
+	})
+
+	// Publish due announcements and unpublish ended ones once per minute
+	p.runPeriodically(1*time.Minute, func(ctx context.Context) {
+		if err := p.announcementsProcessor.PublishDue(ctx); err != nil {
+			log.Errorf("error publishing announcements: %v", err)
+		}
+		if err := p.announcementsProcessor.UnpublishExpired(ctx); err != nil {
+			log.Errorf("error unpublishing announcements: %v", err)
+		}
+	})
+
+	// Remove expired account mutes once per minute
+	p.runPeriodically(1*time.Minute, func(ctx context.Context) {
+		if err := p.db.DeleteExpiredMutes(ctx, time.Now()); err != nil {
+			log.Errorf("error removing expired mutes: %v", err)
+		}
+	})
+
 	return nil
 }
 
 // Stop stops the processor cleanly, finishing handling any remaining messages before closing down.
 func (p *processor) Stop() error {
+	if p.cancelJobs != nil {
+		p.cancelJobs()
+	}
+
 	if err := p.clientWorker.Stop(); err != nil {
 		return err
 	}
@@ -403,9 +690,37 @@ func (p *processor) Stop() error {
 		return err
 	}
 
+	if err := p.pushProcessor.Stop(); err != nil {
+		return err
+	}
+
 	if err := p.statusTimelines.Stop(); err != nil {
 		return err
 	}
 
+	if err := p.listTimelines.Stop(); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+// runPeriodically calls job once every interval in a separate
+// goroutine, until the processor is stopped. The context passed
+// to job is cancelled when the processor is stopped.
+func (p *processor) runPeriodically(interval time.Duration, job func(ctx context.Context)) {
+	ctx := p.jobsCtx
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				job(ctx)
+			}
+		}
+	}()
+}
@@ -19,6 +19,8 @@
 package processing_test
 
 import (
+	"sync"
+
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -32,6 +34,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -47,20 +50,24 @@ type ProcessingStandardTestSuite struct {
 	federator           federation.Federator
 	oauthServer         oauth.Server
 	emailSender         email.Sender
+	webPushSender       webpush.Sender
+	sentPushes          *sync.Map
 
 	// standard suite models
-	testTokens       map[string]*gtsmodel.Token
-	testClients      map[string]*gtsmodel.Client
-	testApplications map[string]*gtsmodel.Application
-	testUsers        map[string]*gtsmodel.User
-	testAccounts     map[string]*gtsmodel.Account
-	testAttachments  map[string]*gtsmodel.MediaAttachment
-	testStatuses     map[string]*gtsmodel.Status
-	testTags         map[string]*gtsmodel.Tag
-	testMentions     map[string]*gtsmodel.Mention
-	testAutheds      map[string]*oauth.Auth
-	testBlocks       map[string]*gtsmodel.Block
-	testActivities   map[string]testrig.ActivityWithSignature
+	testTokens        map[string]*gtsmodel.Token
+	testClients       map[string]*gtsmodel.Client
+	testApplications  map[string]*gtsmodel.Application
+	testUsers         map[string]*gtsmodel.User
+	testAccounts      map[string]*gtsmodel.Account
+	testAttachments   map[string]*gtsmodel.MediaAttachment
+	testStatuses      map[string]*gtsmodel.Status
+	testTags          map[string]*gtsmodel.Tag
+	testMentions      map[string]*gtsmodel.Mention
+	testAutheds       map[string]*oauth.Auth
+	testBlocks        map[string]*gtsmodel.Block
+	testLists         map[string]*gtsmodel.List
+	testConversations map[string]*gtsmodel.Conversation
+	testActivities    map[string]testrig.ActivityWithSignature
 
 	processor processing.Processor
 }
@@ -83,6 +90,8 @@ func (suite *ProcessingStandardTestSuite) SetupSuite() {
 		},
 	}
 	suite.testBlocks = testrig.NewTestBlocks()
+	suite.testLists = testrig.NewTestLists()
+	suite.testConversations = testrig.NewTestConversations()
 }
 
 func (suite *ProcessingStandardTestSuite) SetupTest() {
@@ -103,8 +112,10 @@ func (suite *ProcessingStandardTestSuite) SetupTest() {
 	suite.federator = testrig.NewTestFederator(suite.db, suite.transportController, suite.storage, suite.mediaManager, fedWorker)
 	suite.oauthServer = testrig.NewTestOauthServer(suite.db)
 	suite.emailSender = testrig.NewEmailSender("../../web/template/", nil)
+	suite.sentPushes = &sync.Map{}
+	suite.webPushSender = testrig.NewWebPushSender(suite.db, suite.sentPushes)
 
-	suite.processor = processing.NewProcessor(suite.typeconverter, suite.federator, suite.oauthServer, suite.mediaManager, suite.storage, suite.db, suite.emailSender, clientWorker, fedWorker)
+	suite.processor = processing.NewProcessor(suite.typeconverter, suite.federator, suite.oauthServer, suite.mediaManager, suite.storage, suite.db, suite.emailSender, suite.webPushSender, testrig.NewTestMXResolver(), clientWorker, fedWorker)
 
 	testrig.StandardDBSetup(suite.db, suite.testAccounts)
 	testrig.StandardStorageSetup(suite.storage, "../../testrig/media")
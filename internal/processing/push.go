@@ -0,0 +1,43 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) PushSubscriptionCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.PushSubscriptionCreateRequest) (*apimodel.PushSubscription, gtserror.WithCode) {
+	return p.pushProcessor.Create(ctx, authed.Account, authed.Token.GetAccess(), form)
+}
+
+func (p *processor) PushSubscriptionGet(ctx context.Context, authed *oauth.Auth) (*apimodel.PushSubscription, gtserror.WithCode) {
+	return p.pushProcessor.Get(ctx, authed.Account, authed.Token.GetAccess())
+}
+
+func (p *processor) PushSubscriptionUpdate(ctx context.Context, authed *oauth.Auth, form *apimodel.PushSubscriptionUpdateRequest) (*apimodel.PushSubscription, gtserror.WithCode) {
+	return p.pushProcessor.Update(ctx, authed.Account, authed.Token.GetAccess(), form)
+}
+
+func (p *processor) PushSubscriptionDelete(ctx context.Context, authed *oauth.Auth) gtserror.WithCode {
+	return p.pushProcessor.Delete(ctx, authed.Account, authed.Token.GetAccess())
+}
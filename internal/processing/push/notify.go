@@ -0,0 +1,184 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
+)
+
+// maxBodyLength is the maximum number of runes of status
+// text included in the body of a push notification.
+const maxBodyLength = 140
+
+// sendTimeout is how long we wait for a push
+// service to accept one push notification.
+const sendTimeout = 30 * time.Second
+
+// pushJob is a notification that's queued to be pushed.
+type pushJob struct {
+	notification  *apimodel.Notification
+	targetAccount *gtsmodel.Account
+}
+
+func (p *processor) Notify(ctx context.Context, notification *apimodel.Notification, targetAccount *gtsmodel.Account) {
+	if !config.GetWebPushEnabled() {
+		return
+	}
+
+	// Push services can be slow or unreachable, so don't
+	// make the caller wait for them; the worker pool limits
+	// how many pushes are sent at once.
+	p.pushWorker.Queue(pushJob{
+		notification:  notification,
+		targetAccount: targetAccount,
+	})
+}
+
+func (p *processor) notify(ctx context.Context, job pushJob) error {
+	notification, targetAccount := job.notification, job.targetAccount
+
+	subscriptions, err := p.db.GetWebPushSubscriptionsByAccountID(ctx, targetAccount.ID)
+	if err != nil {
+		return fmt.Errorf("notify: error getting push subscriptions for account %s: %w", targetAccount.ID, err)
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.Notifies(gtsmodel.NotificationType(notification.Type)) {
+			continue
+		}
+
+		token := &gtsmodel.Token{}
+		if err := p.db.GetByID(ctx, subscription.TokenID, token); err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf("notify: error getting token for push subscription %s: %s", subscription.ID, err)
+				continue
+			}
+
+			// The token has been revoked since the subscription
+			// was made, so the subscription is no longer valid.
+			if err := p.db.DeleteWebPushSubscriptionByID(ctx, subscription.ID); err != nil {
+				log.Errorf("notify: error deleting push subscription %s: %s", subscription.ID, err)
+			}
+			continue
+		}
+
+		payload := &webpush.Payload{
+			AccessToken:      token.Access,
+			PreferredLocale:  targetAccount.Language,
+			NotificationID:   notification.ID,
+			NotificationType: notification.Type,
+			Title:            title(notification),
+			Body:             body(notification),
+		}
+		if notification.Account != nil {
+			payload.Icon = notification.Account.Avatar
+		}
+
+		if err := p.send(ctx, subscription, payload); err != nil {
+			if errors.Is(err, webpush.ErrGone) {
+				// The push service told us the subscription
+				// no longer exists, so we can forget it too.
+				if err := p.db.DeleteWebPushSubscriptionByID(ctx, subscription.ID); err != nil {
+					log.Errorf("notify: error deleting push subscription %s: %s", subscription.ID, err)
+				}
+				continue
+			}
+
+			// Don't let one broken push service
+			// stop the others from being notified.
+			log.Errorf("notify: error sending push notification for subscription %s: %s", subscription.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// send sends one push notification, giving
+// up if the push service takes too long.
+func (p *processor) send(ctx context.Context, subscription *gtsmodel.WebPushSubscription, payload *webpush.Payload) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	return p.webPushSender.Send(ctx, subscription, payload)
+}
+
+// title returns a short human-readable title
+// describing the given notification.
+func title(notification *apimodel.Notification) string {
+	name := "Someone"
+	if notification.Account != nil {
+		name = notification.Account.DisplayName
+		if name == "" {
+			name = notification.Account.Username
+		}
+	}
+
+	switch gtsmodel.NotificationType(notification.Type) {
+	case gtsmodel.NotificationFollow:
+		return name + " followed you"
+	case gtsmodel.NotificationFollowRequest:
+		return name + " requested to follow you"
+	case gtsmodel.NotificationMention:
+		return name + " mentioned you"
+	case gtsmodel.NotificationReblog:
+		return name + " boosted your post"
+	case gtsmodel.NotificationFave:
+		return name + " favourited your post"
+	case gtsmodel.NotificationPoll:
+		return "A poll has ended"
+	case gtsmodel.NotificationStatus:
+		return name + " just posted"
+	case gtsmodel.NotificationSignup:
+		return name + " signed up"
+	default:
+		return "New notification"
+	}
+}
+
+// body returns the plaintext body of the given
+// notification, which is the (truncated) text of
+// its status, or the content warning if it has one.
+func body(notification *apimodel.Notification) string {
+	if notification.Status == nil {
+		return ""
+	}
+
+	var body string
+	if notification.Status.SpoilerText != "" {
+		body = text.SanitizePlaintext(notification.Status.SpoilerText)
+	} else {
+		body = text.SanitizePlaintext(notification.Status.Content)
+	}
+
+	if runes := []rune(body); len(runes) > maxBodyLength {
+		body = string(runes[:maxBodyLength-1]) + "…"
+	}
+
+	return body
+}
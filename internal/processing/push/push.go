@@ -0,0 +1,82 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
+)
+
+type Processor interface {
+	// VAPIDKey returns the public VAPID key of this instance, or an empty string if Web Push is disabled.
+	VAPIDKey(ctx context.Context) (string, error)
+	// Create creates a push subscription for the given access token, replacing any existing subscription for that token.
+	Create(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.PushSubscriptionCreateRequest) (*apimodel.PushSubscription, gtserror.WithCode)
+	// Get returns the push subscription for the given access token.
+	Get(ctx context.Context, account *gtsmodel.Account, accessToken string) (*apimodel.PushSubscription, gtserror.WithCode)
+	// Update changes which alerts the push subscription for the given access token receives.
+	Update(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.PushSubscriptionUpdateRequest) (*apimodel.PushSubscription, gtserror.WithCode)
+	// Delete removes the push subscription for the given access token, if it exists.
+	Delete(ctx context.Context, account *gtsmodel.Account, accessToken string) gtserror.WithCode
+	// Notify pushes the given notification to each of the target account's push subscriptions that wants it.
+	//
+	// Pushes are queued to be sent by the push worker pool, so this function returns
+	// without waiting for them to be sent; any errors are logged.
+	Notify(ctx context.Context, notification *apimodel.Notification, targetAccount *gtsmodel.Account)
+
+	// Start starts the worker pool that sends push notifications.
+	Start() error
+	// Stop stops the worker pool that sends push notifications.
+	Stop() error
+}
+
+type processor struct {
+	db            db.DB
+	webPushSender webpush.Sender
+	pushWorker    *concurrency.WorkerPool[pushJob]
+}
+
+// New returns a new push processor.
+//
+// Push notifications are sent by a worker pool, so that only
+// a limited number are sent in parallel; the pool is started
+// and stopped with Start and Stop.
+func New(db db.DB, webPushSender webpush.Sender) Processor {
+	p := &processor{
+		db:            db,
+		webPushSender: webPushSender,
+		pushWorker:    concurrency.NewWorkerPool[pushJob](-1, 10),
+	}
+	p.pushWorker.SetProcessor(p.notify)
+	return p
+}
+
+func (p *processor) Start() error {
+	return p.pushWorker.Start()
+}
+
+func (p *processor) Stop() error {
+	return p.pushWorker.Stop()
+}
@@ -0,0 +1,128 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) VAPIDKey(ctx context.Context) (string, error) {
+	if !config.GetWebPushEnabled() {
+		return "", nil
+	}
+	return p.webPushSender.VAPIDPublicKey(ctx)
+}
+
+func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.PushSubscriptionCreateRequest) (*apimodel.PushSubscription, gtserror.WithCode) {
+	token, errWithCode := p.getToken(ctx, account, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if form.Subscription == nil || form.Subscription.Keys == nil {
+		err := errors.New("subscription endpoint and keys must be provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	endpoint, err := url.Parse(form.Subscription.Endpoint)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" {
+		err := errors.New("subscription endpoint must be an https url")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if form.Subscription.Keys.P256dh == "" || form.Subscription.Keys.Auth == "" {
+		err := errors.New("subscription keys p256dh and auth must be provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Each token can only have one subscription,
+	// so creating a new one replaces the old one.
+	if err := p.db.DeleteWebPushSubscriptionByTokenID(ctx, token.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	subscription := &gtsmodel.WebPushSubscription{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		TokenID:   token.ID,
+		Endpoint:  endpoint.String(),
+		Auth:      form.Subscription.Keys.Auth,
+		P256dh:    form.Subscription.Keys.P256dh,
+	}
+	setAlerts(subscription, form.Data)
+
+	if err := p.db.PutWebPushSubscription(ctx, subscription); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
+
+func (p *processor) Get(ctx context.Context, account *gtsmodel.Account, accessToken string) (*apimodel.PushSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getSubscription(ctx, account, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
+
+func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.PushSubscriptionUpdateRequest) (*apimodel.PushSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getSubscription(ctx, account, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	setAlerts(subscription, form.Data)
+
+	if err := p.db.UpdateWebPushSubscription(ctx, subscription,
+		"notify_follow",
+		"notify_follow_request",
+		"notify_favourite",
+		"notify_mention",
+		"notify_reblog",
+		"notify_poll",
+		"notify_status",
+	); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiSubscription(ctx, subscription)
+}
+
+func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, accessToken string) gtserror.WithCode {
+	token, errWithCode := p.getToken(ctx, account, accessToken)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteWebPushSubscriptionByTokenID(ctx, token.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
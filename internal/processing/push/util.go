@@ -0,0 +1,127 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// getToken is a shortcut to check that Web Push is enabled, and get
+// the database token with the given access token, which must belong
+// to the given account. Will return appropriate errors so caller
+// doesn't need to bother.
+func (p *processor) getToken(ctx context.Context, account *gtsmodel.Account, accessToken string) (*gtsmodel.Token, gtserror.WithCode) {
+	if !config.GetWebPushEnabled() {
+		err := errors.New("web push is not enabled on this instance")
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	token := &gtsmodel.Token{}
+	if err := p.db.GetWhere(ctx, []db.Where{{Key: "access", Value: accessToken}}, token); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Shouldn't happen, the token was just used to authorize.
+			return nil, gtserror.NewErrorUnauthorized(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	user, err := p.db.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if token.UserID != user.ID {
+		err := fmt.Errorf("token %s does not belong to account %s", token.ID, account.ID)
+		return nil, gtserror.NewErrorUnauthorized(err)
+	}
+
+	return token, nil
+}
+
+// getSubscription is a shortcut to get the push subscription of
+// the given access token. Will return appropriate errors so
+// caller doesn't need to bother.
+func (p *processor) getSubscription(ctx context.Context, account *gtsmodel.Account, accessToken string) (*gtsmodel.WebPushSubscription, gtserror.WithCode) {
+	token, errWithCode := p.getToken(ctx, account, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	subscription, err := p.db.GetWebPushSubscriptionByTokenID(ctx, token.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return subscription, nil
+}
+
+// apiSubscription is a shortcut to return the API version of the
+// given subscription, or return an appropriate error if that fails.
+func (p *processor) apiSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) (*apimodel.PushSubscription, gtserror.WithCode) {
+	serverKey, err := p.webPushSender.VAPIDPublicKey(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting vapid key: %w", err))
+	}
+
+	return &apimodel.PushSubscription{
+		ID:        subscription.ID,
+		Endpoint:  subscription.Endpoint,
+		ServerKey: serverKey,
+		Alerts: &apimodel.PushSubscriptionAlerts{
+			Follow:        *subscription.NotifyFollow,
+			FollowRequest: *subscription.NotifyFollowRequest,
+			Favourite:     *subscription.NotifyFavourite,
+			Mention:       *subscription.NotifyMention,
+			Reblog:        *subscription.NotifyReblog,
+			Poll:          *subscription.NotifyPoll,
+			Status:        *subscription.NotifyStatus,
+			AdminSignUp:   *subscription.NotifyAdminSignUp,
+		},
+	}, nil
+}
+
+// setAlerts sets the alerts of the given subscription
+// from the given request data. Alerts that aren't set
+// in the data are turned off.
+func setAlerts(subscription *gtsmodel.WebPushSubscription, data *apimodel.PushSubscriptionRequestData) {
+	alerts := &apimodel.PushSubscriptionAlerts{}
+	if data != nil && data.Alerts != nil {
+		alerts = data.Alerts
+	}
+
+	subscription.NotifyFollow = &alerts.Follow
+	subscription.NotifyFollowRequest = &alerts.FollowRequest
+	subscription.NotifyFavourite = &alerts.Favourite
+	subscription.NotifyMention = &alerts.Mention
+	subscription.NotifyReblog = &alerts.Reblog
+	subscription.NotifyPoll = &alerts.Poll
+	subscription.NotifyStatus = &alerts.Status
+	subscription.NotifyAdminSignUp = &alerts.AdminSignUp
+}
@@ -0,0 +1,98 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledAt, errWithCode := parseScheduledAt(form.ScheduledAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Check that the status would be accepted if it was
+	// posted right now, using the same rules that apply
+	// to statuses which aren't scheduled. This status is
+	// never stored, it just collects the checked values.
+	status := &gtsmodel.Status{}
+
+	if errWithCode := p.statusProcessor.ProcessReplyToID(ctx, form, account.ID, status); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := p.statusProcessor.ProcessMediaIDs(ctx, form, account.ID, status); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := p.statusProcessor.ProcessPoll(ctx, form, status); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.statusProcessor.ProcessVisibility(ctx, form, account.Privacy, status); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.statusProcessor.ProcessLanguage(ctx, form, account.Language, status); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	sensitive := form.Sensitive
+	scheduledStatus := &gtsmodel.ScheduledStatus{
+		ID:             id.NewULID(),
+		AccountID:      account.ID,
+		Account:        account,
+		ApplicationID:  application.ID,
+		ScheduledAt:    scheduledAt,
+		Text:           form.Status,
+		Format:         string(form.Format),
+		ContentWarning: form.SpoilerText,
+		Sensitive:      &sensitive,
+		Visibility:     status.Visibility,
+		Language:       status.Language,
+		InReplyToID:    status.InReplyToID,
+		AttachmentIDs:  status.AttachmentIDs,
+		Attachments:    status.Attachments,
+	}
+
+	if form.Poll != nil {
+		multiple := form.Poll.Multiple
+		hideTotals := form.Poll.HideTotals
+		scheduledStatus.PollOptions = form.Poll.Options
+		scheduledStatus.PollExpiresIn = form.Poll.ExpiresIn
+		scheduledStatus.PollMultiple = &multiple
+		scheduledStatus.PollHideTotals = &hideTotals
+	}
+
+	if err := p.db.PutScheduledStatus(ctx, scheduledStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.reserveAttachments(ctx, scheduledStatus.AttachmentIDs, scheduledStatus.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiScheduledStatus(ctx, scheduledStatus)
+}
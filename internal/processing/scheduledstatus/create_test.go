@@ -0,0 +1,129 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type ScheduledStatusCreateTestSuite struct {
+	ScheduledStatusStandardTestSuite
+}
+
+func (suite *ScheduledStatusCreateTestSuite) TestCreateScheduledStatusWithMedia() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["local_account_1"]
+	testApplication := suite.testApplications["application_1"]
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+	scheduledAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this status will be posted later",
+			MediaIDs:    []string{testAttachment.ID},
+			SpoilerText: "some cw",
+			Visibility:  apimodel.VisibilityUnlisted,
+			ScheduledAt: scheduledAt.Format(time.RFC3339),
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	suite.NoError(errWithCode)
+	suite.NotNil(apiScheduledStatus)
+
+	suite.Equal(util.FormatISO8601(scheduledAt), apiScheduledStatus.ScheduledAt)
+	suite.Equal("this status will be posted later", apiScheduledStatus.Params.Text)
+	suite.Equal("some cw", apiScheduledStatus.Params.SpoilerText)
+	suite.Equal(apimodel.VisibilityUnlisted, apiScheduledStatus.Params.Visibility)
+	suite.Equal("en", apiScheduledStatus.Params.Language)
+	suite.Equal(testApplication.ID, apiScheduledStatus.Params.ApplicationID)
+	suite.Equal([]string{testAttachment.ID}, apiScheduledStatus.Params.MediaIDs)
+	suite.Len(apiScheduledStatus.MediaAttachments, 1)
+	suite.Equal(testAttachment.ID, apiScheduledStatus.MediaAttachments[0].ID)
+
+	// the schedule should be in the db
+	dbScheduledStatus, err := suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.NoError(err)
+	suite.Equal(testAccount.ID, dbScheduledStatus.AccountID)
+
+	// the attachment should be reserved for the status
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.Equal(apiScheduledStatus.ID, dbAttachment.ScheduledStatusID)
+	suite.Empty(dbAttachment.StatusID)
+
+	// so another status can't use it
+	form.ScheduledAt = ""
+	_, errWithCode = suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	suite.EqualError(errWithCode, "scheduled_at  could not be parsed as an ISO 8601 datetime")
+
+	form.ScheduledAt = scheduledAt.Format(time.RFC3339)
+	_, errWithCode = suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	suite.EqualError(errWithCode, "ProcessMediaIDs: media with id "+testAttachment.ID+" is already attached to a status")
+}
+
+func (suite *ScheduledStatusCreateTestSuite) TestCreateScheduledStatusTooSoon() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["local_account_1"]
+	testApplication := suite.testApplications["application_1"]
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this status will be posted in a minute",
+			ScheduledAt: time.Now().Add(time.Minute).Format(time.RFC3339),
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	suite.Nil(apiScheduledStatus)
+	suite.EqualError(errWithCode, "scheduled_at must be at least 5 minutes in the future")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *ScheduledStatusCreateTestSuite) TestCreateScheduledStatusReplyToMissingStatus() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["local_account_1"]
+	testApplication := suite.testApplications["application_1"]
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this status replies to nothing",
+			InReplyToID: "01H2GBY4NWTP8EAT8Z4T1EMR1K",
+			ScheduledAt: time.Now().Add(time.Hour).Format(time.RFC3339),
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	suite.Nil(apiScheduledStatus)
+	suite.EqualError(errWithCode, "status with id 01H2GBY4NWTP8EAT8Z4T1EMR1K not replyable because it doesn't exist")
+}
+
+func TestScheduledStatusCreateTestSuite(t *testing.T) {
+	suite.Run(t, &ScheduledStatusCreateTestSuite{})
+}
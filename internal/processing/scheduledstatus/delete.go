@@ -0,0 +1,45 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	scheduledStatus, errWithCode := p.getScheduledStatus(ctx, account, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteScheduledStatusByID(ctx, scheduledStatus.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Release the attachments; if they're not used
+	// by another status, they'll be pruned eventually.
+	if err := p.reserveAttachments(ctx, scheduledStatus.AttachmentIDs, ""); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledStatus, errWithCode := p.getScheduledStatus(ctx, account, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiScheduledStatus(ctx, scheduledStatus)
+}
+
+func (p *processor) GetAll(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	scheduledStatuses, err := p.db.GetScheduledStatuses(ctx, account.ID, maxID, sinceID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetAll: error getting scheduled statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(scheduledStatuses)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+
+	for i, scheduledStatus := range scheduledStatuses {
+		// Set next + prev values before API converting,
+		// so caller can still page properly.
+		if i == count-1 {
+			nextMaxIDValue = scheduledStatus.ID
+		}
+
+		if i == 0 {
+			prevMinIDValue = scheduledStatus.ID
+		}
+
+		apiScheduledStatus, errWithCode := p.apiScheduledStatus(ctx, scheduledStatus)
+		if errWithCode != nil {
+			log.Errorf("GetAll: error converting scheduled status %s: %s", scheduledStatus.ID, errWithCode)
+			continue
+		}
+
+		items = append(items, apiScheduledStatus)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/scheduled_statuses",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
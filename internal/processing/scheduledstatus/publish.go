@@ -0,0 +1,152 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (p *processor) PublishDue(ctx context.Context) error {
+	scheduledStatuses, err := p.db.GetDueScheduledStatuses(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("PublishDue: error getting due scheduled statuses: %w", err)
+	}
+
+	for _, scheduledStatus := range scheduledStatuses {
+		if err := p.publish(ctx, scheduledStatus); err != nil {
+			log.WithField("scheduledStatusID", scheduledStatus.ID).Errorf("error publishing scheduled status: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// maxPublishAttempts is how many times publishing a scheduled
+// status is tried, when it fails with an error that might go away
+// by itself, before the schedule is given up on.
+const maxPublishAttempts = 10
+
+// publish creates a status from the given scheduled status,
+// in the same way as a status that's posted right away.
+func (p *processor) publish(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error {
+	account, err := p.db.GetAccountByID(ctx, scheduledStatus.AccountID)
+	if err != nil {
+		return p.publishFailed(ctx, scheduledStatus, fmt.Errorf("error getting account: %w", err), errors.Is(err, db.ErrNoEntries))
+	}
+
+	if !account.SuspendedAt.IsZero() {
+		// Account has been suspended or deleted since
+		// scheduling this, so just drop the schedule.
+		if err := p.db.DeleteScheduledStatusByID(ctx, scheduledStatus.ID); err != nil {
+			return fmt.Errorf("error deleting scheduled status: %w", err)
+		}
+		return nil
+	}
+
+	application := &gtsmodel.Application{}
+	if err := p.db.GetByID(ctx, scheduledStatus.ApplicationID, application); err != nil {
+		return p.publishFailed(ctx, scheduledStatus, fmt.Errorf("error getting application: %w", err), errors.Is(err, db.ErrNoEntries))
+	}
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      scheduledStatus.Text,
+			MediaIDs:    scheduledStatus.AttachmentIDs,
+			InReplyToID: scheduledStatus.InReplyToID,
+			Sensitive:   *scheduledStatus.Sensitive,
+			SpoilerText: scheduledStatus.ContentWarning,
+			Visibility:  p.tc.VisToAPIVis(ctx, scheduledStatus.Visibility),
+			Language:    scheduledStatus.Language,
+			Format:      apimodel.StatusFormat(scheduledStatus.Format),
+		},
+	}
+
+	if len(scheduledStatus.PollOptions) != 0 {
+		form.Poll = &apimodel.PollRequest{
+			Options:    scheduledStatus.PollOptions,
+			ExpiresIn:  scheduledStatus.PollExpiresIn,
+			Multiple:   *scheduledStatus.PollMultiple,
+			HideTotals: *scheduledStatus.PollHideTotals,
+		}
+	}
+
+	// Release the attachments, so they
+	// can be attached to the new status.
+	if err := p.reserveAttachments(ctx, scheduledStatus.AttachmentIDs, ""); err != nil {
+		return p.publishFailed(ctx, scheduledStatus, fmt.Errorf("error releasing attachments: %w", err), false)
+	}
+
+	if _, errWithCode := p.statusProcessor.Create(ctx, account, application, form); errWithCode != nil {
+		// A 4xx means the status can't be created as it
+		// is (eg., the status it replies to or one of its
+		// attachments is gone), so trying again won't help.
+		code := errWithCode.Code()
+		final := code >= http.StatusBadRequest && code < http.StatusInternalServerError
+		return p.publishFailed(ctx, scheduledStatus, fmt.Errorf("error creating status: %w", errWithCode), final)
+	}
+
+	// Only remove the schedule now the status has been published.
+	if err := p.db.DeleteScheduledStatusByID(ctx, scheduledStatus.ID); err != nil {
+		return fmt.Errorf("error deleting scheduled status: %w", err)
+	}
+
+	return nil
+}
+
+// publishFailed handles an error publishing the given scheduled status.
+// If the error is final, or publishing has been tried maxPublishAttempts
+// times, the schedule is dropped. Otherwise the schedule is kept, so that
+// it's retried next time round and the owner can still see (and delete)
+// it in their scheduled statuses. The returned error wraps the given one.
+func (p *processor) publishFailed(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus, err error, final bool) error {
+	scheduledStatus.PublishAttempts++
+	if !final && scheduledStatus.PublishAttempts < maxPublishAttempts {
+		if err := p.db.UpdateScheduledStatus(ctx, scheduledStatus, "publish_attempts"); err != nil {
+			log.WithField("scheduledStatusID", scheduledStatus.ID).Errorf("error updating publish attempts: %v", err)
+		}
+
+		// Make sure the attachments are still reserved.
+		if err := p.reserveAttachments(ctx, scheduledStatus.AttachmentIDs, scheduledStatus.ID); err != nil {
+			log.WithField("scheduledStatusID", scheduledStatus.ID).Errorf("error re-reserving attachments: %v", err)
+		}
+
+		return fmt.Errorf("%w (attempt %d of %d, will retry)", err, scheduledStatus.PublishAttempts, maxPublishAttempts)
+	}
+
+	if err := p.db.DeleteScheduledStatusByID(ctx, scheduledStatus.ID); err != nil {
+		log.WithField("scheduledStatusID", scheduledStatus.ID).Errorf("error deleting scheduled status: %v", err)
+	}
+
+	// Release the attachments; if they're not used
+	// by another status, they'll be pruned eventually.
+	if err := p.reserveAttachments(ctx, scheduledStatus.AttachmentIDs, ""); err != nil {
+		log.WithField("scheduledStatusID", scheduledStatus.ID).Errorf("error releasing attachments: %v", err)
+	}
+
+	return fmt.Errorf("%w (giving up on scheduled status)", err)
+}
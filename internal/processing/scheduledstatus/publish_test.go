@@ -0,0 +1,202 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ScheduledStatusPublishTestSuite struct {
+	ScheduledStatusStandardTestSuite
+}
+
+func (suite *ScheduledStatusPublishTestSuite) TestPublishDue() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["local_account_1"]
+	testApplication := suite.testApplications["application_1"]
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+	futureScheduledStatus := suite.testScheduledStatuses["local_account_1_scheduled_status_1"]
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "hello from the past",
+			MediaIDs:    []string{testAttachment.ID},
+			Visibility:  apimodel.VisibilityPrivate,
+			ScheduledAt: time.Now().Add(time.Hour).Format(time.RFC3339),
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// nothing is due yet
+	suite.NoError(suite.scheduledStatus.PublishDue(ctx))
+	_, err := suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.NoError(err)
+
+	// pretend the hour has passed
+	dbScheduledStatus, err := suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.NoError(err)
+	dbScheduledStatus.ScheduledAt = time.Now().Add(-time.Second)
+	suite.NoError(suite.db.UpdateScheduledStatus(ctx, dbScheduledStatus, "scheduled_at"))
+
+	suite.NoError(suite.scheduledStatus.PublishDue(ctx))
+
+	// the schedule should be gone now
+	_, err = suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// but the schedule that's still in the future should remain
+	_, err = suite.db.GetScheduledStatusByID(ctx, futureScheduledStatus.ID)
+	suite.NoError(err)
+
+	// the attachment should be attached to the published status
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.Empty(dbAttachment.ScheduledStatusID)
+	suite.NotEmpty(dbAttachment.StatusID)
+
+	status, err := suite.db.GetStatusByID(ctx, dbAttachment.StatusID)
+	suite.NoError(err)
+	suite.Equal(testAccount.ID, status.AccountID)
+	suite.Equal(testApplication.ID, status.CreatedWithApplicationID)
+	suite.Equal("hello from the past", status.Text)
+	suite.Equal("<p>hello from the past</p>", status.Content)
+	suite.Equal(gtsmodel.VisibilityFollowersOnly, status.Visibility)
+	suite.Equal([]string{testAttachment.ID}, status.AttachmentIDs)
+}
+
+func (suite *ScheduledStatusPublishTestSuite) TestPublishDueFinalFailureDropsSchedule() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["local_account_1"]
+	testApplication := suite.testApplications["application_1"]
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "replying to nothing",
+			MediaIDs:    []string{testAttachment.ID},
+			ScheduledAt: time.Now().Add(time.Hour).Format(time.RFC3339),
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// make it due, and a reply to a status that doesn't
+	// exist, so that publishing it can never succeed
+	dbScheduledStatus, err := suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.NoError(err)
+	dbScheduledStatus.ScheduledAt = time.Now().Add(-time.Second)
+	dbScheduledStatus.InReplyToID = "01H3BFVYN62DFXMZ2A5VRA4Q2S"
+	suite.NoError(suite.db.UpdateScheduledStatus(ctx, dbScheduledStatus, "scheduled_at", "in_reply_to_id"))
+
+	suite.NoError(suite.scheduledStatus.PublishDue(ctx))
+
+	// the schedule should have been given up on right away
+	_, err = suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// and the attachment released
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.Empty(dbAttachment.ScheduledStatusID)
+	suite.Empty(dbAttachment.StatusID)
+}
+
+func (suite *ScheduledStatusPublishTestSuite) TestPublishDueApplicationGone() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["local_account_1"]
+	testApplication := suite.testApplications["application_1"]
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "posted from an app that's since been removed",
+			ScheduledAt: time.Now().Add(time.Hour).Format(time.RFC3339),
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	dbScheduledStatus, err := suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.NoError(err)
+	dbScheduledStatus.ScheduledAt = time.Now().Add(-time.Second)
+	dbScheduledStatus.ApplicationID = "01H3BFXA9XGVY1DKA1SBYB0G8S"
+	suite.NoError(suite.db.UpdateScheduledStatus(ctx, dbScheduledStatus, "scheduled_at", "application_id"))
+
+	suite.NoError(suite.scheduledStatus.PublishDue(ctx))
+
+	_, err = suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *ScheduledStatusPublishTestSuite) TestDeleteReleasesAttachments() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["local_account_1"]
+	testApplication := suite.testApplications["application_1"]
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "never mind",
+			MediaIDs:    []string{testAttachment.ID},
+			ScheduledAt: time.Now().Add(time.Hour).Format(time.RFC3339),
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// another account can't delete it
+	errWithCode = suite.scheduledStatus.Delete(ctx, suite.testAccounts["local_account_2"], apiScheduledStatus.ID)
+	suite.Error(errWithCode)
+
+	suite.NoError(suite.scheduledStatus.Delete(ctx, testAccount, apiScheduledStatus.ID))
+
+	_, err := suite.db.GetScheduledStatusByID(ctx, apiScheduledStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.Empty(dbAttachment.ScheduledStatusID)
+}
+
+func TestScheduledStatusPublishTestSuite(t *testing.T) {
+	suite.Run(t, &ScheduledStatusPublishTestSuite{})
+}
@@ -0,0 +1,61 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor interface {
+	// Create schedules a status to be created later from the given form, at the form's scheduled_at time.
+	Create(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// Get returns one scheduled status of the given account, with the given id.
+	Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// GetAll returns a pageable response of scheduled statuses of the given account.
+	GetAll(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// Update changes when the scheduled status with the given id will be published.
+	Update(ctx context.Context, account *gtsmodel.Account, id string, scheduledAt string) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// Delete cancels the scheduled status with the given id.
+	Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+	// PublishDue publishes all scheduled statuses whose scheduled time has passed.
+	PublishDue(ctx context.Context) error
+}
+
+type processor struct {
+	db              db.DB
+	tc              typeutils.TypeConverter
+	statusProcessor status.Processor
+}
+
+// New returns a new scheduled status processor, which
+// uses the given status processor to publish statuses.
+func New(db db.DB, tc typeutils.TypeConverter, statusProcessor status.Processor) Processor {
+	return &processor{
+		db:              db,
+		tc:              tc,
+		statusProcessor: statusProcessor,
+	}
+}
@@ -0,0 +1,108 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// parseScheduledAt parses the given ISO 8601 datetime, and
+// checks that it's far enough in the future to be scheduled.
+func parseScheduledAt(scheduledAt string) (time.Time, gtserror.WithCode) {
+	t, err := time.Parse(time.RFC3339, scheduledAt)
+	if err != nil {
+		err := fmt.Errorf("scheduled_at %s could not be parsed as an ISO 8601 datetime", scheduledAt)
+		return time.Time{}, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if t.Before(time.Now().Add(gtsmodel.ScheduledStatusMinDelay)) {
+		err := fmt.Errorf("scheduled_at must be at least %d minutes in the future", gtsmodel.ScheduledStatusMinDelay/time.Minute)
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return t, nil
+}
+
+// getScheduledStatus is a shortcut to get one scheduled status
+// from the database and check that it's owned by the given
+// account. Will return appropriate errors so caller doesn't
+// need to bother.
+func (p *processor) getScheduledStatus(ctx context.Context, account *gtsmodel.Account, id string) (*gtsmodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledStatus, err := p.db.GetScheduledStatusByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if scheduledStatus.AccountID != account.ID {
+		err = fmt.Errorf("scheduled status with id %s does not belong to account %s", scheduledStatus.ID, account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return scheduledStatus, nil
+}
+
+// apiScheduledStatus is a shortcut to return the API version of the given
+// scheduled status, or return an appropriate error if conversion fails.
+func (p *processor) apiScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	apiScheduledStatus, err := p.tc.ScheduledStatusToAPIScheduledStatus(ctx, scheduledStatus)
+	if err != nil {
+		err = fmt.Errorf("error converting scheduled status %s to frontend representation: %w", scheduledStatus.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiScheduledStatus, nil
+}
+
+// reserveAttachments sets the scheduled status ID of the given attachments,
+// so they can't be used by another status and won't be pruned as unattached.
+// Passing an empty scheduled status ID releases the attachments again.
+func (p *processor) reserveAttachments(ctx context.Context, attachmentIDs []string, scheduledStatusID string) error {
+	for _, attachmentID := range attachmentIDs {
+		attachment, err := p.db.GetAttachmentByID(ctx, attachmentID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// Attachment was deleted in the meantime,
+				// nothing to do for this one.
+				log.Debugf("reserveAttachments: attachment %s not found", attachmentID)
+				continue
+			}
+			return err
+		}
+
+		attachment.ScheduledStatusID = scheduledStatusID
+		attachment.UpdatedAt = time.Now()
+		if err := p.db.UpdateByID(ctx, attachment, attachment.ID, "scheduled_status_id", "updated_at"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
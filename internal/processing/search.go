@@ -34,6 +34,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -65,14 +66,13 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		Hashtags: []apimodel.Tag{},
 	}
 
-	// currently the search will only ever return one result,
-	// so return nothing if the offset is greater than 0
-	if search.Offset > 0 {
-		return searchResult, nil
-	}
+	// only full-text status search can be paged through by
+	// offset; the other searches only return a first page
+	firstPage := search.Offset == 0
 
 	foundAccounts := []*gtsmodel.Account{}
 	foundStatuses := []*gtsmodel.Status{}
+	foundTags := []*gtsmodel.Tag{}
 
 	var foundOne bool
 
@@ -85,7 +85,7 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		maybeNamestring = "@" + maybeNamestring
 	}
 
-	if username, domain, err := util.ExtractNamestringParts(maybeNamestring); err == nil {
+	if username, domain, err := util.ExtractNamestringParts(maybeNamestring); err == nil && firstPage {
 		l.Trace("search term is a mention, looking it up...")
 		foundAccount, err := p.searchAccountByUsernameDomain(ctx, authed, username, domain, search.Resolve)
 		if err != nil {
@@ -94,19 +94,18 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 				// return a proper error only if it wasn't just not retrievable
 				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error looking up account: %w", err))
 			}
-			return searchResult, nil
+		} else {
+			foundAccounts = append(foundAccounts, foundAccount)
+			foundOne = true
+			l.Trace("got an account by searching by mention")
 		}
-
-		foundAccounts = append(foundAccounts, foundAccount)
-		foundOne = true
-		l.Trace("got an account by searching by mention")
 	}
 
 	/*
 		SEARCH BY URI
 		check if the query is a URI with a recognizable scheme and dereference it
 	*/
-	if !foundOne {
+	if !foundOne && firstPage {
 		if uri, err := url.Parse(query); err == nil {
 			if uri.Scheme == "https" || uri.Scheme == "http" {
 				l.Trace("search term is a uri, looking it up...")
@@ -147,6 +146,44 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		}
 	}
 
+	/*
+		SEARCH BY HASHTAG
+		check if the query is something like #whatever or just whatever, and look for hashtags that start with it
+	*/
+	if (search.Type == "" || search.Type == "hashtags") && firstPage {
+		if name, ok := text.NormalizeHashtag(query); ok {
+			l.Trace("search term is a hashtag, looking it up...")
+			tags, err := p.db.SearchTags(ctx, name, search.Limit)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error looking up hashtags: %w", err))
+			}
+
+			if len(tags) != 0 {
+				foundTags = append(foundTags, tags...)
+				foundOne = true
+				l.Trace("got hashtags by searching by hashtag")
+			}
+		}
+	}
+
+	/*
+		SEARCH BY TEXT
+		if the query didn't point to a specific account or status, and wasn't explicitly a hashtag, look for local statuses containing its text
+	*/
+	if (search.Type == "" || search.Type == "statuses") && len(foundAccounts) == 0 && len(foundStatuses) == 0 && query[0] != '#' {
+		l.Trace("searching statuses by text...")
+		statuses, err := p.db.SearchStatuses(ctx, authed.Account.ID, query, search.AccountID, search.MaxID, search.MinID, search.Limit, search.Offset)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error searching statuses: %w", err))
+		}
+
+		if len(statuses) != 0 {
+			foundStatuses = append(foundStatuses, statuses...)
+			foundOne = true
+			l.Trace("got statuses by searching by text")
+		}
+	}
+
 	if !foundOne {
 		// we got nothing, we can return early
 		l.Trace("found nothing, returning")
@@ -201,6 +238,16 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		searchResult.Statuses = append(searchResult.Statuses, *apiStatus)
 	}
 
+	for _, foundTag := range foundTags {
+		apiTag, err := p.tc.TagToAPITag(ctx, foundTag)
+		if err != nil {
+			err = fmt.Errorf("SearchGet: error converting tag %s to api tag: %s", foundTag.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		searchResult.Hashtags = append(searchResult.Hashtags, apiTag)
+	}
+
 	return searchResult, nil
 }
 
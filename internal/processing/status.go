@@ -34,6 +34,18 @@ func (p *processor) StatusDelete(ctx context.Context, authed *oauth.Auth, target
 	return p.statusProcessor.Delete(ctx, authed.Account, targetStatusID)
 }
 
+func (p *processor) StatusEdit(ctx context.Context, authed *oauth.Auth, targetStatusID string, form *apimodel.StatusEditRequest) (*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.Edit(ctx, authed.Account, targetStatusID, form)
+}
+
+func (p *processor) StatusHistoryGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) ([]*apimodel.StatusEdit, gtserror.WithCode) {
+	return p.statusProcessor.History(ctx, authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusSourceGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.StatusSource, gtserror.WithCode) {
+	return p.statusProcessor.Source(ctx, authed.Account, targetStatusID)
+}
+
 func (p *processor) StatusFave(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
 	return p.statusProcessor.Fave(ctx, authed.Account, targetStatusID)
 }
@@ -73,3 +85,19 @@ func (p *processor) StatusBookmark(ctx context.Context, authed *oauth.Auth, targ
 func (p *processor) StatusUnbookmark(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
 	return p.statusProcessor.Unbookmark(ctx, authed.Account, targetStatusID)
 }
+
+func (p *processor) StatusMute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.Mute(ctx, authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusUnmute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.Unmute(ctx, authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusPin(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.Pin(ctx, authed.Account, targetStatusID)
+}
+
+func (p *processor) StatusUnpin(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+	return p.statusProcessor.Unpin(ctx, authed.Account, targetStatusID)
+}
@@ -25,6 +25,7 @@ import (
 	"sort"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -46,6 +47,11 @@ func (p *processor) Context(ctx context.Context, requestingAccount *gtsmodel.Acc
 		return nil, gtserror.NewErrorNotFound(errors.New("status is not visible"))
 	}
 
+	filters, err := p.db.GetFiltersForAccountID(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	context := &apimodel.Context{
 		Ancestors:   []apimodel.Status{},
 		Descendants: []apimodel.Status{},
@@ -59,6 +65,9 @@ func (p *processor) Context(ctx context.Context, requestingAccount *gtsmodel.Acc
 	for _, status := range parents {
 		if v, err := p.filter.StatusVisible(ctx, status, requestingAccount); err == nil && v {
 			apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, requestingAccount)
+			if err == nil {
+				apiStatus, err = p.tc.ApplyFilters(ctx, apiStatus, filters, gtsmodel.FilterContextThread)
+			}
 			if err == nil {
 				context.Ancestors = append(context.Ancestors, *apiStatus)
 			}
@@ -77,6 +86,9 @@ func (p *processor) Context(ctx context.Context, requestingAccount *gtsmodel.Acc
 	for _, status := range children {
 		if v, err := p.filter.StatusVisible(ctx, status, requestingAccount); err == nil && v {
 			apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, requestingAccount)
+			if err == nil {
+				apiStatus, err = p.tc.ApplyFilters(ctx, apiStatus, filters, gtsmodel.FilterContextThread)
+			}
 			if err == nil {
 				context.Descendants = append(context.Descendants, *apiStatus)
 			}
@@ -64,6 +64,10 @@ func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 		return nil, errWithCode
 	}
 
+	if errWithCode := p.ProcessPoll(ctx, form, newStatus); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	if err := p.ProcessVisibility(ctx, form, account.Privacy, newStatus); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -0,0 +1,183 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+func (p *processor) Edit(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, form *apimodel.StatusEditRequest) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, err := p.db.GetStatusByID(ctx, targetStatusID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("status %s not found", targetStatusID))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching status %s: %s", targetStatusID, err))
+	}
+
+	if targetStatus.AccountID != requestingAccount.ID {
+		return nil, gtserror.NewErrorForbidden(errors.New("status doesn't belong to requesting account"))
+	}
+
+	if targetStatus.BoostOfID != "" {
+		err := errors.New("boosts can't be edited")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	// take a snapshot of the status as it is
+	// now, before we change anything about it
+	edit := p.tc.StatusToStatusEdit(ctx, targetStatus)
+
+	// the processing utils work on status create forms,
+	// so just fill one in with the fields we're editing
+	createForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      form.Status,
+			MediaIDs:    form.MediaIDs,
+			Poll:        form.Poll,
+			Sensitive:   form.Sensitive,
+			SpoilerText: form.SpoilerText,
+			Language:    form.Language,
+			Format:      form.Format,
+		},
+	}
+
+	now := time.Now()
+	sensitive := form.Sensitive
+	oldMentionIDs := targetStatus.MentionIDs
+	oldPoll := targetStatus.Poll
+
+	targetStatus.EditedAt = now
+	targetStatus.UpdatedAt = now
+	targetStatus.Text = form.Status
+	targetStatus.ContentWarning = text.SanitizePlaintext(form.SpoilerText)
+	targetStatus.Sensitive = &sensitive
+
+	// clear out everything that's
+	// about to be processed again
+	targetStatus.Attachments = nil
+	targetStatus.AttachmentIDs = nil
+	targetStatus.Mentions = nil
+	targetStatus.MentionIDs = nil
+	targetStatus.Tags = nil
+	targetStatus.TagIDs = nil
+	targetStatus.Emojis = nil
+	targetStatus.EmojiIDs = nil
+
+	if errWithCode := p.ProcessMediaIDs(ctx, createForm, requestingAccount.ID, targetStatus); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// only replace the poll if it's actually been changed,
+	// otherwise all the votes cast in it so far would be lost
+	replacePoll := !pollUnchanged(oldPoll, form.Poll)
+	if replacePoll {
+		targetStatus.Poll = nil
+		targetStatus.PollID = ""
+		targetStatus.ActivityStreamsType = ap.ObjectNote
+
+		if errWithCode := p.ProcessPoll(ctx, createForm, targetStatus); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	if err := p.ProcessLanguage(ctx, createForm, requestingAccount.Language, targetStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.ProcessContent(ctx, createForm, requestingAccount.ID, targetStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if replacePoll {
+		if oldPoll != nil {
+			if err := p.db.DeletePollByID(ctx, oldPoll.ID); err != nil {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error deleting old poll %s: %s", oldPoll.ID, err))
+			}
+		}
+
+		if targetStatus.Poll != nil {
+			if err := p.db.PutPoll(ctx, targetStatus.Poll); err != nil {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error putting new poll: %s", err))
+			}
+		}
+	}
+
+	// mentions were all created anew when the
+	// content was processed, so get rid of the old ones
+	for _, id := range oldMentionIDs {
+		if err := p.db.DeleteByID(ctx, id, &gtsmodel.Mention{}); err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error deleting old mention %s: %s", id, err))
+		}
+	}
+
+	if err := p.db.UpdateStatus(ctx, targetStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error updating status %s: %s", targetStatus.ID, err))
+	}
+
+	if err := p.db.PutStatusEdit(ctx, edit); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error putting status edit: %s", err))
+	}
+
+	// send it back to the processor for async processing
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       targetStatus,
+		OriginAccount:  requestingAccount,
+	})
+
+	apiStatus, err := p.tc.StatusToAPIStatus(ctx, targetStatus, requestingAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status %s to frontend representation: %s", targetStatus.ID, err))
+	}
+
+	return apiStatus, nil
+}
+
+// pollUnchanged returns true if the given poll request
+// would result in the same poll as the given existing one.
+func pollUnchanged(poll *gtsmodel.Poll, form *apimodel.PollRequest) bool {
+	if poll == nil || form == nil {
+		return poll == nil && form == nil
+	}
+
+	if *poll.Multiple != form.Multiple || len(poll.Options) != len(form.Options) {
+		return false
+	}
+
+	for i, option := range form.Options {
+		if poll.Options[i] != text.SanitizePlaintext(option) {
+			return false
+		}
+	}
+
+	return true
+}
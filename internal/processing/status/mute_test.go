@@ -0,0 +1,70 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StatusMuteTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusMuteTestSuite) TestMuteUnmute() {
+	ctx := context.Background()
+
+	// mute a reply in a thread
+	mutingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_3"]
+
+	apiStatus, err := suite.status.Mute(ctx, mutingAccount, targetStatus.ID)
+	suite.NoError(err)
+	suite.True(apiStatus.Muted)
+	suite.Equal(targetStatus.ID, apiStatus.ID)
+
+	// the root of the thread, and its other replies, should now be muted too
+	rootStatus, err := suite.status.Get(ctx, mutingAccount, suite.testStatuses["local_account_1_status_1"].ID)
+	suite.NoError(err)
+	suite.True(rootStatus.Muted)
+
+	otherReply, err := suite.status.Get(ctx, mutingAccount, suite.testStatuses["local_account_2_status_5"].ID)
+	suite.NoError(err)
+	suite.True(otherReply.Muted)
+
+	// muting again should be a no-op
+	apiStatus, err = suite.status.Mute(ctx, mutingAccount, rootStatus.ID)
+	suite.NoError(err)
+	suite.True(apiStatus.Muted)
+
+	// unmuting any status in the thread unmutes the whole thread
+	apiStatus, err = suite.status.Unmute(ctx, mutingAccount, otherReply.ID)
+	suite.NoError(err)
+	suite.False(apiStatus.Muted)
+
+	rootStatus, err = suite.status.Get(ctx, mutingAccount, rootStatus.ID)
+	suite.NoError(err)
+	suite.False(rootStatus.Muted)
+}
+
+func TestStatusMuteTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusMuteTestSuite))
+}
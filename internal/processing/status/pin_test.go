@@ -0,0 +1,96 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type StatusPinTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusPinTestSuite) TestPinUnpin() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	apiStatus, errWithCode := suite.status.Pin(ctx, requestingAccount, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.True(apiStatus.Pinned)
+
+	pinned, err := suite.db.GetAccountPinnedStatuses(ctx, requestingAccount.ID)
+	suite.NoError(err)
+	suite.Len(pinned, 1)
+	suite.Equal(targetStatus.ID, pinned[0].ID)
+
+	// pinning again should be a no-op
+	apiStatus, errWithCode = suite.status.Pin(ctx, requestingAccount, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.True(apiStatus.Pinned)
+
+	apiStatus, errWithCode = suite.status.Unpin(ctx, requestingAccount, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.False(apiStatus.Pinned)
+
+	count, err := suite.db.CountAccountPinned(ctx, requestingAccount.ID)
+	suite.NoError(err)
+	suite.Zero(count)
+}
+
+func (suite *StatusPinTestSuite) TestPinOtherAccountStatus() {
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	apiStatus, errWithCode := suite.status.Pin(context.Background(), requestingAccount, targetStatus.ID)
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *StatusPinTestSuite) TestPinDirectStatus() {
+	requestingAccount := suite.testAccounts["local_account_2"]
+	targetStatus := suite.testStatuses["local_account_2_status_6"]
+
+	apiStatus, errWithCode := suite.status.Pin(context.Background(), requestingAccount, targetStatus.ID)
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *StatusPinTestSuite) TestPinTooMany() {
+	ctx := context.Background()
+	config.SetStatusesPinnedMax(1)
+
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	_, errWithCode := suite.status.Pin(ctx, requestingAccount, suite.testStatuses["local_account_1_status_1"].ID)
+	suite.NoError(errWithCode)
+
+	apiStatus, errWithCode := suite.status.Pin(ctx, requestingAccount, suite.testStatuses["local_account_1_status_2"].ID)
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func TestStatusPinTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusPinTestSuite))
+}
@@ -38,6 +38,12 @@ type Processor interface {
 	Create(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.Status, gtserror.WithCode)
 	// Delete processes the delete of a given status, returning the deleted status if the delete goes through.
 	Delete(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// Edit processes the edit of a given status, returning the edited status if the edit goes through.
+	Edit(ctx context.Context, account *gtsmodel.Account, targetStatusID string, form *apimodel.StatusEditRequest) (*apimodel.Status, gtserror.WithCode)
+	// History returns the edit history of the given status, from the original revision to the current one.
+	History(ctx context.Context, account *gtsmodel.Account, targetStatusID string) ([]*apimodel.StatusEdit, gtserror.WithCode)
+	// Source returns the plain-text source of the given status, for editing it.
+	Source(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.StatusSource, gtserror.WithCode)
 	// Fave processes the faving of a given status, returning the updated status if the fave goes through.
 	Fave(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// Boost processes the boost/reblog of a given status, returning the newly-created boost if all is well.
@@ -58,6 +64,14 @@ type Processor interface {
 	Bookmark(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// Removes a bookmark for a status
 	Unbookmark(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// Mute mutes the thread that the given status is part of, so that notifications will no longer be created for it.
+	Mute(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// Unmute undoes a mute of the thread that the given status is part of.
+	Unmute(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// Pin pins the given status to the profile of the account that owns it.
+	Pin(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// Unpin removes the given status from the profile of the account that owns it.
+	Unpin(ctx context.Context, account *gtsmodel.Account, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 
 	/*
 		PROCESSING UTILS
@@ -66,6 +80,7 @@ type Processor interface {
 	ProcessVisibility(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultVis gtsmodel.Visibility, status *gtsmodel.Status) error
 	ProcessReplyToID(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, thisAccountID string, status *gtsmodel.Status) gtserror.WithCode
 	ProcessMediaIDs(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, thisAccountID string, status *gtsmodel.Status) gtserror.WithCode
+	ProcessPoll(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, status *gtsmodel.Status) gtserror.WithCode
 	ProcessLanguage(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultLanguage string, status *gtsmodel.Status) error
 	ProcessContent(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountID string, status *gtsmodel.Status) error
 }
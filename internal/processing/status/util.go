@@ -22,12 +22,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 )
 
@@ -179,7 +182,7 @@ func (p *processor) ProcessMediaIDs(ctx context.Context, form *apimodel.Advanced
 			return gtserror.NewErrorBadRequest(err, err.Error())
 		}
 
-		if attachment.StatusID != "" || attachment.ScheduledStatusID != "" {
+		if (attachment.StatusID != "" && attachment.StatusID != status.ID) || attachment.ScheduledStatusID != "" {
 			err = fmt.Errorf("ProcessMediaIDs: media with id %s is already attached to a status", mediaID)
 			return gtserror.NewErrorBadRequest(err, err.Error())
 		}
@@ -199,6 +202,45 @@ func (p *processor) ProcessMediaIDs(ctx context.Context, form *apimodel.Advanced
 	return nil
 }
 
+func (p *processor) ProcessPoll(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, status *gtsmodel.Status) gtserror.WithCode {
+	if form.Poll == nil {
+		return nil
+	}
+
+	if maxOptions := config.GetStatusesPollMaxOptions(); len(form.Poll.Options) > maxOptions {
+		err := fmt.Errorf("too many poll options provided, %d provided but limit is %d", len(form.Poll.Options), maxOptions)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	options := make([]string, 0, len(form.Poll.Options))
+	for _, option := range form.Poll.Options {
+		options = append(options, text.SanitizePlaintext(option))
+	}
+
+	multiple := form.Poll.Multiple
+	hideCounts := form.Poll.HideTotals
+	now := time.Now()
+
+	poll := &gtsmodel.Poll{
+		ID:         id.NewULID(),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		StatusID:   status.ID,
+		Status:     status,
+		Multiple:   &multiple,
+		HideCounts: &hideCounts,
+		Options:    options,
+		Votes:      make([]int, len(options)),
+		ExpiresAt:  now.Add(time.Duration(form.Poll.ExpiresIn) * time.Second),
+	}
+
+	status.Poll = poll
+	status.PollID = poll.ID
+	status.ActivityStreamsType = ap.ActivityQuestion
+
+	return nil
+}
+
 func (p *processor) ProcessLanguage(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultLanguage string, status *gtsmodel.Status) error {
 	if form.Language != "" {
 		status.Language = form.Language
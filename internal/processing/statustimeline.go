@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
@@ -137,6 +138,122 @@ func StatusSkipInsertFunction() timeline.SkipInsertFunction {
 	}
 }
 
+// ListGrabFunction returns a function that satisfies the GrabFunction interface in internal/timeline,
+// for timelines keyed by list ID rather than by account ID.
+func ListGrabFunction(database db.DB) timeline.GrabFunction {
+	return func(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]timeline.Timelineable, bool, error) {
+		statuses, err := database.GetListTimeline(ctx, listID, maxID, sinceID, minID, limit)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, false, fmt.Errorf("listGrabFunction: error getting statuses from db: %s", err)
+		}
+
+		if len(statuses) == 0 {
+			return nil, true, nil // we just don't have enough statuses left in the db so return stop = true
+		}
+
+		items := make([]timeline.Timelineable, 0, len(statuses))
+		for _, s := range statuses {
+			items = append(items, s)
+		}
+
+		return items, false, nil
+	}
+}
+
+// ListFilterFunction returns a function that satisfies the FilterFunction interface in internal/timeline,
+// for timelines keyed by list ID rather than by account ID.
+func ListFilterFunction(database db.DB, filter visibility.Filter) timeline.FilterFunction {
+	return func(ctx context.Context, listID string, item timeline.Timelineable) (shouldIndex bool, err error) {
+		status, ok := item.(*gtsmodel.Status)
+		if !ok {
+			return false, errors.New("listFilterFunction: could not convert item to *gtsmodel.Status")
+		}
+
+		list, err := database.GetListByID(ctx, listID)
+		if err != nil {
+			return false, fmt.Errorf("listFilterFunction: error getting list with id %s", listID)
+		}
+
+		timelineable, err := listTimelineable(ctx, database, filter, list, status)
+		if err != nil {
+			log.Warnf("error checking listtimelineability of status %s for list %s: %s", status.ID, listID, err)
+		}
+
+		return timelineable, nil // we don't return the error here because we want to just skip this item if something goes wrong
+	}
+}
+
+// ListPrepareFunction returns a function that satisfies the PrepareFunction interface in internal/timeline,
+// for timelines keyed by list ID rather than by account ID.
+func ListPrepareFunction(database db.DB, tc typeutils.TypeConverter) timeline.PrepareFunction {
+	return func(ctx context.Context, listID string, itemID string) (timeline.Preparable, error) {
+		status, err := database.GetStatusByID(ctx, itemID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting status with id %s", itemID)
+		}
+
+		list, err := database.GetListByID(ctx, listID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting list with id %s", listID)
+		}
+
+		return tc.StatusToAPIStatus(ctx, status, list.Account)
+	}
+}
+
+// listTimelineable returns true if the given status should be shown in the given
+// list, taking account of both the list owner's view of the status and the list
+// replies policy.
+func listTimelineable(ctx context.Context, database db.DB, filter visibility.Filter, list *gtsmodel.List, status *gtsmodel.Status) (bool, error) {
+	// The list owner has to be able to see
+	// the status in their home timeline first.
+	timelineable, err := filter.StatusHometimelineable(ctx, status, list.Account)
+	if err != nil || !timelineable {
+		return false, err
+	}
+
+	if status.InReplyToAccountID == "" || status.InReplyToAccountID == status.AccountID || status.InReplyToAccountID == list.AccountID {
+		// Not a reply, or a reply to self or to the
+		// list owner; these are always shown.
+		return true, nil
+	}
+
+	switch list.RepliesPolicy {
+	case gtsmodel.RepliesPolicyNone:
+		// Don't show replies to anyone else.
+		return false, nil
+	case gtsmodel.RepliesPolicyList:
+		// Only show replies to other members of the list.
+		follow := &gtsmodel.Follow{}
+		if err := database.GetWhere(ctx, []db.Where{
+			{Key: "account_id", Value: list.AccountID},
+			{Key: "target_account_id", Value: status.InReplyToAccountID},
+		}, follow); err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		listEntries, err := database.GetListEntriesForFollowID(ctx, follow.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return false, err
+		}
+
+		for _, listEntry := range listEntries {
+			if listEntry.ListID == list.ID {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	default:
+		// Show replies to anyone the owner follows; this
+		// is already covered by the hometimelineable check.
+		return true, nil
+	}
+}
+
 func (p *processor) HomeTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode) {
 	preparedItems, err := p.statusTimelines.GetTimeline(ctx, authed.Account.ID, maxID, sinceID, minID, limit, local)
 	if err != nil {
@@ -149,18 +266,15 @@ func (p *processor) HomeTimelineGet(ctx context.Context, authed *oauth.Auth, max
 		return util.EmptyPageableResponse(), nil
 	}
 
-	items := []interface{}{}
-	nextMaxIDValue := ""
-	prevMinIDValue := ""
-	for i, item := range preparedItems {
-		if i == count-1 {
-			nextMaxIDValue = item.GetID()
-		}
+	// Paging IDs are taken from the unfiltered
+	// items, so that hidden statuses don't
+	// affect where the next/prev pages begin.
+	nextMaxIDValue := preparedItems[count-1].GetID()
+	prevMinIDValue := preparedItems[0].GetID()
 
-		if i == 0 {
-			prevMinIDValue = item.GetID()
-		}
-		items = append(items, item)
+	items, err := p.filterPreparedItems(ctx, authed.Account, preparedItems, gtsmodel.FilterContextHome)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
 	}
 
 	return util.PackagePageableResponse(util.PageableResponseParams{
@@ -172,6 +286,103 @@ func (p *processor) HomeTimelineGet(ctx context.Context, authed *oauth.Auth, max
 	})
 }
 
+func (p *processor) ListTimelineGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	// Ensure list exists + is owned by this account.
+	list, err := p.db.GetListByID(ctx, listID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if list.AccountID != authed.Account.ID {
+		err = fmt.Errorf("list with id %s does not belong to account %s", list.ID, authed.Account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	preparedItems, err := p.listTimelines.GetTimeline(ctx, listID, maxID, sinceID, minID, limit, false)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(preparedItems)
+
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	// Paging IDs are taken from the unfiltered
+	// items, so that hidden statuses don't
+	// affect where the next/prev pages begin.
+	nextMaxIDValue := preparedItems[count-1].GetID()
+	prevMinIDValue := preparedItems[0].GetID()
+
+	items, err := p.filterPreparedItems(ctx, authed.Account, preparedItems, gtsmodel.FilterContextHome)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/timelines/list/" + listID,
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
+// filterPreparedItems applies the given account's filters to the given prepared timeline
+// items in the given context, returning a slice of statuses ready to be packaged into a
+// pageable response. Statuses hidden by a filter are omitted.
+func (p *processor) filterPreparedItems(ctx context.Context, account *gtsmodel.Account, preparedItems []timeline.Preparable, filterContext gtsmodel.FilterContext) ([]interface{}, error) {
+	filters, err := p.db.GetFiltersForAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("filterPreparedItems: error getting filters for account %s: %w", account.ID, err)
+	}
+
+	items := make([]interface{}, 0, len(preparedItems))
+	for _, item := range preparedItems {
+		apiStatus, ok := item.(*apimodel.Status)
+		if !ok {
+			// Not a status; nothing to filter.
+			items = append(items, item)
+			continue
+		}
+
+		apiStatus, err = p.tc.ApplyFilters(ctx, apiStatus, filters, filterContext)
+		if err != nil {
+			if errors.Is(err, typeutils.ErrHideStatus) {
+				continue
+			}
+			return nil, fmt.Errorf("filterPreparedItems: error applying filters: %w", err)
+		}
+
+		items = append(items, apiStatus)
+	}
+
+	return items, nil
+}
+
+// filterStatusForAccount applies the filters owned by the account with the given ID to the given
+// status, in the given context. If the status should be hidden, false will be returned.
+func (p *processor) filterStatusForAccount(ctx context.Context, apiStatus *apimodel.Status, accountID string, filterContext gtsmodel.FilterContext) (*apimodel.Status, bool, error) {
+	filters, err := p.db.GetFiltersForAccountID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, false, fmt.Errorf("filterStatusForAccount: error getting filters for account %s: %w", accountID, err)
+	}
+
+	apiStatus, err = p.tc.ApplyFilters(ctx, apiStatus, filters, filterContext)
+	if err != nil {
+		if errors.Is(err, typeutils.ErrHideStatus) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("filterStatusForAccount: error applying filters: %w", err)
+	}
+
+	return apiStatus, true, nil
+}
+
 func (p *processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode) {
 	statuses, err := p.db.GetPublicTimeline(ctx, maxID, sinceID, minID, limit, local)
 	if err != nil {
@@ -217,6 +428,68 @@ func (p *processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, m
 	})
 }
 
+func (p *processor) TagTimelineGet(ctx context.Context, authed *oauth.Auth, tagName string, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode) {
+	name, ok := text.NormalizeHashtag(tagName)
+	if !ok {
+		err := fmt.Errorf("%s is not a valid hashtag", tagName)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.db.GetTagByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// nobody has used this tag yet
+			return util.EmptyPageableResponse(), nil
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !*tag.Listable {
+		// tag has been hidden by an admin
+		return util.EmptyPageableResponse(), nil
+	}
+
+	statuses, err := p.db.GetTagTimeline(ctx, tag.ID, maxID, sinceID, minID, limit, local)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			// there are just no entries left
+			return util.EmptyPageableResponse(), nil
+		}
+		// there's an actual error
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(statuses)
+
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	// Paging IDs are taken from the unfiltered
+	// statuses, so that hidden statuses don't
+	// affect where the next/prev pages begin.
+	nextMaxIDValue := statuses[count-1].ID
+	prevMinIDValue := statuses[0].ID
+
+	filtered, err := p.filterTagStatuses(ctx, authed, statuses)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	items := make([]interface{}, 0, len(filtered))
+	for _, item := range filtered {
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/timelines/tag/" + name,
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
 func (p *processor) FavedTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
 	statuses, nextMaxID, prevMinID, err := p.db.GetFavedTimeline(ctx, authed.Account.ID, maxID, minID, limit)
 	if err != nil {
@@ -252,6 +525,17 @@ func (p *processor) FavedTimelineGet(ctx context.Context, authed *oauth.Auth, ma
 }
 
 func (p *processor) filterPublicStatuses(ctx context.Context, authed *oauth.Auth, statuses []*gtsmodel.Status) ([]*apimodel.Status, error) {
+	var filters []*gtsmodel.Filter
+	if authed.Account != nil {
+		// the public timeline may be viewed without
+		// logging in, in which case there's no filters
+		var err error
+		filters, err = p.db.GetFiltersForAccountID(ctx, authed.Account.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("filterPublicStatuses: error getting filters for account %s: %w", authed.Account.ID, err)
+		}
+	}
+
 	apiStatuses := []*apimodel.Status{}
 	for _, s := range statuses {
 		targetAccount := &gtsmodel.Account{}
@@ -278,6 +562,57 @@ func (p *processor) filterPublicStatuses(ctx context.Context, authed *oauth.Auth
 			continue
 		}
 
+		apiStatus, err = p.tc.ApplyFilters(ctx, apiStatus, filters, gtsmodel.FilterContextPublic)
+		if err != nil {
+			if !errors.Is(err, typeutils.ErrHideStatus) {
+				log.Debugf("filterPublicStatuses: skipping status %s because of an error applying filters: %s", s.ID, err)
+			}
+			continue
+		}
+
+		apiStatuses = append(apiStatuses, apiStatus)
+	}
+
+	return apiStatuses, nil
+}
+
+// filterTagStatuses is like filterPublicStatuses, but since tag timelines
+// should also show replies, it only checks that each status is visible.
+func (p *processor) filterTagStatuses(ctx context.Context, authed *oauth.Auth, statuses []*gtsmodel.Status) ([]*apimodel.Status, error) {
+	var filters []*gtsmodel.Filter
+	if authed.Account != nil {
+		var err error
+		filters, err = p.db.GetFiltersForAccountID(ctx, authed.Account.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("filterTagStatuses: error getting filters for account %s: %w", authed.Account.ID, err)
+		}
+	}
+
+	apiStatuses := []*apimodel.Status{}
+	for _, s := range statuses {
+		visible, err := p.filter.StatusVisible(ctx, s, authed.Account)
+		if err != nil {
+			log.Debugf("filterTagStatuses: skipping status %s because of an error checking status visibility: %s", s.ID, err)
+			continue
+		}
+		if !visible {
+			continue
+		}
+
+		apiStatus, err := p.tc.StatusToAPIStatus(ctx, s, authed.Account)
+		if err != nil {
+			log.Debugf("filterTagStatuses: skipping status %s because it couldn't be converted to its api representation: %s", s.ID, err)
+			continue
+		}
+
+		apiStatus, err = p.tc.ApplyFilters(ctx, apiStatus, filters, gtsmodel.FilterContextPublic)
+		if err != nil {
+			if !errors.Is(err, typeutils.ErrHideStatus) {
+				log.Debugf("filterTagStatuses: skipping status %s because of an error applying filters: %s", s.ID, err)
+			}
+			continue
+		}
+
 		apiStatuses = append(apiStatuses, apiStatus)
 	}
 
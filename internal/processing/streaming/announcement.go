@@ -0,0 +1,61 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package streaming
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+)
+
+func (p *processor) StreamAnnouncement(a *apimodel.Announcement) error {
+	bytes, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("error marshalling announcement to json: %s", err)
+	}
+
+	if err := p.streamToAllAccounts(string(bytes), stream.EventTypeAnnouncement, []string{stream.TimelineHome}); err != nil {
+		return fmt.Errorf("one or more errors streaming announcement: %w", err)
+	}
+
+	return nil
+}
+
+func (p *processor) StreamAnnouncementReaction(r *apimodel.AnnouncementReaction) error {
+	bytes, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("error marshalling announcement reaction to json: %s", err)
+	}
+
+	if err := p.streamToAllAccounts(string(bytes), stream.EventTypeAnnouncementReaction, []string{stream.TimelineHome}); err != nil {
+		return fmt.Errorf("one or more errors streaming announcement reaction: %w", err)
+	}
+
+	return nil
+}
+
+func (p *processor) StreamAnnouncementDelete(announcementID string) error {
+	if err := p.streamToAllAccounts(announcementID, stream.EventTypeAnnouncementDelete, []string{stream.TimelineHome}); err != nil {
+		return fmt.Errorf("one or more errors streaming announcement delete: %w", err)
+	}
+
+	return nil
+}
@@ -22,8 +22,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"codeberg.org/gruf/go-kv"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -39,6 +41,23 @@ func (p *processor) OpenStreamForAccount(ctx context.Context, account *gtsmodel.
 	}...)
 	l.Debug("received open stream request")
 
+	// if this is a list stream, make sure the list
+	// exists and actually belongs to the requester
+	if listID := strings.TrimPrefix(streamTimeline, stream.TimelineList+":"); listID != streamTimeline {
+		list, err := p.db.GetListByID(ctx, listID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				return nil, gtserror.NewErrorNotFound(err)
+			}
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting list %s: %s", listID, err))
+		}
+
+		if list.AccountID != account.ID {
+			err := fmt.Errorf("list with id %s does not belong to account %s", listID, account.ID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+	}
+
 	// each stream needs a unique ID so we know to close it
 	streamID, err := id.NewRandomULID()
 	if err != nil {
@@ -20,35 +20,13 @@ package streaming
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 )
 
 func (p *processor) StreamDelete(statusID string) error {
-	errs := []string{}
-
-	// get all account IDs with open streams
-	accountIDs := []string{}
-	p.streamMap.Range(func(k interface{}, _ interface{}) bool {
-		key, ok := k.(string)
-		if !ok {
-			panic("streamMap key was not a string (account id)")
-		}
-
-		accountIDs = append(accountIDs, key)
-		return true
-	})
-
-	// stream the delete to every account
-	for _, accountID := range accountIDs {
-		if err := p.streamToAccount(statusID, stream.EventTypeDelete, stream.AllStatusTimelines, accountID); err != nil {
-			errs = append(errs, err.Error())
-		}
-	}
-
-	if len(errs) != 0 {
-		return fmt.Errorf("one or more errors streaming status delete: %s", strings.Join(errs, ";"))
+	if err := p.streamToAllAccounts(statusID, stream.EventTypeDelete, stream.AllStatusTimelines); err != nil {
+		return fmt.Errorf("one or more errors streaming status delete: %w", err)
 	}
 
 	return nil
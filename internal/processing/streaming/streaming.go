@@ -38,10 +38,20 @@ type Processor interface {
 	OpenStreamForAccount(ctx context.Context, account *gtsmodel.Account, timeline string) (*stream.Stream, gtserror.WithCode)
 	// StreamUpdateToAccount streams the given update to any open, appropriate streams belonging to the given account.
 	StreamUpdateToAccount(s *apimodel.Status, account *gtsmodel.Account, timeline string) error
+	// StreamStatusUpdateToAccount streams the given edited status to any open, appropriate streams belonging to the given account.
+	StreamStatusUpdateToAccount(s *apimodel.Status, account *gtsmodel.Account, timeline string) error
 	// StreamNotificationToAccount streams the given notification to any open, appropriate streams belonging to the given account.
 	StreamNotificationToAccount(n *apimodel.Notification, account *gtsmodel.Account) error
+	// StreamConversationToAccount streams the given updated conversation to any open direct streams belonging to the given account.
+	StreamConversationToAccount(c *apimodel.Conversation, account *gtsmodel.Account) error
 	// StreamDelete streams the delete of the given statusID to *ALL* open streams.
 	StreamDelete(statusID string) error
+	// StreamAnnouncement streams the given published or updated announcement to *ALL* open home timeline streams.
+	StreamAnnouncement(a *apimodel.Announcement) error
+	// StreamAnnouncementReaction streams the given changed announcement reaction to *ALL* open home timeline streams.
+	StreamAnnouncementReaction(r *apimodel.AnnouncementReaction) error
+	// StreamAnnouncementDelete streams the delete of the given announcementID to *ALL* open home timeline streams.
+	StreamAnnouncementDelete(announcementID string) error
 }
 
 type processor struct {
@@ -20,6 +20,7 @@ package streaming
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 )
@@ -47,9 +48,9 @@ func (p *processor) streamToAccount(payload string, event string, timelines []st
 		}
 
 		for _, t := range timelines {
-			if s.Timeline == string(t) {
+			if s.Timeline == string(t) || (t == stream.TimelineList && strings.HasPrefix(s.Timeline, stream.TimelineList+":")) {
 				s.Messages <- &stream.Message{
-					Stream:  []string{string(t)},
+					Stream:  messageStream(s.Timeline),
 					Event:   string(event),
 					Payload: payload,
 				}
@@ -59,3 +60,44 @@ func (p *processor) streamToAccount(payload string, event string, timelines []st
 
 	return nil
 }
+
+// streamToAllAccounts streams the given payload with the given event type to the streams currently open for every account.
+func (p *processor) streamToAllAccounts(payload string, event string, timelines []string) error {
+	errs := []string{}
+
+	// get all account IDs with open streams
+	accountIDs := []string{}
+	p.streamMap.Range(func(k interface{}, _ interface{}) bool {
+		key, ok := k.(string)
+		if !ok {
+			panic("streamMap key was not a string (account id)")
+		}
+
+		accountIDs = append(accountIDs, key)
+		return true
+	})
+
+	// stream the payload to every account
+	for _, accountID := range accountIDs {
+		if err := p.streamToAccount(payload, event, timelines, accountID); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) != 0 {
+		return errors.New(strings.Join(errs, ";"))
+	}
+
+	return nil
+}
+
+// messageStream returns the stream value to use in messages
+// delivered to a stream with the given timeline. For list
+// streams this is ["list", "<list id>"], to match the
+// Mastodon streaming API; for all others it's just the timeline.
+func messageStream(timeline string) []string {
+	if listID := strings.TrimPrefix(timeline, stream.TimelineList+":"); listID != timeline {
+		return []string{stream.TimelineList, listID}
+	}
+	return []string{timeline}
+}
@@ -0,0 +1,59 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) TagGet(ctx context.Context, authed *oauth.Auth, tagName string) (*apimodel.Tag, gtserror.WithCode) {
+	return p.tagsProcessor.Get(ctx, authed.Account, tagName)
+}
+
+func (p *processor) TagFollow(ctx context.Context, authed *oauth.Auth, tagName string) (*apimodel.Tag, gtserror.WithCode) {
+	return p.tagsProcessor.Follow(ctx, authed.Account, tagName)
+}
+
+func (p *processor) TagUnfollow(ctx context.Context, authed *oauth.Auth, tagName string) (*apimodel.Tag, gtserror.WithCode) {
+	return p.tagsProcessor.Unfollow(ctx, authed.Account, tagName)
+}
+
+func (p *processor) FollowedTagsGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.tagsProcessor.FollowedTagsGet(ctx, authed.Account, maxID, sinceID, minID, limit)
+}
+
+func (p *processor) FeaturedTagsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	return p.tagsProcessor.FeaturedTagsGet(ctx, authed.Account)
+}
+
+func (p *processor) FeaturedTagCreate(ctx context.Context, authed *oauth.Auth, tagName string) (*apimodel.FeaturedTag, gtserror.WithCode) {
+	return p.tagsProcessor.FeatureTag(ctx, authed.Account, tagName)
+}
+
+func (p *processor) FeaturedTagDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.tagsProcessor.UnfeatureTag(ctx, authed.Account, id)
+}
+
+func (p *processor) AccountFeaturedTagsGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	return p.tagsProcessor.AccountFeaturedTagsGet(ctx, authed.Account, targetAccountID)
+}
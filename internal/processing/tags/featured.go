@@ -0,0 +1,178 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+func (p *processor) FeaturedTagsGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	return p.apiFeaturedTags(ctx, account.ID)
+}
+
+func (p *processor) AccountFeaturedTagsGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	if _, err := p.db.GetAccountByID(ctx, targetAccountID); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(errors.New("account not found"))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("AccountFeaturedTagsGet: db error getting account: %w", err))
+	}
+
+	if requestingAccount != nil {
+		blocked, err := p.db.IsBlocked(ctx, requestingAccount.ID, targetAccountID, true)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("AccountFeaturedTagsGet: error checking block: %w", err))
+		}
+
+		if blocked {
+			// Don't show featured tags across blocks.
+			return []*apimodel.FeaturedTag{}, nil
+		}
+	}
+
+	return p.apiFeaturedTags(ctx, targetAccountID)
+}
+
+func (p *processor) FeatureTag(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.FeaturedTag, gtserror.WithCode) {
+	normalized, ok := text.NormalizeHashtag(name)
+	if !ok {
+		err := fmt.Errorf("%s is not a valid hashtag", name)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	featuredTags, err := p.db.GetAccountFeaturedTags(ctx, account.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeatureTag: error getting featured tags: %w", err))
+	}
+
+	if len(featuredTags) >= gtsmodel.FeaturedTagsMax {
+		err := fmt.Errorf("you can feature at most %d hashtags", gtsmodel.FeaturedTagsMax)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	tag, err := p.db.GetTagByName(ctx, normalized)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag == nil {
+		// Featuring a tag nobody has used on
+		// this instance yet is fine; just create
+		// the tag so we have something to point to.
+		tag, err = p.db.TagStringToTag(ctx, normalized, account.ID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if err := p.db.PutTag(ctx, tag); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if !*tag.Useable {
+		err = fmt.Errorf("tag %s is not useable", tag.Name)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	featuredTagID := id.NewULID()
+	if err := p.db.PutFeaturedTag(ctx, &gtsmodel.FeaturedTag{
+		ID:        featuredTagID,
+		AccountID: account.ID,
+		TagID:     tag.ID,
+	}); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = fmt.Errorf("tag %s is already featured", tag.Name)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Get the featured tag fresh from the db
+	// so that the status count + last status
+	// at fields are populated for the response.
+	featuredTag, err := p.db.GetFeaturedTag(ctx, featuredTagID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("FeatureTag: error getting featured tag: %w", err))
+	}
+
+	return p.apiFeaturedTag(ctx, featuredTag)
+}
+
+func (p *processor) UnfeatureTag(ctx context.Context, account *gtsmodel.Account, featuredTagID string) gtserror.WithCode {
+	featuredTag, err := p.db.GetFeaturedTag(ctx, featuredTagID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return gtserror.NewErrorNotFound(errors.New("featured tag not found"))
+		}
+		return gtserror.NewErrorInternalError(fmt.Errorf("UnfeatureTag: db error getting featured tag: %w", err))
+	}
+
+	if featuredTag.AccountID != account.ID {
+		// Pretend it doesn't exist.
+		return gtserror.NewErrorNotFound(errors.New("featured tag not found"))
+	}
+
+	if err := p.db.DeleteFeaturedTagByID(ctx, featuredTag.ID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("UnfeatureTag: db error deleting featured tag: %w", err))
+	}
+
+	return nil
+}
+
+// apiFeaturedTags is a shortcut to return the API
+// versions of all tags featured by the given account.
+func (p *processor) apiFeaturedTags(ctx context.Context, accountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	featuredTags, err := p.db.GetAccountFeaturedTags(ctx, accountID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting featured tags: %w", err))
+	}
+
+	apiFeaturedTags := make([]*apimodel.FeaturedTag, 0, len(featuredTags))
+	for _, featuredTag := range featuredTags {
+		apiFeaturedTag, errWithCode := p.apiFeaturedTag(ctx, featuredTag)
+		if errWithCode != nil {
+			log.Errorf("apiFeaturedTags: error converting featured tag %s: %s", featuredTag.ID, errWithCode)
+			continue
+		}
+
+		apiFeaturedTags = append(apiFeaturedTags, apiFeaturedTag)
+	}
+
+	return apiFeaturedTags, nil
+}
+
+// apiFeaturedTag is a shortcut to return the API version of the given featured tag.
+func (p *processor) apiFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) (*apimodel.FeaturedTag, gtserror.WithCode) {
+	apiFeaturedTag, err := p.tc.FeaturedTagToAPIFeaturedTag(ctx, featuredTag)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting featured tag to api: %w", err))
+	}
+
+	return &apiFeaturedTag, nil
+}
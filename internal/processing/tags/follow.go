@@ -0,0 +1,88 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+func (p *processor) Follow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode) {
+	normalized, ok := text.NormalizeHashtag(name)
+	if !ok {
+		err := fmt.Errorf("%s is not a valid hashtag", name)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.db.GetTagByName(ctx, normalized)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag == nil {
+		// Following a tag nobody has used on
+		// this instance yet is fine; just create
+		// the tag so we have something to point to.
+		tag, err = p.db.TagStringToTag(ctx, normalized, "")
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if err := p.db.PutTag(ctx, tag); err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if !*tag.Listable {
+		err = fmt.Errorf("tag %s is not listable", tag.Name)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.db.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		TagID:     tag.ID,
+	}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		// Already following is fine, anything else isn't.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiTag(ctx, tag, true)
+}
+
+func (p *processor) Unfollow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.db.DeleteFollowedTag(ctx, account.ID, tag.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiTag(ctx, tag, false)
+}
@@ -0,0 +1,94 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) Get(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	following := true
+	if _, err := p.db.GetFollowedTag(ctx, account.ID, tag.ID); err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		following = false
+	}
+
+	return p.apiTag(ctx, tag, following)
+}
+
+func (p *processor) FollowedTagsGet(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	followedTags, err := p.db.GetFollowedTags(ctx, account.ID, maxID, sinceID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("FollowedTagsGet: error getting followed tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(followedTags)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+
+	for i, followedTag := range followedTags {
+		// Set next + prev values before API converting,
+		// so caller can still page properly.
+		if i == count-1 {
+			nextMaxIDValue = followedTag.ID
+		}
+
+		if i == 0 {
+			prevMinIDValue = followedTag.ID
+		}
+
+		apiTag, errWithCode := p.apiTag(ctx, followedTag.Tag, true)
+		if errWithCode != nil {
+			log.Errorf("FollowedTagsGet: error converting followed tag %s: %s", followedTag.ID, errWithCode)
+			continue
+		}
+
+		items = append(items, apiTag)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/followed_tags",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
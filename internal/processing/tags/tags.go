@@ -0,0 +1,62 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tags
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+type Processor interface {
+	// Get returns the hashtag with the given name, including whether the given account follows it.
+	Get(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode)
+	// Follow makes the given account follow the hashtag with the given name, creating the tag if necessary.
+	Follow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode)
+	// Unfollow makes the given account stop following the hashtag with the given name.
+	Unfollow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode)
+	// FollowedTagsGet returns a pageable response of hashtags followed by the given account.
+	FollowedTagsGet(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// FeaturedTagsGet returns all hashtags featured on the profile of the given account.
+	FeaturedTagsGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FeaturedTag, gtserror.WithCode)
+	// AccountFeaturedTagsGet returns all hashtags featured on the profile of the target account, as seen by the
+	// requesting account. The requesting account may be nil.
+	AccountFeaturedTagsGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode)
+	// FeatureTag features the hashtag with the given name on the profile of the given account, creating the tag if necessary.
+	FeatureTag(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.FeaturedTag, gtserror.WithCode)
+	// UnfeatureTag removes the featured tag with the given id from the profile of the given account.
+	UnfeatureTag(ctx context.Context, account *gtsmodel.Account, featuredTagID string) gtserror.WithCode
+}
+
+type processor struct {
+	db db.DB
+	tc typeutils.TypeConverter
+}
+
+// New returns a new tags processor.
+func New(db db.DB, tc typeutils.TypeConverter) Processor {
+	return &processor{
+		db: db,
+		tc: tc,
+	}
+}
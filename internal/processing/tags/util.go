@@ -0,0 +1,71 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// getTag is a shortcut to normalize the given hashtag name and
+// get the tag from the database. Will return appropriate errors
+// so caller doesn't need to bother.
+func (p *processor) getTag(ctx context.Context, name string) (*gtsmodel.Tag, gtserror.WithCode) {
+	normalized, ok := text.NormalizeHashtag(name)
+	if !ok {
+		err := fmt.Errorf("%s is not a valid hashtag", name)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.db.GetTagByName(ctx, normalized)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Tag doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !*tag.Listable {
+		err = fmt.Errorf("tag %s is not listable", tag.Name)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return tag, nil
+}
+
+// apiTag is a shortcut to return the API version of the given
+// tag, with its following status set for the requesting account.
+func (p *processor) apiTag(ctx context.Context, tag *gtsmodel.Tag, following bool) (*apimodel.Tag, gtserror.WithCode) {
+	apiTag, err := p.tc.TagToAPITag(ctx, tag)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting tag to api: %w", err))
+	}
+	apiTag.Following = &following
+
+	return &apiTag, nil
+}
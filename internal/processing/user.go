@@ -31,6 +31,30 @@ func (p *processor) UserChangePassword(ctx context.Context, authed *oauth.Auth,
 	return p.userProcessor.ChangePassword(ctx, authed.User, form.OldPassword, form.NewPassword)
 }
 
+func (p *processor) UserChangeEmail(ctx context.Context, authed *oauth.Auth, form *apimodel.EmailChangeRequest) gtserror.WithCode {
+	return p.userProcessor.ChangeEmail(ctx, authed.User, form.Password, form.NewEmail)
+}
+
 func (p *processor) UserConfirmEmail(ctx context.Context, token string) (*gtsmodel.User, gtserror.WithCode) {
 	return p.userProcessor.ConfirmEmail(ctx, token)
 }
+
+func (p *processor) UserTwoFactorGet(ctx context.Context, authed *oauth.Auth) (*apimodel.TwoFactorStatus, gtserror.WithCode) {
+	return p.userProcessor.TwoFactorGet(ctx, authed.User)
+}
+
+func (p *processor) UserTwoFactorSetup(ctx context.Context, authed *oauth.Auth) (*apimodel.TwoFactorSetup, gtserror.WithCode) {
+	return p.userProcessor.TwoFactorSetup(ctx, authed.User)
+}
+
+func (p *processor) UserTwoFactorEnable(ctx context.Context, authed *oauth.Auth, form *apimodel.TwoFactorEnableRequest) (*apimodel.TwoFactorRecoveryCodes, gtserror.WithCode) {
+	return p.userProcessor.TwoFactorEnable(ctx, authed.User, form.Password, form.Code)
+}
+
+func (p *processor) UserTwoFactorDisable(ctx context.Context, authed *oauth.Auth, form *apimodel.TwoFactorDisableRequest) gtserror.WithCode {
+	return p.userProcessor.TwoFactorDisable(ctx, authed.User, form.Password)
+}
+
+func (p *processor) UserTwoFactorCheck(ctx context.Context, user *gtsmodel.User, code string) gtserror.WithCode {
+	return p.userProcessor.TwoFactorCheck(ctx, user, code)
+}
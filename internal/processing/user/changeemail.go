@@ -0,0 +1,88 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (p *processor) ChangeEmail(ctx context.Context, user *gtsmodel.User, password string, newEmail string) gtserror.WithCode {
+	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(password)); err != nil {
+		return gtserror.NewErrorUnauthorized(err, "password was incorrect")
+	}
+
+	if err := validate.Email(newEmail); err != nil {
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if newEmail == user.Email {
+		err := errors.New("new email address is the same as the current email address")
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	emailBlocked, err := p.emailDomains.Blocked(ctx, newEmail)
+	if err != nil {
+		return gtserror.NewErrorBadRequest(err)
+	}
+	if emailBlocked {
+		err := fmt.Errorf("email address %s is on a blocked domain", newEmail)
+		return gtserror.NewErrorUnprocessableEntity(err, "email addresses from this domain are not accepted on this instance")
+	}
+
+	// if the user is just asking for another confirmation
+	// email for the same address, there's no need to check
+	// availability, since the address is only taken by them
+	if newEmail != user.UnconfirmedEmail {
+		emailAvailable, err := p.db.IsEmailAvailable(ctx, newEmail)
+		if err != nil {
+			return gtserror.NewErrorBadRequest(err)
+		}
+		if !emailAvailable {
+			return gtserror.NewErrorConflict(fmt.Errorf("email address %s is not available", newEmail))
+		}
+	}
+
+	if user.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, user.AccountID)
+		if err != nil {
+			return gtserror.NewErrorInternalError(fmt.Errorf("ChangeEmail: error getting account %s: %w", user.AccountID, err))
+		}
+		user.Account = a
+	}
+
+	// the current email address stays in place until the
+	// new one has been confirmed via the emailed link
+	user.UnconfirmedEmail = newEmail
+	if err := p.db.UpdateUser(ctx, user, "unconfirmed_email"); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.SendConfirmEmail(ctx, user, user.Account.Username); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
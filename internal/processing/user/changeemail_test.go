@@ -0,0 +1,95 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ChangeEmailTestSuite struct {
+	UserStandardTestSuite
+}
+
+func (suite *ChangeEmailTestSuite) TestChangeEmailOK() {
+	user := suite.testUsers["local_account_1"]
+
+	errWithCode := suite.user.ChangeEmail(context.Background(), user, "password", "zork.new@example.org")
+	suite.NoError(errWithCode)
+
+	// a confirmation email should have been sent to the new address
+	email, ok := suite.sentEmails["zork.new@example.org"]
+	suite.True(ok)
+	suite.Contains(email, "the_mighty_zork")
+
+	// get user from the db again
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+
+	// the new address is unconfirmed, the old one still in place
+	suite.Equal("zork@example.org", dbUser.Email)
+	suite.Equal("zork.new@example.org", dbUser.UnconfirmedEmail)
+	suite.NotEmpty(dbUser.ConfirmationToken)
+}
+
+func (suite *ChangeEmailTestSuite) TestChangeEmailIncorrectPassword() {
+	user := suite.testUsers["local_account_1"]
+
+	errWithCode := suite.user.ChangeEmail(context.Background(), user, "ooooopsydoooopsy", "zork.new@example.org")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	suite.Equal("Unauthorized: password was incorrect", errWithCode.Safe())
+	suite.Empty(suite.sentEmails)
+}
+
+func (suite *ChangeEmailTestSuite) TestChangeEmailTaken() {
+	user := suite.testUsers["local_account_1"]
+
+	errWithCode := suite.user.ChangeEmail(context.Background(), user, "password", "admin@example.org")
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+	suite.Empty(suite.sentEmails)
+}
+
+func (suite *ChangeEmailTestSuite) TestChangeEmailBlockedDomain() {
+	user := suite.testUsers["local_account_1"]
+
+	errWithCode := suite.user.ChangeEmail(context.Background(), user, "password", "zork@spam-mail.org")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: email addresses from this domain are not accepted on this instance", errWithCode.Safe())
+	suite.Empty(suite.sentEmails)
+}
+
+func (suite *ChangeEmailTestSuite) TestChangeEmailBlockedMX() {
+	user := suite.testUsers["local_account_1"]
+
+	// sneaky-inbox.com isn't blocked itself, but its mail servers are
+	errWithCode := suite.user.ChangeEmail(context.Background(), user, "password", "zork@sneaky-inbox.com")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Empty(suite.sentEmails)
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	suite.Empty(dbUser.UnconfirmedEmail)
+}
+
+func TestChangeEmailTestSuite(t *testing.T) {
+	suite.Run(t, &ChangeEmailTestSuite{})
+}
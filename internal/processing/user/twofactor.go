@@ -0,0 +1,268 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image/png"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/qrcode"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// recoveryCodesCount is the number of recovery codes
+	// handed out when two-factor authentication is enabled.
+	recoveryCodesCount = 10
+	// recoveryCodeLength is the number of characters
+	// of each recovery code, not counting the dash.
+	recoveryCodeLength = 10
+	// qrCodeScale is the width in pixels of each module
+	// of the QR code shown when setting up two-factor auth.
+	qrCodeScale = 6
+)
+
+// recoveryCodeEncoding is lowercase base32 without padding,
+// which avoids characters that are easily mixed up.
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+func (p *processor) TwoFactorGet(ctx context.Context, user *gtsmodel.User) (*apimodel.TwoFactorStatus, gtserror.WithCode) {
+	status := &apimodel.TwoFactorStatus{
+		Enabled: !user.TwoFactorEnabledAt.IsZero(),
+	}
+
+	if status.Enabled {
+		status.EnabledAt = util.FormatISO8601(user.TwoFactorEnabledAt)
+		status.RecoveryCodesLeft = len(user.TwoFactorRecoveryCodes)
+	}
+
+	return status, nil
+}
+
+func (p *processor) TwoFactorSetup(ctx context.Context, user *gtsmodel.User) (*apimodel.TwoFactorSetup, gtserror.WithCode) {
+	if !user.TwoFactorEnabledAt.IsZero() {
+		err := errors.New("two-factor authentication is already enabled")
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	if user.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, user.AccountID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("TwoFactorSetup: error getting account %s: %w", user.AccountID, err))
+		}
+		user.Account = a
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("TwoFactorSetup: error generating secret: %w", err))
+	}
+
+	// any previous secret that was never confirmed
+	// is simply replaced; it's not enforced until
+	// the user proves they stored it by enabling
+	user.TwoFactorSecret = secret
+	if err := p.db.UpdateUser(ctx, user, "two_factor_secret"); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	uri := totp.URI(secret, config.GetHost(), user.Account.Username)
+	qrCode, err := qrCodeDataURL(uri)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("TwoFactorSetup: error drawing qr code: %w", err))
+	}
+
+	return &apimodel.TwoFactorSetup{
+		Secret: secret,
+		URI:    uri,
+		QRCode: qrCode,
+	}, nil
+}
+
+func (p *processor) TwoFactorEnable(ctx context.Context, user *gtsmodel.User, password string, code string) (*apimodel.TwoFactorRecoveryCodes, gtserror.WithCode) {
+	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(password)); err != nil {
+		return nil, gtserror.NewErrorUnauthorized(err, "password was incorrect")
+	}
+
+	if !user.TwoFactorEnabledAt.IsZero() {
+		err := errors.New("two-factor authentication is already enabled")
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	if user.TwoFactorSecret == "" {
+		err := errors.New("two-factor authentication has not been set up")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	step, ok := totp.ValidateStep(user.TwoFactorSecret, code, time.Now())
+	if !ok {
+		err := errors.New("two-factor code was incorrect")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	recoveryCodes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+	for i := 0; i < recoveryCodesCount; i++ {
+		recoveryCode, hash, err := newRecoveryCode()
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("TwoFactorEnable: error generating recovery code: %w", err))
+		}
+		recoveryCodes = append(recoveryCodes, recoveryCode)
+		hashes = append(hashes, hash)
+	}
+
+	user.TwoFactorEnabledAt = time.Now()
+	user.TwoFactorRecoveryCodes = hashes
+	user.TwoFactorLastUsedStep = step // the code just given can't be used to sign in
+	if err := p.db.UpdateUser(ctx, user, "two_factor_enabled_at", "two_factor_recovery_codes", "two_factor_last_used_step"); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.TwoFactorRecoveryCodes{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (p *processor) TwoFactorDisable(ctx context.Context, user *gtsmodel.User, password string) gtserror.WithCode {
+	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(password)); err != nil {
+		return gtserror.NewErrorUnauthorized(err, "password was incorrect")
+	}
+
+	if user.TwoFactorEnabledAt.IsZero() {
+		err := errors.New("two-factor authentication is not enabled")
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	user.TwoFactorSecret = ""
+	user.TwoFactorEnabledAt = time.Time{}
+	user.TwoFactorRecoveryCodes = nil
+	if err := p.db.UpdateUser(ctx, user, "two_factor_secret", "two_factor_enabled_at", "two_factor_recovery_codes"); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
+
+func (p *processor) TwoFactorCheck(ctx context.Context, user *gtsmodel.User, code string) gtserror.WithCode {
+	if user.TwoFactorEnabledAt.IsZero() {
+		// nothing to check
+		return nil
+	}
+
+	if step, ok := totp.ValidateStep(user.TwoFactorSecret, code, time.Now()); ok {
+		if step <= user.TwoFactorLastUsedStep {
+			// this code, or a later one, has been
+			// used already, so this may be a replay
+			err := fmt.Errorf("two-factor code for step %d was already used by user %s", step, user.ID)
+			return gtserror.NewErrorUnauthorized(err, "two-factor code was already used")
+		}
+
+		user.TwoFactorLastUsedStep = step
+		if err := p.db.UpdateUser(ctx, user, "two_factor_last_used_step"); err != nil {
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		return nil
+	}
+
+	// not a valid TOTP code, so try it as a recovery
+	// code instead; each of these can only be used once
+	normalized := normalizeRecoveryCode(code)
+	if len(normalized) == recoveryCodeLength {
+		for i, hash := range user.TwoFactorRecoveryCodes {
+			if bcrypt.CompareHashAndPassword([]byte(hash), []byte(normalized)) != nil {
+				continue
+			}
+
+			remaining := make([]string, 0, len(user.TwoFactorRecoveryCodes)-1)
+			remaining = append(remaining, user.TwoFactorRecoveryCodes[:i]...)
+			remaining = append(remaining, user.TwoFactorRecoveryCodes[i+1:]...)
+			user.TwoFactorRecoveryCodes = remaining
+			if err := p.db.UpdateUser(ctx, user, "two_factor_recovery_codes"); err != nil {
+				return gtserror.NewErrorInternalError(err)
+			}
+
+			return nil
+		}
+	}
+
+	err := fmt.Errorf("two-factor code was incorrect for user %s", user.ID)
+	return gtserror.NewErrorUnauthorized(err, "two-factor code was incorrect")
+}
+
+// newRecoveryCode returns a new random recovery code
+// formatted for display, eg., "abcde-fghij", and
+// the bcrypt hash of it to be stored in the database.
+func newRecoveryCode() (string, string, error) {
+	b := make([]byte, recoveryCodeLength*5/8)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	code := recoveryCodeEncoding.EncodeToString(b)
+	hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+	if err != nil {
+		return "", "", err
+	}
+
+	half := recoveryCodeLength / 2
+	return code[:half] + "-" + code[half:], string(hash), nil
+}
+
+// normalizeRecoveryCode strips the formatting of a recovery
+// code as typed in by a user, so it can be compared to a hash.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, code)
+}
+
+// qrCodeDataURL draws the given text as
+// a QR code, and returns it as a PNG data URL.
+func qrCodeDataURL(text string) (string, error) {
+	code, err := qrcode.Encode([]byte(text))
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, code.Image(qrCodeScale)); err != nil {
+		return "", err
+	}
+
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,237 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+)
+
+type TwoFactorTestSuite struct {
+	UserStandardTestSuite
+}
+
+// enable sets up and enables two-factor auth
+// for the given user, returning the secret and
+// the recovery codes.
+func (suite *TwoFactorTestSuite) enable(user *gtsmodel.User) (string, []string) {
+	setup, errWithCode := suite.user.TwoFactorSetup(context.Background(), user)
+	suite.NoError(errWithCode)
+
+	code, err := totp.Code(setup.Secret, time.Now())
+	suite.NoError(err)
+
+	recoveryCodes, errWithCode := suite.user.TwoFactorEnable(context.Background(), user, "password", code)
+	suite.NoError(errWithCode)
+
+	return setup.Secret, recoveryCodes.RecoveryCodes
+}
+
+func (suite *TwoFactorTestSuite) TestSetup() {
+	user := suite.testUsers["local_account_1"]
+
+	setup, errWithCode := suite.user.TwoFactorSetup(context.Background(), user)
+	suite.NoError(errWithCode)
+	suite.Len(setup.Secret, 32)
+	suite.Equal("otpauth://totp/the_mighty_zork?algorithm=SHA1&digits=6&issuer=localhost%3A8080&period=30&secret="+setup.Secret, setup.URI)
+	suite.True(strings.HasPrefix(setup.QRCode, "data:image/png;base64,"))
+
+	// the secret is stored, but not enabled yet
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	suite.Equal(setup.Secret, dbUser.TwoFactorSecret)
+	suite.Zero(dbUser.TwoFactorEnabledAt)
+
+	status, errWithCode := suite.user.TwoFactorGet(context.Background(), dbUser)
+	suite.NoError(errWithCode)
+	suite.False(status.Enabled)
+}
+
+func (suite *TwoFactorTestSuite) TestEnable() {
+	user := suite.testUsers["local_account_1"]
+
+	_, recoveryCodes := suite.enable(user)
+	suite.Len(recoveryCodes, 10)
+	suite.Regexp("^[a-z2-7]{5}-[a-z2-7]{5}$", recoveryCodes[0])
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	suite.NotZero(dbUser.TwoFactorEnabledAt)
+	suite.Len(dbUser.TwoFactorRecoveryCodes, 10)
+
+	status, errWithCode := suite.user.TwoFactorGet(context.Background(), dbUser)
+	suite.NoError(errWithCode)
+	suite.True(status.Enabled)
+	suite.NotEmpty(status.EnabledAt)
+	suite.Equal(10, status.RecoveryCodesLeft)
+
+	// can't set up again while enabled
+	_, errWithCode = suite.user.TwoFactorSetup(context.Background(), dbUser)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+}
+
+func (suite *TwoFactorTestSuite) TestEnableWrongCode() {
+	user := suite.testUsers["local_account_1"]
+
+	_, errWithCode := suite.user.TwoFactorSetup(context.Background(), user)
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.user.TwoFactorEnable(context.Background(), user, "password", "000000")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: two-factor code was incorrect", errWithCode.Safe())
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	suite.Zero(dbUser.TwoFactorEnabledAt)
+}
+
+func (suite *TwoFactorTestSuite) TestEnableWrongPassword() {
+	user := suite.testUsers["local_account_1"]
+
+	setup, errWithCode := suite.user.TwoFactorSetup(context.Background(), user)
+	suite.NoError(errWithCode)
+
+	code, err := totp.Code(setup.Secret, time.Now())
+	suite.NoError(err)
+
+	_, errWithCode = suite.user.TwoFactorEnable(context.Background(), user, "wrong password", code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	suite.Equal("Unauthorized: password was incorrect", errWithCode.Safe())
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	suite.Zero(dbUser.TwoFactorEnabledAt)
+}
+
+func (suite *TwoFactorTestSuite) TestEnableNotSetUp() {
+	user := suite.testUsers["local_account_1"]
+
+	_, errWithCode := suite.user.TwoFactorEnable(context.Background(), user, "password", "123456")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: two-factor authentication has not been set up", errWithCode.Safe())
+}
+
+func (suite *TwoFactorTestSuite) TestCheck() {
+	user := suite.testUsers["local_account_1"]
+
+	// anything goes when two-factor auth isn't enabled
+	suite.NoError(suite.user.TwoFactorCheck(context.Background(), user, ""))
+
+	secret, _ := suite.enable(user)
+
+	// the code used to enable two-factor auth was for the
+	// current period, so use the next one, within the skew
+	code, err := totp.Code(secret, time.Now().Add(totp.Period))
+	suite.NoError(err)
+	suite.NoError(suite.user.TwoFactorCheck(context.Background(), user, code))
+
+	errWithCode := suite.user.TwoFactorCheck(context.Background(), user, "")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	suite.Equal("Unauthorized: two-factor code was incorrect", errWithCode.Safe())
+}
+
+func (suite *TwoFactorTestSuite) TestCheckReplay() {
+	user := suite.testUsers["local_account_1"]
+
+	secret, _ := suite.enable(user)
+
+	// work from the period of the code used to enable two-factor
+	// auth, rather than time.Now(), in case a period has passed
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	enabledAt := time.Unix(dbUser.TwoFactorLastUsedStep*int64(totp.Period/time.Second), 0)
+
+	// the code used to enable two-factor auth can't be used to sign in
+	code, err := totp.Code(secret, enabledAt)
+	suite.NoError(err)
+	errWithCode := suite.user.TwoFactorCheck(context.Background(), dbUser, code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+	suite.Equal("Unauthorized: two-factor code was already used", errWithCode.Safe())
+
+	code, err = totp.Code(secret, enabledAt.Add(totp.Period))
+	suite.NoError(err)
+	suite.NoError(suite.user.TwoFactorCheck(context.Background(), dbUser, code))
+
+	// nor can a code be used twice, even by a user
+	// model that's been fetched from the db again
+	dbUser, err = suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	errWithCode = suite.user.TwoFactorCheck(context.Background(), dbUser, code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// and neither can an older one
+	code, err = totp.Code(secret, enabledAt)
+	suite.NoError(err)
+	errWithCode = suite.user.TwoFactorCheck(context.Background(), dbUser, code)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+}
+
+func (suite *TwoFactorTestSuite) TestCheckRecoveryCode() {
+	user := suite.testUsers["local_account_1"]
+
+	_, recoveryCodes := suite.enable(user)
+
+	// formatting of the code doesn't matter
+	recoveryCode := strings.ToUpper(strings.ReplaceAll(recoveryCodes[3], "-", " "))
+	suite.NoError(suite.user.TwoFactorCheck(context.Background(), user, recoveryCode))
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	suite.Len(dbUser.TwoFactorRecoveryCodes, 9)
+
+	// recovery codes can only be used once
+	errWithCode := suite.user.TwoFactorCheck(context.Background(), dbUser, recoveryCode)
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	// others still work
+	suite.NoError(suite.user.TwoFactorCheck(context.Background(), dbUser, recoveryCodes[4]))
+}
+
+func (suite *TwoFactorTestSuite) TestDisable() {
+	user := suite.testUsers["local_account_1"]
+
+	suite.enable(user)
+
+	errWithCode := suite.user.TwoFactorDisable(context.Background(), user, "wrong password")
+	suite.Equal(http.StatusUnauthorized, errWithCode.Code())
+
+	errWithCode = suite.user.TwoFactorDisable(context.Background(), user, "password")
+	suite.NoError(errWithCode)
+
+	dbUser, err := suite.db.GetUserByID(context.Background(), user.ID)
+	suite.NoError(err)
+	suite.Empty(dbUser.TwoFactorSecret)
+	suite.Zero(dbUser.TwoFactorEnabledAt)
+	suite.Empty(dbUser.TwoFactorRecoveryCodes)
+
+	// can't disable twice
+	errWithCode = suite.user.TwoFactorDisable(context.Background(), dbUser, "password")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func TestTwoFactorTestSuite(t *testing.T) {
+	suite.Run(t, &TwoFactorTestSuite{})
+}
@@ -21,8 +21,10 @@ package user
 import (
 	"context"
 
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/emaildomain"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -32,21 +34,41 @@ type Processor interface {
 	// ChangePassword changes the specified user's password from old => new,
 	// or returns an error if the new password is too weak, or the old password is incorrect.
 	ChangePassword(ctx context.Context, user *gtsmodel.User, oldPassword string, newPassword string) gtserror.WithCode
+	// ChangeEmail sets the specified user's unconfirmed email address to newEmail, and sends a confirmation email to it,
+	// or returns an error if the password is incorrect, or the new email address is invalid, taken, or on a blocked domain.
+	ChangeEmail(ctx context.Context, user *gtsmodel.User, password string, newEmail string) gtserror.WithCode
 	// SendConfirmEmail sends a 'confirm-your-email-address' type email to a user.
 	SendConfirmEmail(ctx context.Context, user *gtsmodel.User, username string) error
 	// ConfirmEmail confirms an email address using the given token.
 	ConfirmEmail(ctx context.Context, token string) (*gtsmodel.User, gtserror.WithCode)
+	// TwoFactorGet returns the two-factor authentication status of the given user.
+	TwoFactorGet(ctx context.Context, user *gtsmodel.User) (*apimodel.TwoFactorStatus, gtserror.WithCode)
+	// TwoFactorSetup generates a new TOTP secret for the given user, to be confirmed with TwoFactorEnable.
+	// It returns a conflict error if two-factor authentication is already enabled.
+	TwoFactorSetup(ctx context.Context, user *gtsmodel.User) (*apimodel.TwoFactorSetup, gtserror.WithCode)
+	// TwoFactorEnable enables two-factor authentication for the given user, if the password is correct and the code
+	// matches the secret generated by TwoFactorSetup, and returns a fresh set of one-time recovery codes.
+	TwoFactorEnable(ctx context.Context, user *gtsmodel.User, password string, code string) (*apimodel.TwoFactorRecoveryCodes, gtserror.WithCode)
+	// TwoFactorDisable disables two-factor authentication for the given user,
+	// or returns an error if the password is incorrect.
+	TwoFactorDisable(ctx context.Context, user *gtsmodel.User, password string) gtserror.WithCode
+	// TwoFactorCheck checks the second step of a password sign-in: the code must be either the current TOTP code,
+	// and newer than the last one accepted, or one of the unused recovery codes, which is then used up. If the user
+	// doesn't have two-factor authentication enabled, any code passes.
+	TwoFactorCheck(ctx context.Context, user *gtsmodel.User, code string) gtserror.WithCode
 }
 
 type processor struct {
-	emailSender email.Sender
-	db          db.DB
+	emailSender  email.Sender
+	emailDomains emaildomain.Checker
+	db           db.DB
 }
 
 // New returns a new user processor
-func New(db db.DB, emailSender email.Sender) Processor {
+func New(db db.DB, emailSender email.Sender, emailDomains emaildomain.Checker) Processor {
 	return &processor{
-		emailSender: emailSender,
-		db:          db,
+		emailSender:  emailSender,
+		emailDomains: emailDomains,
+		db:           db,
 	}
 }
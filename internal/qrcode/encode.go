@@ -0,0 +1,165 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package qrcode
+
+// bitBuffer is an append-only sequence of bits.
+type bitBuffer []bool
+
+// append appends the lowest n bits of value, most significant first.
+func (b *bitBuffer) append(value int, n int) {
+	for i := n - 1; i >= 0; i-- {
+		*b = append(*b, (value>>i)&1 == 1)
+	}
+}
+
+// encodeData returns the data codewords of the
+// given data in byte mode, including padding.
+func encodeData(version int, v versionInfo, data []byte) []byte {
+	capacity := v.dataCodewords() * 8
+
+	bits := bitBuffer{}
+	bits.append(0b0100, 4) // byte mode
+	bits.append(len(data), countBits(version))
+	for _, d := range data {
+		bits.append(int(d), 8)
+	}
+
+	// terminator of up to four zeros, then
+	// zeros up to the next byte boundary
+	terminator := capacity - len(bits)
+	if terminator > 4 {
+		terminator = 4
+	}
+	bits.append(0, terminator)
+	bits.append(0, (8-len(bits)%8)%8)
+
+	codewords := make([]byte, 0, v.dataCodewords())
+	for i := 0; i < len(bits); i += 8 {
+		var b byte
+		for j := 0; j < 8; j++ {
+			if bits[i+j] {
+				b |= 1 << (7 - j)
+			}
+		}
+		codewords = append(codewords, b)
+	}
+
+	// fill up the remaining capacity with alternating pad codewords
+	for pad := byte(0xEC); len(codewords) < v.dataCodewords(); pad ^= 0xEC ^ 0x11 {
+		codewords = append(codewords, pad)
+	}
+
+	return codewords
+}
+
+// addErrorCorrection splits the data codewords into blocks, computes
+// the error correction codewords of each block, and interleaves them
+// into the final sequence of codewords.
+func addErrorCorrection(v versionInfo, data []byte) []byte {
+	generator := rsGenerator(v.ecPerBlock)
+
+	dataBlocks := [][]byte{}
+	ecBlocks := [][]byte{}
+	for _, group := range v.groups {
+		for i := 0; i < group[0]; i++ {
+			block := data[:group[1]]
+			data = data[group[1]:]
+			dataBlocks = append(dataBlocks, block)
+			ecBlocks = append(ecBlocks, rsRemainder(block, generator))
+		}
+	}
+
+	result := []byte{}
+	result = append(result, interleave(dataBlocks)...)
+	result = append(result, interleave(ecBlocks)...)
+	return result
+}
+
+// interleave takes the first codeword of each block, then the
+// second of each block, and so on, skipping blocks that are shorter.
+func interleave(blocks [][]byte) []byte {
+	result := []byte{}
+	for i := 0; ; i++ {
+		added := false
+		for _, block := range blocks {
+			if i < len(block) {
+				result = append(result, block[i])
+				added = true
+			}
+		}
+		if !added {
+			return result
+		}
+	}
+}
+
+// gfMultiply multiplies two elements of the Galois field GF(2^8),
+// using the primitive polynomial x^8 + x^4 + x^3 + x^2 + 1 of QR codes.
+func gfMultiply(x byte, y byte) byte {
+	var z byte
+	for i := 7; i >= 0; i-- {
+		carry := z & 0x80
+		z <<= 1
+		if carry != 0 {
+			z ^= 0x1D
+		}
+		if (y>>i)&1 == 1 {
+			z ^= x
+		}
+	}
+	return z
+}
+
+// rsGenerator returns the coefficients of the Reed-Solomon generator
+// polynomial of the given degree, highest power first, excluding the
+// leading coefficient (which is always 1).
+func rsGenerator(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	// multiply together (x - r^i) for i
+	// from 0 to degree-1, where r = 0x02
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := 0; j < degree; j++ {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < degree {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+
+	return result
+}
+
+// rsRemainder returns the error correction codewords
+// of the given data, for the given generator polynomial.
+func rsRemainder(data []byte, generator []byte) []byte {
+	result := make([]byte, len(generator))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coefficient := range generator {
+			result[i] ^= gfMultiply(coefficient, factor)
+		}
+	}
+	return result
+}
@@ -0,0 +1,164 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package qrcode encodes text as QR codes (ISO/IEC 18004), using byte mode
+// and error correction level M. It only supports what GoToSocial needs, eg.,
+// for showing otpauth:// URIs to people setting up two-factor authentication.
+package qrcode
+
+import (
+	"errors"
+	"image"
+	"image/color"
+)
+
+// QuietZone is the number of light modules drawn
+// around the code by Image, as required by the spec.
+const QuietZone = 4
+
+// ErrTooLong is returned when the data doesn't fit in the largest supported version.
+var ErrTooLong = errors.New("qrcode: data too long")
+
+// versionInfo describes the error correction block
+// structure of one version, at error correction level M.
+type versionInfo struct {
+	ecPerBlock int       // error correction codewords in each block
+	groups     [2][2]int // number of blocks, data codewords per block
+	alignment  []int     // row/column centers of alignment patterns
+}
+
+// versions lists the supported versions (1 up to and
+// including 20), at error correction level M.
+var versions = []versionInfo{
+	{10, [2][2]int{{1, 16}}, nil},
+	{16, [2][2]int{{1, 28}}, []int{6, 18}},
+	{26, [2][2]int{{1, 44}}, []int{6, 22}},
+	{18, [2][2]int{{2, 32}}, []int{6, 26}},
+	{24, [2][2]int{{2, 43}}, []int{6, 30}},
+	{16, [2][2]int{{4, 27}}, []int{6, 34}},
+	{18, [2][2]int{{4, 31}}, []int{6, 22, 38}},
+	{22, [2][2]int{{2, 38}, {2, 39}}, []int{6, 24, 42}},
+	{22, [2][2]int{{3, 36}, {2, 37}}, []int{6, 26, 46}},
+	{26, [2][2]int{{4, 43}, {1, 44}}, []int{6, 28, 50}},
+	{30, [2][2]int{{1, 50}, {4, 51}}, []int{6, 30, 54}},
+	{22, [2][2]int{{6, 36}, {2, 37}}, []int{6, 32, 58}},
+	{22, [2][2]int{{8, 37}, {1, 38}}, []int{6, 34, 62}},
+	{24, [2][2]int{{4, 40}, {5, 41}}, []int{6, 26, 46, 66}},
+	{24, [2][2]int{{5, 41}, {5, 42}}, []int{6, 26, 48, 70}},
+	{28, [2][2]int{{7, 45}, {3, 46}}, []int{6, 26, 50, 74}},
+	{28, [2][2]int{{10, 46}, {1, 47}}, []int{6, 30, 54, 78}},
+	{26, [2][2]int{{9, 43}, {4, 44}}, []int{6, 30, 56, 82}},
+	{26, [2][2]int{{3, 44}, {11, 45}}, []int{6, 30, 58, 86}},
+	{26, [2][2]int{{3, 41}, {13, 42}}, []int{6, 34, 62, 90}},
+}
+
+// dataCodewords returns the total number of data codewords of this version.
+func (v versionInfo) dataCodewords() int {
+	return v.groups[0][0]*v.groups[0][1] + v.groups[1][0]*v.groups[1][1]
+}
+
+// Code is an encoded QR code.
+type Code struct {
+	// Size is the width and height of the code, in modules.
+	Size int
+	// Version of the code, from 1 to 20.
+	Version int
+
+	modules    [][]bool // true means dark
+	isFunction [][]bool // true for modules that aren't data
+}
+
+// Encode encodes the given data as a QR code, using the smallest
+// version that fits it. ErrTooLong is returned if nothing fits.
+func Encode(data []byte) (*Code, error) {
+	for i, v := range versions {
+		version := i + 1
+		if len(data) > byteCapacity(version, v) {
+			continue
+		}
+
+		c := newCode(version)
+		c.drawFunctionPatterns()
+		c.drawCodewords(addErrorCorrection(v, encodeData(version, v, data)))
+		c.applyBestMask()
+		return c, nil
+	}
+
+	return nil, ErrTooLong
+}
+
+// Dark returns true if the module at the given
+// column x and row y is dark. Coordinates outside
+// of the code are light, so callers needn't check.
+func (c *Code) Dark(x int, y int) bool {
+	if x < 0 || y < 0 || x >= c.Size || y >= c.Size {
+		return false
+	}
+	return c.modules[y][x]
+}
+
+// Image draws the code as a black and white image, with each module being
+// scale pixels wide, surrounded by a quiet zone of QuietZone modules.
+func (c *Code) Image(scale int) image.Image {
+	if scale < 1 {
+		scale = 1
+	}
+
+	size := (c.Size + 2*QuietZone) * scale
+	img := image.NewGray(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if c.Dark(x/scale-QuietZone, y/scale-QuietZone) {
+				img.SetGray(x, y, color.Gray{Y: 0})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+
+	return img
+}
+
+func newCode(version int) *Code {
+	size := version*4 + 17
+	c := &Code{
+		Size:       size,
+		Version:    version,
+		modules:    make([][]bool, size),
+		isFunction: make([][]bool, size),
+	}
+	for i := range c.modules {
+		c.modules[i] = make([]bool, size)
+		c.isFunction[i] = make([]bool, size)
+	}
+	return c
+}
+
+// byteCapacity returns how many bytes fit in the given version.
+func byteCapacity(version int, v versionInfo) int {
+	bits := v.dataCodewords()*8 - 4 - countBits(version)
+	return bits / 8
+}
+
+// countBits returns the length of the character count indicator of byte mode.
+func countBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+	return 16
+}
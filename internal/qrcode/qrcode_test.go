@@ -0,0 +1,96 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package qrcode
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type QRCodeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *QRCodeTestSuite) TestFormatBits() {
+	// values from the table in annex C of the spec
+	suite.Equal(0b101010000010010, formatBits(0))
+	suite.Equal(0b100101010100000, formatBits(7))
+}
+
+func (suite *QRCodeTestSuite) TestVersionBits() {
+	// values from the table in annex D of the spec
+	suite.Equal(0x07C94, versionBits(7))
+	suite.Equal(0x149A6, versionBits(20))
+}
+
+func (suite *QRCodeTestSuite) TestEncodeVersions() {
+	for length, version := range map[int]int{
+		1:   1,
+		14:  1,
+		15:  2,
+		106: 6,
+		107: 7,
+		666: 20,
+	} {
+		c, err := Encode([]byte(strings.Repeat("a", length)))
+		suite.NoError(err)
+		suite.Equal(version, c.Version, "length %d", length)
+		suite.Equal(version*4+17, c.Size)
+	}
+}
+
+func (suite *QRCodeTestSuite) TestEncodeTooLong() {
+	c, err := Encode([]byte(strings.Repeat("a", 667)))
+	suite.ErrorIs(err, ErrTooLong)
+	suite.Nil(c)
+}
+
+func (suite *QRCodeTestSuite) TestFinderPatterns() {
+	c, err := Encode([]byte("otpauth://totp/example.org:zork?secret=JBSWY3DPEHPK3PXP"))
+	suite.NoError(err)
+
+	// each finder is a 7x7 dark ring, a light ring,
+	// and a dark 3x3 center, with a light separator
+	for _, corner := range [][2]int{{0, 0}, {c.Size - 7, 0}, {0, c.Size - 7}} {
+		for dy := -1; dy <= 7; dy++ {
+			for dx := -1; dx <= 7; dx++ {
+				ring := max(abs(dx-3), abs(dy-3))
+				suite.Equal(ring != 2 && ring != 4, c.Dark(corner[0]+dx, corner[1]+dy))
+			}
+		}
+	}
+
+	// the dark module next to the bottom left finder
+	suite.True(c.Dark(8, c.Size-8))
+}
+
+func (suite *QRCodeTestSuite) TestImage() {
+	c, err := Encode([]byte("hello"))
+	suite.NoError(err)
+
+	img := c.Image(3)
+	suite.Equal((c.Size+2*QuietZone)*3, img.Bounds().Dx())
+	suite.Equal((c.Size+2*QuietZone)*3, img.Bounds().Dy())
+}
+
+func TestQRCodeTestSuite(t *testing.T) {
+	suite.Run(t, new(QRCodeTestSuite))
+}
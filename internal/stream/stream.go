@@ -27,6 +27,16 @@ const (
 	EventTypeUpdate string = "update"
 	// EventTypeDelete -- something should be deleted from a user
 	EventTypeDelete string = "delete"
+	// EventTypeStatusUpdate -- something in a user's timeline has been edited
+	EventTypeStatusUpdate string = "status.update"
+	// EventTypeConversation -- a direct message conversation has been updated
+	EventTypeConversation string = "conversation"
+	// EventTypeAnnouncement -- an instance announcement has been published or updated
+	EventTypeAnnouncement string = "announcement"
+	// EventTypeAnnouncementReaction -- the reactions to an instance announcement have changed
+	EventTypeAnnouncementReaction string = "announcement.reaction"
+	// EventTypeAnnouncementDelete -- an instance announcement has been deleted or unpublished
+	EventTypeAnnouncementDelete string = "announcement.delete"
 )
 
 const (
@@ -40,6 +50,11 @@ const (
 	TimelineNotifications string = "user:notification"
 	// TimelineDirect -- statuses sent to a user directly.
 	TimelineDirect string = "direct"
+	// TimelineList -- statuses for a user's list timeline.
+	//
+	// Streams for a specific list use this prefix, followed
+	// by a colon and the list ID, eg., "list:01H0G8E4Q2J3FE3JDWJVWEDCD1".
+	TimelineList string = "list"
 )
 
 // AllStatusTimelines contains all Timelines that a status could conceivably be delivered to -- useful for doing deletes.
@@ -48,6 +63,7 @@ var AllStatusTimelines = []string{
 	TimelinePublic,
 	TimelineHome,
 	TimelineDirect,
+	TimelineList,
 }
 
 // StreamsForAccount is a wrapper for the multiple streams that one account can have running at the same time.
@@ -0,0 +1,55 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package text
+
+import (
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeHashtag normalizes the given hashtag text by removing any leading
+// '#' symbol, and then composing characters + combining diacritics in the
+// text into single unicode characters, following Normalization Form C (NFC).
+//
+// This is specifically to avoid cases where visually-identical hashtags are
+// stored with different unicode representations (e.g. with combining
+// diacritics). It allows a tasteful number of combining diacritics to be used,
+// as long as they can be combined with parent characters to form regular letter
+// symbols.
+//
+// The returned bool indicates whether the result is a valid hashtag, ie., it
+// is not empty, not too long, and contains only permitted characters. Note
+// that case is preserved: the returned string is suitable for display, and
+// it is up to the caller to lowercase it for storage or comparison.
+func NormalizeHashtag(text string) (string, bool) {
+	normalized := norm.NFC.String(strings.TrimPrefix(text, "#"))
+	if normalized == "" {
+		return "", false
+	}
+
+	for i, r := range normalized {
+		if i >= maximumHashtagLength || !util.IsPermittedInHashtag(r) {
+			return "", false
+		}
+	}
+
+	return normalized, true
+}
@@ -0,0 +1,53 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+type NormalizeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *NormalizeTestSuite) TestNormalizeHashtag() {
+	for _, test := range []struct {
+		input      string
+		normalized string
+		ok         bool
+	}{
+		{input: "#hashtag", normalized: "hashtag", ok: true},
+		{input: "HashTag", normalized: "HashTag", ok: true},
+		{input: "#e\u0301te\u0301", normalized: "\u00e9t\u00e9", ok: true}, // combining accents are composed
+		{input: "#", normalized: "", ok: false},
+		{input: "#hash-tag", normalized: "", ok: false},
+		{input: "#ThisOneIsThirtyOneCharactersLong", normalized: "", ok: false},
+	} {
+		normalized, ok := text.NormalizeHashtag(test.input)
+		suite.Equal(test.ok, ok, test.input)
+		suite.Equal(test.normalized, normalized, test.input)
+	}
+}
+
+func TestNormalizeTestSuite(t *testing.T) {
+	suite.Run(t, new(NormalizeTestSuite))
+}
@@ -22,8 +22,6 @@ import (
 	"errors"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
-	"github.com/superseriousbusiness/gotosocial/internal/util"
-	"golang.org/x/text/unicode/norm"
 	"strings"
 )
 
@@ -87,20 +85,12 @@ func (r *customRenderer) replaceMention(text string) string {
 	return b.String()
 }
 
-// replaceMention takes a string in the form #HashedTag, and will normalize it before
+// replaceHashtag takes a string in the form #HashedTag, and will normalize it before
 // adding it to the db and turning it into HTML.
 func (r *customRenderer) replaceHashtag(text string) string {
-	// this normalization is specifically to avoid cases where visually-identical
-	// hashtags are stored with different unicode representations (e.g. with combining
-	// diacritics). It allows a tasteful number of combining diacritics to be used,
-	// as long as they can be combined with parent characters to form regular letter
-	// symbols.
-	normalized := norm.NFC.String(text[1:])
-
-	for i, r := range normalized {
-		if i >= maximumHashtagLength || !util.IsPermittedInHashtag(r) {
-			return text
-		}
+	normalized, ok := NormalizeHashtag(text)
+	if !ok {
+		return text
 	}
 
 	tag, err := r.f.db.TagStringToTag(r.ctx, normalized, r.accountID)
@@ -118,12 +108,18 @@ func (r *customRenderer) replaceHashtag(text string) string {
 		}
 	}
 	if !listed {
-		err = r.f.db.Put(r.ctx, tag)
+		err = r.f.db.PutTag(r.ctx, tag)
 		if err != nil {
 			if !errors.Is(err, db.ErrAlreadyExists) {
 				log.Errorf("error putting tags in db: %s", err)
 				return text
 			}
+
+			// tag already existed, so just
+			// mark it as having been used now
+			if err := r.f.db.UpdateTag(r.ctx, tag, "last_status_at"); err != nil {
+				log.Errorf("error updating tag in db: %s", err)
+			}
 		}
 		r.result.Tags = append(r.result.Tags, tag)
 	}
@@ -70,6 +70,8 @@ type Manager interface {
 	Remove(ctx context.Context, timelineAccountID string, itemID string) (int, error)
 	// WipeItemFromAllTimelines removes one item from the index and prepared items of all timelines
 	WipeItemFromAllTimelines(ctx context.Context, itemID string) error
+	// ReprepareItemInAllTimelines prepares again any prepared entries of the given item in all timelines.
+	ReprepareItemInAllTimelines(ctx context.Context, itemID string) error
 	// WipeStatusesFromAccountID removes all items by the given accountID from the timelineAccountID's timelines.
 	WipeItemsFromAccountID(ctx context.Context, timelineAccountID string, accountID string) error
 	// Start starts hourly cleanup jobs for this timeline manager.
@@ -243,6 +245,29 @@ func (m *manager) WipeItemFromAllTimelines(ctx context.Context, statusID string)
 	return err
 }
 
+func (m *manager) ReprepareItemInAllTimelines(ctx context.Context, itemID string) error {
+	errors := []string{}
+	m.accountTimelines.Range(func(k interface{}, i interface{}) bool {
+		t, ok := i.(Timeline)
+		if !ok {
+			panic("couldn't parse entry as Timeline, this should never happen so panic")
+		}
+
+		if _, err := t.Reprepare(ctx, itemID); err != nil {
+			errors = append(errors, err.Error())
+		}
+
+		return true
+	})
+
+	var err error
+	if len(errors) > 0 {
+		err = fmt.Errorf("one or more errors repreparing item %s in all timelines: %s", itemID, strings.Join(errors, ";"))
+	}
+
+	return err
+}
+
 func (m *manager) WipeItemsFromAccountID(ctx context.Context, timelineAccountID string, accountID string) error {
 	t, err := m.getOrCreateTimeline(ctx, timelineAccountID)
 	if err != nil {
@@ -239,6 +239,45 @@ func (t *timeline) prepare(ctx context.Context, itemID string) error {
 	return t.preparedItems.insertPrepared(ctx, preparedItemsEntry)
 }
 
+func (t *timeline) Reprepare(ctx context.Context, itemID string) (int, error) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.preparedItems == nil || t.preparedItems.data == nil {
+		// nothing prepared yet so nothing to do
+		return 0, nil
+	}
+
+	var reprepared int
+	for e := t.preparedItems.data.Front(); e != nil; e = e.Next() {
+		entry, ok := e.Value.(*preparedItemsEntry)
+		if !ok {
+			return reprepared, errors.New("Reprepare: could not parse e as a preparedItemsEntry")
+		}
+
+		if entry.itemID != itemID && entry.boostOfID != itemID {
+			continue
+		}
+
+		// prepare the entry again (boosts included, since
+		// they wrap the item), and swap in the new version
+		prepared, err := t.prepareFunction(ctx, t.accountID, entry.itemID)
+		if err != nil {
+			if err == db.ErrNoEntries {
+				// the item doesn't exist anymore,
+				// it will be removed elsewhere
+				continue
+			}
+			return reprepared, fmt.Errorf("Reprepare: error preparing item with id %s: %s", entry.itemID, err)
+		}
+
+		entry.prepared = prepared
+		reprepared++
+	}
+
+	return reprepared, nil
+}
+
 // oldestPreparedItemID returns the id of the rearmost (ie., the oldest) prepared item, or an error if something goes wrong.
 // If nothing goes wrong but there's no oldest item, an empty string will be returned so make sure to check for this.
 func (t *timeline) oldestPreparedItemID(ctx context.Context) (string, error) {
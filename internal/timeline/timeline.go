@@ -123,6 +123,11 @@ type Timeline interface {
 	//
 	// The returned int indicates the amount of entries that were removed.
 	Remove(ctx context.Context, itemID string) (int, error)
+	// Reprepare prepares again any prepared entries of the item with the given ID, or of boosts of it,
+	// so that they reflect the latest version of the item (eg., after a status has been edited).
+	//
+	// The returned int indicates the amount of entries that were prepared again.
+	Reprepare(ctx context.Context, itemID string) (int, error)
 	// RemoveAllBy removes all items by the given accountID, from both the index and prepared items.
 	//
 	// The returned int indicates the amount of entries that were removed.
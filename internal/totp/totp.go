@@ -0,0 +1,176 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package totp implements time-based one-time passwords as described in
+// RFC 6238, using the defaults that authenticator apps expect: HMAC-SHA1,
+// 6 digits and a 30 second period.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505 -- RFC 6238 and authenticator apps use HMAC-SHA1
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the number of digits in a code.
+	Digits = 6
+	// Period is how long one code is valid for.
+	Period = 30 * time.Second
+	// Skew is how many periods before and after the current
+	// one are also accepted, to allow for clock drift and for
+	// people who take a while to type the code in.
+	Skew = 1
+
+	secretLength = 20 // 160 bits, as recommended by RFC 4226
+)
+
+// secretEncoding is the encoding used for secrets: base32 without padding,
+// which is what authenticator apps expect in provisioning URIs.
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret returns a new random secret, encoded as base32.
+func NewSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("NewSecret: error reading random bytes: %w", err)
+	}
+	return secretEncoding.EncodeToString(b), nil
+}
+
+// Code returns the code for the given base32 secret at the given time.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return code(key, step(t)), nil
+}
+
+// Validate returns true if the given code is valid for the given base32
+// secret at the given time. Codes of the previous and next periods are
+// accepted as well, see Skew. Spaces in the code are ignored.
+func Validate(secret string, passcode string, t time.Time) bool {
+	_, valid := ValidateStep(secret, passcode, t)
+	return valid
+}
+
+// ValidateStep is like Validate, but also returns the step (the number of
+// periods since the unix epoch) that the code was valid for. Callers can
+// store this, and reject codes for the same or an earlier step, so that
+// a code can't be used twice.
+func ValidateStep(secret string, passcode string, t time.Time) (int64, bool) {
+	passcode = strings.ReplaceAll(passcode, " ", "")
+	if len(passcode) != Digits {
+		return 0, false
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	var (
+		matched int64
+		valid   bool
+	)
+	current := step(t)
+	for i := current - Skew; i <= current+Skew; i++ {
+		// don't stop at the first match, so that checking
+		// a code always takes the same amount of time
+		if subtle.ConstantTimeCompare([]byte(code(key, i)), []byte(passcode)) == 1 {
+			matched = i
+			valid = true
+		}
+	}
+
+	return matched, valid
+}
+
+// URI returns an otpauth:// provisioning URI for the given base32 secret,
+// which authenticator apps can import, usually by scanning it as a QR code.
+// The issuer is shown in the app as the service the code is for, and the
+// account name tells apart different accounts with the same issuer.
+func URI(secret string, issuer string, accountName string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(Digits))
+	query.Set("period", fmt.Sprint(int(Period/time.Second)))
+
+	// the label may only contain a colon to separate the
+	// issuer from the account name, so leave the issuer out
+	// of it if it has a colon of its own (eg., a host with a
+	// port); apps fall back to the issuer parameter then
+	label := accountName
+	if !strings.Contains(issuer, ":") {
+		label = issuer + ":" + accountName
+	}
+
+	u := &url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + label,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := secretEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return nil, fmt.Errorf("decodeSecret: error decoding secret: %w", err)
+	}
+	return key, nil
+}
+
+// step returns the number of periods since the unix epoch at t.
+func step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// code implements the HOTP algorithm of RFC 4226
+// for the given key and counter value.
+func code(key []byte, counter int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", Digits, value%mod)
+}
@@ -0,0 +1,111 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package totp_test
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/totp"
+)
+
+type TOTPTestSuite struct {
+	suite.Suite
+}
+
+// rfcSecret is the SHA1 seed of the RFC 6238 test vectors, as base32.
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func (suite *TOTPTestSuite) TestCodeRFCVectors() {
+	// RFC 6238 appendix B, truncated to 6 digits
+	for unix, expected := range map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	} {
+		code, err := totp.Code(rfcSecret, time.Unix(unix, 0))
+		suite.NoError(err)
+		suite.Equal(expected, code, "time %d", unix)
+	}
+}
+
+func (suite *TOTPTestSuite) TestValidate() {
+	secret, err := totp.NewSecret()
+	suite.NoError(err)
+	suite.Len(secret, 32)
+
+	now := time.Now()
+	code, err := totp.Code(secret, now)
+	suite.NoError(err)
+
+	suite.True(totp.Validate(secret, code, now))
+	suite.True(totp.Validate(secret, code[:3]+" "+code[3:], now))
+
+	// one period of skew either way is fine
+	suite.True(totp.Validate(secret, code, now.Add(totp.Period)))
+	suite.True(totp.Validate(secret, code, now.Add(-totp.Period)))
+
+	// more isn't
+	suite.False(totp.Validate(secret, code, now.Add(3*totp.Period)))
+	suite.False(totp.Validate(secret, code, now.Add(-3*totp.Period)))
+
+	suite.False(totp.Validate(secret, "", now))
+	suite.False(totp.Validate(secret, "12345", now))
+	suite.False(totp.Validate("not base32!", code, now))
+}
+
+func (suite *TOTPTestSuite) TestURI() {
+	uri := totp.URI("JBSWY3DPEHPK3PXP", "example.org", "the_mighty_zork")
+	suite.Equal("otpauth://totp/example.org:the_mighty_zork?algorithm=SHA1&digits=6&issuer=example.org&period=30&secret=JBSWY3DPEHPK3PXP", uri)
+}
+
+func (suite *TOTPTestSuite) TestURIIssuerWithColon() {
+	// only the issuer parameter can contain a colon
+	uri := totp.URI("JBSWY3DPEHPK3PXP", "localhost:8080", "the_mighty_zork")
+	suite.Equal("otpauth://totp/the_mighty_zork?algorithm=SHA1&digits=6&issuer=localhost%3A8080&period=30&secret=JBSWY3DPEHPK3PXP", uri)
+}
+
+func (suite *TOTPTestSuite) TestValidateStep() {
+	secret, err := totp.NewSecret()
+	suite.NoError(err)
+
+	now := time.Now()
+	code, err := totp.Code(secret, now)
+	suite.NoError(err)
+
+	// the step is that of the code, not of the time it's checked at
+	nowStep := now.Unix() / int64(totp.Period/time.Second)
+	for _, t := range []time.Time{now, now.Add(totp.Period), now.Add(-totp.Period)} {
+		step, ok := totp.ValidateStep(secret, code, t)
+		suite.True(ok)
+		suite.Equal(nowStep, step)
+	}
+
+	_, ok := totp.ValidateStep(secret, code, now.Add(3*totp.Period))
+	suite.False(ok)
+}
+
+func TestTOTPTestSuite(t *testing.T) {
+	suite.Run(t, new(TOTPTestSuite))
+}
@@ -115,6 +115,15 @@ func (i *importer) instanceDecode(e transmodel.Entry) (*transmodel.Instance, err
 	return inst, nil
 }
 
+func (i *importer) inviteDecode(e transmodel.Entry) (*transmodel.Invite, error) {
+	invite := &transmodel.Invite{}
+	if err := i.simpleDecode(e, invite); err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
 func (i *importer) userDecode(e transmodel.Entry) (*transmodel.User, error) {
 	u := &transmodel.User{}
 	if err := i.simpleDecode(e, u); err != nil {
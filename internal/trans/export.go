@@ -205,6 +205,23 @@ func (e *exporter) exportInstances(ctx context.Context, file *os.File) ([]*trans
 	return instances, nil
 }
 
+func (e *exporter) exportInvites(ctx context.Context, file *os.File) ([]*transmodel.Invite, error) {
+	invites := []*transmodel.Invite{}
+
+	if err := e.db.GetAll(ctx, &invites); err != nil {
+		return nil, fmt.Errorf("exportInvites: error selecting invites: %s", err)
+	}
+
+	for _, i := range invites {
+		i.Type = transmodel.TransInvite
+		if err := e.simpleEncode(ctx, file, i, i.ID); err != nil {
+			return nil, fmt.Errorf("exportInvites: error encoding invite: %s", err)
+		}
+	}
+
+	return invites, nil
+}
+
 func (e *exporter) exportUsers(ctx context.Context, file *os.File) ([]*transmodel.User, error) {
 	users := []*transmodel.User{}
 
@@ -131,6 +131,11 @@ func (e *exporter) ExportMinimal(ctx context.Context, path string) error {
 		return fmt.Errorf("ExportMinimal: error exporting users: %s", err)
 	}
 
+	// export all invites
+	if _, err := e.exportInvites(ctx, file); err != nil {
+		return fmt.Errorf("ExportMinimal: error exporting invites: %s", err)
+	}
+
 	// export all instances
 	if _, err := e.exportInstances(ctx, file); err != nil {
 		return fmt.Errorf("ExportMinimal: error exporting instances: %s", err)
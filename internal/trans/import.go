@@ -126,6 +126,16 @@ func (i *importer) inputEntry(ctx context.Context, entry transmodel.Entry) error
 		}
 		log.Infof("inputEntry: added instance with id %s", inst.ID)
 		return nil
+	case transmodel.TransInvite:
+		invite, err := i.inviteDecode(entry)
+		if err != nil {
+			return fmt.Errorf("inputEntry: error decoding entry into invite: %s", err)
+		}
+		if err := i.putInDB(ctx, invite); err != nil {
+			return fmt.Errorf("inputEntry: error adding invite to database: %s", err)
+		}
+		log.Infof("inputEntry: added invite with id %s", invite.ID)
+		return nil
 	case transmodel.TransUser:
 		user, err := i.userDecode(entry)
 		if err != nil {
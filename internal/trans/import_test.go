@@ -88,6 +88,23 @@ func (suite *ImportMinimalTestSuite) TestImportMinimalOK() {
 	suite.NoError(err)
 	suite.NotEmpty(domainBlocks)
 
+	// we should have all the invites in the database
+	invites := []*gtsmodel.Invite{}
+	err = newDB.GetAll(ctx, &invites)
+	suite.NoError(err)
+	suite.Len(invites, len(suite.testInvites))
+
+	// compare test invite before + after
+	testInviteBefore := suite.testInvites["local_account_1_invite"]
+	testInviteAfter, err := newDB.GetInviteByID(ctx, testInviteBefore.ID)
+	suite.NoError(err)
+	suite.Equal(testInviteBefore.Code, testInviteAfter.Code)
+	suite.Equal(testInviteBefore.AccountID, testInviteAfter.AccountID)
+	suite.Equal(testInviteBefore.MaxUses, testInviteAfter.MaxUses)
+	suite.Equal(testInviteBefore.Uses, testInviteAfter.Uses)
+	suite.True(testInviteBefore.ExpiresAt.Equal(testInviteAfter.ExpiresAt))
+	suite.Equal(*testInviteBefore.SkipApproval, *testInviteAfter.SkipApproval)
+
 	// compare test account before + after
 	testAccountAfter, err := newDB.GetAccountByID(ctx, suite.testAccounts["local_account_1"].ID)
 	if err != nil {
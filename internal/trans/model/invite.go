@@ -0,0 +1,34 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package trans
+
+import "time"
+
+// Invite represents an invite code as serialized in an exported file.
+type Invite struct {
+	Type         Type       `json:"type" bun:"-"`
+	ID           string     `json:"id" bun:",nullzero"`
+	CreatedAt    *time.Time `json:"createdAt" bun:",nullzero"`
+	Code         string     `json:"code" bun:",nullzero"`
+	AccountID    string     `json:"accountID" bun:",nullzero"`
+	MaxUses      int        `json:"maxUses" bun:",notnull,default:0"`
+	Uses         int        `json:"uses" bun:",notnull,default:0"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty" bun:",nullzero"`
+	SkipApproval *bool      `json:"skipApproval" bun:",nullzero,notnull,default:false"`
+}
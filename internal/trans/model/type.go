@@ -33,6 +33,7 @@ const (
 	TransFollow           Type = "follow"
 	TransFollowRequest    Type = "followRequest"
 	TransInstance         Type = "instance"
+	TransInvite           Type = "invite"
 	TransUser             Type = "user"
 )
 
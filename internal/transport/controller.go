@@ -44,6 +44,16 @@ type Controller interface {
 
 	// NewTransportForUsername searches for account with username, and returns result of .NewTransport().
 	NewTransportForUsername(ctx context.Context, username string) (Transport, error)
+
+	// Start starts regularly retrying queued deliveries in the background.
+	Start()
+
+	// Stop stops retrying queued deliveries, cancelling any retries in progress.
+	Stop()
+
+	// RetryDeliveries attempts all queued deliveries that are due to be retried,
+	// and gives up on any that have been failing for longer than the configured max age.
+	RetryDeliveries(ctx context.Context) error
 }
 
 type controller struct {
@@ -54,6 +64,10 @@ type controller struct {
 	trspCache cache.Cache[string, *transport]
 	badHosts  cache.Cache[string, struct{}]
 	userAgent string
+
+	// cancels the context of
+	// the retry job, see Start
+	stopRetries context.CancelFunc
 }
 
 // NewController returns an implementation of the Controller interface for creating new transports
@@ -89,6 +103,12 @@ func NewController(db db.DB, federatingDB federatingdb.DB, clock pub.Clock, clie
 }
 
 func (c *controller) NewTransport(pubKeyID string, privkey *rsa.PrivateKey) (Transport, error) {
+	return c.newTransport(pubKeyID, privkey), nil
+}
+
+// newTransport returns a (possibly cached) transport
+// for the given public key ID and private key.
+func (c *controller) newTransport(pubKeyID string, privkey *rsa.PrivateKey) *transport {
 	// Generate public key string for cache key
 	//
 	// NOTE: it is safe to use the public key as the cache
@@ -100,7 +120,7 @@ func (c *controller) NewTransport(pubKeyID string, privkey *rsa.PrivateKey) (Tra
 	// First check for cached transport
 	transp, ok := c.trspCache.Get(pubStr)
 	if ok {
-		return transp, nil
+		return transp
 	}
 
 	// Create the transport
@@ -124,7 +144,7 @@ func (c *controller) NewTransport(pubKeyID string, privkey *rsa.PrivateKey) (Tra
 		}
 	}
 
-	return transp, nil
+	return transp
 }
 
 func (c *controller) NewTransportForUsername(ctx context.Context, username string) (Transport, error) {
@@ -21,14 +21,17 @@ package transport
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
 
+	errorsv2 "codeberg.org/gruf/go-errors/v2"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 )
 
 func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*url.URL) error {
@@ -39,7 +42,7 @@ func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*ur
 		wg.Add(1)
 		go func(r *url.URL) {
 			defer wg.Done()
-			if err := t.Deliver(ctx, b, r); err != nil {
+			if err := t.deliverOrQueue(ctx, b, r); err != nil {
 				errCh <- err
 			}
 		}(recipient)
@@ -92,8 +95,43 @@ func (t *transport) Deliver(ctx context.Context, b []byte, to *url.URL) error {
 
 	if code := resp.StatusCode; code != http.StatusOK &&
 		code != http.StatusCreated && code != http.StatusAccepted {
-		return fmt.Errorf("POST request to %s failed (%d): %s", urlStr, resp.StatusCode, resp.Status)
+		return &deliveryError{
+			url:    urlStr,
+			code:   resp.StatusCode,
+			status: resp.Status,
+		}
 	}
 
 	return nil
 }
+
+// deliveryError is returned by Deliver when the remote
+// server responds to a delivery with an unexpected status.
+type deliveryError struct {
+	url    string
+	code   int
+	status string
+}
+
+func (e *deliveryError) Error() string {
+	return fmt.Sprintf("POST request to %s failed (%d): %s", e.url, e.code, e.status)
+}
+
+// retryable returns whether a delivery that failed with the given error
+// might succeed if it's retried later. Deliveries that are rejected by the
+// remote server with a client error, or that can never be made, are not retried.
+func retryable(err error) bool {
+	var deliveryErr *deliveryError
+	if errors.As(err, &deliveryErr) {
+		code := deliveryErr.code
+		return code >= 500 ||
+			code == http.StatusRequestTimeout ||
+			code == http.StatusTooManyRequests
+	}
+
+	return !errorsv2.Is(err,
+		httpclient.ErrInvalidRequest,
+		httpclient.ErrBodyTooLarge,
+		httpclient.ErrReservedAddr,
+	)
+}
@@ -0,0 +1,279 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"codeberg.org/gruf/go-kv"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// backoff before the first retry of a failed
+	// delivery, doubled after each further attempt.
+	deliveryBaseBackoff = 5 * time.Minute
+
+	// max backoff between retries of a failed delivery.
+	deliveryMaxBackoff = 12 * time.Hour
+
+	// max no. queued deliveries to retry in one go.
+	deliveryRetryBatch = 500
+)
+
+func (c *controller) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.stopRetries = cancel
+
+	// Retry queued deliveries once per minute
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.RetryDeliveries(ctx); err != nil {
+					log.Errorf("error retrying deliveries: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+func (c *controller) Stop() {
+	if c.stopRetries != nil {
+		c.stopRetries()
+	}
+}
+
+func (c *controller) RetryDeliveries(ctx context.Context) error {
+	// Queued deliveries already back off between
+	// attempts, don't retry within an attempt too.
+	ctx = WithFastfail(ctx)
+
+	deliveries, err := c.db.GetDueDeliveries(ctx, c.clock.Now(), deliveryRetryBatch)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("RetryDeliveries: error getting due deliveries: %w", err)
+	}
+
+	// Group deliveries by inbox, so that deliveries
+	// to the same inbox are attempted one at a time
+	// (in order), while different inboxes are done
+	// concurrently.
+	byInbox := make(map[string][]*gtsmodel.Delivery)
+	for _, delivery := range deliveries {
+		byInbox[delivery.InboxURI] = append(byInbox[delivery.InboxURI], delivery)
+	}
+
+	wg := sync.WaitGroup{}
+	for _, inboxDeliveries := range byInbox {
+		wg.Add(1)
+		go func(inboxDeliveries []*gtsmodel.Delivery) {
+			defer wg.Done()
+			for _, delivery := range inboxDeliveries {
+				if err := c.retryDelivery(ctx, delivery); err != nil {
+					log.WithFields(kv.Fields{
+						{"deliveryID", delivery.ID},
+						{"inbox", delivery.InboxURI},
+					}...).Errorf("error retrying delivery: %v", err)
+				}
+			}
+		}(inboxDeliveries)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// retryDelivery retries the given queued delivery
+// using the key of the account that it was signed by.
+func (c *controller) retryDelivery(ctx context.Context, delivery *gtsmodel.Delivery) error {
+	account := &gtsmodel.Account{}
+	if err := c.db.GetWhere(ctx, []db.Where{{Key: "public_key_uri", Value: delivery.PubKeyID}}, account); err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("error getting account for public key %s: %w", delivery.PubKeyID, err)
+		}
+
+		// The account that this delivery belongs
+		// to doesn't exist anymore, so we can't
+		// sign it; nothing for it but to give up.
+		return c.db.DeleteDeliveryByID(ctx, delivery.ID)
+	}
+
+	t := c.newTransport(account.PublicKeyURI, account.PrivateKey)
+	return t.attemptDelivery(ctx, delivery)
+}
+
+// deliverOrQueue delivers b to the given inbox, queueing the delivery
+// to be retried later if it fails in a way that might be temporary.
+func (t *transport) deliverOrQueue(ctx context.Context, b []byte, to *url.URL) error {
+	// if the 'to' host is our own, there's nothing to deliver or keep track of
+	if to.Host == config.GetHost() || to.Host == config.GetAccountDomain() {
+		return nil
+	}
+
+	return t.attemptDelivery(ctx, &gtsmodel.Delivery{
+		CreatedAt: t.controller.clock.Now(),
+		PubKeyID:  t.pubKeyID,
+		InboxURI:  to.String(),
+		Payload:   b,
+	})
+}
+
+// attemptDelivery attempts the given delivery, which may either be new, or
+// already queued from an earlier attempt. If the attempt fails in a way that
+// might be temporary, the delivery is (re)queued with backoff; otherwise it is
+// removed from the queue. Deliveries to inboxes which have been marked as
+// unreachable are only actually attempted once every so often.
+func (t *transport) attemptDelivery(ctx context.Context, delivery *gtsmodel.Delivery) error {
+	c := t.controller
+	now := c.clock.Now()
+
+	l := log.WithFields(kv.Fields{
+		{"pubKeyID", delivery.PubKeyID},
+		{"inbox", delivery.InboxURI},
+	}...)
+
+	inbox, err := c.db.GetFailingInboxByURI(ctx, delivery.InboxURI)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("error getting failing inbox %s: %w", delivery.InboxURI, err)
+	}
+
+	if inbox != nil && inbox.Unreachable() {
+		if next := inbox.LastFailedAt.Add(config.GetInstanceDeliveryUnreachableRetry()); now.Before(next) {
+			// Inbox is unreachable and not due
+			// another attempt yet, so just wait.
+			delivery.NextAttemptAt = next
+			delivery.LastError = "inbox unreachable"
+			return c.queueDelivery(ctx, delivery)
+		}
+	}
+
+	inboxIRI, err := url.Parse(delivery.InboxURI)
+	if err != nil {
+		return fmt.Errorf("error parsing inbox uri %s: %w", delivery.InboxURI, err)
+	}
+
+	deliverErr := t.Deliver(ctx, delivery.Payload, inboxIRI)
+	delivery.Attempts++
+
+	if deliverErr == nil || !retryable(deliverErr) {
+		// The inbox was reached, even if it
+		// didn't accept the delivery; either
+		// way we're done with this delivery.
+		if inbox != nil {
+			if err := c.db.DeleteFailingInboxByURI(ctx, inbox.URI); err != nil {
+				l.Errorf("error deleting failing inbox: %v", err)
+			}
+		}
+
+		if delivery.ID != "" {
+			if err := c.db.DeleteDeliveryByID(ctx, delivery.ID); err != nil {
+				l.Errorf("error deleting delivery: %v", err)
+			}
+		}
+
+		return deliverErr
+	}
+
+	if err := c.inboxFailed(ctx, inbox, delivery.InboxURI, now); err != nil {
+		l.Errorf("error marking inbox as failing: %v", err)
+	}
+
+	backoff := deliveryBaseBackoff << (delivery.Attempts - 1)
+	if backoff <= 0 || backoff > deliveryMaxBackoff {
+		// cap the backoff (and
+		// watch for overflows)
+		backoff = deliveryMaxBackoff
+	}
+
+	delivery.NextAttemptAt = now.Add(backoff)
+	delivery.LastError = deliverErr.Error()
+	l.Warnf("delivery attempt %d failed, retrying at %s: %v", delivery.Attempts, delivery.NextAttemptAt, deliverErr)
+
+	return c.queueDelivery(ctx, delivery)
+}
+
+// queueDelivery puts or updates the given delivery in the queue, or drops it if
+// the next attempt would be beyond the configured max age of queued deliveries.
+func (c *controller) queueDelivery(ctx context.Context, delivery *gtsmodel.Delivery) error {
+	if expiry := delivery.CreatedAt.Add(config.GetInstanceDeliveryMaxAge()); delivery.NextAttemptAt.After(expiry) {
+		log.WithFields(kv.Fields{
+			{"pubKeyID", delivery.PubKeyID},
+			{"inbox", delivery.InboxURI},
+		}...).Warnf("giving up on delivery after %d attempts: %s", delivery.Attempts, delivery.LastError)
+
+		if delivery.ID == "" {
+			// never queued
+			return nil
+		}
+
+		return c.db.DeleteDeliveryByID(ctx, delivery.ID)
+	}
+
+	if delivery.ID == "" {
+		delivery.ID = id.NewULID()
+		return c.db.PutDelivery(ctx, delivery)
+	}
+
+	return c.db.UpdateDelivery(ctx, delivery, "attempts", "last_error", "next_attempt_at")
+}
+
+// inboxFailed records a failed delivery to the inbox with the given uri,
+// marking it as unreachable if deliveries to it have been failing for long
+// enough. The given inbox should be nil if the inbox wasn't failing already.
+func (c *controller) inboxFailed(ctx context.Context, inbox *gtsmodel.FailingInbox, uri string, now time.Time) error {
+	if inbox == nil {
+		err := c.db.PutFailingInbox(ctx, &gtsmodel.FailingInbox{
+			ID:           id.NewULID(),
+			CreatedAt:    now,
+			UpdatedAt:    now,
+			URI:          uri,
+			LastFailedAt: now,
+		})
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// a concurrent delivery got there first
+			return nil
+		}
+		return err
+	}
+
+	inbox.LastFailedAt = now
+	columns := []string{"last_failed_at"}
+
+	if !inbox.Unreachable() && now.Sub(inbox.CreatedAt) >= config.GetInstanceDeliveryUnreachableAfter() {
+		log.Warnf("deliveries to inbox %s have been failing since %s, marking it as unreachable", uri, inbox.CreatedAt)
+		inbox.UnreachableAt = now
+		columns = append(columns, "unreachable_at")
+	}
+
+	return c.db.UpdateFailingInbox(ctx, inbox, columns...)
+}
@@ -0,0 +1,253 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const testInbox = "http://example.org/users/some_user/inbox"
+
+type QueueTestSuite struct {
+	suite.Suite
+	db           db.DB
+	testAccounts map[string]*gtsmodel.Account
+
+	// status code the mock remote inbox responds with
+	responseCode int
+	// number of requests made to the mock remote inbox
+	requests int32
+}
+
+func (suite *QueueTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+	suite.db = testrig.NewTestDB()
+	suite.testAccounts = testrig.NewTestAccounts()
+	testrig.StandardDBSetup(suite.db, suite.testAccounts)
+
+	suite.responseCode = http.StatusOK
+	suite.requests = 0
+}
+
+func (suite *QueueTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+func (suite *QueueTestSuite) controller() transport.Controller {
+	return testrig.NewTestTransportController(testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&suite.requests, 1)
+		return &http.Response{
+			StatusCode: suite.responseCode,
+			Status:     http.StatusText(suite.responseCode),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	}, ""), suite.db, concurrency.NewWorkerPool[messages.FromFederator](-1, -1))
+}
+
+func (suite *QueueTestSuite) batchDeliver(controller transport.Controller) error {
+	ctx := transport.WithFastfail(context.Background())
+
+	t, err := controller.NewTransportForUsername(ctx, suite.testAccounts["local_account_1"].Username)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	inbox, _ := url.Parse(testInbox)
+	return t.BatchDeliver(ctx, []byte(`{"hello":"world"}`), []*url.URL{inbox})
+}
+
+func (suite *QueueTestSuite) queued() []*gtsmodel.Delivery {
+	deliveries, err := suite.db.GetDueDeliveries(context.Background(), time.Now().Add(24*time.Hour), 0)
+	if err != nil && err != db.ErrNoEntries {
+		suite.FailNow(err.Error())
+	}
+	return deliveries
+}
+
+func (suite *QueueTestSuite) TestDeliverOK() {
+	suite.NoError(suite.batchDeliver(suite.controller()))
+	suite.EqualValues(1, suite.requests)
+	suite.Empty(suite.queued())
+}
+
+func (suite *QueueTestSuite) TestDeliverRejected() {
+	suite.responseCode = http.StatusNotFound
+
+	// a permanent failure is an error, and is not retried
+	suite.Error(suite.batchDeliver(suite.controller()))
+	suite.Empty(suite.queued())
+
+	_, err := suite.db.GetFailingInboxByURI(context.Background(), testInbox)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *QueueTestSuite) TestDeliverQueuedAndRetried() {
+	ctx := context.Background()
+	controller := suite.controller()
+	suite.responseCode = http.StatusServiceUnavailable
+
+	// a temporary failure is not an error, the delivery is queued instead
+	suite.NoError(suite.batchDeliver(controller))
+
+	deliveries := suite.queued()
+	if !suite.Len(deliveries, 1) {
+		suite.FailNow("")
+	}
+	delivery := deliveries[0]
+	suite.Equal(testInbox, delivery.InboxURI)
+	suite.Equal(suite.testAccounts["local_account_1"].PublicKeyURI, delivery.PubKeyID)
+	suite.Equal(`{"hello":"world"}`, string(delivery.Payload))
+	suite.Equal(1, delivery.Attempts)
+	suite.WithinDuration(time.Now().Add(5*time.Minute), delivery.NextAttemptAt, time.Minute)
+
+	inbox, err := suite.db.GetFailingInboxByURI(ctx, testInbox)
+	suite.NoError(err)
+	suite.False(inbox.Unreachable())
+
+	// not due yet, so nothing happens
+	suite.NoError(controller.RetryDeliveries(ctx))
+	suite.EqualValues(1, suite.requests)
+
+	// make it due, and let the remote recover
+	delivery.NextAttemptAt = time.Now().Add(-time.Minute)
+	suite.NoError(suite.db.UpdateDelivery(ctx, delivery, "next_attempt_at"))
+	suite.responseCode = http.StatusOK
+
+	suite.NoError(controller.RetryDeliveries(ctx))
+	suite.EqualValues(2, suite.requests)
+	suite.Empty(suite.queued())
+
+	_, err = suite.db.GetFailingInboxByURI(ctx, testInbox)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *QueueTestSuite) TestRetryBacksOff() {
+	ctx := context.Background()
+	controller := suite.controller()
+	suite.responseCode = http.StatusServiceUnavailable
+
+	suite.NoError(suite.db.PutDelivery(ctx, &gtsmodel.Delivery{
+		ID:            "01GWBQ5N3QH2BK7MPX9MFWYC6Y",
+		CreatedAt:     time.Now().Add(-time.Hour),
+		PubKeyID:      suite.testAccounts["local_account_1"].PublicKeyURI,
+		InboxURI:      testInbox,
+		Payload:       []byte(`{"hello":"world"}`),
+		Attempts:      3,
+		NextAttemptAt: time.Now().Add(-time.Minute),
+	}))
+
+	suite.NoError(controller.RetryDeliveries(ctx))
+	suite.EqualValues(1, suite.requests)
+
+	deliveries := suite.queued()
+	if !suite.Len(deliveries, 1) {
+		suite.FailNow("")
+	}
+	suite.Equal(4, deliveries[0].Attempts)
+	suite.WithinDuration(time.Now().Add(40*time.Minute), deliveries[0].NextAttemptAt, time.Minute)
+	suite.Contains(deliveries[0].LastError, "Service Unavailable")
+}
+
+func (suite *QueueTestSuite) TestRetryGivesUp() {
+	ctx := context.Background()
+	controller := suite.controller()
+	suite.responseCode = http.StatusServiceUnavailable
+
+	// delivery is nearly at the max age of 48h
+	suite.NoError(suite.db.PutDelivery(ctx, &gtsmodel.Delivery{
+		ID:            "01GWBQ5N3QH2BK7MPX9MFWYC6Y",
+		CreatedAt:     time.Now().Add(-47 * time.Hour),
+		PubKeyID:      suite.testAccounts["local_account_1"].PublicKeyURI,
+		InboxURI:      testInbox,
+		Payload:       []byte(`{"hello":"world"}`),
+		Attempts:      10,
+		NextAttemptAt: time.Now().Add(-time.Minute),
+	}))
+
+	suite.NoError(controller.RetryDeliveries(ctx))
+	suite.EqualValues(1, suite.requests)
+	suite.Empty(suite.queued())
+}
+
+func (suite *QueueTestSuite) TestDeliverToUnreachableInbox() {
+	ctx := context.Background()
+	controller := suite.controller()
+	lastFailed := time.Now().Add(-time.Hour)
+
+	suite.NoError(suite.db.PutFailingInbox(ctx, &gtsmodel.FailingInbox{
+		ID:            "01GWBQEXZ7V3C0N9SZ8JK5QDKR",
+		CreatedAt:     time.Now().Add(-10 * 24 * time.Hour),
+		UpdatedAt:     lastFailed,
+		URI:           testInbox,
+		LastFailedAt:  lastFailed,
+		UnreachableAt: time.Now().Add(-3 * 24 * time.Hour),
+	}))
+
+	// no request is made, delivery is just queued for when the inbox is next tried
+	suite.NoError(suite.batchDeliver(controller))
+	suite.EqualValues(0, suite.requests)
+
+	deliveries := suite.queued()
+	if !suite.Len(deliveries, 1) {
+		suite.FailNow("")
+	}
+	suite.Equal(0, deliveries[0].Attempts)
+	suite.WithinDuration(lastFailed.Add(24*time.Hour), deliveries[0].NextAttemptAt, time.Second)
+}
+
+func (suite *QueueTestSuite) TestInboxMarkedUnreachable() {
+	ctx := context.Background()
+	controller := suite.controller()
+	suite.responseCode = http.StatusBadGateway
+
+	suite.NoError(suite.db.PutFailingInbox(ctx, &gtsmodel.FailingInbox{
+		ID:           "01GWBQEXZ7V3C0N9SZ8JK5QDKR",
+		CreatedAt:    time.Now().Add(-8 * 24 * time.Hour),
+		UpdatedAt:    time.Now().Add(-time.Hour),
+		URI:          testInbox,
+		LastFailedAt: time.Now().Add(-time.Hour),
+	}))
+
+	suite.NoError(suite.batchDeliver(controller))
+	suite.EqualValues(1, suite.requests)
+
+	inbox, err := suite.db.GetFailingInboxByURI(ctx, testInbox)
+	suite.NoError(err)
+	suite.True(inbox.Unreachable())
+}
+
+func TestQueueTestSuite(t *testing.T) {
+	suite.Run(t, &QueueTestSuite{})
+}
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
@@ -188,7 +189,18 @@ func (c *converter) ASRepresentationToAccount(ctx context.Context, accountable a
 
 	// TODO: FeaturedTagsURI
 
-	// TODO: alsoKnownAs
+	// alsoKnownAs
+	for _, uri := range ap.ExtractAlsoKnownAs(accountable) {
+		acct.AlsoKnownAsURIs = append(acct.AlsoKnownAsURIs, uri.String())
+	}
+
+	// movedTo
+	// We can only set this if we know the account moved to already.
+	if movedTo := ap.ExtractMovedTo(accountable); movedTo != nil {
+		if movedToAcct, err := c.db.GetAccountByURI(ctx, movedTo.String()); err == nil {
+			acct.MovedToAccountID = movedToAcct.ID
+		}
+	}
 
 	// publicKey
 	pkey, pkeyURL, err := ap.ExtractPublicKeyForOwner(accountable, uri)
@@ -297,6 +309,11 @@ func (c *converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 		status.UpdatedAt = published
 	}
 
+	// has this status been edited since it was published?
+	if updated := ap.ExtractUpdated(statusable); updated.After(status.CreatedAt) {
+		status.EditedAt = updated
+	}
+
 	// which account posted this status?
 	// if we don't know the account yet we can dereference it later
 	attributedTo, err := ap.ExtractAttributedTo(statusable)
@@ -366,9 +383,55 @@ func (c *converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	// ActivityStreamsType
 	status.ActivityStreamsType = statusable.GetTypeName()
 
+	// poll -- only Questions have these
+	if pollable, ok := statusable.(ap.Pollable); ok {
+		poll, err := c.ASQuestionToPoll(ctx, pollable)
+		if err != nil {
+			return nil, fmt.Errorf("ASStatusToStatus: error converting question to poll: %s", err)
+		}
+		status.Poll = poll
+	}
+
 	return status, nil
 }
 
+func (c *converter) ASQuestionToPoll(ctx context.Context, pollable ap.Pollable) (*gtsmodel.Poll, error) {
+	optionables, multiple := ap.ExtractPollOptionables(pollable)
+	if len(optionables) == 0 {
+		return nil, errors.New("ASQuestionToPoll: question had no oneOf or anyOf options")
+	}
+
+	options := make([]string, 0, len(optionables))
+	votes := make([]int, 0, len(optionables))
+	for _, optionable := range optionables {
+		name := ap.ExtractName(optionable)
+		if name == "" {
+			return nil, errors.New("ASQuestionToPoll: question option had no name")
+		}
+		options = append(options, name)
+		votes = append(votes, ap.ExtractPollOptionVotes(optionable))
+	}
+
+	hideCounts := false
+	poll := &gtsmodel.Poll{
+		Multiple:   &multiple,
+		HideCounts: &hideCounts,
+		Options:    options,
+		Votes:      votes,
+		Voters:     ap.ExtractVotersCount(pollable),
+		ExpiresAt:  ap.ExtractEndTime(pollable),
+		ClosedAt:   ap.ExtractClosed(pollable, time.Now()),
+	}
+
+	if poll.Voters == 0 && !multiple {
+		// Not all implementations send votersCount, but
+		// for single-choice polls it's just the total votes.
+		poll.Voters = poll.TotalVotes()
+	}
+
+	return poll, nil
+}
+
 func (c *converter) ASFollowToFollowRequest(ctx context.Context, followable ap.Followable) (*gtsmodel.FollowRequest, error) {
 	idProp := followable.GetJSONLDId()
 	if idProp == nil || !idProp.IsIRI() {
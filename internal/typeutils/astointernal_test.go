@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams"
@@ -124,6 +125,30 @@ func (suite *ASToInternalTestSuite) TestParsePublicStatusNoURL() {
 	suite.Equal("http://fossbros-anonymous.io/users/foss_satan/statuses/108138763199405167", status.URL)
 }
 
+func (suite *ASToInternalTestSuite) TestParseQuestion() {
+	t := suite.jsonToType(questionActivityJson)
+	rep, ok := t.(ap.Statusable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	status, err := suite.typeconverter.ASStatusToStatus(context.Background(), rep)
+	suite.NoError(err)
+
+	suite.Equal(ap.ActivityQuestion, status.ActivityStreamsType)
+	suite.Equal("<p>which is the best operating system?</p>", status.Content)
+
+	poll := status.Poll
+	suite.NotNil(poll)
+	suite.True(*poll.Multiple)
+	suite.False(*poll.HideCounts)
+	suite.Equal([]string{"linux", "also linux"}, poll.Options)
+	suite.Equal([]int{5, 4}, poll.Votes)
+	suite.Equal(7, poll.Voters)
+	suite.Equal("2023-03-11T12:00:00Z", poll.ExpiresAt.UTC().Format(time.RFC3339))
+	suite.False(poll.Closed())
+}
+
 func (suite *ASToInternalTestSuite) TestParseGargron() {
 	t := suite.jsonToType(gargronAsActivityJson)
 	rep, ok := t.(ap.Accountable)
@@ -53,6 +53,9 @@ type TypeConverter interface {
 	// something goes wrong. The returned account will be a bare minimum representation of the account. This function should be used
 	// when someone wants to view an account they've blocked.
 	AccountToAPIAccountBlocked(ctx context.Context, account *gtsmodel.Account) (*apimodel.Account, error)
+	// AccountToAdminAPIAccount converts a gts model account into an admin view account, for serving at /api/v1/admin/accounts.
+	// For local accounts, this includes user-level information such as email address and approval status.
+	AccountToAdminAPIAccount(ctx context.Context, account *gtsmodel.Account) (*apimodel.AdminAccountInfo, error)
 	// AppToAPIAppSensitive takes a db model application as a param, and returns a populated apitype application, or an error
 	// if something goes wrong. The returned application should be ready to serialize on an API level, and may have sensitive fields
 	// (such as client id and client secret), so serve it only to an authorized user who should have permission to see it.
@@ -73,10 +76,19 @@ type TypeConverter interface {
 	EmojiCategoryToAPIEmojiCategory(ctx context.Context, category *gtsmodel.EmojiCategory) (*apimodel.EmojiCategory, error)
 	// TagToAPITag converts a gts model tag into its api (frontend) representation for serialization on the API.
 	TagToAPITag(ctx context.Context, t *gtsmodel.Tag) (apimodel.Tag, error)
+	// FeaturedTagToAPIFeaturedTag converts a gts model featured tag into its api (frontend) representation for serialization on the API.
+	FeaturedTagToAPIFeaturedTag(ctx context.Context, f *gtsmodel.FeaturedTag) (apimodel.FeaturedTag, error)
 	// StatusToAPIStatus converts a gts model status into its api (frontend) representation for serialization on the API.
 	//
 	// Requesting account can be nil.
 	StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, requestingAccount *gtsmodel.Account) (*apimodel.Status, error)
+	// PollToAPIPoll converts a gts model poll into its api (frontend) representation for serialization on the API.
+	//
+	// Requesting account can be nil.
+	PollToAPIPoll(ctx context.Context, p *gtsmodel.Poll, requestingAccount *gtsmodel.Account) (*apimodel.Poll, error)
+	// StatusEditToAPIStatusEdit converts a gts model status edit, belonging to the given status,
+	// into its api (frontend) representation for serialization on the API.
+	StatusEditToAPIStatusEdit(ctx context.Context, e *gtsmodel.StatusEdit, s *gtsmodel.Status) (*apimodel.StatusEdit, error)
 	// VisToAPIVis converts a gts visibility into its api equivalent
 	VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) apimodel.Visibility
 	// InstanceToAPIV1Instance converts a gts instance into its api equivalent for serving at /api/v1/instance
@@ -89,10 +101,44 @@ type TypeConverter interface {
 	NotificationToAPINotification(ctx context.Context, n *gtsmodel.Notification) (*apimodel.Notification, error)
 	// DomainBlockToAPIDomainBlock converts a gts model domin block into a api domain block, for serving at /api/v1/admin/domain_blocks
 	DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel.DomainBlock, export bool) (*apimodel.DomainBlock, error)
+	// EmailDomainBlockToAPIEmailDomainBlock converts a gts model email domain block into an api email domain block, for serving at /api/v1/admin/email_domain_blocks
+	EmailDomainBlockToAPIEmailDomainBlock(ctx context.Context, b *gtsmodel.EmailDomainBlock) (*apimodel.EmailDomainBlock, error)
 	// ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports
 	ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error)
 	// ReportToAdminAPIReport converts a gts model report into an admin view report, for serving at /api/v1/admin/reports
 	ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Report, requestingAccount *gtsmodel.Account) (*apimodel.AdminReport, error)
+	// ScheduledStatusToAPIScheduledStatus converts a gts model scheduled status into its api representation, for serving at /api/v1/scheduled_statuses/{id}
+	ScheduledStatusToAPIScheduledStatus(ctx context.Context, s *gtsmodel.ScheduledStatus) (*apimodel.ScheduledStatus, error)
+	// InviteToAPIInvite converts a gts model invite into its api representation, for serving at /api/v1/invites
+	InviteToAPIInvite(ctx context.Context, i *gtsmodel.Invite) (*apimodel.Invite, error)
+	// ConversationToAPIConversation converts a gts model conversation into its api representation, for serving at /api/v1/conversations
+	ConversationToAPIConversation(ctx context.Context, c *gtsmodel.Conversation, requestingAccount *gtsmodel.Account) (*apimodel.Conversation, error)
+	// MarkersToAPIMarker converts the given gts model markers of one account into an api model marker, for serving at /api/v1/markers
+	MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, error)
+	// AnnouncementToAPIAnnouncement converts a gts model announcement into its api (frontend) representation.
+	// If requestingAccount is set, its dismissal of and reactions to the announcement will be reflected.
+	AnnouncementToAPIAnnouncement(ctx context.Context, a *gtsmodel.Announcement, requestingAccount *gtsmodel.Account) (*apimodel.Announcement, error)
+	// AnnouncementReactionsToAPIAnnouncementReactions groups the given reactions to an announcement by name, in order of first use.
+	// If requestingAccount is set, reactions of that account will be marked as their own.
+	AnnouncementReactionsToAPIAnnouncementReactions(ctx context.Context, reactions []*gtsmodel.AnnouncementReaction, requestingAccount *gtsmodel.Account) ([]apimodel.AnnouncementReaction, error)
+	// ListToAPIList converts one gts model list into an api model list, for serving at /api/v1/lists/{id}
+	ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error)
+	// FilterKeywordToAPIFilterV1 converts one gts model filter keyword (and its parent filter)
+	// into an api model v1 filter, for serving at /api/v1/filters/{id}. The filter keyword
+	// must have its Filter field populated.
+	FilterKeywordToAPIFilterV1(ctx context.Context, fk *gtsmodel.FilterKeyword) (*apimodel.Filter, error)
+	// FilterToAPIFilterV2 converts one gts model filter into an api model v2 filter, for serving at /api/v2/filters/{id}
+	FilterToAPIFilterV2(ctx context.Context, f *gtsmodel.Filter) (*apimodel.FilterV2, error)
+	// FilterKeywordToAPIFilterKeyword converts one gts model filter keyword into an api model
+	// filter keyword, for serving at /api/v2/filters/keywords/{id}
+	FilterKeywordToAPIFilterKeyword(ctx context.Context, fk *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, error)
+	// ApplyFilters checks the given api model status against the given filters, in the given context.
+	//
+	// If the status does not match any filter, it is returned as-is. If it matches any
+	// filter with a 'hide' action, ErrHideStatus is returned. If it only matches filters with
+	// a 'warn' action, a copy of the status is returned with its Filtered field set; the
+	// original is not modified, since it may be shared (eg., a prepared timeline entry).
+	ApplyFilters(ctx context.Context, s *apimodel.Status, filters []*gtsmodel.Filter, filterContext gtsmodel.FilterContext) (*apimodel.Status, error)
 
 	/*
 		INTERNAL (gts) MODEL TO FRONTEND (rss) MODEL
@@ -117,6 +163,9 @@ type TypeConverter interface {
 	ASRepresentationToAccount(ctx context.Context, accountable ap.Accountable, accountDomain string) (*gtsmodel.Account, error)
 	// ASStatus converts a remote activitystreams 'status' representation into a gts model status.
 	ASStatusToStatus(ctx context.Context, statusable ap.Statusable) (*gtsmodel.Status, error)
+	// ASQuestionToPoll converts the options and vote counts of a remote activitystreams Question into a gts model poll.
+	// The returned poll will not have an ID or StatusID set yet.
+	ASQuestionToPoll(ctx context.Context, pollable ap.Pollable) (*gtsmodel.Poll, error)
 	// ASFollowToFollowRequest converts a remote activitystreams `follow` representation into gts model follow request.
 	ASFollowToFollowRequest(ctx context.Context, followable ap.Followable) (*gtsmodel.FollowRequest, error)
 	// ASFollowToFollowRequest converts a remote activitystreams `follow` representation into gts model follow.
@@ -153,14 +202,17 @@ type TypeConverter interface {
 	// suitable for serving to requesters to whom we want to give as little information as possible because
 	// we don't trust them (yet).
 	AccountToASMinimal(ctx context.Context, a *gtsmodel.Account) (vocab.ActivityStreamsPerson, error)
-	// StatusToAS converts a gts model status into an activity streams note, suitable for federation
-	StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsNote, error)
+	// StatusToAS converts a gts model status into an activity streams note, suitable for federation.
+	// If the status has a poll attached, it will be converted into an activity streams question instead.
+	StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Statusable, error)
 	// FollowToASFollow converts a gts model Follow into an activity streams Follow, suitable for federation
 	FollowToAS(ctx context.Context, f *gtsmodel.Follow, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (vocab.ActivityStreamsFollow, error)
 	// MentionToAS converts a gts model mention into an activity streams Mention, suitable for federation
 	MentionToAS(ctx context.Context, m *gtsmodel.Mention) (vocab.ActivityStreamsMention, error)
 	// EmojiToAS converts a gts emoji into a mastodon ns Emoji, suitable for federation
 	EmojiToAS(ctx context.Context, e *gtsmodel.Emoji) (vocab.TootEmoji, error)
+	// TagToAS converts a gts model tag into an activity streams Hashtag, suitable for federation
+	TagToAS(ctx context.Context, t *gtsmodel.Tag) (vocab.ActivityStreamsLink, error)
 	// AttachmentToAS converts a gts model media attachment into an activity streams Attachment, suitable for federation
 	AttachmentToAS(ctx context.Context, a *gtsmodel.MediaAttachment) (vocab.ActivityStreamsDocument, error)
 	// FaveToAS converts a gts model status fave into an activityStreams LIKE, suitable for federation.
@@ -169,8 +221,14 @@ type TypeConverter interface {
 	BoostToAS(ctx context.Context, boostWrapperStatus *gtsmodel.Status, boostingAccount *gtsmodel.Account, boostedAccount *gtsmodel.Account) (vocab.ActivityStreamsAnnounce, error)
 	// BlockToAS converts a gts model block into an activityStreams BLOCK, suitable for federation.
 	BlockToAS(ctx context.Context, block *gtsmodel.Block) (vocab.ActivityStreamsBlock, error)
+	// AccountToASMove converts the move of a gts model account to the given target account
+	// into an activityStreams MOVE, addressed to the followers of the moving account.
+	AccountToASMove(ctx context.Context, a *gtsmodel.Account, target *gtsmodel.Account) (vocab.ActivityStreamsMove, error)
 	// StatusToASRepliesCollection converts a gts model status into an activityStreams REPLIES collection.
 	StatusToASRepliesCollection(ctx context.Context, status *gtsmodel.Status, onlyOtherAccounts bool) (vocab.ActivityStreamsCollection, error)
+	// PollVoteToASCreates converts a gts model poll vote into one activity streams Create per chosen option,
+	// each wrapping a Note with the name of that option, in reply to the poll, and addressed to the poll author.
+	PollVoteToASCreates(ctx context.Context, vote *gtsmodel.PollVote) ([]vocab.ActivityStreamsCreate, error)
 	// StatusURIsToASRepliesPage returns a collection page with appropriate next/part of pagination.
 	StatusURIsToASRepliesPage(ctx context.Context, status *gtsmodel.Status, onlyOtherAccounts bool, minID string, replies map[string]*url.URL) (vocab.ActivityStreamsCollectionPage, error)
 	// OutboxToASCollection returns an ordered collection with appropriate id, next, and last fields.
@@ -185,6 +243,12 @@ type TypeConverter interface {
 	//
 	// Appropriate 'next' and 'prev' fields will be created based on the highest and lowest IDs present in the statuses slice.
 	StatusesToASOutboxPage(ctx context.Context, outboxID string, maxID string, minID string, statuses []*gtsmodel.Status) (vocab.ActivityStreamsOrderedCollectionPage, error)
+	// StatusesToASFeaturedCollection returns an ordered collection of the URIs of the given (pinned) statuses,
+	// suitable for serving at an account's featured collection IRI. The collection is not paged.
+	StatusesToASFeaturedCollection(ctx context.Context, featuredCollectionID string, statuses []*gtsmodel.Status) (vocab.ActivityStreamsOrderedCollection, error)
+	// FeaturedTagsToASCollection returns a collection of Hashtags for the given featured tags,
+	// suitable for serving at an account's featured tags IRI. The collection is not paged.
+	FeaturedTagsToASCollection(ctx context.Context, featuredTagsID string, featuredTags []*gtsmodel.FeaturedTag) (vocab.ActivityStreamsCollection, error)
 	// ReportToASFlag converts a gts model report into an activitystreams FLAG, suitable for federation.
 	ReportToASFlag(ctx context.Context, r *gtsmodel.Report) (vocab.ActivityStreamsFlag, error)
 
@@ -196,6 +260,9 @@ type TypeConverter interface {
 	FollowRequestToFollow(ctx context.Context, f *gtsmodel.FollowRequest) *gtsmodel.Follow
 	// StatusToBoost wraps the given status into a boosting status.
 	StatusToBoost(ctx context.Context, s *gtsmodel.Status, boostingAccount *gtsmodel.Account) (*gtsmodel.Status, error)
+	// StatusToStatusEdit takes a snapshot of the current revision of the given status,
+	// so that it can be stored in the status's edit history before the status is edited.
+	StatusToStatusEdit(ctx context.Context, s *gtsmodel.Status) *gtsmodel.StatusEdit
 
 	/*
 		WRAPPER CONVENIENCE FUNCTIONS
@@ -203,12 +270,14 @@ type TypeConverter interface {
 
 	// WrapPersonInUpdate
 	WrapPersonInUpdate(person vocab.ActivityStreamsPerson, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error)
-	// WrapNoteInCreate wraps a Note with a Create activity.
+	// WrapNoteInCreate wraps a Note (or Question) with a Create activity.
 	//
 	// If objectIRIOnly is set to true, then the function won't put the *entire* note in the Object field of the Create,
 	// but just the AP URI of the note. This is useful in cases where you want to give a remote server something to dereference,
 	// and still have control over whether or not they're allowed to actually see the contents.
-	WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error)
+	WrapNoteInCreate(note ap.Statusable, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error)
+	// WrapNoteInUpdate wraps a Note (or Question) with an Update activity, addressed the same as the note itself.
+	WrapNoteInUpdate(note ap.Statusable, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error)
 }
 
 type converter struct {
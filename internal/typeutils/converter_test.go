@@ -464,6 +464,57 @@ const (
 		"type": "Service",
 		"url": "https://owncast.example.org/federation/user/rgh"
 	} 
+`
+	questionActivityJson = `
+	{
+		"@context": [
+		  "https://www.w3.org/ns/activitystreams",
+		  {
+			"ostatus": "http://ostatus.org#",
+			"atomUri": "ostatus:atomUri",
+			"sensitive": "as:sensitive",
+			"toot": "http://joinmastodon.org/ns#",
+			"votersCount": "toot:votersCount"
+		  }
+		],
+		"id": "http://fossbros-anonymous.io/users/foss_satan/statuses/109976530154380012",
+		"type": "Question",
+		"summary": null,
+		"inReplyTo": null,
+		"published": "2023-03-10T12:00:00Z",
+		"url": "http://fossbros-anonymous.io/@foss_satan/109976530154380012",
+		"attributedTo": "http://fossbros-anonymous.io/users/foss_satan",
+		"to": [
+		  "https://www.w3.org/ns/activitystreams#Public"
+		],
+		"cc": [
+		  "http://fossbros-anonymous.io/users/foss_satan/followers"
+		],
+		"sensitive": false,
+		"content": "<p>which is the best operating system?</p>",
+		"attachment": [],
+		"tag": [],
+		"endTime": "2023-03-11T12:00:00Z",
+		"votersCount": 7,
+		"anyOf": [
+		  {
+			"type": "Note",
+			"name": "linux",
+			"replies": {
+			  "type": "Collection",
+			  "totalItems": 5
+			}
+		  },
+		  {
+			"type": "Note",
+			"name": "also linux",
+			"replies": {
+			  "type": "Collection",
+			  "totalItems": 4
+			}
+		  }
+		]
+	}
 `
 )
 
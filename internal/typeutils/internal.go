@@ -89,3 +89,33 @@ func (c *converter) StatusToBoost(ctx context.Context, s *gtsmodel.Status, boost
 
 	return boostWrapperStatus, nil
 }
+
+func (c *converter) StatusToStatusEdit(ctx context.Context, s *gtsmodel.Status) *gtsmodel.StatusEdit {
+	// this revision was posted either when
+	// the status was created, or last edited
+	createdAt := s.CreatedAt
+	if !s.EditedAt.IsZero() {
+		createdAt = s.EditedAt
+	}
+
+	sensitive := *s.Sensitive
+
+	edit := &gtsmodel.StatusEdit{
+		ID:             id.NewULID(),
+		CreatedAt:      createdAt,
+		StatusID:       s.ID,
+		Content:        s.Content,
+		ContentWarning: s.ContentWarning,
+		Text:           s.Text,
+		Language:       s.Language,
+		Sensitive:      &sensitive,
+		AttachmentIDs:  append([]string{}, s.AttachmentIDs...),
+		EmojiIDs:       append([]string{}, s.EmojiIDs...),
+	}
+
+	if s.Poll != nil {
+		edit.PollOptions = append([]string{}, s.Poll.Options...)
+	}
+
+	return edit
+}
@@ -28,10 +28,13 @@ import (
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 // Converts a gts model account into an Activity Streams person type.
@@ -114,7 +117,25 @@ func (c *converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	person.SetTootFeatured(featuredProp)
 
 	// featuredTags
-	// NOT IMPLEMENTED
+	// Hashtags featured on the profile. We only
+	// know where to find these for our own accounts.
+	if a.Domain == "" {
+		ap.SetFeaturedTags(person, uris.GenerateURIsForAccount(a.Username).FeaturedTagsURI)
+	}
+
+	// alsoKnownAs
+	// Other accounts that this account is also known as.
+	ap.SetAlsoKnownAs(person, a.AlsoKnownAsURIs)
+
+	// movedTo
+	// The account that this account has moved to, if any.
+	if a.MovedToAccountID != "" {
+		movedTo, err := c.db.GetAccountByID(ctx, a.MovedToAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("AccountToAS: error getting moved to account %s: %w", a.MovedToAccountID, err)
+		}
+		ap.SetMovedTo(person, movedTo.URI)
+	}
 
 	// preferredUsername
 	// Used for Webfinger lookup. Must be unique on the domain, and must correspond to a Webfinger acct: URI.
@@ -380,7 +401,7 @@ func (c *converter) AccountToASMinimal(ctx context.Context, a *gtsmodel.Account)
 	return person, nil
 }
 
-func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsNote, error) {
+func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Statusable, error) {
 	// ensure prerequisites here before we get stuck in
 
 	// check if author account is already attached to status and attach it if not
@@ -438,6 +459,13 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 	publishedProp.Set(s.CreatedAt)
 	status.SetActivityStreamsPublished(publishedProp)
 
+	// updated, only if the status has been edited
+	if !s.EditedAt.IsZero() {
+		updatedProp := streams.NewActivityStreamsUpdatedProperty()
+		updatedProp.Set(s.EditedAt)
+		status.SetActivityStreamsUpdated(updatedProp)
+	}
+
 	// url
 	if s.URL != "" {
 		sURL, err := url.Parse(s.URL)
@@ -503,7 +531,24 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 	}
 
 	// tag -- hashtags
-	// TODO
+	tags := s.Tags
+	if len(s.TagIDs) > len(tags) {
+		tags = []*gtsmodel.Tag{}
+		for _, tagID := range s.TagIDs {
+			tag, err := c.db.GetTag(ctx, tagID)
+			if err != nil {
+				return nil, fmt.Errorf("StatusToAS: error getting tag %s from database: %s", tagID, err)
+			}
+			tags = append(tags, tag)
+		}
+	}
+	for _, t := range tags {
+		asHashtag, err := c.TagToAS(ctx, t)
+		if err != nil {
+			return nil, fmt.Errorf("StatusToAS: error converting tag to AS hashtag: %s", err)
+		}
+		tagProp.AppendActivityStreamsLink(asHashtag)
+	}
 
 	status.SetActivityStreamsTag(tagProp)
 
@@ -614,9 +659,223 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 	sensitiveProp.AppendXMLSchemaBoolean(*s.Sensitive)
 	status.SetActivityStreamsSensitive(sensitiveProp)
 
+	// if the status has a poll attached, it
+	// should be federated as a Question instead
+	if s.PollID != "" {
+		return c.noteToASQuestion(ctx, s, status)
+	}
+
 	return status, nil
 }
 
+// noteToASQuestion converts the given note, which should have been generated from
+// the given status, into a Question, by copying across the relevant properties of
+// the note, and then adding the options and vote counts of the status' poll.
+func (c *converter) noteToASQuestion(ctx context.Context, s *gtsmodel.Status, note vocab.ActivityStreamsNote) (vocab.ActivityStreamsQuestion, error) {
+	if s.Poll == nil {
+		p, err := c.db.GetPollByID(ctx, s.PollID)
+		if err != nil {
+			return nil, fmt.Errorf("noteToASQuestion: error retrieving poll %s from database: %s", s.PollID, err)
+		}
+		s.Poll = p
+	}
+	poll := s.Poll
+
+	question := streams.NewActivityStreamsQuestion()
+	question.SetJSONLDId(note.GetJSONLDId())
+	question.SetActivityStreamsSummary(note.GetActivityStreamsSummary())
+	question.SetActivityStreamsInReplyTo(note.GetActivityStreamsInReplyTo())
+	question.SetActivityStreamsPublished(note.GetActivityStreamsPublished())
+	question.SetActivityStreamsUpdated(note.GetActivityStreamsUpdated())
+	question.SetActivityStreamsUrl(note.GetActivityStreamsUrl())
+	question.SetActivityStreamsAttributedTo(note.GetActivityStreamsAttributedTo())
+	question.SetActivityStreamsTag(note.GetActivityStreamsTag())
+	question.SetActivityStreamsTo(note.GetActivityStreamsTo())
+	question.SetActivityStreamsCc(note.GetActivityStreamsCc())
+	question.SetActivityStreamsContent(note.GetActivityStreamsContent())
+	question.SetActivityStreamsAttachment(note.GetActivityStreamsAttachment())
+	question.SetActivityStreamsReplies(note.GetActivityStreamsReplies())
+	question.SetActivityStreamsSensitive(note.GetActivityStreamsSensitive())
+
+	// only show vote counts if the
+	// poll doesn't want them hidden
+	showCounts := !*poll.HideCounts || poll.Closed()
+
+	// options, each as a Note with a name,
+	// and the number of votes it received
+	// as the totalItems of its replies
+	var (
+		oneOfProp = streams.NewActivityStreamsOneOfProperty()
+		anyOfProp = streams.NewActivityStreamsAnyOfProperty()
+	)
+	for i, title := range poll.Options {
+		option := streams.NewActivityStreamsNote()
+
+		nameProp := streams.NewActivityStreamsNameProperty()
+		nameProp.AppendXMLSchemaString(title)
+		option.SetActivityStreamsName(nameProp)
+
+		var votes int
+		if showCounts && i < len(poll.Votes) {
+			votes = poll.Votes[i]
+		}
+
+		replies := streams.NewActivityStreamsCollection()
+		totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+		totalItemsProp.Set(votes)
+		replies.SetActivityStreamsTotalItems(totalItemsProp)
+
+		repliesProp := streams.NewActivityStreamsRepliesProperty()
+		repliesProp.SetActivityStreamsCollection(replies)
+		option.SetActivityStreamsReplies(repliesProp)
+
+		if *poll.Multiple {
+			anyOfProp.AppendActivityStreamsNote(option)
+		} else {
+			oneOfProp.AppendActivityStreamsNote(option)
+		}
+	}
+	if *poll.Multiple {
+		question.SetActivityStreamsAnyOf(anyOfProp)
+	} else {
+		question.SetActivityStreamsOneOf(oneOfProp)
+	}
+
+	// endTime
+	if !poll.ExpiresAt.IsZero() {
+		endTimeProp := streams.NewActivityStreamsEndTimeProperty()
+		endTimeProp.Set(poll.ExpiresAt)
+		question.SetActivityStreamsEndTime(endTimeProp)
+	}
+
+	// closed
+	if poll.Closed() {
+		closedProp := streams.NewActivityStreamsClosedProperty()
+		closedProp.AppendXMLSchemaDateTime(poll.ClosedAt)
+		question.SetActivityStreamsClosed(closedProp)
+	}
+
+	// votersCount
+	var voters int
+	if showCounts {
+		voters = poll.Voters
+	}
+	votersCountProp := streams.NewTootVotersCountProperty()
+	votersCountProp.Set(voters)
+	question.SetTootVotersCount(votersCountProp)
+
+	return question, nil
+}
+
+func (c *converter) PollVoteToASCreates(ctx context.Context, vote *gtsmodel.PollVote) ([]vocab.ActivityStreamsCreate, error) {
+	if vote.Account == nil {
+		a, err := c.db.GetAccountByID(ctx, vote.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error getting voter account %s: %s", vote.AccountID, err)
+		}
+		vote.Account = a
+	}
+
+	if vote.Poll == nil {
+		p, err := c.db.GetPollByID(ctx, vote.PollID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error getting poll %s: %s", vote.PollID, err)
+		}
+		vote.Poll = p
+	}
+
+	if vote.Poll.Status == nil {
+		s, err := c.db.GetStatusByID(ctx, vote.Poll.StatusID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error getting poll status %s: %s", vote.Poll.StatusID, err)
+		}
+		vote.Poll.Status = s
+	}
+
+	voterURI, err := url.Parse(vote.Account.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error parsing url %s: %s", vote.Account.URI, err)
+	}
+
+	pollURI, err := url.Parse(vote.Poll.Status.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error parsing url %s: %s", vote.Poll.Status.URI, err)
+	}
+
+	pollAuthorURI, err := url.Parse(vote.Poll.Status.AccountURI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error parsing url %s: %s", vote.Poll.Status.AccountURI, err)
+	}
+
+	// each choice is federated as a separate Note, with
+	// the name of the chosen option, in reply to the poll
+	creates := make([]vocab.ActivityStreamsCreate, 0, len(vote.Choices))
+	for _, choice := range vote.Choices {
+		if choice < 0 || choice >= len(vote.Poll.Options) {
+			return nil, fmt.Errorf("PollVoteToASCreates: choice %d out of range", choice)
+		}
+
+		note := streams.NewActivityStreamsNote()
+
+		// id
+		noteID := fmt.Sprintf("%s#votes/%s/%d", vote.Account.URI, vote.ID, choice)
+		noteURI, err := url.Parse(noteID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error parsing url %s: %s", noteID, err)
+		}
+		noteIDProp := streams.NewJSONLDIdProperty()
+		noteIDProp.SetIRI(noteURI)
+		note.SetJSONLDId(noteIDProp)
+
+		// name
+		nameProp := streams.NewActivityStreamsNameProperty()
+		nameProp.AppendXMLSchemaString(vote.Poll.Options[choice])
+		note.SetActivityStreamsName(nameProp)
+
+		// inReplyTo
+		inReplyToProp := streams.NewActivityStreamsInReplyToProperty()
+		inReplyToProp.AppendIRI(pollURI)
+		note.SetActivityStreamsInReplyTo(inReplyToProp)
+
+		// attributedTo
+		attributedToProp := streams.NewActivityStreamsAttributedToProperty()
+		attributedToProp.AppendIRI(voterURI)
+		note.SetActivityStreamsAttributedTo(attributedToProp)
+
+		// to (only the poll author should see votes)
+		toProp := streams.NewActivityStreamsToProperty()
+		toProp.AppendIRI(pollAuthorURI)
+		note.SetActivityStreamsTo(toProp)
+
+		// wrap it in a create
+		create := streams.NewActivityStreamsCreate()
+
+		createIDProp := streams.NewJSONLDIdProperty()
+		createURI, err := url.Parse(noteID + "/activity")
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error parsing url %s: %s", noteID+"/activity", err)
+		}
+		createIDProp.SetIRI(createURI)
+		create.SetJSONLDId(createIDProp)
+
+		actorProp := streams.NewActivityStreamsActorProperty()
+		actorProp.AppendIRI(voterURI)
+		create.SetActivityStreamsActor(actorProp)
+
+		createToProp := streams.NewActivityStreamsToProperty()
+		createToProp.AppendIRI(pollAuthorURI)
+		create.SetActivityStreamsTo(createToProp)
+
+		objectProp := streams.NewActivityStreamsObjectProperty()
+		objectProp.AppendActivityStreamsNote(note)
+		create.SetActivityStreamsObject(objectProp)
+
+		creates = append(creates, create)
+	}
+
+	return creates, nil
+}
+
 func (c *converter) FollowToAS(ctx context.Context, f *gtsmodel.Follow, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (vocab.ActivityStreamsFollow, error) {
 	// parse out the various URIs we need for this
 	// origin account (who's doing the follow)
@@ -760,6 +1019,15 @@ func (c *converter) EmojiToAS(ctx context.Context, e *gtsmodel.Emoji) (vocab.Too
 	return emoji, nil
 }
 
+func (c *converter) TagToAS(ctx context.Context, t *gtsmodel.Tag) (vocab.ActivityStreamsLink, error) {
+	hrefURL, err := url.Parse(t.URL)
+	if err != nil {
+		return nil, fmt.Errorf("TagToAS: error parsing url %s: %s", t.URL, err)
+	}
+
+	return ap.NewHashtag(hrefURL, "#"+t.Name), nil
+}
+
 func (c *converter) AttachmentToAS(ctx context.Context, a *gtsmodel.MediaAttachment) (vocab.ActivityStreamsDocument, error) {
 	// type -- Document
 	doc := streams.NewActivityStreamsDocument()
@@ -1022,6 +1290,55 @@ func (c *converter) BlockToAS(ctx context.Context, b *gtsmodel.Block) (vocab.Act
 	return block, nil
 }
 
+func (c *converter) AccountToASMove(ctx context.Context, a *gtsmodel.Account, target *gtsmodel.Account) (vocab.ActivityStreamsMove, error) {
+	move := streams.NewActivityStreamsMove()
+
+	accountIRI, err := url.Parse(a.URI)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", a.URI, err)
+	}
+
+	targetIRI, err := url.Parse(target.URI)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", target.URI, err)
+	}
+
+	followersIRI, err := url.Parse(a.FollowersURI)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", a.FollowersURI, err)
+	}
+
+	// set the ID property to a new move URI
+	idIRI, err := url.Parse(uris.GenerateURIForMove(a.Username, id.NewULID()))
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error generating move uri: %s", err)
+	}
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(idIRI)
+	move.SetJSONLDId(idProp)
+
+	// the moving account is both the actor and the object of the move
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(accountIRI)
+	move.SetActivityStreamsActor(actorProp)
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(accountIRI)
+	move.SetActivityStreamsObject(objectProp)
+
+	// set the target property to the account moved to
+	targetProp := streams.NewActivityStreamsTargetProperty()
+	targetProp.AppendIRI(targetIRI)
+	move.SetActivityStreamsTarget(targetProp)
+
+	// address the move to the followers of the moving account
+	toProp := streams.NewActivityStreamsToProperty()
+	toProp.AppendIRI(followersIRI)
+	move.SetActivityStreamsTo(toProp)
+
+	return move, nil
+}
+
 /*
 the goal is to end up with something like this:
 
@@ -1215,12 +1532,12 @@ func (c *converter) StatusesToASOutboxPage(ctx context.Context, outboxID string,
 	var highest string
 	var lowest string
 	for _, s := range statuses {
-		note, err := c.StatusToAS(ctx, s)
+		statusable, err := c.StatusToAS(ctx, s)
 		if err != nil {
 			return nil, err
 		}
 
-		create, err := c.WrapNoteInCreate(note, true)
+		create, err := c.WrapNoteInCreate(statusable, true)
 		if err != nil {
 			return nil, err
 		}
@@ -1296,6 +1613,100 @@ func (c *converter) OutboxToASCollection(ctx context.Context, outboxID string) (
 	return collection, nil
 }
 
+/*
+we want something that looks like this:
+
+	{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id": "https://example.org/users/whatever/collections/featured",
+		"type": "OrderedCollection",
+		"totalItems": 1,
+		"orderedItems": [
+			"https://example.org/users/whatever/statuses/01FJC1MKPVX2VMWP2ST93Q90K7"
+		]
+	}
+*/
+func (c *converter) StatusesToASFeaturedCollection(ctx context.Context, featuredCollectionID string, statuses []*gtsmodel.Status) (vocab.ActivityStreamsOrderedCollection, error) {
+	collection := streams.NewActivityStreamsOrderedCollection()
+
+	collectionIDProp := streams.NewJSONLDIdProperty()
+	featuredCollectionIDURI, err := url.Parse(featuredCollectionID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing url %s", featuredCollectionID)
+	}
+	collectionIDProp.SetIRI(featuredCollectionIDURI)
+	collection.SetJSONLDId(collectionIDProp)
+
+	itemsProp := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, s := range statuses {
+		uri, err := url.Parse(s.URI)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing url %s: %w", s.URI, err)
+		}
+		itemsProp.AppendIRI(uri)
+	}
+	collection.SetActivityStreamsOrderedItems(itemsProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(len(statuses))
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+
+	return collection, nil
+}
+
+/*
+we want something that looks like this:
+
+	{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"id": "https://example.org/users/whatever/collections/tags",
+		"type": "Collection",
+		"totalItems": 1,
+		"items": [
+			{
+				"type": "Hashtag",
+				"href": "https://example.org/tags/gardening",
+				"name": "#gardening"
+			}
+		]
+	}
+*/
+func (c *converter) FeaturedTagsToASCollection(ctx context.Context, featuredTagsID string, featuredTags []*gtsmodel.FeaturedTag) (vocab.ActivityStreamsCollection, error) {
+	collection := streams.NewActivityStreamsCollection()
+
+	collectionIDProp := streams.NewJSONLDIdProperty()
+	featuredTagsIDURI, err := url.Parse(featuredTagsID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing url %s", featuredTagsID)
+	}
+	collectionIDProp.SetIRI(featuredTagsIDURI)
+	collection.SetJSONLDId(collectionIDProp)
+
+	itemsProp := streams.NewActivityStreamsItemsProperty()
+	for _, f := range featuredTags {
+		if f.Tag == nil {
+			tag, err := c.db.GetTag(ctx, f.TagID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting tag %s: %w", f.TagID, err)
+			}
+			f.Tag = tag
+		}
+
+		asHashtag, err := c.TagToAS(ctx, f.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("error converting tag %s to as hashtag: %w", f.TagID, err)
+		}
+		itemsProp.AppendActivityStreamsLink(asHashtag)
+	}
+	collection.SetActivityStreamsItems(itemsProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(len(featuredTags))
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+
+	return collection, nil
+}
+
 func (c *converter) ReportToASFlag(ctx context.Context, r *gtsmodel.Report) (vocab.ActivityStreamsFlag, error) {
 	flag := streams.NewActivityStreamsFlag()
 
@@ -53,6 +53,7 @@ func (suite *InternalToASTestSuite) TestAccountToAS() {
 
 	suite.Equal(`: true,
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -103,6 +104,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithEmoji() {
 
 	suite.Equal(`: true,
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -167,6 +169,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
     "sharedInbox": "http://localhost:8080/sharedInbox"
   },
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -197,6 +200,51 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
 }`, trimmed)
 }
 
+func (suite *InternalToASTestSuite) TestAccountToASWithAliasAndMove() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"] // take zork for this test
+	testAccount.AlsoKnownAsURIs = []string{"http://fossbros-anonymous.io/users/foss_satan"}
+	testAccount.MovedToAccountID = suite.testAccounts["local_account_2"].ID
+
+	asPerson, err := suite.typeconverter.AccountToAS(context.Background(), testAccount)
+	suite.NoError(err)
+
+	ser, err := streams.Serialize(asPerson)
+	suite.NoError(err)
+
+	bytes, err := json.Marshal(map[string]interface{}{
+		"alsoKnownAs": ser["alsoKnownAs"],
+		"movedTo":     ser["movedTo"],
+	})
+	suite.NoError(err)
+
+	suite.Equal(`{"alsoKnownAs":["http://fossbros-anonymous.io/users/foss_satan"],"movedTo":"http://localhost:8080/users/1happyturtle"}`, string(bytes))
+}
+
+func (suite *InternalToASTestSuite) TestAccountToASMove() {
+	testAccount := suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	asMove, err := suite.typeconverter.AccountToASMove(context.Background(), testAccount, targetAccount)
+	suite.NoError(err)
+
+	ser, err := streams.Serialize(asMove)
+	suite.NoError(err)
+	delete(ser, "id") // the id is random
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "http://localhost:8080/users/the_mighty_zork",
+  "object": "http://localhost:8080/users/the_mighty_zork",
+  "target": "http://localhost:8080/users/1happyturtle",
+  "to": "http://localhost:8080/users/the_mighty_zork/followers",
+  "type": "Move"
+}`, string(bytes))
+}
+
 func (suite *InternalToASTestSuite) TestOutboxToASCollection() {
 	testAccount := suite.testAccounts["admin_account"]
 	ctx := context.Background()
@@ -258,6 +306,75 @@ func (suite *InternalToASTestSuite) TestStatusToAS() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestStatusWithPollToAS() {
+	testStatus := suite.testStatuses["local_account_2_status_7"]
+	ctx := context.Background()
+
+	asStatus, err := suite.typeconverter.StatusToAS(ctx, testStatus)
+	suite.NoError(err)
+
+	ser, err := streams.Serialize(asStatus)
+	suite.NoError(err)
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	// trim off everything up to 'attachment';
+	// this is necessary because the order of multiple 'context' entries is not determinate
+	trimmed := strings.Split(string(bytes), "\"attachment\"")[1]
+
+	suite.Equal(`: [],
+  "attributedTo": "http://localhost:8080/users/1happyturtle",
+  "cc": [],
+  "content": "🐢 hi followers! did u know i'm a turtle? 🐢",
+  "id": "http://localhost:8080/users/1happyturtle/statuses/01G20ZM733MGN8J344T4ZDDFY1",
+  "oneOf": [
+    {
+      "name": "yes",
+      "replies": {
+        "totalItems": 1,
+        "type": "Collection"
+      },
+      "type": "Note"
+    },
+    {
+      "name": "no",
+      "replies": {
+        "totalItems": 0,
+        "type": "Collection"
+      },
+      "type": "Note"
+    },
+    {
+      "name": "i'm a turtle too",
+      "replies": {
+        "totalItems": 0,
+        "type": "Collection"
+      },
+      "type": "Note"
+    }
+  ],
+  "published": "2021-10-20T12:40:37+02:00",
+  "replies": {
+    "first": {
+      "id": "http://localhost:8080/users/1happyturtle/statuses/01G20ZM733MGN8J344T4ZDDFY1/replies?page=true",
+      "next": "http://localhost:8080/users/1happyturtle/statuses/01G20ZM733MGN8J344T4ZDDFY1/replies?only_other_accounts=false\u0026page=true",
+      "partOf": "http://localhost:8080/users/1happyturtle/statuses/01G20ZM733MGN8J344T4ZDDFY1/replies",
+      "type": "CollectionPage"
+    },
+    "id": "http://localhost:8080/users/1happyturtle/statuses/01G20ZM733MGN8J344T4ZDDFY1/replies",
+    "type": "Collection"
+  },
+  "sensitive": false,
+  "summary": "",
+  "tag": [],
+  "to": "http://localhost:8080/users/1happyturtle/followers",
+  "type": "Question",
+  "url": "http://localhost:8080/@1happyturtle/statuses/01G20ZM733MGN8J344T4ZDDFY1",
+  "votersCount": 1
+}`, trimmed)
+}
+
 func (suite *InternalToASTestSuite) TestStatusWithTagsToASWithIDs() {
 	// use the status with just IDs of attachments and emojis pinned on it
 	testStatus := suite.testStatuses["admin_account_status_1"]
@@ -300,17 +417,24 @@ func (suite *InternalToASTestSuite) TestStatusWithTagsToASWithIDs() {
   },
   "sensitive": false,
   "summary": "",
-  "tag": {
-    "icon": {
-      "mediaType": "image/png",
-      "type": "Image",
-      "url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png"
+  "tag": [
+    {
+      "icon": {
+        "mediaType": "image/png",
+        "type": "Image",
+        "url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png"
+      },
+      "id": "http://localhost:8080/emoji/01F8MH9H8E4VG3KDYJR9EGPXCQ",
+      "name": ":rainbow:",
+      "type": "Emoji",
+      "updated": "2021-09-20T10:40:37Z"
     },
-    "id": "http://localhost:8080/emoji/01F8MH9H8E4VG3KDYJR9EGPXCQ",
-    "name": ":rainbow:",
-    "type": "Emoji",
-    "updated": "2021-09-20T10:40:37Z"
-  },
+    {
+      "href": "http://localhost:8080/tags/welcome",
+      "name": "#welcome",
+      "type": "Hashtag"
+    }
+  ],
   "to": "https://www.w3.org/ns/activitystreams#Public",
   "type": "Note",
   "url": "http://localhost:8080/@admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R"
@@ -360,17 +484,24 @@ func (suite *InternalToASTestSuite) TestStatusWithTagsToASFromDB() {
   },
   "sensitive": false,
   "summary": "",
-  "tag": {
-    "icon": {
-      "mediaType": "image/png",
-      "type": "Image",
-      "url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png"
+  "tag": [
+    {
+      "icon": {
+        "mediaType": "image/png",
+        "type": "Image",
+        "url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png"
+      },
+      "id": "http://localhost:8080/emoji/01F8MH9H8E4VG3KDYJR9EGPXCQ",
+      "name": ":rainbow:",
+      "type": "Emoji",
+      "updated": "2021-09-20T10:40:37Z"
     },
-    "id": "http://localhost:8080/emoji/01F8MH9H8E4VG3KDYJR9EGPXCQ",
-    "name": ":rainbow:",
-    "type": "Emoji",
-    "updated": "2021-09-20T10:40:37Z"
-  },
+    {
+      "href": "http://localhost:8080/tags/welcome",
+      "name": "#welcome",
+      "type": "Hashtag"
+    }
+  ],
   "to": "https://www.w3.org/ns/activitystreams#Public",
   "type": "Note",
   "url": "http://localhost:8080/@admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R"
@@ -20,10 +20,12 @@ package typeutils
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -32,6 +34,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -40,12 +43,17 @@ const (
 	instanceMediaAttachmentsImageMatrixLimit    = 16777216 // width * height
 	instanceMediaAttachmentsVideoMatrixLimit    = 16777216 // width * height
 	instanceMediaAttachmentsVideoFrameRateLimit = 60
-	instancePollsMinExpiration                  = 300     // seconds
-	instancePollsMaxExpiration                  = 2629746 // seconds
-	instanceAccountsMaxFeaturedTags             = 10
+	instancePollsMinExpiration                  = int(gtsmodel.PollMinExpiry / time.Second)
+	instancePollsMaxExpiration                  = int(gtsmodel.PollMaxExpiry / time.Second)
+	instanceAccountsMaxFeaturedTags             = gtsmodel.FeaturedTagsMax
 	instanceSourceURL                           = "https://github.com/superseriousbusiness/gotosocial"
 )
 
+// ErrHideStatus is returned by ApplyFilters when a status
+// matches a filter with a 'hide' action, and should therefore
+// not be shown to the requesting account at all.
+var ErrHideStatus = errors.New("hide status")
+
 func (c *converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmodel.Account) (*apimodel.Account, error) {
 	// we can build this sensitive account easily by first getting the public account....
 	apiAccount, err := c.AccountToAPIAccountPublic(ctx, a)
@@ -80,12 +88,19 @@ func (c *converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		Note:                a.NoteRaw,
 		Fields:              apiAccount.Fields,
 		FollowRequestsCount: frc,
+		AlsoKnownAsURIs:     a.AlsoKnownAsURIs,
 	}
 
 	return apiAccount, nil
 }
 
 func (c *converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.Account) (*apimodel.Account, error) {
+	return c.accountToAPIAccountPublic(ctx, a, true)
+}
+
+// accountToAPIAccountPublic converts the given account to its public frontend representation.
+// If withMoved is true, the account that the given account has moved to, if any, is included too.
+func (c *converter) accountToAPIAccountPublic(ctx context.Context, a *gtsmodel.Account, withMoved bool) (*apimodel.Account, error) {
 	// count followers
 	followersCount, err := c.db.CountAccountFollowedBy(ctx, a.ID, false)
 	if err != nil {
@@ -224,6 +239,18 @@ func (c *converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		Role:           role,
 	}
 
+	if withMoved && a.MovedToAccountID != "" {
+		// only go one level deep here, so that
+		// accounts which have moved to each
+		// other don't send us round in circles
+		movedTo, err := c.db.GetAccountByID(ctx, a.MovedToAccountID)
+		if err != nil {
+			log.Errorf("AccountToAPIAccountPublic: error getting moved to account with id %s: %s", a.MovedToAccountID, err)
+		} else if accountFrontend.Moved, err = c.accountToAPIAccountPublic(ctx, movedTo, false); err != nil {
+			log.Errorf("AccountToAPIAccountPublic: error converting moved to account with id %s: %s", a.MovedToAccountID, err)
+		}
+	}
+
 	c.ensureAvatar(accountFrontend)
 	c.ensureHeader(accountFrontend)
 
@@ -271,6 +298,7 @@ func (c *converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		suspended              bool
 		role                   apimodel.AccountRole = apimodel.AccountRoleUser // assume user by default
 		createdByApplicationID string
+		invitedByAccountID     string
 	)
 
 	// take user-level information if possible
@@ -305,6 +333,16 @@ func (c *converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		silenced = !user.Account.SilencedAt.IsZero()
 		suspended = !user.Account.SuspendedAt.IsZero()
 		createdByApplicationID = user.CreatedByApplicationID
+
+		if user.InviteID != "" {
+			invite, err := c.db.GetInviteByID(ctx, user.InviteID)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return nil, fmt.Errorf("AccountToAdminAPIAccount: error getting invite %s from database: %w", user.InviteID, err)
+			}
+			if invite != nil {
+				invitedByAccountID = invite.AccountID
+			}
+		}
 	}
 
 	apiAccount, err := c.AccountToAPIAccountPublic(ctx, a)
@@ -330,7 +368,7 @@ func (c *converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Suspended:              suspended,
 		Account:                apiAccount,
 		CreatedByApplicationID: createdByApplicationID,
-		InvitedByAccountID:     "", // not implemented (yet)
+		InvitedByAccountID:     invitedByAccountID,
 	}, nil
 }
 
@@ -412,6 +450,14 @@ func (c *converter) AttachmentToAPIAttachment(ctx context.Context, a *gtsmodel.M
 			apiAttachment.Meta.Original.FrameRate = fr + "/1"
 		}
 
+		if i := a.FileMeta.Original.Bitrate; i != nil {
+			apiAttachment.Meta.Original.Bitrate = int(*i)
+		}
+	case gtsmodel.FileTypeAudio:
+		if i := a.FileMeta.Original.Duration; i != nil {
+			apiAttachment.Meta.Original.Duration = *i
+		}
+
 		if i := a.FileMeta.Original.Bitrate; i != nil {
 			apiAttachment.Meta.Original.Bitrate = int(*i)
 		}
@@ -503,6 +549,30 @@ func (c *converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag) (apimodel.
 	}, nil
 }
 
+func (c *converter) FeaturedTagToAPIFeaturedTag(ctx context.Context, f *gtsmodel.FeaturedTag) (apimodel.FeaturedTag, error) {
+	if f.Tag == nil {
+		tag, err := c.db.GetTag(ctx, f.TagID)
+		if err != nil {
+			return apimodel.FeaturedTag{}, fmt.Errorf("error getting tag %s: %w", f.TagID, err)
+		}
+		f.Tag = tag
+	}
+
+	var lastStatusAt *string
+	if !f.LastStatusAt.IsZero() {
+		lastStatusAtString := util.FormatISO8601(f.LastStatusAt)
+		lastStatusAt = &lastStatusAtString
+	}
+
+	return apimodel.FeaturedTag{
+		ID:            f.ID,
+		Name:          f.Tag.Name,
+		URL:           f.Tag.URL,
+		StatusesCount: f.StatusesCount,
+		LastStatusAt:  lastStatusAt,
+	}, nil
+}
+
 func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, requestingAccount *gtsmodel.Account) (*apimodel.Status, error) {
 	repliesCount, err := c.db.CountStatusReplies(ctx, s)
 	if err != nil {
@@ -611,6 +681,23 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		language = &s.Language
 	}
 
+	var apiPoll *apimodel.Poll
+	if s.PollID != "" {
+		if s.Poll == nil {
+			p, err := c.db.GetPollByID(ctx, s.PollID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting poll %s for status %s: %s", s.PollID, s.ID, err)
+			}
+			s.Poll = p
+		}
+		s.Poll.Status = s
+
+		apiPoll, err = c.PollToAPIPoll(ctx, s.Poll, requestingAccount)
+		if err != nil {
+			return nil, fmt.Errorf("error converting status poll: %s", err)
+		}
+	}
+
 	apiStatus := &apimodel.Status{
 		ID:                 s.ID,
 		CreatedAt:          util.FormatISO8601(s.CreatedAt),
@@ -639,7 +726,7 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		Tags:               apiTags,
 		Emojis:             apiEmojis,
 		Card:               nil, // TODO: implement cards
-		Poll:               nil, // TODO: implement polls
+		Poll:               apiPoll,
 		Text:               s.Text,
 	}
 
@@ -654,6 +741,10 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		apiStatus.InReplyToAccountID = &i
 	}
 
+	if !s.EditedAt.IsZero() {
+		apiStatus.EditedAt = util.FormatISO8601(s.EditedAt)
+	}
+
 	if apiRebloggedStatus != nil {
 		apiStatus.Reblog = &apimodel.StatusReblogged{Status: apiRebloggedStatus}
 	}
@@ -661,6 +752,116 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 	return apiStatus, nil
 }
 
+func (c *converter) PollToAPIPoll(ctx context.Context, p *gtsmodel.Poll, requestingAccount *gtsmodel.Account) (*apimodel.Poll, error) {
+	if p.Status == nil {
+		status, err := c.db.GetStatusByID(ctx, p.StatusID)
+		if err != nil {
+			return nil, fmt.Errorf("PollToAPIPoll: error getting status %s: %w", p.StatusID, err)
+		}
+		p.Status = status
+	}
+
+	var (
+		expired  = p.Closed() || p.Expired(time.Now())
+		isAuthor = requestingAccount != nil && requestingAccount.ID == p.Status.AccountID
+		// counts are only shown for hidden-total polls once they've
+		// expired, or if the account requesting them is the author
+		showCounts = !*p.HideCounts || expired || isAuthor
+	)
+
+	apiPoll := &apimodel.Poll{
+		ID:       p.ID,
+		Expired:  expired,
+		Multiple: *p.Multiple,
+		Options:  make([]apimodel.PollOptions, len(p.Options)),
+		Emojis:   []apimodel.Emoji{},
+	}
+
+	if !p.ExpiresAt.IsZero() {
+		apiPoll.ExpiresAt = util.FormatISO8601(p.ExpiresAt)
+	}
+
+	for i, title := range p.Options {
+		apiPoll.Options[i].Title = title
+		if showCounts && i < len(p.Votes) {
+			apiPoll.Options[i].VotesCount = p.Votes[i]
+		}
+	}
+
+	if showCounts {
+		apiPoll.VotesCount = p.TotalVotes()
+		if *p.Multiple {
+			apiPoll.VotersCount = p.Voters
+		}
+	}
+
+	if requestingAccount != nil {
+		vote, err := c.db.GetPollVoteBy(ctx, p.ID, requestingAccount.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("PollToAPIPoll: error checking vote of account %s: %w", requestingAccount.ID, err)
+		}
+
+		if vote != nil {
+			apiPoll.Voted = true
+			apiPoll.OwnVotes = vote.Choices
+		} else {
+			// authors can't vote in their own poll, so
+			// we mark it as voted to prevent them trying
+			apiPoll.Voted = isAuthor
+		}
+	}
+
+	return apiPoll, nil
+}
+
+func (c *converter) StatusEditToAPIStatusEdit(ctx context.Context, e *gtsmodel.StatusEdit, s *gtsmodel.Status) (*apimodel.StatusEdit, error) {
+	if s.Account == nil {
+		a, err := c.db.GetAccountByID(ctx, s.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting status author: %s", err)
+		}
+		s.Account = a
+	}
+
+	apiAuthorAccount, err := c.AccountToAPIAccountPublic(ctx, s.Account)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing account of status author: %s", err)
+	}
+
+	// convert attachments of this revision to frontend api model attachments
+	apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, nil, e.AttachmentIDs)
+	if err != nil {
+		log.Errorf("error converting status edit attachments: %v", err)
+	}
+
+	// convert emojis of this revision to frontend api model emojis
+	apiEmojis, err := c.convertEmojisToAPIEmojis(ctx, nil, e.EmojiIDs)
+	if err != nil {
+		log.Errorf("error converting status edit emojis: %v", err)
+	}
+
+	var apiPoll *apimodel.StatusEditPoll
+	if len(e.PollOptions) != 0 {
+		apiPoll = &apimodel.StatusEditPoll{
+			Options: make([]apimodel.StatusEditPollOption, len(e.PollOptions)),
+		}
+		for i, title := range e.PollOptions {
+			apiPoll.Options[i].Title = title
+		}
+	}
+
+	return &apimodel.StatusEdit{
+		Content:          e.Content,
+		SpoilerText:      e.ContentWarning,
+		Sensitive:        *e.Sensitive,
+		CreatedAt:        util.FormatISO8601(e.CreatedAt),
+		Account:          apiAuthorAccount,
+		Poll:             apiPoll,
+		MediaAttachments: apiAttachments,
+		Emojis:           apiEmojis,
+	}, nil
+}
+
 // VisToapi converts a gts visibility into its api equivalent
 func (c *converter) VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) apimodel.Visibility {
 	switch m {
@@ -688,7 +889,7 @@ func (c *converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 		Languages:        []string{}, // todo: not supported yet
 		Registrations:    config.GetAccountsRegistrationOpen(),
 		ApprovalRequired: config.GetAccountsApprovalRequired(),
-		InvitesEnabled:   false, // todo: not supported yet
+		InvitesEnabled:   true,
 		MaxTootChars:     uint(config.GetStatusesMaxChars()),
 	}
 
@@ -961,6 +1162,15 @@ func (c *converter) DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel
 	return domainBlock, nil
 }
 
+func (c *converter) EmailDomainBlockToAPIEmailDomainBlock(ctx context.Context, b *gtsmodel.EmailDomainBlock) (*apimodel.EmailDomainBlock, error) {
+	return &apimodel.EmailDomainBlock{
+		ID:        b.ID,
+		Domain:    b.Domain,
+		CreatedBy: b.CreatedByAccountID,
+		CreatedAt: util.FormatISO8601(b.CreatedAt),
+	}, nil
+}
+
 func (c *converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error) {
 	report := &apimodel.Report{
 		ID:          r.ID,
@@ -1204,8 +1414,8 @@ func (c *converter) convertTagsToAPITags(ctx context.Context, tags []*gtsmodel.T
 
 		// Fetch GTS models for tag IDs
 		for _, id := range tagIDs {
-			tag := new(gtsmodel.Tag)
-			if err := c.db.GetByID(ctx, id, tag); err != nil {
+			tag, err := c.db.GetTag(ctx, id)
+			if err != nil {
 				errs.Appendf("error fetching tag %s from database: %v", id, err)
 				continue
 			}
@@ -1228,3 +1438,431 @@ func (c *converter) convertTagsToAPITags(ctx context.Context, tags []*gtsmodel.T
 
 	return apiTags, errs.Combine()
 }
+
+func (c *converter) ScheduledStatusToAPIScheduledStatus(ctx context.Context, s *gtsmodel.ScheduledStatus) (*apimodel.ScheduledStatus, error) {
+	// convert attachments reserved for this status to frontend api model attachments
+	apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, s.Attachments, s.AttachmentIDs)
+	if err != nil {
+		log.Errorf("error converting scheduled status attachments: %v", err)
+	}
+
+	var apiPoll *apimodel.PollRequest
+	if len(s.PollOptions) != 0 {
+		apiPoll = &apimodel.PollRequest{
+			Options:    s.PollOptions,
+			ExpiresIn:  s.PollExpiresIn,
+			Multiple:   *s.PollMultiple,
+			HideTotals: *s.PollHideTotals,
+		}
+	}
+
+	return &apimodel.ScheduledStatus{
+		ID:          s.ID,
+		ScheduledAt: util.FormatISO8601(s.ScheduledAt),
+		Params: &apimodel.StatusParams{
+			Text:          s.Text,
+			InReplyToID:   s.InReplyToID,
+			MediaIDs:      s.AttachmentIDs,
+			Poll:          apiPoll,
+			Sensitive:     *s.Sensitive,
+			SpoilerText:   s.ContentWarning,
+			Visibility:    c.VisToAPIVis(ctx, s.Visibility),
+			Language:      s.Language,
+			ApplicationID: s.ApplicationID,
+		},
+		MediaAttachments: apiAttachments,
+	}, nil
+}
+
+func (c *converter) InviteToAPIInvite(ctx context.Context, i *gtsmodel.Invite) (*apimodel.Invite, error) {
+	var expiresAt *string
+	if !i.ExpiresAt.IsZero() {
+		e := util.FormatISO8601(i.ExpiresAt)
+		expiresAt = &e
+	}
+
+	var maxUses *int
+	if i.MaxUses != 0 {
+		m := i.MaxUses
+		maxUses = &m
+	}
+
+	return &apimodel.Invite{
+		ID:           i.ID,
+		Code:         i.Code,
+		CreatedAt:    util.FormatISO8601(i.CreatedAt),
+		ExpiresAt:    expiresAt,
+		MaxUses:      maxUses,
+		Uses:         i.Uses,
+		SkipApproval: *i.SkipApproval,
+		Expired:      !i.Usable(time.Now()),
+	}, nil
+}
+
+func (c *converter) ConversationToAPIConversation(ctx context.Context, conversation *gtsmodel.Conversation, requestingAccount *gtsmodel.Account) (*apimodel.Conversation, error) {
+	if conversation.OtherAccounts == nil {
+		for _, id := range conversation.OtherAccountIDs {
+			account, err := c.db.GetAccountByID(ctx, id)
+			if err != nil {
+				// account may have been deleted since
+				log.Errorf("error getting conversation participant %s: %v", id, err)
+				continue
+			}
+			conversation.OtherAccounts = append(conversation.OtherAccounts, account)
+		}
+	}
+
+	apiAccounts := make([]apimodel.Account, 0, len(conversation.OtherAccounts))
+	for _, account := range conversation.OtherAccounts {
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			return nil, fmt.Errorf("ConversationToAPIConversation: error converting account %s to api account: %w", account.ID, err)
+		}
+		apiAccounts = append(apiAccounts, *apiAccount)
+	}
+
+	if conversation.LastStatus == nil {
+		lastStatus, err := c.db.GetStatusByID(ctx, conversation.LastStatusID)
+		if err != nil {
+			return nil, fmt.Errorf("ConversationToAPIConversation: error getting last status %s: %w", conversation.LastStatusID, err)
+		}
+		conversation.LastStatus = lastStatus
+	}
+
+	apiLastStatus, err := c.StatusToAPIStatus(ctx, conversation.LastStatus, requestingAccount)
+	if err != nil {
+		return nil, fmt.Errorf("ConversationToAPIConversation: error converting last status %s to api status: %w", conversation.LastStatusID, err)
+	}
+
+	return &apimodel.Conversation{
+		ID:         conversation.ID,
+		Accounts:   apiAccounts,
+		Unread:     !*conversation.Read,
+		LastStatus: apiLastStatus,
+	}, nil
+}
+
+func (c *converter) MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, error) {
+	apiMarker := &apimodel.Marker{}
+	for _, marker := range markers {
+		apiTimelineMarker := &apimodel.TimelineMarker{
+			LastReadID: marker.LastReadID,
+			UpdatedAt:  util.FormatISO8601(marker.UpdatedAt),
+			Version:    marker.Version,
+		}
+		switch apimodel.MarkerName(marker.Name) {
+		case apimodel.MarkerNameHome:
+			apiMarker.Home = apiTimelineMarker
+		case apimodel.MarkerNameNotifications:
+			apiMarker.Notifications = apiTimelineMarker
+		default:
+			return nil, fmt.Errorf("MarkersToAPIMarker: unknown marker timeline name: %s", marker.Name)
+		}
+	}
+	return apiMarker, nil
+}
+
+func (c *converter) AnnouncementToAPIAnnouncement(ctx context.Context, a *gtsmodel.Announcement, requestingAccount *gtsmodel.Account) (*apimodel.Announcement, error) {
+	apiAnnouncement := &apimodel.Announcement{
+		ID:          a.ID,
+		Content:     a.Content,
+		AllDay:      *a.AllDay,
+		PublishedAt: util.FormatISO8601(a.PublishedAt),
+		UpdatedAt:   util.FormatISO8601(a.UpdatedAt),
+		Published:   *a.Published,
+		Mentions:    []apimodel.Mention{},
+		Statuses:    []apimodel.Status{},
+		Tags:        []apimodel.Tag{},
+		Emojis:      []apimodel.Emoji{},
+	}
+
+	if !a.StartsAt.IsZero() {
+		apiAnnouncement.StartsAt = util.FormatISO8601(a.StartsAt)
+	}
+
+	if !a.EndsAt.IsZero() {
+		apiAnnouncement.EndsAt = util.FormatISO8601(a.EndsAt)
+	}
+
+	if !*a.Published {
+		// not published yet, so show when it's going to be
+		apiAnnouncement.PublishedAt = ""
+		if !a.ScheduledAt.IsZero() {
+			apiAnnouncement.PublishedAt = util.FormatISO8601(a.ScheduledAt)
+		}
+	}
+
+	if requestingAccount != nil {
+		read, err := c.db.IsAnnouncementDismissed(ctx, a.ID, requestingAccount.ID)
+		if err != nil {
+			return nil, fmt.Errorf("AnnouncementToAPIAnnouncement: error checking dismissal of announcement %s: %w", a.ID, err)
+		}
+		apiAnnouncement.Read = read
+	}
+
+	for _, accountID := range a.MentionedAccountIDs {
+		apiMention, err := c.MentionToAPIMention(ctx, &gtsmodel.Mention{TargetAccountID: accountID})
+		if err != nil {
+			log.Errorf("AnnouncementToAPIAnnouncement: error converting mention of account %s: %v", accountID, err)
+			continue
+		}
+		apiAnnouncement.Mentions = append(apiAnnouncement.Mentions, apiMention)
+	}
+
+	for _, tagID := range a.TagIDs {
+		tag, err := c.db.GetTag(ctx, tagID)
+		if err != nil {
+			log.Errorf("AnnouncementToAPIAnnouncement: error getting tag %s: %v", tagID, err)
+			continue
+		}
+		apiTag, err := c.TagToAPITag(ctx, tag)
+		if err != nil {
+			log.Errorf("AnnouncementToAPIAnnouncement: error converting tag %s: %v", tagID, err)
+			continue
+		}
+		apiAnnouncement.Tags = append(apiAnnouncement.Tags, apiTag)
+	}
+
+	for _, emojiID := range a.EmojiIDs {
+		emoji, err := c.db.GetEmojiByID(ctx, emojiID)
+		if err != nil {
+			log.Errorf("AnnouncementToAPIAnnouncement: error getting emoji %s: %v", emojiID, err)
+			continue
+		}
+		apiEmoji, err := c.EmojiToAPIEmoji(ctx, emoji)
+		if err != nil {
+			log.Errorf("AnnouncementToAPIAnnouncement: error converting emoji %s: %v", emojiID, err)
+			continue
+		}
+		apiAnnouncement.Emojis = append(apiAnnouncement.Emojis, apiEmoji)
+	}
+
+	reactions, err := c.db.GetAnnouncementReactions(ctx, a.ID)
+	if err != nil {
+		return nil, fmt.Errorf("AnnouncementToAPIAnnouncement: error getting reactions to announcement %s: %w", a.ID, err)
+	}
+
+	apiAnnouncement.Reactions, err = c.AnnouncementReactionsToAPIAnnouncementReactions(ctx, reactions, requestingAccount)
+	if err != nil {
+		return nil, fmt.Errorf("AnnouncementToAPIAnnouncement: %w", err)
+	}
+
+	return apiAnnouncement, nil
+}
+
+func (c *converter) AnnouncementReactionsToAPIAnnouncementReactions(ctx context.Context, reactions []*gtsmodel.AnnouncementReaction, requestingAccount *gtsmodel.Account) ([]apimodel.AnnouncementReaction, error) {
+	apiReactions := []apimodel.AnnouncementReaction{}
+	indexes := make(map[string]int, len(reactions))
+
+	for _, reaction := range reactions {
+		i, ok := indexes[reaction.Name]
+		if !ok {
+			apiReaction := apimodel.AnnouncementReaction{
+				Name: reaction.Name,
+			}
+
+			if reaction.EmojiID != "" {
+				if reaction.Emoji == nil {
+					emoji, err := c.db.GetEmojiByID(ctx, reaction.EmojiID)
+					if err != nil {
+						return nil, fmt.Errorf("AnnouncementReactionsToAPIAnnouncementReactions: error getting emoji %s: %w", reaction.EmojiID, err)
+					}
+					reaction.Emoji = emoji
+				}
+				apiReaction.URL = reaction.Emoji.ImageURL
+				apiReaction.StaticURL = reaction.Emoji.ImageStaticURL
+			}
+
+			i = len(apiReactions)
+			indexes[reaction.Name] = i
+			apiReactions = append(apiReactions, apiReaction)
+		}
+
+		apiReactions[i].Count++
+		if requestingAccount != nil && reaction.AccountID == requestingAccount.ID {
+			apiReactions[i].Me = true
+		}
+	}
+
+	return apiReactions, nil
+}
+
+func (c *converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error) {
+	return &apimodel.List{
+		ID:            l.ID,
+		Title:         l.Title,
+		RepliesPolicy: string(l.RepliesPolicy),
+	}, nil
+}
+
+func (c *converter) FilterKeywordToAPIFilterV1(ctx context.Context, fk *gtsmodel.FilterKeyword) (*apimodel.Filter, error) {
+	if fk.Filter == nil {
+		return nil, fmt.Errorf("FilterKeywordToAPIFilterV1: filter keyword %s has no filter set", fk.ID)
+	}
+
+	var expiresAt string
+	if !fk.Filter.ExpiresAt.IsZero() {
+		expiresAt = util.FormatISO8601(fk.Filter.ExpiresAt)
+	}
+
+	return &apimodel.Filter{
+		// v1 filters have a single keyword each,
+		// so use the keyword's ID as the filter ID.
+		ID:           fk.ID,
+		Phrase:       fk.Keyword,
+		Context:      filterToAPIFilterContexts(fk.Filter),
+		WholeWord:    fk.WholeWord != nil && *fk.WholeWord,
+		ExpiresAt:    expiresAt,
+		Irreversible: fk.Filter.Action == gtsmodel.FilterActionHide,
+	}, nil
+}
+
+func (c *converter) FilterToAPIFilterV2(ctx context.Context, f *gtsmodel.Filter) (*apimodel.FilterV2, error) {
+	var expiresAt *string
+	if !f.ExpiresAt.IsZero() {
+		expiresAtStr := util.FormatISO8601(f.ExpiresAt)
+		expiresAt = &expiresAtStr
+	}
+
+	apiKeywords := make([]apimodel.FilterKeyword, 0, len(f.Keywords))
+	for _, fk := range f.Keywords {
+		apiKeyword, err := c.FilterKeywordToAPIFilterKeyword(ctx, fk)
+		if err != nil {
+			return nil, err
+		}
+		apiKeywords = append(apiKeywords, *apiKeyword)
+	}
+
+	return &apimodel.FilterV2{
+		ID:           f.ID,
+		Title:        f.Title,
+		Context:      filterToAPIFilterContexts(f),
+		ExpiresAt:    expiresAt,
+		FilterAction: string(f.Action),
+		Keywords:     apiKeywords,
+		Statuses:     []apimodel.FilterStatus{},
+	}, nil
+}
+
+func (c *converter) FilterKeywordToAPIFilterKeyword(ctx context.Context, fk *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, error) {
+	return &apimodel.FilterKeyword{
+		ID:        fk.ID,
+		Keyword:   fk.Keyword,
+		WholeWord: fk.WholeWord != nil && *fk.WholeWord,
+	}, nil
+}
+
+// filterToAPIFilterContexts returns the
+// contexts that the given filter applies to.
+func filterToAPIFilterContexts(f *gtsmodel.Filter) []string {
+	contexts := make([]string, 0, 5)
+	for _, fc := range []gtsmodel.FilterContext{
+		gtsmodel.FilterContextHome,
+		gtsmodel.FilterContextNotifications,
+		gtsmodel.FilterContextPublic,
+		gtsmodel.FilterContextThread,
+		gtsmodel.FilterContextAccount,
+	} {
+		if f.AppliesTo(fc) {
+			contexts = append(contexts, string(fc))
+		}
+	}
+	return contexts
+}
+
+func (c *converter) ApplyFilters(ctx context.Context, s *apimodel.Status, filters []*gtsmodel.Filter, filterContext gtsmodel.FilterContext) (*apimodel.Status, error) {
+	if len(filters) == 0 {
+		// Nothing to do.
+		return s, nil
+	}
+
+	// Gather the plaintext fields we
+	// want to match filter keywords against.
+	fields := filterableFields(s)
+	if s.Reblog != nil && s.Reblog.Status != nil {
+		fields = append(fields, filterableFields(s.Reblog.Status)...)
+	}
+
+	now := time.Now()
+	var results []apimodel.FilterResult
+
+	for _, filter := range filters {
+		if filter.Expired(now) || !filter.AppliesTo(filterContext) {
+			// Filter doesn't apply here.
+			continue
+		}
+
+		var keywordMatches []string
+		for _, keyword := range filter.Keywords {
+			if keyword.Regexp == nil {
+				// Keyword wasn't compiled;
+				// can't apply it.
+				continue
+			}
+
+			for _, field := range fields {
+				if keyword.Regexp.MatchString(field) {
+					keywordMatches = append(keywordMatches, keyword.Keyword)
+					break
+				}
+			}
+		}
+
+		if len(keywordMatches) == 0 {
+			// Filter didn't match.
+			continue
+		}
+
+		if filter.Action == gtsmodel.FilterActionHide {
+			// No need to check anything else,
+			// this status should just be hidden.
+			return nil, ErrHideStatus
+		}
+
+		apiFilter, err := c.FilterToAPIFilterV2(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("ApplyFilters: error converting filter %s: %w", filter.ID, err)
+		}
+
+		results = append(results, apimodel.FilterResult{
+			Filter:         *apiFilter,
+			KeywordMatches: keywordMatches,
+			StatusMatches:  []string{},
+		})
+	}
+
+	if len(results) == 0 {
+		// Status wasn't filtered.
+		return s, nil
+	}
+
+	// Copy the status so we don't modify
+	// the original, then set filter results.
+	filtered := new(apimodel.Status)
+	*filtered = *s
+	filtered.Filtered = results
+
+	return filtered, nil
+}
+
+// filterableFields returns the plaintext representations of the
+// parts of a status that should be checked against filter keywords.
+func filterableFields(s *apimodel.Status) []string {
+	fields := make([]string, 0, 2+len(s.MediaAttachments))
+
+	if s.SpoilerText != "" {
+		fields = append(fields, s.SpoilerText)
+	}
+
+	if s.Content != "" {
+		fields = append(fields, text.SanitizePlaintext(s.Content))
+	}
+
+	for _, attachment := range s.MediaAttachments {
+		if attachment.Description != nil && *attachment.Description != "" {
+			fields = append(fields, *attachment.Description)
+		}
+	}
+
+	return fields
+}
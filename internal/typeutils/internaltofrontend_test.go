@@ -22,11 +22,14 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 type InternalToFrontendTestSuite struct {
@@ -420,6 +423,58 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestApplyFilters() {
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	requestingAccount := suite.testAccounts["local_account_1"]
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(context.Background(), testStatus, requestingAccount)
+	suite.NoError(err)
+
+	filter := &gtsmodel.Filter{
+		ID:             "01HN2CY1RG4GN1RW9XZ8D8EXCT",
+		AccountID:      requestingAccount.ID,
+		Title:          "greetings",
+		Action:         gtsmodel.FilterActionWarn,
+		ContextHome:    testrig.TrueBool(),
+		ContextAccount: testrig.FalseBool(),
+	}
+	keyword := &gtsmodel.FilterKeyword{
+		ID:        "01HN2CYRG2HSTD9DWJ0AQ4F1EC",
+		AccountID: requestingAccount.ID,
+		FilterID:  filter.ID,
+		Keyword:   "HELLO",
+		WholeWord: testrig.TrueBool(),
+	}
+	suite.NoError(keyword.Compile())
+	filter.Keywords = []*gtsmodel.FilterKeyword{keyword}
+	filters := []*gtsmodel.Filter{filter}
+
+	// Warn in the home context: status should be
+	// copied, and the original left untouched.
+	filtered, err := suite.typeconverter.ApplyFilters(context.Background(), apiStatus, filters, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.NotSame(apiStatus, filtered)
+	suite.Empty(apiStatus.Filtered)
+	suite.Len(filtered.Filtered, 1)
+	suite.Equal("greetings", filtered.Filtered[0].Filter.Title)
+	suite.Equal([]string{"HELLO"}, filtered.Filtered[0].KeywordMatches)
+
+	// Filter doesn't apply in the account context.
+	filtered, err = suite.typeconverter.ApplyFilters(context.Background(), apiStatus, filters, gtsmodel.FilterContextAccount)
+	suite.NoError(err)
+	suite.Same(apiStatus, filtered)
+
+	// Hide action should return ErrHideStatus.
+	filter.Action = gtsmodel.FilterActionHide
+	_, err = suite.typeconverter.ApplyFilters(context.Background(), apiStatus, filters, gtsmodel.FilterContextHome)
+	suite.ErrorIs(err, typeutils.ErrHideStatus)
+
+	// Expired filters should not be applied.
+	filter.ExpiresAt = time.Now().Add(-1 * time.Minute)
+	filtered, err = suite.typeconverter.ApplyFilters(context.Background(), apiStatus, filters, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.Same(apiStatus, filtered)
+}
+
 func (suite *InternalToFrontendTestSuite) TestVideoAttachmentToFrontend() {
 	testAttachment := suite.testAttachments["local_account_1_status_4_attachment_2"]
 	apiAttachment, err := suite.typeconverter.AttachmentToAPIAttachment(context.Background(), testAttachment)
@@ -455,6 +510,60 @@ func (suite *InternalToFrontendTestSuite) TestVideoAttachmentToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestAudioAttachmentToFrontend() {
+	testAttachment := &gtsmodel.MediaAttachment{}
+	*testAttachment = *suite.testAttachments["local_account_1_status_4_attachment_2"]
+
+	// make it an audio file instead
+	duration := float32(182.43)
+	bitrate := uint64(128000)
+	testAttachment.Type = gtsmodel.FileTypeAudio
+	testAttachment.URL = "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01CDR64G398ADCHXK08WWTHEZ5.mp3"
+	testAttachment.File.ContentType = "audio/mpeg"
+	testAttachment.Description = "A cow mooing"
+	testAttachment.FileMeta = gtsmodel.FileMeta{
+		Original: gtsmodel.Original{
+			Duration: &duration,
+			Bitrate:  &bitrate,
+		},
+		Small: gtsmodel.Small{
+			Width:  512,
+			Height: 512,
+			Size:   262144,
+			Aspect: 1,
+		},
+	}
+
+	apiAttachment, err := suite.typeconverter.AttachmentToAPIAttachment(context.Background(), testAttachment)
+	suite.NoError(err)
+
+	b, err := json.MarshalIndent(apiAttachment, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "id": "01CDR64G398ADCHXK08WWTHEZ5",
+  "type": "audio",
+  "url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01CDR64G398ADCHXK08WWTHEZ5.mp3",
+  "text_url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01CDR64G398ADCHXK08WWTHEZ5.mp3",
+  "preview_url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01CDR64G398ADCHXK08WWTHEZ5.jpg",
+  "remote_url": null,
+  "preview_remote_url": null,
+  "meta": {
+    "original": {
+      "duration": 182.43,
+      "bitrate": 128000
+    },
+    "small": {
+      "width": 512,
+      "height": 512,
+      "size": "512x512",
+      "aspect": 1
+    }
+  },
+  "description": "A cow mooing"
+}`, string(b))
+}
+
 func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
 	ctx := context.Background()
 
@@ -481,7 +590,7 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -494,7 +603,10 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -603,7 +715,10 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
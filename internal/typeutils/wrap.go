@@ -67,15 +67,15 @@ func (c *converter) WrapPersonInUpdate(person vocab.ActivityStreamsPerson, origi
 	return update, nil
 }
 
-func (c *converter) WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error) {
+func (c *converter) WrapNoteInCreate(note ap.Statusable, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error) {
 	create := streams.NewActivityStreamsCreate()
 
 	// Object property
 	objectProp := streams.NewActivityStreamsObjectProperty()
 	if objectIRIOnly {
 		objectProp.AppendIRI(note.GetJSONLDId().GetIRI())
-	} else {
-		objectProp.AppendActivityStreamsNote(note)
+	} else if err := objectProp.AppendType(note); err != nil {
+		return nil, fmt.Errorf("WrapNoteInCreate: couldn't append object: %s", err)
 	}
 	create.SetActivityStreamsObject(objectProp)
 
@@ -129,3 +129,44 @@ func (c *converter) WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOn
 
 	return create, nil
 }
+
+func (c *converter) WrapNoteInUpdate(note ap.Statusable, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error) {
+	update := streams.NewActivityStreamsUpdate()
+
+	// set the actor
+	actorURI, err := url.Parse(originAccount.URI)
+	if err != nil {
+		return nil, fmt.Errorf("WrapNoteInUpdate: error parsing url %s: %s", originAccount.URI, err)
+	}
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(actorURI)
+	update.SetActivityStreamsActor(actorProp)
+
+	// set the ID
+	newID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, err
+	}
+
+	idString := uris.GenerateURIForUpdate(originAccount.Username, newID)
+	idURI, err := url.Parse(idString)
+	if err != nil {
+		return nil, fmt.Errorf("WrapNoteInUpdate: error parsing url %s: %s", idString, err)
+	}
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.SetIRI(idURI)
+	update.SetJSONLDId(idProp)
+
+	// set the note as the object here
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	if err := objectProp.AppendType(note); err != nil {
+		return nil, fmt.Errorf("WrapNoteInUpdate: couldn't append object: %s", err)
+	}
+	update.SetActivityStreamsObject(objectProp)
+
+	// to and cc should be the same as the note
+	update.SetActivityStreamsTo(note.GetActivityStreamsTo())
+	update.SetActivityStreamsCc(note.GetActivityStreamsCc())
+
+	return update, nil
+}
@@ -36,9 +36,11 @@ const (
 	LikedPath        = "liked"         // LikedPath represents the activitypub liked location
 	CollectionsPath  = "collections"   // CollectionsPath represents the activitypub collections location
 	FeaturedPath     = "featured"      // FeaturedPath represents the activitypub featured location
+	FeaturedTagsPath = "tags"          // FeaturedTagsPath represents the activitypub featured tags location
 	PublicKeyPath    = "main-key"      // PublicKeyPath is for serving an account's public key
 	FollowPath       = "follow"        // FollowPath used to generate the URI for an individual follow or follow request
 	UpdatePath       = "updates"       // UpdatePath is used to generate the URI for an account update
+	MovesPath        = "moves"         // MovesPath is used to generate the URI for an account move
 	BlocksPath       = "blocks"        // BlocksPath is used to generate the URI for a block
 	ReportsPath      = "reports"       // ReportsPath is used to generate the URI for a report/flag
 	ConfirmEmailPath = "confirm_email" // ConfirmEmailPath is used to generate the URI for an email confirmation link
@@ -71,6 +73,8 @@ type UserURIs struct {
 	LikedURI string
 	// The activitypub URI for this user's featured collections, eg., https://example.org/users/example_user/collections/featured
 	CollectionURI string
+	// The activitypub URI for this user's featured tags, eg., https://example.org/users/example_user/collections/tags
+	FeaturedTagsURI string
 	// The URI for this user's public key, eg., https://example.org/users/example_user/publickey
 	PublicKeyURI string
 }
@@ -99,6 +103,14 @@ func GenerateURIForUpdate(username string, thisUpdateID string) string {
 	return fmt.Sprintf("%s://%s/%s/%s#%s/%s", protocol, host, UsersPath, username, UpdatePath, thisUpdateID)
 }
 
+// GenerateURIForMove returns the AP URI for a new move activity -- something like:
+// https://example.org/users/whatever_user#moves/01F7XTH1QGBAPMGF49WJZ91XGC
+func GenerateURIForMove(username string, thisMoveID string) string {
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	return fmt.Sprintf("%s://%s/%s/%s#%s/%s", protocol, host, UsersPath, username, MovesPath, thisMoveID)
+}
+
 // GenerateURIForBlock returns the AP URI for a new block activity -- something like:
 // https://example.org/users/whatever_user/blocks/01F7XTH1QGBAPMGF49WJZ91XGC
 func GenerateURIForBlock(username string, thisBlockID string) string {
@@ -145,6 +157,7 @@ func GenerateURIsForAccount(username string) *UserURIs {
 	followingURI := fmt.Sprintf("%s/%s", userURI, FollowingPath)
 	likedURI := fmt.Sprintf("%s/%s", userURI, LikedPath)
 	collectionURI := fmt.Sprintf("%s/%s/%s", userURI, CollectionsPath, FeaturedPath)
+	featuredTagsURI := fmt.Sprintf("%s/%s/%s", userURI, CollectionsPath, FeaturedTagsPath)
 	publicKeyURI := fmt.Sprintf("%s/%s", userURI, PublicKeyPath)
 
 	return &UserURIs{
@@ -152,15 +165,16 @@ func GenerateURIsForAccount(username string) *UserURIs {
 		UserURL:     userURL,
 		StatusesURL: statusesURL,
 
-		UserURI:       userURI,
-		StatusesURI:   statusesURI,
-		InboxURI:      inboxURI,
-		OutboxURI:     outboxURI,
-		FollowersURI:  followersURI,
-		FollowingURI:  followingURI,
-		LikedURI:      likedURI,
-		CollectionURI: collectionURI,
-		PublicKeyURI:  publicKeyURI,
+		UserURI:         userURI,
+		StatusesURI:     statusesURI,
+		InboxURI:        inboxURI,
+		OutboxURI:       outboxURI,
+		FollowersURI:    followersURI,
+		FollowingURI:    followingURI,
+		LikedURI:        likedURI,
+		CollectionURI:   collectionURI,
+		FeaturedTagsURI: featuredTagsURI,
+		PublicKeyURI:    publicKeyURI,
 	}
 }
 
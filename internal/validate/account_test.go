@@ -54,7 +54,6 @@ func happyAccount() *gtsmodel.Account {
 		Fields:                  []gtsmodel.Field{},
 		Note:                    "hey yo this is my profile!",
 		Memorial:                testrig.FalseBool(),
-		AlsoKnownAs:             "",
 		MovedToAccountID:        "",
 		Bot:                     testrig.FalseBool(),
 		Reason:                  "I wanna be on this damned webbed site so bad! Please! Wow",
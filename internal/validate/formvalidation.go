@@ -23,9 +23,11 @@ import (
 	"fmt"
 	"net/mail"
 	"strings"
+	"unicode"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/regexes"
 	pwv "github.com/wagslane/go-password-validator"
 	"golang.org/x/text/language"
@@ -43,6 +45,11 @@ const (
 	maximumUsernameLength         = 64
 	maximumCustomCSSLength        = 5000
 	maximumEmojiCategoryLength    = 64
+	maximumListTitleLength        = 200
+	maximumFilterTitleLength      = 200
+	maximumFilterKeywordLength    = 100
+	maximumAnnouncementLength     = 5000
+	maximumUnicodeEmojiLength     = 64 // in bytes; long enough for zwj sequences like family emojis
 )
 
 // NewPassword returns an error if the given password is not sufficiently strong, or nil if it's ok.
@@ -227,6 +234,150 @@ func SiteTerms(t string) error {
 	return nil
 }
 
+// ListTitle validates the title of a new or updated List.
+func ListTitle(title string) error {
+	if title == "" {
+		return fmt.Errorf("list title must be provided, and must be no more than %d chars", maximumListTitleLength)
+	}
+
+	if length := len([]rune(title)); length > maximumListTitleLength {
+		return fmt.Errorf("list title should be no more than %d chars but given title was %d", maximumListTitleLength, length)
+	}
+
+	return nil
+}
+
+// ListRepliesPolicy validates the replies_policy of a new or updated list.
+func ListRepliesPolicy(repliesPolicy gtsmodel.RepliesPolicy) error {
+	switch repliesPolicy {
+	case "", gtsmodel.RepliesPolicyFollowed, gtsmodel.RepliesPolicyList, gtsmodel.RepliesPolicyNone:
+		return nil
+	default:
+		return fmt.Errorf("list replies_policy must be either empty or one of 'followed', 'list', 'none'")
+	}
+}
+
+// FilterTitle validates the title of a new or updated filter.
+func FilterTitle(title string) error {
+	if title == "" {
+		return fmt.Errorf("filter title must be provided, and must be no more than %d chars", maximumFilterTitleLength)
+	}
+
+	if length := len([]rune(title)); length > maximumFilterTitleLength {
+		return fmt.Errorf("filter title should be no more than %d chars but given title was %d", maximumFilterTitleLength, length)
+	}
+
+	return nil
+}
+
+// FilterKeyword validates a keyword or phrase of a new or updated filter.
+func FilterKeyword(keyword string) error {
+	if keyword == "" {
+		return fmt.Errorf("filter keyword must be provided, and must be no more than %d chars", maximumFilterKeywordLength)
+	}
+
+	if length := len([]rune(keyword)); length > maximumFilterKeywordLength {
+		return fmt.Errorf("filter keyword should be no more than %d chars but given keyword was %d", maximumFilterKeywordLength, length)
+	}
+
+	return nil
+}
+
+// FilterContexts validates the contexts of a new or updated filter.
+// At least one context must be provided.
+func FilterContexts(contexts []string) error {
+	if len(contexts) == 0 {
+		return errors.New("at least one filter context must be provided")
+	}
+
+	for _, context := range contexts {
+		switch gtsmodel.FilterContext(context) {
+		case gtsmodel.FilterContextHome,
+			gtsmodel.FilterContextNotifications,
+			gtsmodel.FilterContextPublic,
+			gtsmodel.FilterContextThread,
+			gtsmodel.FilterContextAccount:
+			continue
+		default:
+			return fmt.Errorf("filter context %q not recognized, must be one of 'home', 'notifications', 'public', 'thread', 'account'", context)
+		}
+	}
+
+	return nil
+}
+
+// FilterAction validates the action of a new or updated filter.
+func FilterAction(action gtsmodel.FilterAction) error {
+	switch action {
+	case "", gtsmodel.FilterActionWarn, gtsmodel.FilterActionHide:
+		return nil
+	default:
+		return fmt.Errorf("filter action must be either empty or one of 'warn', 'hide'")
+	}
+}
+
+// FilterExpiresIn validates the expires_in of a new or updated filter.
+func FilterExpiresIn(expiresIn *int) error {
+	if expiresIn != nil && *expiresIn < 0 {
+		return fmt.Errorf("filter expires_in must be a positive number of seconds, or 0 for no expiry")
+	}
+
+	return nil
+}
+
+// AnnouncementText validates the text of a new or updated announcement.
+func AnnouncementText(text string) error {
+	if text == "" {
+		return errors.New("announcement text must be provided")
+	}
+
+	if length := len([]rune(text)); length > maximumAnnouncementLength {
+		return fmt.Errorf("announcement text should be no more than %d chars but given text was %d", maximumAnnouncementLength, length)
+	}
+
+	return nil
+}
+
+// UnicodeEmoji checks that the given string is a single unicode emoji,
+// including modifier and zero width joiner sequences, flags, and keycaps.
+// This is a loose check: it doesn't know every valid emoji sequence,
+// but it ensures that there's only emoji-ish symbols in the string.
+func UnicodeEmoji(emoji string) error {
+	if emoji == "" || len(emoji) > maximumUnicodeEmojiLength {
+		return fmt.Errorf("%q is not a unicode emoji", emoji)
+	}
+
+	var symbols int
+	keycap := strings.ContainsRune(emoji, '\u20E3')
+	for _, r := range emoji {
+		switch {
+		case unicode.Is(unicode.So, r):
+			// emoji proper, and regional indicators for flags
+			symbols++
+		case unicode.Is(unicode.Sk, r),
+			// skin tone modifiers
+			r == '\u200D',
+			// zero width joiner
+			r == '\uFE0E', r == '\uFE0F',
+			// variation selectors
+			r >= '\U000E0020' && r <= '\U000E007F':
+			// tags, for subdivision flags
+			continue
+		case keycap && (r == '\u20E3' || r == '#' || r == '*' || (r >= '0' && r <= '9')):
+			// keycap sequence like #️⃣
+			symbols++
+		default:
+			return fmt.Errorf("%q is not a unicode emoji", emoji)
+		}
+	}
+
+	if symbols == 0 {
+		return fmt.Errorf("%q is not a unicode emoji", emoji)
+	}
+
+	return nil
+}
+
 // ULID returns true if the passed string is a valid ULID.
 func ULID(i string) bool {
 	return regexes.ULID.MatchString(i)
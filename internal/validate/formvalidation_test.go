@@ -21,6 +21,7 @@ package validate_test
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -284,6 +285,39 @@ func (suite *ValidationTestSuite) TestValidateReason() {
 	}
 }
 
+func (suite *ValidationTestSuite) TestValidateAnnouncementText() {
+	suite.NoError(validate.AnnouncementText("The instance will be down for maintenance tonight."))
+	suite.EqualError(validate.AnnouncementText(""), "announcement text must be provided")
+	suite.EqualError(validate.AnnouncementText(strings.Repeat("a", 5001)), "announcement text should be no more than 5000 chars but given text was 5001")
+}
+
+func (suite *ValidationTestSuite) TestValidateUnicodeEmoji() {
+	for _, emoji := range []string{
+		"👍",
+		"👍🏽",
+		"❤️",
+		"👩‍👩‍👧‍👦",
+		"🇳🇱",
+		"🏴󠁧󠁢󠁳󠁣󠁴󠁿",
+		"#️⃣",
+	} {
+		suite.NoError(validate.UnicodeEmoji(emoji), emoji)
+	}
+
+	for _, notEmoji := range []string{
+		"",
+		"a",
+		"blobcat",
+		"1",
+		"👍 ",
+		"👍a",
+		"🏽",
+		strings.Repeat("👍", 20),
+	} {
+		suite.Error(validate.UnicodeEmoji(notEmoji), notEmoji)
+	}
+}
+
 func TestValidationTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidationTestSuite))
 }
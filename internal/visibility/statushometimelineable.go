@@ -20,10 +20,12 @@ package visibility
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"codeberg.org/gruf/go-kv"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -48,6 +50,22 @@ func (f *filter) StatusHometimelineable(ctx context.Context, targetStatus *gtsmo
 		return true, nil
 	}
 
+	// statuses from accounts muted by the timeline owner, and boosts of their statuses, shouldn't be timelined
+	muted, err := f.db.IsMuted(ctx, timelineOwnerAccount.ID, targetStatus.AccountID)
+	if err != nil {
+		return false, fmt.Errorf("StatusHometimelineable: error checking if %s mutes %s: %s", timelineOwnerAccount.ID, targetStatus.AccountID, err)
+	}
+	if !muted && targetStatus.BoostOfAccountID != "" && targetStatus.BoostOfAccountID != timelineOwnerAccount.ID {
+		muted, err = f.db.IsMuted(ctx, timelineOwnerAccount.ID, targetStatus.BoostOfAccountID)
+		if err != nil {
+			return false, fmt.Errorf("StatusHometimelineable: error checking if %s mutes %s: %s", timelineOwnerAccount.ID, targetStatus.BoostOfAccountID, err)
+		}
+	}
+	if muted {
+		l.Debug("status is not hometimelineable because the timeline owner has muted its author")
+		return false, nil
+	}
+
 	v, err := f.StatusVisible(ctx, targetStatus, timelineOwnerAccount)
 	if err != nil {
 		return false, fmt.Errorf("StatusHometimelineable: error checking visibility of status with id %s: %s", targetStatus.ID, err)
@@ -78,7 +96,15 @@ func (f *filter) StatusHometimelineable(ctx context.Context, targetStatus *gtsmo
 		return false, fmt.Errorf("StatusHometimelineable: error checking if %s follows %s: %s", timelineOwnerAccount.ID, targetStatus.AccountID, err)
 	}
 	if !following {
-		return false, nil
+		// we don't follow the originator, but we may still
+		// want to see the status if it uses a tag we follow
+		followingTag, err := f.followsStatusTag(ctx, targetStatus, timelineOwnerAccount)
+		if err != nil {
+			return false, fmt.Errorf("StatusHometimelineable: error checking if %s follows tags of status %s: %s", timelineOwnerAccount.ID, targetStatus.ID, err)
+		}
+		if !followingTag {
+			return false, nil
+		}
 	}
 
 	// Don't timeline a status whose parent hasn't been dereferenced yet or can't be dereferenced.
@@ -124,3 +150,24 @@ func (f *filter) StatusHometimelineable(ctx context.Context, targetStatus *gtsmo
 
 	return true, nil
 }
+
+// followsStatusTag returns true if the given status is public,
+// and the given account follows at least one of its hashtags.
+func (f *filter) followsStatusTag(ctx context.Context, targetStatus *gtsmodel.Status, account *gtsmodel.Account) (bool, error) {
+	if targetStatus.Visibility != gtsmodel.VisibilityPublic {
+		// only public statuses are shown to tag followers
+		return false, nil
+	}
+
+	for _, tagID := range targetStatus.TagIDs {
+		if _, err := f.db.GetFollowedTag(ctx, account.ID, tagID); err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				continue
+			}
+			return false, err
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
@@ -67,6 +67,35 @@ func (suite *StatusStatusHometimelineableTestSuite) TestNotFollowingStatusHometi
 	suite.False(timelineable)
 }
 
+func (suite *StatusStatusHometimelineableTestSuite) TestMutedStatusNotHometimelineable() {
+	testStatus := suite.testStatuses["local_account_2_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01H3E1B7M0Q5QW2N3J8K2XKZ8R",
+		AccountID:       testAccount.ID,
+		TargetAccountID: testStatus.AccountID,
+		Notifications:   testrig.FalseBool(),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err := suite.filter.StatusHometimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	// boosts of the muted account's statuses shouldn't be timelined either
+	boost := &gtsmodel.Status{}
+	*boost = *suite.testStatuses["admin_account_status_4"]
+	boost.BoostOfID = testStatus.ID
+	boost.BoostOfAccountID = testStatus.AccountID
+
+	timelineable, err = suite.filter.StatusHometimelineable(ctx, boost, testAccount)
+	suite.NoError(err)
+	suite.False(timelineable)
+}
+
 func (suite *StatusStatusHometimelineableTestSuite) TestStatusTooNewNotTimelineable() {
 	testStatus := &gtsmodel.Status{}
 	*testStatus = *suite.testStatuses["local_account_1_status_1"]
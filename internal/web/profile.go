@@ -108,6 +108,12 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 		return
 	}
 
+	featuredTags, errWithCode := m.processor.AccountFeaturedTagsGet(ctx, authed, account.ID)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
 	stylesheets := []string{
 		assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
 		distPathPrefix + "/status.css",
@@ -123,6 +129,7 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 		"ogMeta":           ogBase(instance).withAccount(account),
 		"rssFeed":          rssFeed,
 		"robotsMeta":       robotsMeta,
+		"featuredTags":     featuredTags,
 		"statuses":         statusResp.Items,
 		"statuses_next":    statusResp.NextLink,
 		"show_back_to_top": showBackToTop,
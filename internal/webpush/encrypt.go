@@ -0,0 +1,151 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// recordSize is the record size given in the aes128gcm
+// content coding header. Notifications always fit in a
+// single record, so this also limits the payload size.
+const recordSize = 4096
+
+// encrypt encrypts the given plaintext for a subscription with the given base64url
+// encoded ECDH public key and auth secret, as described in RFC 8291, using the
+// aes128gcm content coding from RFC 8188. A fresh key pair and salt are used for
+// every message.
+func encrypt(plaintext []byte, p256dh string, auth string) ([]byte, error) {
+	private, _, _, err := elliptic.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating ecdh key: %w", err)
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("error generating salt: %w", err)
+	}
+
+	return encryptWith(plaintext, p256dh, auth, private, salt)
+}
+
+// encryptWith is like encrypt, but uses the given application server
+// private key (a P-256 scalar, as returned by elliptic.GenerateKey) and salt.
+func encryptWith(plaintext []byte, p256dh string, auth string, private []byte, salt []byte) ([]byte, error) {
+	curve := elliptic.P256()
+
+	uaPublicBytes, err := decodeBase64URL(p256dh)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding p256dh key: %w", err)
+	}
+
+	// Unmarshal also checks that the point is on the curve.
+	uaX, uaY := elliptic.Unmarshal(curve, uaPublicBytes)
+	if uaX == nil {
+		return nil, errors.New("error parsing p256dh key: not an uncompressed P-256 point")
+	}
+
+	authSecret, err := decodeBase64URL(auth)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding auth secret: %w", err)
+	}
+
+	// Payload + padding delimiter + AEAD tag must fit in one record.
+	if len(plaintext)+1+16 > recordSize {
+		return nil, fmt.Errorf("payload of %d bytes is too large", len(plaintext))
+	}
+
+	// The ECDH secret is the x coordinate of the shared point.
+	sharedX, _ := curve.ScalarMult(uaX, uaY, private)
+	ecdhSecret := sharedX.FillBytes(make([]byte, 32))
+
+	asX, asY := curve.ScalarBaseMult(private)
+	asPublicBytes := elliptic.Marshal(curve, asX, asY)
+
+	// Combine the ECDH secret with the auth secret (RFC 8291 section 3.3).
+	keyInfo := make([]byte, 0, 14+65+65)
+	keyInfo = append(keyInfo, "WebPush: info\x00"...)
+	keyInfo = append(keyInfo, uaPublicBytes...)
+	keyInfo = append(keyInfo, asPublicBytes...)
+
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ecdhSecret, authSecret, keyInfo), ikm); err != nil {
+		return nil, err
+	}
+
+	// Derive the content encryption key and nonce (RFC 8188 section 2.2 + 2.3).
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+
+	cek := make([]byte, 16)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: aes128gcm\x00")), cek); err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, 12)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: nonce\x00")), nonce); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Header: salt || record size || key id length || key id,
+	// where the key id is the application server public key.
+	body := make([]byte, 0, 16+4+1+len(asPublicBytes)+len(plaintext)+1+gcm.Overhead())
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, recordSize)
+	body = append(body, byte(len(asPublicBytes)))
+	body = append(body, asPublicBytes...)
+
+	// There's only one record, so pad it with just the
+	// final record delimiter, and seal it onto the header.
+	record := make([]byte, 0, len(plaintext)+1)
+	record = append(record, plaintext...)
+	record = append(record, 0x02)
+
+	return gcm.Seal(body, nonce, record, nil), nil
+}
+
+// decodeBase64URL decodes the given base64url string,
+// which may or may not include padding: subscriptions
+// from browsers don't, but some clients add it anyway.
+func decodeBase64URL(s string) ([]byte, error) {
+	if l := len(s) % 4; l != 0 {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
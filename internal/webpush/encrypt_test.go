@@ -0,0 +1,71 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+// TestEncryptRFC8291 checks encryption against the
+// example in RFC 8291 appendix A.
+func TestEncryptRFC8291(t *testing.T) {
+	asPrivate, _ := base64.RawURLEncoding.DecodeString("yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw")
+
+	salt, _ := base64.RawURLEncoding.DecodeString("DGv6ra1nlYgDCS1FRnbzlw")
+
+	body, err := encryptWith(
+		[]byte("When I grow up, I want to be a watermelon"),
+		"BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		"BTBZMqHH6r4Tts7J_aSIgg",
+		asPrivate,
+		salt,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN"
+	if encoded := base64.RawURLEncoding.EncodeToString(body); encoded != expected {
+		t.Fatalf("wanted %s, got %s", expected, encoded)
+	}
+}
+
+func TestEncryptPaddedKeys(t *testing.T) {
+	// Keys with base64 padding should be accepted too.
+	if _, err := encrypt(
+		[]byte("hello"),
+		"BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4=",
+		"BTBZMqHH6r4Tts7J_aSIgg==",
+	); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEncryptTooLarge(t *testing.T) {
+	_, err := encrypt(
+		[]byte(strings.Repeat("a", recordSize)),
+		"BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		"BTBZMqHH6r4Tts7J_aSIgg",
+	)
+	if err == nil || !strings.Contains(err.Error(), "too large") {
+		t.Fatalf("wanted payload too large error, got %v", err)
+	}
+}
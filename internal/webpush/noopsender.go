@@ -0,0 +1,58 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// NewNoopSender returns a no-op Web Push sender that will just execute the given
+// sendCallback every time it would otherwise push the given payload to the given
+// subscription. VAPID keys are still generated and stored in the given db.
+//
+// Passing a nil function is also acceptable, in which case Send will just return nil.
+func NewNoopSender(db db.DB, sendCallback func(subscription *gtsmodel.WebPushSubscription, payload *Payload)) Sender {
+	return &noopSender{
+		keys:         &keyStore{db: db},
+		sendCallback: sendCallback,
+	}
+}
+
+type noopSender struct {
+	keys         *keyStore
+	sendCallback func(subscription *gtsmodel.WebPushSubscription, payload *Payload)
+}
+
+func (s *noopSender) VAPIDPublicKey(ctx context.Context) (string, error) {
+	keyPair, _, err := s.keys.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return keyPair.Public, nil
+}
+
+func (s *noopSender) Send(ctx context.Context, subscription *gtsmodel.WebPushSubscription, payload *Payload) error {
+	if s.sendCallback != nil {
+		s.sendCallback(subscription, payload)
+	}
+	return nil
+}
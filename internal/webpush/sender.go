@@ -0,0 +1,109 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// pushTTL is how long push services should hold on to
+// a notification for an offline client before dropping it.
+const pushTTL = 24 * time.Hour
+
+// maxResponseBodySize is the most we'll read of a push service's
+// response body; we don't need it, so it's only drained for reuse.
+const maxResponseBodySize = 64 * 1024
+
+type sender struct {
+	keys   *keyStore
+	client HTTPClient
+}
+
+func (s *sender) VAPIDPublicKey(ctx context.Context) (string, error) {
+	keyPair, _, err := s.keys.load(ctx)
+	if err != nil {
+		return "", err
+	}
+	return keyPair.Public, nil
+}
+
+func (s *sender) Send(ctx context.Context, subscription *gtsmodel.WebPushSubscription, payload *Payload) error {
+	keyPair, private, err := s.keys.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := url.Parse(subscription.Endpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint %s: %w", subscription.Endpoint, err)
+	}
+
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	body, err := encrypt(plaintext, subscription.P256dh, subscription.Auth)
+	if err != nil {
+		return fmt.Errorf("error encrypting payload: %w", err)
+	}
+
+	authorization, err := vapidAuthorization(endpoint, keyPair, private, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(pushTTL.Seconds())))
+	req.Header.Set("Urgency", "normal")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error pushing to %s: %w", endpoint.Host, err)
+	}
+	defer resp.Body.Close()
+
+	// Drain (a bounded amount of) the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrGone
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return fmt.Errorf("error pushing to %s: %s", endpoint.Host, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// vapidTokenTTL is how long a signed VAPID token is valid for.
+// Push services reject tokens valid for longer than 24 hours.
+const vapidTokenTTL = 12 * time.Hour
+
+// keyStore lazily loads the instance VAPID key
+// pair from the database, generating it if needed.
+type keyStore struct {
+	db db.DB
+
+	mu      sync.Mutex
+	keyPair *gtsmodel.VAPIDKeyPair
+	private *ecdsa.PrivateKey
+}
+
+// load returns the instance key pair, loading
+// or generating and storing it if necessary.
+func (k *keyStore) load(ctx context.Context) (*gtsmodel.VAPIDKeyPair, *ecdsa.PrivateKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.keyPair != nil {
+		return k.keyPair, k.private, nil
+	}
+
+	keyPair, err := k.db.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return nil, nil, fmt.Errorf("error getting vapid key pair: %w", err)
+		}
+
+		// No key pair yet, generate one.
+		keyPair, err = generateVAPIDKeyPair()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating vapid key pair: %w", err)
+		}
+
+		if err := k.db.PutVAPIDKeyPair(ctx, keyPair); err != nil {
+			return nil, nil, fmt.Errorf("error storing vapid key pair: %w", err)
+		}
+	}
+
+	private, err := parseVAPIDPrivateKey(keyPair)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k.keyPair = keyPair
+	k.private = private
+	return k.keyPair, k.private, nil
+}
+
+// generateVAPIDKeyPair generates a new P-256 key pair, encoded as
+// expected by push services and clients (RFC 8292 section 3.2).
+func generateVAPIDKeyPair() (*gtsmodel.VAPIDKeyPair, error) {
+	curve := elliptic.P256()
+
+	private, x, y, err := elliptic.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gtsmodel.VAPIDKeyPair{
+		ID:      1,
+		Public:  base64.RawURLEncoding.EncodeToString(elliptic.Marshal(curve, x, y)),
+		Private: base64.RawURLEncoding.EncodeToString(private),
+	}, nil
+}
+
+// parseVAPIDPrivateKey parses the given key pair into
+// an ecdsa private key which can be used for signing.
+func parseVAPIDPrivateKey(keyPair *gtsmodel.VAPIDKeyPair) (*ecdsa.PrivateKey, error) {
+	privateBytes, err := base64.RawURLEncoding.DecodeString(keyPair.Private)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding vapid private key: %w", err)
+	}
+
+	// The private key is a scalar in the range [1, N-1],
+	// from which the public key can be derived.
+	curve := elliptic.P256()
+	d := new(big.Int).SetBytes(privateBytes)
+	if len(privateBytes) != 32 || d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, errors.New("error parsing vapid private key: not a valid P-256 scalar")
+	}
+
+	x, y := curve.ScalarBaseMult(privateBytes)
+
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: curve,
+			X:     x,
+			Y:     y,
+		},
+		D: d,
+	}, nil
+}
+
+// vapidAuthorization returns an Authorization header value
+// for pushing to the given endpoint, in the form described
+// in RFC 8292 section 3, signed with the given key pair.
+func vapidAuthorization(endpoint *url.URL, keyPair *gtsmodel.VAPIDKeyPair, private *ecdsa.PrivateKey, now time.Time) (string, error) {
+	subject := config.GetWebPushVAPIDSubject()
+	if subject == "" {
+		subject = "https://" + config.GetHost()
+	}
+
+	header, err := json.Marshal(map[string]string{
+		"typ": "JWT",
+		"alg": "ES256",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"aud": endpoint.Scheme + "://" + endpoint.Host,
+		"exp": now.Add(vapidTokenTTL).Unix(),
+		"sub": subject,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	r, s, err := ecdsa.Sign(rand.Reader, private, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("error signing vapid token: %w", err)
+	}
+
+	// JWS ES256 signatures are the fixed
+	// width concatenation of r and s.
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	token := signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+	return "vapid t=" + token + ", k=" + keyPair.Public, nil
+}
@@ -0,0 +1,130 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+func TestVAPIDAuthorization(t *testing.T) {
+	config.SetHost("example.org")
+	config.SetWebPushVAPIDSubject("")
+
+	keyPair, err := generateVAPIDKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	private, err := parseVAPIDPrivateKey(keyPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint, _ := url.Parse("https://push.example.com/wpush/v2/abcdef")
+	now := time.Unix(1700000000, 0)
+
+	authorization, err := vapidAuthorization(endpoint, keyPair, private, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// vapid t=<token>, k=<key>
+	token, key, ok := strings.Cut(strings.TrimPrefix(authorization, "vapid t="), ", k=")
+	if !ok {
+		t.Fatalf("unexpected authorization format: %s", authorization)
+	}
+
+	if key != keyPair.Public {
+		t.Fatalf("wanted key %s, got %s", keyPair.Public, key)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("wanted 3 token parts, got %d", len(parts))
+	}
+
+	claimsBytes, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(claimsBytes, &claims); err != nil {
+		t.Fatal(err)
+	}
+
+	if aud := claims["aud"]; aud != "https://push.example.com" {
+		t.Fatalf("wanted aud https://push.example.com, got %v", aud)
+	}
+
+	if sub := claims["sub"]; sub != "https://example.org" {
+		t.Fatalf("wanted sub https://example.org, got %v", sub)
+	}
+
+	if exp := claims["exp"]; exp != float64(now.Add(vapidTokenTTL).Unix()) {
+		t.Fatalf("unexpected exp %v", exp)
+	}
+
+	// Signature should verify against the public key.
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	if len(signature) != 64 {
+		t.Fatalf("wanted 64 byte signature, got %d", len(signature))
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	if !ecdsa.Verify(&private.PublicKey, digest[:], r, s) {
+		t.Fatal("signature didn't verify")
+	}
+}
+
+func TestVAPIDAuthorizationSubject(t *testing.T) {
+	config.SetHost("example.org")
+	config.SetWebPushVAPIDSubject("mailto:admin@example.org")
+	defer config.SetWebPushVAPIDSubject("")
+
+	keyPair, err := generateVAPIDKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	private, err := parseVAPIDPrivateKey(keyPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint, _ := url.Parse("https://push.example.com/wpush/v2/abcdef")
+	authorization, err := vapidAuthorization(endpoint, keyPair, private, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, _, _ := strings.Cut(strings.TrimPrefix(authorization, "vapid t="), ", k=")
+	claimsBytes, _ := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[1])
+	if !strings.Contains(string(claimsBytes), `"sub":"mailto:admin@example.org"`) {
+		t.Fatalf("subject not set in claims %s", claimsBytes)
+	}
+}
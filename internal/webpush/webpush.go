@@ -0,0 +1,79 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// ErrGone is returned by Send when the push service reports that a
+// subscription no longer exists. The subscription should be deleted.
+var ErrGone = errors.New("web push subscription no longer exists")
+
+// Sender contains functions for sending Web Push notifications to client apps.
+type Sender interface {
+	// VAPIDPublicKey returns the base64url encoded public key that push services
+	// can use to verify notifications sent by this instance. The instance key pair
+	// will be generated and stored first if it doesn't exist yet.
+	VAPIDPublicKey(ctx context.Context) (string, error)
+
+	// Send encrypts the given payload for the given subscription, and delivers it
+	// to the subscription's push endpoint. If the push service reports that the
+	// subscription no longer exists, ErrGone will be returned.
+	Send(ctx context.Context, subscription *gtsmodel.WebPushSubscription, payload *Payload) error
+}
+
+// HTTPClient is the subset of http client functionality used for delivering notifications.
+type HTTPClient interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// Payload is the JSON payload of a Web Push notification,
+// in the form expected by Mastodon API client apps.
+type Payload struct {
+	// Access token of the subscription, so the client
+	// knows which of its accounts the notification is for.
+	AccessToken string `json:"access_token"`
+	// Preferred locale of the receiving user.
+	PreferredLocale string `json:"preferred_locale"`
+	// ID of the notification.
+	NotificationID string `json:"notification_id"`
+	// Type of the notification, eg 'mention'.
+	NotificationType string `json:"notification_type"`
+	// URL of the avatar of the account that triggered the notification.
+	Icon string `json:"icon"`
+	// Title of the notification, eg 'Someone mentioned you'.
+	Title string `json:"title"`
+	// Plaintext body of the notification.
+	Body string `json:"body"`
+}
+
+// NewSender returns a new Web Push Sender, which stores its
+// keys in the given db and sends notifications using the given client.
+func NewSender(db db.DB, client HTTPClient) Sender {
+	return &sender{
+		keys:   &keyStore{db: db},
+		client: client,
+	}
+}
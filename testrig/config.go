@@ -68,6 +68,10 @@ var testDefaults = config.Configuration{
 	InstanceExposeSuspendedWeb:     true,
 	InstanceDeliverToSharedInboxes: true,
 
+	InstanceDeliveryMaxAge:           time.Hour * 48,
+	InstanceDeliveryUnreachableAfter: time.Hour * 24 * 7,
+	InstanceDeliveryUnreachableRetry: time.Hour * 24,
+
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
 	AccountsReasonRequired:   true,
@@ -92,6 +96,7 @@ var testDefaults = config.Configuration{
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
+	StatusesPinnedMax:          5,
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         0,
@@ -113,6 +118,9 @@ var testDefaults = config.Configuration{
 	SMTPPassword: "",
 	SMTPFrom:     "GoToSocial",
 
+	WebPushEnabled:      true,
+	WebPushVAPIDSubject: "",
+
 	SyslogEnabled:  false,
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
@@ -36,6 +36,7 @@ var testModels = []interface{}{
 	&gtsmodel.AccountToEmoji{},
 	&gtsmodel.Application{},
 	&gtsmodel.Block{},
+	&gtsmodel.UserMute{},
 	&gtsmodel.DomainBlock{},
 	&gtsmodel.EmailDomainBlock{},
 	&gtsmodel.Follow{},
@@ -48,17 +49,38 @@ var testModels = []interface{}{
 	&gtsmodel.StatusFave{},
 	&gtsmodel.StatusBookmark{},
 	&gtsmodel.StatusMute{},
+	&gtsmodel.StatusEdit{},
 	&gtsmodel.Tag{},
+	&gtsmodel.FollowedTag{},
+	&gtsmodel.FeaturedTag{},
+	&gtsmodel.ScheduledStatus{},
+	&gtsmodel.Invite{},
+	&gtsmodel.Conversation{},
+	&gtsmodel.ConversationToStatus{},
+	&gtsmodel.Marker{},
+	&gtsmodel.Announcement{},
+	&gtsmodel.AnnouncementReaction{},
+	&gtsmodel.AnnouncementDismissal{},
 	&gtsmodel.User{},
 	&gtsmodel.Emoji{},
+	&gtsmodel.Filter{},
+	&gtsmodel.FilterKeyword{},
 	&gtsmodel.Instance{},
+	&gtsmodel.List{},
+	&gtsmodel.ListEntry{},
 	&gtsmodel.Notification{},
+	&gtsmodel.Poll{},
+	&gtsmodel.PollVote{},
 	&gtsmodel.RouterSession{},
 	&gtsmodel.Token{},
 	&gtsmodel.Client{},
 	&gtsmodel.EmojiCategory{},
 	&gtsmodel.Tombstone{},
 	&gtsmodel.Report{},
+	&gtsmodel.Delivery{},
+	&gtsmodel.FailingInbox{},
+	&gtsmodel.WebPushSubscription{},
+	&gtsmodel.VAPIDKeyPair{},
 }
 
 // NewTestDB returns a new initialized, empty database for testing.
@@ -152,6 +174,12 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	for _, v := range NewTestUserMutes() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
 	for _, v := range NewTestReports() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(err)
@@ -164,6 +192,12 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	for _, v := range NewTestEmailDomainBlocks() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
 	for _, v := range NewTestInstances() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(err)
@@ -226,6 +260,66 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	for _, v := range NewTestFollowedTags() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestFeaturedTags() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestScheduledStatuses() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestInvites() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestConversations() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestConversationToStatuses() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestMarkers() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestAnnouncements() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestAnnouncementReactions() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestAnnouncementDismissals() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
 	for _, v := range NewTestStatusToTags() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(err)
@@ -250,6 +344,42 @@ func StandardDBSetup(db db.DB, accounts map[string]*gtsmodel.Account) {
 		}
 	}
 
+	for _, v := range NewTestLists() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestListEntries() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestFilters() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestFilterKeywords() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestPolls() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
+	for _, v := range NewTestPollVotes() {
+		if err := db.Put(ctx, v); err != nil {
+			log.Panic(err)
+		}
+	}
+
 	for _, v := range NewTestNotifications() {
 		if err := db.Put(ctx, v); err != nil {
 			log.Panic(err)
@@ -0,0 +1,60 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package testrig
+
+import (
+	"context"
+	"net"
+)
+
+// MXResolver is a stub MX record resolver which never makes any network calls.
+//
+// It maps email domains to the hostnames of their mail servers.
+type MXResolver map[string][]string
+
+// LookupMX returns MX records for the given name from the map,
+// or a 'not found' DNS error if the name isn't in the map.
+func (r MXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	hosts, ok := r[name]
+	if !ok {
+		return nil, &net.DNSError{
+			Err:        "no such host",
+			Name:       name,
+			IsNotFound: true,
+		}
+	}
+
+	records := make([]*net.MX, 0, len(hosts))
+	for i, host := range hosts {
+		records = append(records, &net.MX{
+			Host: host,
+			Pref: uint16(10 * (i + 1)),
+		})
+	}
+
+	return records, nil
+}
+
+// NewTestMXResolver returns a stub MX record resolver with some test domains.
+func NewTestMXResolver() MXResolver {
+	return MXResolver{
+		"example.org":      {"mail.example.org."},
+		"sneaky-inbox.com": {"mx1.spam-mail.org.", "mx2.spam-mail.org."},
+	}
+}
@@ -342,7 +342,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/localhost:8080/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/localhost:8080/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			SensitizedAt:            time.Time{},
@@ -380,7 +379,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/weed_lord420/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/weed_lord420/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/weed_lord420#main-key",
@@ -420,7 +418,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/admin/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/admin/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			SensitizedAt:            time.Time{},
@@ -459,7 +456,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/the_mighty_zork/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/the_mighty_zork/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/the_mighty_zork/main-key",
@@ -499,7 +495,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/1happyturtle/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/1happyturtle/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/1happyturtle#main-key",
@@ -535,7 +530,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:          "http://fossbros-anonymous.io/users/foss_satan/following",
 			FeaturedCollectionURI: "http://fossbros-anonymous.io/users/foss_satan/collections/featured",
 			ActorType:             ap.ActorPerson,
-			AlsoKnownAs:           "",
 			PrivateKey:            &rsa.PrivateKey{},
 			PublicKey:             &rsa.PublicKey{},
 			PublicKeyURI:          "http://fossbros-anonymous.io/users/foss_satan/main-key",
@@ -571,7 +565,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:          "http://example.org/users/Some_User/following",
 			FeaturedCollectionURI: "http://example.org/users/Some_User/collections/featured",
 			ActorType:             ap.ActorPerson,
-			AlsoKnownAs:           "",
 			PrivateKey:            &rsa.PrivateKey{},
 			PublicKey:             &rsa.PublicKey{},
 			PublicKeyURI:          "http://example.org/users/Some_User#main-key",
@@ -607,7 +600,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://thequeenisstillalive.technology/users/her_fuckin_maj/following",
 			FeaturedCollectionURI:   "http://thequeenisstillalive.technology/users/her_fuckin_maj/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://thequeenisstillalive.technology/users/her_fuckin_maj#main-key",
@@ -1203,6 +1195,56 @@ func NewTestDomainBlocks() map[string]*gtsmodel.DomainBlock {
 	}
 }
 
+func NewTestEmailDomainBlocks() map[string]*gtsmodel.EmailDomainBlock {
+	return map[string]*gtsmodel.EmailDomainBlock{
+		"spam-mail.org": {
+			ID:                 "01GYQM9PW0Y1S2DTQRHVZVX36R",
+			CreatedAt:          TimeMustParse("2022-10-06T10:11:12+02:00"),
+			UpdatedAt:          TimeMustParse("2022-10-06T10:11:12+02:00"),
+			Domain:             "spam-mail.org",
+			CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+		},
+	}
+}
+
+// NewTestInvites returns a map of invite codes created by local accounts.
+func NewTestInvites() map[string]*gtsmodel.Invite {
+	return map[string]*gtsmodel.Invite{
+		"local_account_1_invite": {
+			ID:           "01GZ0RJNPVH1A83WNKPVXSGBQ7",
+			CreatedAt:    TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:    TimeMustParse("2022-06-04T13:12:00Z"),
+			Code:         "8wLRgQw7",
+			AccountID:    "01F8MH1H7YV1Z7D2C8K2730QBF",
+			MaxUses:      5,
+			Uses:         1,
+			ExpiresAt:    TimeMustParse("2050-01-01T12:00:00Z"),
+			SkipApproval: FalseBool(),
+		},
+		"admin_account_invite": {
+			ID:           "01GZ0S4Y9KQ0T0X6YAM2QDMR5E",
+			CreatedAt:    TimeMustParse("2022-06-05T10:00:00Z"),
+			UpdatedAt:    TimeMustParse("2022-06-05T10:00:00Z"),
+			Code:         "Mk2zTbR4",
+			AccountID:    "01F8MH17FWEB39HZJ76B6VXSKF",
+			MaxUses:      1,
+			Uses:         0,
+			SkipApproval: TrueBool(),
+		},
+		"admin_account_invite_expired": {
+			ID:           "01GZ0SBN2Z1XH4C9EXR8Y6T7PV",
+			CreatedAt:    TimeMustParse("2022-05-01T10:00:00Z"),
+			UpdatedAt:    TimeMustParse("2022-05-01T10:00:00Z"),
+			Code:         "pXcLMsF2",
+			AccountID:    "01F8MH17FWEB39HZJ76B6VXSKF",
+			MaxUses:      0,
+			Uses:         3,
+			ExpiresAt:    TimeMustParse("2022-06-01T10:00:00Z"),
+			SkipApproval: FalseBool(),
+		},
+	}
+}
+
 type filenames struct {
 	Original string
 	Small    string
@@ -1661,6 +1703,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Content:                  "🐢 hi followers! did u know i'm a turtle? 🐢",
 			Text:                     "🐢 hi followers! did u know i'm a turtle? 🐢",
 			AttachmentIDs:            []string{},
+			PollID:                   "01GWCS94GZYSDWPPJ9FQHEWVAZ",
 			CreatedAt:                TimeMustParse("2021-10-20T12:40:37+02:00"),
 			UpdatedAt:                TimeMustParse("2021-10-20T12:40:37+02:00"),
 			Local:                    TrueBool(),
@@ -1739,6 +1782,170 @@ func NewTestTags() map[string]*gtsmodel.Tag {
 	}
 }
 
+// NewTestFollowedTags returns a map of hashtags followed by local accounts.
+func NewTestFollowedTags() map[string]*gtsmodel.FollowedTag {
+	return map[string]*gtsmodel.FollowedTag{
+		"local_account_2_Hashtag": {
+			ID:        "01H1DYRDWRGYZTDQSPHY0JZA9S",
+			CreatedAt: TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt: TimeMustParse("2022-06-04T13:12:00Z"),
+			AccountID: "01F8MH5NBDF2MV7CTC4Q5128HF",
+			TagID:     "01FCT9SGYA71487N8D0S1M638G",
+		},
+	}
+}
+
+// NewTestFeaturedTags returns a map of hashtags featured by local accounts.
+func NewTestFeaturedTags() map[string]*gtsmodel.FeaturedTag {
+	return map[string]*gtsmodel.FeaturedTag{
+		"admin_account_welcome": {
+			ID:        "01H2WQ0SXKWC7NSS0ZY53PM7X9",
+			CreatedAt: TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt: TimeMustParse("2022-06-04T13:12:00Z"),
+			AccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+			TagID:     "01F8MHA1A2NF9MJ3WCCQ3K8BSZ",
+		},
+	}
+}
+
+// NewTestScheduledStatuses returns a map of statuses scheduled to be published later by local accounts.
+func NewTestScheduledStatuses() map[string]*gtsmodel.ScheduledStatus {
+	return map[string]*gtsmodel.ScheduledStatus{
+		"local_account_1_scheduled_status_1": {
+			ID:            "01H2G8KFH3R8JXWN5M5TWYV2PE",
+			CreatedAt:     TimeMustParse("2022-06-04T13:12:00Z"),
+			UpdatedAt:     TimeMustParse("2022-06-04T13:12:00Z"),
+			AccountID:     "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+			ScheduledAt:   TimeMustParse("2050-01-01T12:00:00Z"),
+			Text:          "this is a message from the future",
+			Sensitive:     FalseBool(),
+			Visibility:    gtsmodel.VisibilityPublic,
+			Language:      "en",
+		},
+	}
+}
+
+// NewTestConversations returns a map of direct message conversations keyed by the owning account and a number.
+func NewTestConversations() map[string]*gtsmodel.Conversation {
+	return map[string]*gtsmodel.Conversation{
+		"local_account_1_conversation_1": {
+			ID:               "01H2H0Z4DNGB5P0N6Y5Z7ZP2FK",
+			CreatedAt:        TimeMustParse("2021-10-20T12:40:37+02:00"),
+			UpdatedAt:        TimeMustParse("2021-10-20T12:40:37+02:00"),
+			AccountID:        "01F8MH1H7YV1Z7D2C8K2730QBF",
+			OtherAccountIDs:  []string{"01F8MH5NBDF2MV7CTC4Q5128HF"},
+			OtherAccountsKey: "01F8MH5NBDF2MV7CTC4Q5128HF",
+			ThreadID:         "01FN3VJGFH10KR7S2PB0GFJZYG",
+			LastStatusID:     "01FN3VJGFH10KR7S2PB0GFJZYG",
+			Read:             FalseBool(),
+		},
+		"local_account_2_conversation_1": {
+			ID:               "01H2H0ZWWD4XPX2W1N2Y8D3HCR",
+			CreatedAt:        TimeMustParse("2021-10-20T12:40:37+02:00"),
+			UpdatedAt:        TimeMustParse("2021-10-20T12:40:37+02:00"),
+			AccountID:        "01F8MH5NBDF2MV7CTC4Q5128HF",
+			OtherAccountIDs:  []string{"01F8MH1H7YV1Z7D2C8K2730QBF"},
+			OtherAccountsKey: "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ThreadID:         "01FN3VJGFH10KR7S2PB0GFJZYG",
+			LastStatusID:     "01FN3VJGFH10KR7S2PB0GFJZYG",
+			Read:             TrueBool(),
+		},
+	}
+}
+
+// NewTestConversationToStatuses returns a map of links between test conversations and the statuses in them.
+func NewTestConversationToStatuses() map[string]*gtsmodel.ConversationToStatus {
+	return map[string]*gtsmodel.ConversationToStatus{
+		"local_account_1_conversation_1_local_account_2_status_6": {
+			ConversationID: "01H2H0Z4DNGB5P0N6Y5Z7ZP2FK",
+			StatusID:       "01FN3VJGFH10KR7S2PB0GFJZYG",
+		},
+		"local_account_2_conversation_1_local_account_2_status_6": {
+			ConversationID: "01H2H0ZWWD4XPX2W1N2Y8D3HCR",
+			StatusID:       "01FN3VJGFH10KR7S2PB0GFJZYG",
+		},
+	}
+}
+
+// NewTestMarkers returns a map of timeline markers keyed by the owning account and timeline.
+func NewTestMarkers() map[string]*gtsmodel.Marker {
+	return map[string]*gtsmodel.Marker{
+		"local_account_1_home_marker": {
+			AccountID:  "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Name:       gtsmodel.MarkerNameHome,
+			UpdatedAt:  TimeMustParse("2022-05-14T13:21:09+02:00"),
+			Version:    0,
+			LastReadID: "01F8MH82FYRXD2RC6108DAJ5HB",
+		},
+		"local_account_1_notification_marker": {
+			AccountID:  "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Name:       gtsmodel.MarkerNameNotifications,
+			UpdatedAt:  TimeMustParse("2022-05-14T13:21:09+02:00"),
+			Version:    4,
+			LastReadID: "01F8Q0ANPTWW10DAKTX7BRPBJP",
+		},
+	}
+}
+
+// NewTestAnnouncements returns a map of instance announcements, keyed by a description of the announcement.
+func NewTestAnnouncements() map[string]*gtsmodel.Announcement {
+	return map[string]*gtsmodel.Announcement{
+		"announcement_1": {
+			ID:                  "01H2M8F3Z0H1GQ5V6X7Y8Z9A0B",
+			CreatedAt:           TimeMustParse("2023-04-01T10:00:00+02:00"),
+			UpdatedAt:           TimeMustParse("2023-04-01T10:00:00+02:00"),
+			Text:                "Welcome to the instance! :rainbow:",
+			Content:             "<p>Welcome to the instance! :rainbow:</p>",
+			AllDay:              FalseBool(),
+			Published:           TrueBool(),
+			PublishedAt:         TimeMustParse("2023-04-01T10:00:00+02:00"),
+			MentionedAccountIDs: []string{},
+			TagIDs:              []string{},
+			EmojiIDs:            []string{"01F8MH9H8E4VG3KDYJR9EGPXCQ"},
+		},
+		"announcement_2_scheduled": {
+			ID:                  "01H2M8H6D7R5XQ0CJ9S3W2N4KA",
+			CreatedAt:           TimeMustParse("2023-04-02T10:00:00+02:00"),
+			UpdatedAt:           TimeMustParse("2023-04-02T10:00:00+02:00"),
+			Text:                "Scheduled maintenance next week.",
+			Content:             "<p>Scheduled maintenance next week.</p>",
+			StartsAt:            TimeMustParse("2099-04-09T10:00:00+02:00"),
+			EndsAt:              TimeMustParse("2099-04-09T12:00:00+02:00"),
+			AllDay:              FalseBool(),
+			ScheduledAt:         TimeMustParse("2099-04-02T10:00:00+02:00"),
+			Published:           FalseBool(),
+			MentionedAccountIDs: []string{},
+			TagIDs:              []string{},
+			EmojiIDs:            []string{},
+		},
+	}
+}
+
+// NewTestAnnouncementReactions returns a map of reactions to announcements, keyed by announcement and reacting account.
+func NewTestAnnouncementReactions() map[string]*gtsmodel.AnnouncementReaction {
+	return map[string]*gtsmodel.AnnouncementReaction{
+		"announcement_1_local_account_2_reaction": {
+			ID:             "01H2M8JXQ2TBZ5C7ZB1N7A9FGE",
+			CreatedAt:      TimeMustParse("2023-04-01T11:00:00+02:00"),
+			AnnouncementID: "01H2M8F3Z0H1GQ5V6X7Y8Z9A0B",
+			AccountID:      "01F8MH5NBDF2MV7CTC4Q5128HF",
+			Name:           "👍",
+		},
+	}
+}
+
+// NewTestAnnouncementDismissals returns a map of dismissed announcements, keyed by announcement and dismissing account.
+func NewTestAnnouncementDismissals() map[string]*gtsmodel.AnnouncementDismissal {
+	return map[string]*gtsmodel.AnnouncementDismissal{
+		"announcement_1_local_account_2_dismissal": {
+			AnnouncementID: "01H2M8F3Z0H1GQ5V6X7Y8Z9A0B",
+			AccountID:      "01F8MH5NBDF2MV7CTC4Q5128HF",
+			CreatedAt:      TimeMustParse("2023-04-01T11:00:00+02:00"),
+		},
+	}
+}
+
 func NewTestStatusToTags() map[string]*gtsmodel.StatusToTag {
 	return map[string]*gtsmodel.StatusToTag{
 		"admin_account_status_1_welcome": {
@@ -1909,6 +2116,99 @@ func NewTestFollows() map[string]*gtsmodel.Follow {
 	}
 }
 
+func NewTestLists() map[string]*gtsmodel.List {
+	return map[string]*gtsmodel.List{
+		"local_account_1_list_1": {
+			ID:            "01H0G8E4Q2J3FE3JDWJVWEDCD1",
+			CreatedAt:     TimeMustParse("2022-05-27T13:56:09+02:00"),
+			UpdatedAt:     TimeMustParse("2022-05-27T13:56:09+02:00"),
+			Title:         "Cool Ass Posters From This Instance",
+			AccountID:     "01F8MH1H7YV1Z7D2C8K2730QBF",
+			RepliesPolicy: gtsmodel.RepliesPolicyFollowed,
+		},
+	}
+}
+
+func NewTestListEntries() map[string]*gtsmodel.ListEntry {
+	return map[string]*gtsmodel.ListEntry{
+		"local_account_1_list_1_entry_1": {
+			ID:        "01H0G89MWVQE0M58VD2HQYMQWH",
+			CreatedAt: TimeMustParse("2022-05-27T13:56:09+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-27T13:56:09+02:00"),
+			ListID:    "01H0G8E4Q2J3FE3JDWJVWEDCD1",
+			FollowID:  "01F8PYDCE8XE23GRE5DPZJDZDP",
+		},
+		"local_account_1_list_1_entry_2": {
+			ID:        "01H0G8FFM1AGQDRNGBGGX8CYJQ",
+			CreatedAt: TimeMustParse("2022-05-27T13:57:09+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-27T13:57:09+02:00"),
+			ListID:    "01H0G8E4Q2J3FE3JDWJVWEDCD1",
+			FollowID:  "01F8PY8RHWRQZV038T4E8T9YK8",
+		},
+	}
+}
+
+func NewTestFilters() map[string]*gtsmodel.Filter {
+	return map[string]*gtsmodel.Filter{
+		"local_account_1_filter_1": {
+			ID:                   "01HN26VM6KZTW1ANNRVSBMA461",
+			CreatedAt:            TimeMustParse("2022-05-27T13:56:09+02:00"),
+			UpdatedAt:            TimeMustParse("2022-05-27T13:56:09+02:00"),
+			AccountID:            "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Title:                "fnord",
+			Action:               gtsmodel.FilterActionWarn,
+			ContextHome:          TrueBool(),
+			ContextNotifications: FalseBool(),
+			ContextPublic:        TrueBool(),
+			ContextThread:        FalseBool(),
+			ContextAccount:       FalseBool(),
+		},
+	}
+}
+
+func NewTestFilterKeywords() map[string]*gtsmodel.FilterKeyword {
+	return map[string]*gtsmodel.FilterKeyword{
+		"local_account_1_filter_1_keyword_1": {
+			ID:        "01HN272TAVWAXX72ZX4M8JZ0PS",
+			CreatedAt: TimeMustParse("2022-05-27T13:56:09+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-27T13:56:09+02:00"),
+			AccountID: "01F8MH1H7YV1Z7D2C8K2730QBF",
+			FilterID:  "01HN26VM6KZTW1ANNRVSBMA461",
+			Keyword:   "fnord",
+			WholeWord: TrueBool(),
+		},
+	}
+}
+
+func NewTestPolls() map[string]*gtsmodel.Poll {
+	return map[string]*gtsmodel.Poll{
+		"local_account_2_status_7_poll": {
+			ID:         "01GWCS94GZYSDWPPJ9FQHEWVAZ",
+			CreatedAt:  TimeMustParse("2021-10-20T12:40:37+02:00"),
+			UpdatedAt:  TimeMustParse("2021-10-20T12:40:37+02:00"),
+			StatusID:   "01G20ZM733MGN8J344T4ZDDFY1",
+			Multiple:   FalseBool(),
+			HideCounts: FalseBool(),
+			Options:    []string{"yes", "no", "i'm a turtle too"},
+			Votes:      []int{1, 0, 0},
+			Voters:     1,
+		},
+	}
+}
+
+func NewTestPollVotes() map[string]*gtsmodel.PollVote {
+	return map[string]*gtsmodel.PollVote{
+		"local_account_2_status_7_poll_vote_local_account_1": {
+			ID:        "01GWCSD1Q5XKN0J3WA8ZEY9F6B",
+			CreatedAt: TimeMustParse("2021-10-20T14:41:12+02:00"),
+			UpdatedAt: TimeMustParse("2021-10-20T14:41:12+02:00"),
+			PollID:    "01GWCS94GZYSDWPPJ9FQHEWVAZ",
+			AccountID: "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Choices:   []int{0},
+		},
+	}
+}
+
 func NewTestBlocks() map[string]*gtsmodel.Block {
 	return map[string]*gtsmodel.Block{
 		"local_account_2_block_remote_account_1": {
@@ -1922,6 +2222,28 @@ func NewTestBlocks() map[string]*gtsmodel.Block {
 	}
 }
 
+func NewTestUserMutes() map[string]*gtsmodel.UserMute {
+	return map[string]*gtsmodel.UserMute{
+		"local_account_1_mute_remote_account_3": {
+			ID:              "01H3D2N4RZ8P7M6K5J4H3G2F1E",
+			CreatedAt:       TimeMustParse("2022-06-04T13:12:00+02:00"),
+			UpdatedAt:       TimeMustParse("2022-06-04T13:12:00+02:00"),
+			AccountID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
+			TargetAccountID: "062G5WYKY35KKD12EMSM3F8PJ8",
+			Notifications:   TrueBool(),
+		},
+		"admin_account_mute_local_account_2_expired": {
+			ID:              "01GQ7YV3XW2B8K9N4T5R6M7P8Q",
+			CreatedAt:       TimeMustParse("2022-06-04T13:12:00+02:00"),
+			UpdatedAt:       TimeMustParse("2022-06-04T13:12:00+02:00"),
+			ExpiresAt:       TimeMustParse("2022-06-05T13:12:00+02:00"),
+			AccountID:       "01F8MH17FWEB39HZJ76B6VXSKF",
+			TargetAccountID: "01F8MH5NBDF2MV7CTC4Q5128HF",
+			Notifications:   FalseBool(),
+		},
+	}
+}
+
 func NewTestReports() map[string]*gtsmodel.Report {
 	return map[string]*gtsmodel.Report{
 		"local_account_2_report_remote_account_1": {
@@ -2431,6 +2753,33 @@ func NewTestFediStatuses() map[string]vocab.ActivityStreamsNote {
 				),
 			},
 		),
+		"https://turnip.farm/users/turniplover6969/statuses/2f1195a6-5cb0-4475-adf5-92ab9a0147fe": NewAPNote(
+			URLMustParse("https://turnip.farm/users/turniplover6969/statuses/2f1195a6-5cb0-4475-adf5-92ab9a0147fe"),
+			URLMustParse("https://turnip.farm/@turniplover6969/2f1195a6-5cb0-4475-adf5-92ab9a0147fe"),
+			TimeMustParse("2022-07-12T10:01:42+02:00"),
+			"turnip facts: turnips are neat. pinning this so everyone knows",
+			"",
+			URLMustParse("https://turnip.farm/users/turniplover6969"),
+			[]*url.URL{
+				URLMustParse(pub.PublicActivityPubIRI),
+			},
+			[]*url.URL{},
+			false,
+			nil,
+			nil,
+		),
+	}
+}
+
+// NewTestFediFeatured returns a bunch of featured (pinned status) collections of remote accounts, keyed by collection IRI.
+func NewTestFediFeatured() map[string]vocab.ActivityStreamsOrderedCollection {
+	return map[string]vocab.ActivityStreamsOrderedCollection{
+		"https://turnip.farm/users/turniplover6969/collections/featured": newAPFeatured(
+			URLMustParse("https://turnip.farm/users/turniplover6969/collections/featured"),
+			[]*url.URL{
+				URLMustParse("https://turnip.farm/users/turniplover6969/statuses/2f1195a6-5cb0-4475-adf5-92ab9a0147fe"),
+			},
+		),
 	}
 }
 
@@ -2541,6 +2890,22 @@ func NewTestDereferenceRequests(accounts map[string]*gtsmodel.Account) map[strin
 		DateHeader:      date,
 	}
 
+	target = URLMustParse(accounts["local_account_1"].FeaturedCollectionURI)
+	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
+	fossSatanDereferenceZorkFeatured := ActivityWithSignature{
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}
+
+	target = URLMustParse(accounts["admin_account"].URI + "/collections/tags")
+	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
+	fossSatanDereferenceAdminFeaturedTags := ActivityWithSignature{
+		SignatureHeader: sig,
+		DigestHeader:    digest,
+		DateHeader:      date,
+	}
+
 	target = URLMustParse(emojis["rainbow"].URI)
 	sig, digest, date = GetSignatureForDereference(accounts["remote_account_1"].PublicKeyURI, accounts["remote_account_1"].PrivateKey, target)
 	fossSatanDereferenceEmoji := ActivityWithSignature{
@@ -2560,6 +2925,8 @@ func NewTestDereferenceRequests(accounts map[string]*gtsmodel.Account) map[strin
 		"foss_satan_dereference_zork_outbox":                           fossSatanDereferenceZorkOutbox,
 		"foss_satan_dereference_zork_outbox_first":                     fossSatanDereferenceZorkOutboxFirst,
 		"foss_satan_dereference_zork_outbox_next":                      fossSatanDereferenceZorkOutboxNext,
+		"foss_satan_dereference_zork_featured":                         fossSatanDereferenceZorkFeatured,
+		"foss_satan_dereference_admin_featured_tags":                   fossSatanDereferenceAdminFeaturedTags,
 		"foss_satan_dereference_emoji":                                 fossSatanDereferenceEmoji,
 	}
 }
@@ -3462,3 +3829,24 @@ func newAPDelete(deleteTarget *url.URL, deleteActor *url.URL, deletePublished ti
 
 	return delete
 }
+
+// newAPFeatured returns an ordered collection of the given status IRIs, as served at an actor's featured IRI.
+func newAPFeatured(collectionID *url.URL, statusIRIs []*url.URL) vocab.ActivityStreamsOrderedCollection {
+	collection := streams.NewActivityStreamsOrderedCollection()
+
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.SetIRI(collectionID)
+	collection.SetJSONLDId(idProp)
+
+	itemsProp := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, iri := range statusIRIs {
+		itemsProp.AppendIRI(iri)
+	}
+	collection.SetActivityStreamsOrderedItems(itemsProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(len(statusIRIs))
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+
+	return collection
+}
@@ -66,6 +66,7 @@ type MockHTTPClient struct {
 	TestRemoteServices    map[string]vocab.ActivityStreamsService
 	TestRemoteAttachments map[string]RemoteAttachmentFile
 	TestRemoteEmojis      map[string]vocab.TootEmoji
+	TestRemoteFeatured    map[string]vocab.ActivityStreamsOrderedCollection
 	TestTombstones        map[string]*gtsmodel.Tombstone
 
 	SentMessages sync.Map
@@ -94,6 +95,7 @@ func NewMockHTTPClient(do func(req *http.Request) (*http.Response, error), relat
 	mockHTTPClient.TestRemoteServices = NewTestFediServices()
 	mockHTTPClient.TestRemoteAttachments = NewTestFediAttachments(relativeMediaPath)
 	mockHTTPClient.TestRemoteEmojis = NewTestFediEmojis()
+	mockHTTPClient.TestRemoteFeatured = NewTestFediFeatured()
 	mockHTTPClient.TestTombstones = NewTestTombstones()
 
 	mockHTTPClient.do = func(req *http.Request) (*http.Response, error) {
@@ -191,6 +193,19 @@ func NewMockHTTPClient(do func(req *http.Request) (*http.Response, error), relat
 			responseBytes = emojiJSON
 			responseContentType = applicationActivityJSON
 			responseContentLength = len(emojiJSON)
+		} else if featured, ok := mockHTTPClient.TestRemoteFeatured[req.URL.String()]; ok {
+			featuredI, err := streams.Serialize(featured)
+			if err != nil {
+				panic(err)
+			}
+			featuredJSON, err := json.Marshal(featuredI)
+			if err != nil {
+				panic(err)
+			}
+			responseCode = http.StatusOK
+			responseBytes = featuredJSON
+			responseContentType = applicationActivityJSON
+			responseContentLength = len(featuredJSON)
 		} else if attachment, ok := mockHTTPClient.TestRemoteAttachments[req.URL.String()]; ok {
 			responseCode = http.StatusOK
 			responseBytes = attachment.Data
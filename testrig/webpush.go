@@ -0,0 +1,45 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package testrig
+
+import (
+	"sync"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
+)
+
+// NewWebPushSender returns a noop Web Push sender that won't make any remote calls.
+//
+// If sentPushes is not nil, the noop callback function will place sent pushes in
+// the map, with the ID of the subscription as the key, and the value as the payload
+// as it would have been encrypted and sent. Pushes are sent asynchronously, hence
+// the sync.Map.
+func NewWebPushSender(db db.DB, sentPushes *sync.Map) webpush.Sender {
+	var sendCallback func(subscription *gtsmodel.WebPushSubscription, payload *webpush.Payload)
+
+	if sentPushes != nil {
+		sendCallback = func(subscription *gtsmodel.WebPushSubscription, payload *webpush.Payload) {
+			sentPushes.Store(subscription.ID, payload)
+		}
+	}
+
+	return webpush.NewNoopSender(db, sendCallback)
+}
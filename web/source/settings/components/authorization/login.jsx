@@ -32,7 +32,7 @@ module.exports = function Login({ }) {
 		instance: useTextInput("instance", {
 			defaultValue: window.location.origin
 		}),
-		scopes: useValue("scopes", "user admin")
+		scopes: useValue("scopes", "read write admin:read admin:write")
 	};
 
 	const [formSubmit, result] = useFormSubmit(
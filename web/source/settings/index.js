@@ -37,6 +37,7 @@ const nav = {
 	"User": {
 		"Profile": require("./user/profile.js"),
 		"Settings": require("./user/settings.js"),
+		"Invites": require("./user/invites.js"),
 	},
 	"Admin": {
 		adminOnly: true,
@@ -38,6 +38,62 @@ const endpoints = (build) => ({
 			url: `/api/v1/user/password_change`,
 			body: data
 		})
+	}),
+	twoFactorStatus: build.query({
+		query: () => ({
+			url: `/api/v1/user/2fa`
+		}),
+		providesTags: ["TwoFactor"]
+	}),
+	twoFactorSetup: build.mutation({
+		query: () => ({
+			method: "POST",
+			url: `/api/v1/user/2fa/setup`
+		})
+	}),
+	twoFactorEnable: build.mutation({
+		query: (data) => ({
+			method: "POST",
+			url: `/api/v1/user/2fa/enable`,
+			body: data
+		}),
+		invalidatesTags: ["TwoFactor"]
+	}),
+	twoFactorDisable: build.mutation({
+		query: (data) => ({
+			method: "POST",
+			url: `/api/v1/user/2fa/disable`,
+			body: data
+		}),
+		invalidatesTags: ["TwoFactor"]
+	}),
+	listInvites: build.query({
+		query: () => ({
+			url: `/api/v1/invites`,
+			params: {
+				limit: 40
+			}
+		}),
+		providesTags: (res) =>
+			res
+				? [...res.map((invite) => ({ type: "Invites", id: invite.id })), { type: "Invites", id: "LIST" }]
+				: [{ type: "Invites", id: "LIST" }]
+	}),
+	createInvite: build.mutation({
+		query: (formData) => ({
+			method: "POST",
+			url: `/api/v1/invites`,
+			asForm: true,
+			body: formData
+		}),
+		invalidatesTags: [{ type: "Invites", id: "LIST" }]
+	}),
+	revokeInvite: build.mutation({
+		query: (id) => ({
+			method: "DELETE",
+			url: `/api/v1/invites/${id}`
+		}),
+		invalidatesTags: (res, error, id) => [{ type: "Invites", id }]
 	})
 });
 
@@ -0,0 +1,164 @@
+/*
+	GoToSocial
+	Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+
+"use strict";
+
+const React = require("react");
+
+const query = require("../lib/query");
+
+const {
+	useTextInput,
+	useBoolInput
+} = require("../lib/form");
+
+const useFormSubmit = require("../lib/form/submit");
+
+const {
+	Select,
+	Checkbox
+} = require("../components/form/inputs");
+
+const FormWithData = require("../lib/form/form-with-data");
+const MutationButton = require("../components/form/mutation-button");
+
+module.exports = function UserInvites() {
+	const { data: account } = query.useVerifyCredentialsQuery();
+	const isAdmin = account?.role == "admin";
+
+	return (
+		<div className="invites">
+			<h1>Invites</h1>
+			<p>
+				Invite codes let people sign up to this instance, even when registration is closed.
+				Give someone a code, and they can enter it when creating their account.
+			</p>
+			<CreateInvite isAdmin={isAdmin} />
+			<h2>Your invites</h2>
+			<FormWithData
+				dataQuery={query.useListInvitesQuery}
+				DataForm={InvitesList}
+			/>
+		</div>
+	);
+};
+
+function CreateInvite({ isAdmin }) {
+	const form = {
+		maxUses: useTextInput("max_uses", { defaultValue: "1" }),
+		expiresIn: useTextInput("expires_in", { defaultValue: "604800" }),
+		skipApproval: useBoolInput("skip_approval"),
+	};
+
+	if (!isAdmin) {
+		delete form.skipApproval;
+	}
+
+	const [submitForm, result] = useFormSubmit(form, query.useCreateInviteMutation(), { changedOnly: false });
+
+	return (
+		<form className="create-invite" onSubmit={submitForm}>
+			<Select field={form.maxUses} label="Maximum number of uses" options={
+				<>
+					<option value="1">1 use</option>
+					<option value="5">5 uses</option>
+					<option value="10">10 uses</option>
+					<option value="25">25 uses</option>
+					<option value="0">No limit</option>
+				</>
+			}>
+			</Select>
+			<Select field={form.expiresIn} label="Expire after" options={
+				<>
+					<option value="3600">1 hour</option>
+					<option value="86400">1 day</option>
+					<option value="604800">1 week</option>
+					<option value="2592000">30 days</option>
+					<option value="0">Never</option>
+				</>
+			}>
+			</Select>
+			{isAdmin &&
+				<Checkbox
+					field={form.skipApproval}
+					label="Accounts signed up with this invite don't need approval"
+				/>
+			}
+			<MutationButton label="Create invite" result={result} />
+		</form>
+	);
+}
+
+function InvitesList({ data: invites }) {
+	if (invites.length == 0) {
+		return <p>You haven't created any invites yet.</p>;
+	}
+
+	return (
+		<div className="list">
+			{invites.map((invite) => (
+				<InviteEntry key={invite.id} invite={invite} />
+			))}
+		</div>
+	);
+}
+
+function InviteEntry({ invite }) {
+	const [revokeInvite, revokeResult] = query.useRevokeInviteMutation();
+
+	return (
+		<div className={`invite entry${invite.expired ? " expired" : ""}`}>
+			<div className="details">
+				<b>Code: </b>
+				<code>{invite.code}</code>
+
+				<b>Uses: </b>
+				<span>
+					{invite.uses}
+					{invite.max_uses != null && ` of ${invite.max_uses}`}
+				</span>
+
+				<b>Expires: </b>
+				<span>
+					{invite.expires_at != null
+						? new Date(invite.expires_at).toLocaleString()
+						: "never"
+					}
+				</span>
+
+				{invite.skip_approval &&
+					<>
+						<b>Approval: </b>
+						<span>skipped</span>
+					</>
+				}
+			</div>
+			{invite.expired
+				? <i>no longer valid</i>
+				: <MutationButton
+					label="Revoke"
+					type="button"
+					onClick={() => revokeInvite(invite.id)}
+					className="button danger"
+					result={revokeResult}
+				/>
+			}
+		</div>
+	);
+}
@@ -100,6 +100,9 @@ function UserSettingsForm({ data }) {
 			<div>
 				<PasswordChange />
 			</div>
+			<div>
+				<TwoFactor />
+			</div>
 		</>
 	);
 }
@@ -152,4 +155,126 @@ function PasswordChange() {
 			<MutationButton label="Change password" result={result} />
 		</form>
 	);
-}
\ No newline at end of file
+}
+
+function TwoFactor() {
+	return (
+		<div className="two-factor">
+			<h1>Two-factor authentication</h1>
+			<FormWithData
+				dataQuery={query.useTwoFactorStatusQuery}
+				DataForm={TwoFactorForm}
+			/>
+		</div>
+	);
+}
+
+function TwoFactorForm({ data: status }) {
+	// kept up here rather than in SetupTwoFactor, since the recovery
+	// codes returned when enabling have to stay on screen after the
+	// status has switched over to enabled
+	const enableMutation = query.useTwoFactorEnableMutation();
+	const recoveryCodes = enableMutation[1].data?.recovery_codes;
+
+	if (!status.enabled) {
+		return <SetupTwoFactor enableMutation={enableMutation} />;
+	}
+
+	return (
+		<>
+			{recoveryCodes &&
+				<RecoveryCodes codes={recoveryCodes} />
+			}
+			<p>
+				Two-factor authentication is enabled since {new Date(status.enabled_at).toLocaleString()},
+				with {status.recovery_codes_left} unused recovery codes left.
+			</p>
+			<DisableTwoFactor />
+		</>
+	);
+}
+
+function SetupTwoFactor({ enableMutation }) {
+	const [setup, setupResult] = query.useTwoFactorSetupMutation();
+
+	const form = {
+		password: useTextInput("password"),
+		code: useTextInput("code")
+	};
+
+	const [submitForm, result] = useFormSubmit(form, enableMutation, { changedOnly: false });
+
+	if (!setupResult.isSuccess) {
+		return (
+			<>
+				<p>
+					With two-factor authentication enabled, signing in with your password
+					also asks for a code from an authenticator app on your phone or computer.
+				</p>
+				<MutationButton
+					label="Set up two-factor authentication"
+					type="button"
+					onClick={() => setup()}
+					result={setupResult}
+				/>
+			</>
+		);
+	}
+
+	return (
+		<form className="setup" onSubmit={submitForm}>
+			<p>
+				Scan this QR code with your authenticator app, or enter the secret below it by hand.
+				Then enter your current password and the code shown by the app to finish setting up.
+			</p>
+			<img src={setupResult.data.qr_code} alt="QR code of your two-factor authentication secret" />
+			<code>{setupResult.data.secret}</code>
+			<TextInput
+				type="password"
+				field={form.password}
+				label="Current password"
+			/>
+			<TextInput
+				field={form.code}
+				label="Code from your authenticator app"
+				autoComplete="one-time-code"
+			/>
+			<MutationButton label="Enable two-factor authentication" result={result} />
+		</form>
+	);
+}
+
+function RecoveryCodes({ codes }) {
+	return (
+		<div className="recovery-codes">
+			<p>
+				Each of these recovery codes can be used once instead of a code from your authenticator app,
+				for example when you&apos;ve lost your phone. Store them somewhere safe: they won&apos;t be shown again.
+			</p>
+			<ul>
+				{codes.map((code) => (
+					<li key={code}><code>{code}</code></li>
+				))}
+			</ul>
+		</div>
+	);
+}
+
+function DisableTwoFactor() {
+	const form = {
+		password: useTextInput("password")
+	};
+
+	const [submitForm, result] = useFormSubmit(form, query.useTwoFactorDisableMutation(), { changedOnly: false });
+
+	return (
+		<form onSubmit={submitForm}>
+			<TextInput
+				type="password"
+				field={form.password}
+				label="Current password"
+			/>
+			<MutationButton label="Disable two-factor authentication" className="button danger" result={result} />
+		</form>
+	);
+}